@@ -0,0 +1,240 @@
+package api
+
+import (
+	pb "massnet.org/mass-wallet/api/proto"
+	"massnet.org/mass-wallet/blockchain"
+	cfg "massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/wire"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+)
+
+func (s *APIServer) SearchAddressTransactions(ctx context.Context, in *pb.SearchAddressTransactionsRequest) (*pb.SearchAddressTransactionsResponse, error) {
+	logging.CPrint(logging.INFO, "api: SearchAddressTransactions", logging.LogFormat{
+		"address":    in.Address,
+		"min_height": in.MinHeight,
+		"max_height": in.MaxHeight,
+		"skip":       in.Skip,
+		"count":      in.Count,
+		"ascending":  in.Ascending,
+	})
+
+	if in.Count > 1000 {
+		return nil, status.New(ErrAPIInvalidTxHistoryCount, ErrCode[ErrAPIInvalidTxHistoryCount]).Err()
+	}
+	addr, err := checkIndexedAddress(in.Address, &cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+	count := int(in.Count)
+	if count == 0 {
+		count = defaultSearchAddressTxCount
+	}
+	txs, total, bestHeight, err := s.fetchAddressTxs(addr, in.MinHeight, in.MaxHeight, int(in.Skip), count, in.Ascending)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SearchAddressTransactionsResponse{
+		Transactions: make([]*pb.SearchAddressTransactionsResponse_Transaction, 0, len(txs)),
+		Total:        uint32(total),
+	}
+	headers := make(map[uint64]*wire.BlockHeader)
+	for _, atx := range txs {
+		header, ok := headers[atx.Height]
+		if !ok {
+			header, err = s.node.Blockchain().GetHeaderByHeight(atx.Height)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "failed to query the block header according to the height", logging.LogFormat{
+					"height": atx.Height,
+					"error":  err,
+				})
+				return nil, status.New(ErrAPIBlockHeaderNotFound, ErrCode[ErrAPIBlockHeaderNotFound]).Err()
+			}
+			headers[atx.Height] = header
+		}
+		received, err := AmountToString(atx.Received)
+		if err != nil {
+			return nil, err
+		}
+		sent, err := AmountToString(atx.Sent)
+		if err != nil {
+			return nil, err
+		}
+		resp.Transactions = append(resp.Transactions, &pb.SearchAddressTransactionsResponse_Transaction{
+			TxId:          atx.Tx.TxHash().String(),
+			BlockHeight:   atx.Height,
+			BlockHash:     header.BlockHash().String(),
+			Timestamp:     header.Timestamp.Unix(),
+			Confirmations: 1 + bestHeight - atx.Height,
+			Received:      received,
+			Sent:          sent,
+		})
+	}
+
+	logging.CPrint(logging.INFO, "api: SearchAddressTransactions completed", logging.LogFormat{
+		"num":   len(resp.Transactions),
+		"total": resp.Total,
+	})
+	return resp, nil
+}
+
+func (s *APIServer) GetAddressUtxos(ctx context.Context, in *pb.GetAddressUtxosRequest) (*pb.GetAddressUtxosResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetAddressUtxos", logging.LogFormat{
+		"address":    in.Address,
+		"min_height": in.MinHeight,
+		"max_height": in.MaxHeight,
+		"skip":       in.Skip,
+		"count":      in.Count,
+	})
+
+	if in.Count > 1000 {
+		return nil, status.New(ErrAPIInvalidTxHistoryCount, ErrCode[ErrAPIInvalidTxHistoryCount]).Err()
+	}
+	if in.MaxHeight > 0 && in.MinHeight > in.MaxHeight {
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	addr, err := checkIndexedAddress(in.Address, &cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+	count := int(in.Count)
+	if count == 0 {
+		count = defaultSearchAddressTxCount
+	}
+	bestHeight := s.node.Blockchain().BestBlockHeight()
+	maxHeight := in.MaxHeight
+	if maxHeight == 0 || maxHeight > bestHeight {
+		maxHeight = bestHeight
+	}
+	utxos, err := s.node.Blockchain().FetchAddressUtxos(addr, in.MinHeight, maxHeight)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "FetchAddressUtxos failed", logging.LogFormat{"address": in.Address, "err": err})
+		return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+	}
+
+	total := massutil.ZeroAmount()
+	for _, utxo := range utxos {
+		total, err = total.AddInt(utxo.Value)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "amount error", logging.LogFormat{"err": err})
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+	}
+	resp := &pb.GetAddressUtxosResponse{
+		Address:   in.Address,
+		UtxoCount: uint32(len(utxos)),
+	}
+	if int(in.Skip) < len(utxos) {
+		utxos = utxos[in.Skip:]
+	} else {
+		utxos = utxos[:0]
+	}
+	if len(utxos) > count {
+		utxos = utxos[:count]
+	}
+	resp.Utxos = make([]*pb.GetAddressUtxosResponse_Utxo, 0, len(utxos))
+	txPool := s.node.TxMemPool()
+	for _, utxo := range utxos {
+		amt, err := AmountToString(utxo.Value)
+		if err != nil {
+			return nil, err
+		}
+		var confirmations uint64
+		if utxo.Height <= bestHeight {
+			confirmations = 1 + bestHeight - utxo.Height
+		}
+		resp.Utxos = append(resp.Utxos, &pb.GetAddressUtxosResponse_Utxo{
+			TxId:           utxo.OutPoint.Hash.String(),
+			Vout:           utxo.OutPoint.Index,
+			Amount:         amt,
+			BlockHeight:    utxo.Height,
+			Confirmations:  confirmations,
+			IsCoinbase:     utxo.IsCoinbase,
+			SpentByUnmined: txPool.CheckPoolOutPointSpend(&utxo.OutPoint),
+		})
+	}
+	resp.Total, err = checkFormatAmount(total)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: GetAddressUtxos completed", logging.LogFormat{
+		"num":   len(resp.Utxos),
+		"total": resp.UtxoCount,
+	})
+	return resp, nil
+}
+
+func (s *APIServer) GetAddressReceived(ctx context.Context, in *pb.GetAddressReceivedRequest) (*pb.GetAddressReceivedResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetAddressReceived", logging.LogFormat{
+		"address":    in.Address,
+		"min_height": in.MinHeight,
+		"max_height": in.MaxHeight,
+	})
+
+	addr, err := checkIndexedAddress(in.Address, &cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+	txs, _, _, err := s.fetchAddressTxs(addr, in.MinHeight, in.MaxHeight, 0, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	received, sent := massutil.ZeroAmount(), massutil.ZeroAmount()
+	for _, atx := range txs {
+		if received, err = received.AddInt(atx.Received); err == nil {
+			sent, err = sent.AddInt(atx.Sent)
+		}
+		if err != nil {
+			logging.CPrint(logging.ERROR, "amount error", logging.LogFormat{"err": err})
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+	}
+	resp := &pb.GetAddressReceivedResponse{
+		Address: in.Address,
+		TxCount: uint32(len(txs)),
+	}
+	if resp.Received, err = checkFormatAmount(received); err != nil {
+		return nil, err
+	}
+	if resp.Sent, err = checkFormatAmount(sent); err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: GetAddressReceived completed", logging.LogFormat{
+		"address":  in.Address,
+		"received": resp.Received,
+	})
+	return resp, nil
+}
+
+// fetchAddressTxs returns a page of the transactions of main chain related to
+// addr within the heights [minHeight, maxHeight], or up to the best height if
+// maxHeight is 0, as FetchAddressTxs does, along with the number of
+// transactions of addr within the heights and the best height.
+func (s *APIServer) fetchAddressTxs(addr massutil.Address, minHeight, maxHeight uint64, skip, count int,
+	ascending bool) ([]*blockchain.AddressTx, int, uint64, error) {
+	if maxHeight > 0 && minHeight > maxHeight {
+		return nil, 0, 0, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	bestHeight := s.node.Blockchain().BestBlockHeight()
+	if maxHeight == 0 || maxHeight > bestHeight {
+		maxHeight = bestHeight
+	}
+	if minHeight > maxHeight {
+		return []*blockchain.AddressTx{}, 0, bestHeight, nil
+	}
+	txs, total, err := s.node.Blockchain().FetchAddressTxs(addr, minHeight, maxHeight, skip, count, ascending)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "FetchAddressTxs failed", logging.LogFormat{
+			"address": addr.EncodeAddress(),
+			"err":     err,
+		})
+		return nil, 0, 0, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+	}
+	return txs, total, bestHeight, nil
+}
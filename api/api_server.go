@@ -13,7 +13,10 @@ import (
 	"massnet.org/mass-wallet/massutil"
 	"massnet.org/mass-wallet/netsync"
 
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	pb "massnet.org/mass-wallet/api/proto"
 	"massnet.org/mass-wallet/blockchain"
@@ -26,6 +29,9 @@ import (
 const (
 	maxMsgSize        = 1024 * 1024 * 64
 	GRPCListenAddress = "127.0.0.1"
+	// WalletIDMetadataKey is the grpc metadata key selecting the wallet of a
+	// request, served through http by the header "Wallet-Id".
+	WalletIDMetadataKey = "wallet-id"
 )
 
 type MassNode interface {
@@ -63,6 +69,32 @@ func NewAPIServer(node MassNode, masswallet *masswallet.WalletManager, quitClien
 	return srv, nil
 }
 
+// wallet returns the wallet selected by the metadata of ctx, or the wallet in
+// use if not specified.
+func (s *APIServer) wallet(ctx context.Context) (*masswallet.WalletManager, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return s.massWallet, nil
+	}
+	ids := md.Get(WalletIDMetadataKey)
+	if len(ids) == 0 || ids[0] == "" {
+		return s.massWallet, nil
+	}
+	if err := checkWalletIdLen(ids[0]); err != nil {
+		return nil, err
+	}
+	w, err := s.massWallet.ForWallet(ids[0])
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ForWallet failed", logging.LogFormat{"walletId": ids[0], "err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+	return w, nil
+}
+
 func (s *APIServer) Start() error {
 	address := fmt.Sprintf("%s%s%s", GRPCListenAddress, ":", s.config.Network.API.GRPCPort)
 	listen, err := net.Listen("tcp", address)
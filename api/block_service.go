@@ -0,0 +1,234 @@
+package api
+
+import (
+	"encoding/hex"
+
+	pb "massnet.org/mass-wallet/api/proto"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/pocec"
+	"massnet.org/mass-wallet/wire"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	chainTipActive    = "active"
+	chainTipValidFork = "valid-fork"
+)
+
+func (s *APIServer) GetBlock(ctx context.Context, in *pb.GetBlockRequest) (*pb.GetBlockResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetBlock", logging.LogFormat{"hash": in.Hash, "height": in.Height, "verbose": in.Verbose})
+
+	blockHash, err := s.fetchBlockHash(in.Hash, in.Height)
+	if err != nil {
+		return nil, err
+	}
+	block, err := s.node.Blockchain().GetBlockByHash(blockHash)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to query the block according to the block hash", logging.LogFormat{
+			"block": blockHash.String(),
+			"error": err,
+		})
+		return nil, status.New(ErrAPIBlockNotFound, ErrCode[ErrAPIBlockNotFound]).Err()
+	}
+	msgBlock := block.MsgBlock()
+	header, err := s.blockHeaderToPb(&msgBlock.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetBlockResponse{
+		Header: header,
+		Size:   uint32(msgBlock.PlainSize()),
+		TxIds:  make([]string, 0, len(msgBlock.Transactions)),
+	}
+	for _, tx := range block.Transactions() {
+		resp.TxIds = append(resp.TxIds, tx.Hash().String())
+	}
+	if !in.Verbose {
+		resp.Hex, err = messageToHex(msgBlock)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to encode block", logging.LogFormat{"block": blockHash.String(), "error": err})
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		logging.CPrint(logging.INFO, "api: GetBlock completed", logging.LogFormat{"hash": header.Hash})
+		return resp, nil
+	}
+
+	bestHeight := s.node.Blockchain().BestBlockHeight()
+	resp.Txs = make([]*pb.GetRawTransactionResponse, 0, len(msgBlock.Transactions))
+	for _, mtx := range msgBlock.Transactions {
+		tx, err := s.createTxRawResult(mtx, &msgBlock.Header, bestHeight)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to decode transaction", logging.LogFormat{
+				"block": blockHash.String(),
+				"txid":  mtx.TxHash().String(),
+				"error": err,
+			})
+			return nil, status.New(ErrAPIRawTx, ErrCode[ErrAPIRawTx]).Err()
+		}
+		resp.Txs = append(resp.Txs, tx)
+	}
+	logging.CPrint(logging.INFO, "api: GetBlock completed", logging.LogFormat{"hash": header.Hash})
+	return resp, nil
+}
+
+func (s *APIServer) GetBlockHeader(ctx context.Context, in *pb.GetBlockHeaderRequest) (*pb.BlockHeader, error) {
+	logging.CPrint(logging.INFO, "api: GetBlockHeader", logging.LogFormat{"hash": in.Hash, "height": in.Height})
+
+	blockHash, err := s.fetchBlockHash(in.Hash, in.Height)
+	if err != nil {
+		return nil, err
+	}
+	header, err := s.node.Blockchain().GetHeaderByHash(blockHash)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to query the block header according to the block hash", logging.LogFormat{
+			"block": blockHash.String(),
+			"error": err,
+		})
+		return nil, status.New(ErrAPIBlockHeaderNotFound, ErrCode[ErrAPIBlockHeaderNotFound]).Err()
+	}
+	resp, err := s.blockHeaderToPb(header)
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "api: GetBlockHeader completed", logging.LogFormat{"hash": resp.Hash})
+	return resp, nil
+}
+
+func (s *APIServer) GetBlockHash(ctx context.Context, in *pb.GetBlockHashRequest) (*pb.GetBlockHashResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetBlockHash", logging.LogFormat{"height": in.Height})
+
+	blockHash, err := s.fetchBlockHash("", in.Height)
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "api: GetBlockHash completed", logging.LogFormat{"hash": blockHash})
+	return &pb.GetBlockHashResponse{Hash: blockHash.String()}, nil
+}
+
+func (s *APIServer) GetChainTips(ctx context.Context, in *empty.Empty) (*pb.GetChainTipsResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetChainTips", logging.LogFormat{})
+
+	tips := s.node.Blockchain().ChainTips()
+	resp := &pb.GetChainTipsResponse{
+		Tips: make([]*pb.GetChainTipsResponse_ChainTip, 0, len(tips)),
+	}
+	for _, tip := range tips {
+		st := chainTipValidFork
+		if tip.Active {
+			st = chainTipActive
+		}
+		resp.Tips = append(resp.Tips, &pb.GetChainTipsResponse_ChainTip{
+			Hash:      tip.Hash.String(),
+			Height:    tip.Height,
+			BranchLen: tip.BranchLen,
+			Status:    st,
+		})
+	}
+	logging.CPrint(logging.INFO, "api: GetChainTips completed", logging.LogFormat{"tips": len(tips)})
+	return resp, nil
+}
+
+func (s *APIServer) GetMinedBlocks(ctx context.Context, in *pb.GetMinedBlocksRequest) (*pb.GetMinedBlocksResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetMinedBlocks", logging.LogFormat{"pubkey": in.PocPubKey})
+
+	bs, err := hex.DecodeString(in.PocPubKey)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode poc public key", logging.LogFormat{"pubkey": in.PocPubKey, "error": err})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	pubKey, err := pocec.ParsePubKey(bs, pocec.S256())
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to parse poc public key", logging.LogFormat{"pubkey": in.PocPubKey, "error": err})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	heights, err := s.node.Blockchain().FetchMinedBlocks(pubKey)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "FetchMinedBlocks failed", logging.LogFormat{"pubkey": in.PocPubKey, "error": err})
+		return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+	}
+	logging.CPrint(logging.INFO, "api: GetMinedBlocks completed", logging.LogFormat{"blocks": len(heights)})
+	return &pb.GetMinedBlocksResponse{Heights: heights}, nil
+}
+
+// fetchBlockHash returns the hash of the block specified by hash, or by height
+// in main chain if hash is empty.
+func (s *APIServer) fetchBlockHash(hash string, height uint64) (*wire.Hash, error) {
+	if hash != "" {
+		blockHash, err := wire.NewHashFromStr(hash)
+		if err != nil || len(hash) != wire.MaxHashStringSize {
+			logging.CPrint(logging.ERROR, "failed to decode the input string into hash", logging.LogFormat{
+				"input string": hash,
+				"error":        err,
+			})
+			return nil, status.New(ErrAPIInvalidBlockHash, ErrCode[ErrAPIInvalidBlockHash]).Err()
+		}
+		return blockHash, nil
+	}
+	if height > s.node.Blockchain().BestBlockHeight() {
+		return nil, status.New(ErrAPIBlockNotFound, ErrCode[ErrAPIBlockNotFound]).Err()
+	}
+	blockHash, err := s.node.Blockchain().GetBlockHashByHeight(height)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to query the block hash according to the height", logging.LogFormat{
+			"height": height,
+			"error":  err,
+		})
+		return nil, status.New(ErrAPIBlockNotFound, ErrCode[ErrAPIBlockNotFound]).Err()
+	}
+	return blockHash, nil
+}
+
+func (s *APIServer) blockHeaderToPb(header *wire.BlockHeader) (*pb.BlockHeader, error) {
+	chain := s.node.Blockchain()
+	blockHash := header.BlockHash()
+	resp := &pb.BlockHeader{
+		Hash:            blockHash.String(),
+		ChainId:         header.ChainID.String(),
+		Version:         header.Version,
+		Height:          header.Height,
+		Timestamp:       header.Timestamp.Unix(),
+		Previous:        header.Previous.String(),
+		TransactionRoot: header.TransactionRoot.String(),
+		WitnessRoot:     header.WitnessRoot.String(),
+		ProposalRoot:    header.ProposalRoot.String(),
+		Target:          header.Target.Text(16),
+		Challenge:       header.Challenge.String(),
+		Quality:         header.Quality().Text(16),
+		BanList:         make([]string, 0, len(header.BanList)),
+		InMainChain:     chain.InMainChain(blockHash),
+	}
+	if header.PubKey != nil {
+		resp.PubKey = hex.EncodeToString(header.PubKey.SerializeCompressed())
+	}
+	if header.Proof != nil {
+		resp.BitLength = uint32(header.Proof.BitLength)
+	}
+	if header.Signature != nil {
+		resp.Signature = hex.EncodeToString(header.Signature.Serialize())
+	}
+	for _, pubKey := range header.BanList {
+		resp.BanList = append(resp.BanList, hex.EncodeToString(pubKey.SerializeCompressed()))
+	}
+
+	if !resp.InMainChain {
+		return resp, nil
+	}
+	bestHeight := chain.BestBlockHeight()
+	resp.Confirmations = 1 + bestHeight - header.Height
+	if header.Height < bestHeight {
+		next, err := chain.GetBlockHashByHeight(header.Height + 1)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to query the block hash according to the height", logging.LogFormat{
+				"height": header.Height + 1,
+				"error":  err,
+			})
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		resp.Next = next.String()
+	}
+	return resp, nil
+}
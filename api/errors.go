@@ -2,19 +2,32 @@ package api
 
 const (
 	// transaction err
-	ErrAPINoTxInfo           = 1101
-	ErrAPIRawTx              = 1102
-	ErrAPIUserTxFee          = 1103
-	ErrAPIGetStakingTxDetail = 1105
-	ErrAPISignRawTx          = 1106
-	ErrAPIUnspendable        = 1107
-	ErrAPIDoubleSpend        = 1108
-	ErrAPIOverfullInputs     = 1109
-	ErrAPIBigTransactionFee  = 1110
+	ErrAPINoTxInfo             = 1101
+	ErrAPIRawTx                = 1102
+	ErrAPIUserTxFee            = 1103
+	ErrAPIGetStakingTxDetail   = 1105
+	ErrAPISignRawTx            = 1106
+	ErrAPIUnspendable          = 1107
+	ErrAPIDoubleSpend          = 1108
+	ErrAPIOverfullInputs       = 1109
+	ErrAPIBigTransactionFee    = 1110
+	ErrAPIIncompleteSignature  = 1111
+	ErrAPITxNotUnmined         = 1112
+	ErrAPITxNotReplaceable     = 1113
+	ErrAPIReplacementFee       = 1114
+	ErrAPINoChangeOutput       = 1115
+	ErrAPINothingToConsolidate = 1116
+	ErrAPINothingToSweep       = 1117
+	ErrAPIFeeEstimate          = 1118
+	ErrAPINotInMempool         = 1119
+	ErrAPINothingToWithdraw    = 1120
+	ErrAPIStakingNotExpired    = 1121
 
 	// block err
 	ErrAPINewestHash          = 1201
 	ErrAPIBlockHeaderNotFound = 1202
+	ErrAPIBlockNotFound       = 1203
+	ErrAPIInvalidBlockHash    = 1204
 
 	// wallet err
 	ErrAPINoAddressInWallet         = 1301
@@ -28,6 +41,9 @@ const (
 	ErrAPIChangePassUnsupported     = 1309
 	ErrAPIWalletUnlocked            = 1310
 	ErrAPIInvalidKeystoreVersion    = 1311
+	ErrAPIWalletLocked              = 1312
+	ErrAPIWatchOnlyWallet           = 1313
+	ErrAPIEventsOverflow            = 1314
 
 	// txScript
 	ErrAPIRejectTx          = 1401
@@ -59,6 +75,15 @@ const (
 	ErrAPIDustChange             = 1522
 	ErrAPIDustAmount             = 1523
 	ErrAPINotEnoughInputs        = 1524
+	ErrAPIInvalidTimeout         = 1525
+	ErrAPIInvalidMultisigPolicy  = 1526
+	ErrAPIInvalidCosignerXpub    = 1527
+	ErrAPIInvalidPsbt            = 1528
+	ErrAPIInvalidAccountXpub     = 1529
+	ErrAPIInvalidCursor          = 1530
+	ErrAPIInvalidTxCategory      = 1531
+	ErrAPIInvalidExportFormat    = 1532
+	ErrAPIInvalidWIF             = 1533
 
 	// other err
 	ErrAPIUnknownErr      = 1701
@@ -79,6 +104,8 @@ var ErrCode = map[uint32]string{
 	ErrAPINewestHash:                "Failed to get newest hash",
 	ErrAPIRawTx:                     "Failed to create raw transaction",
 	ErrAPIBlockHeaderNotFound:       "Failed to find block header",
+	ErrAPIBlockNotFound:             "Failed to find block",
+	ErrAPIInvalidBlockHash:          "Invalid block hash",
 	ErrAPIUnknownErr:                "Unknown error",
 	ErrAPIUserTxFee:                 "Invalid userTxFee",
 	ErrAPIGetStakingTxDetail:        "Failed to query staking tx detail",
@@ -120,4 +147,27 @@ var ErrCode = map[uint32]string{
 	ErrAPIChangePassUnsupported: "Unsupported to change passphrase of current wallet",
 	ErrAPIWalletUnlocked:        "Wallet unlocked, try again later",
 	ErrAPIBigTransactionFee:     "Big transaction fee",
+	ErrAPIWalletLocked:          "Wallet is locked, passphrase required",
+	ErrAPIWatchOnlyWallet:       "Watch-only wallet has no private keys",
+	ErrAPIEventsOverflow:        "Too many events not received, subscribe again",
+	ErrAPIInvalidTimeout:        "Invalid timeout",
+	ErrAPIInvalidMultisigPolicy: "Invalid multisig policy",
+	ErrAPIInvalidCosignerXpub:   "Invalid cosigner xpub",
+	ErrAPIInvalidPsbt:           "Invalid psbt",
+	ErrAPIInvalidAccountXpub:    "Invalid account xpub",
+	ErrAPIInvalidCursor:         "Invalid cursor",
+	ErrAPIInvalidTxCategory:     "Invalid transaction category",
+	ErrAPIInvalidExportFormat:   "Invalid export format",
+	ErrAPIIncompleteSignature:   "Transaction requires signatures of cosigners",
+	ErrAPITxNotUnmined:          "Transaction is not unmined",
+	ErrAPITxNotReplaceable:      "Transaction is not replaceable",
+	ErrAPIReplacementFee:        "Fee too low to replace transaction",
+	ErrAPINoChangeOutput:        "Transaction has no change output",
+	ErrAPINothingToConsolidate:  "No utxo to consolidate",
+	ErrAPIInvalidWIF:            "Invalid WIF private key",
+	ErrAPINothingToSweep:        "No utxo to sweep",
+	ErrAPIFeeEstimate:           "Insufficient data to estimate fee rate",
+	ErrAPINotInMempool:          "Transaction not in mempool",
+	ErrAPINothingToWithdraw:     "No utxo to withdraw",
+	ErrAPIStakingNotExpired:     "Staking output is not expired",
 }
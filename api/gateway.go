@@ -1,10 +1,13 @@
 package api
 
 import (
+	"bytes"
 	"crypto/tls"
 	"crypto/x509"
 	"io/ioutil"
 	"net/http"
+	"net/textproto"
+	"strings"
 
 	"fmt"
 
@@ -19,8 +22,19 @@ import (
 const (
 	// DefaultHTTPLimit default max http conns
 	DefaultHTTPLimit = 128
+
+	walletIDHeader = "Wallet-Id"
 )
 
+// incomingHeaderMatcher passes the header "Wallet-Id" to grpc metadata, along
+// with the headers passed by default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == walletIDHeader {
+		return WalletIDMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func statusUnavailableHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusServiceUnavailable)
 	w.Write([]byte("{\"err:\",\"Sorry, we received too many simultaneous requests.\nPlease try again later.\"}"))
@@ -29,7 +43,7 @@ func statusUnavailableHandler(w http.ResponseWriter, r *http.Request) {
 func allowCORS(h http.Handler, config *config.Config) http.Handler {
 	httpCh := make(chan bool, DefaultHTTPLimit)
 	c := cors.New(cors.Options{
-		AllowedHeaders: []string{"Content-Type", "Accept"},
+		AllowedHeaders: []string{"Content-Type", "Accept", walletIDHeader},
 		AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "DELETE"},
 		AllowedOrigins: config.Network.API.HttpCORSAddr,
 		MaxAge:         600,
@@ -72,7 +86,8 @@ func Run(cfg *config.Config) error {
 	defer cancel()
 
 	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard,
-		&runtime.JSONPb{OrigName: true, EmitDefaults: true}))
+		&runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 
 	opts := []grpc.DialOption{
 		grpc.WithInsecure(),
@@ -83,7 +98,7 @@ func Run(cfg *config.Config) error {
 		return err
 	}
 
-	handle := maxBytesHandler(mux)
+	handle := maxBytesHandler(sseHandler(mux))
 	addr := fmt.Sprintf("%s%s%s", cfg.Network.API.Host, ":", cfg.Network.API.HttpPort)
 	serv := &http.Server{
 		Addr:    addr,
@@ -113,3 +128,66 @@ func maxBytesHandler(h http.Handler) http.Handler {
 		h.ServeHTTP(w, req)
 	})
 }
+
+// sseHandler serves the server-streaming methods as server-sent events to
+// clients accepting "text/event-stream". Every newline-delimited json chunk
+// written by the gateway is sent as the data of one event.
+func sseHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		f, ok := w.(http.Flusher)
+		if !ok || !strings.Contains(req.Header.Get("Accept"), "text/event-stream") {
+			h.ServeHTTP(w, req)
+			return
+		}
+		sw := &sseWriter{ResponseWriter: w, flusher: f}
+		h.ServeHTTP(sw, req)
+		// the error chunk ending a stream has no delimiter
+		sw.writeEvent(sw.buf)
+		sw.Flush()
+	})
+}
+
+type sseWriter struct {
+	http.ResponseWriter
+	flusher     http.Flusher
+	buf         []byte
+	wroteHeader bool
+}
+
+func (sw *sseWriter) WriteHeader(code int) {
+	if sw.wroteHeader {
+		return
+	}
+	sw.wroteHeader = true
+	sw.Header().Set("Content-Type", "text/event-stream")
+	sw.Header().Set("Cache-Control", "no-cache")
+	sw.Header().Del("Content-Length")
+	sw.ResponseWriter.WriteHeader(code)
+}
+
+func (sw *sseWriter) Write(p []byte) (int, error) {
+	sw.WriteHeader(http.StatusOK)
+	sw.buf = append(sw.buf, p...)
+	for {
+		i := bytes.IndexByte(sw.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if err := sw.writeEvent(sw.buf[:i]); err != nil {
+			return 0, err
+		}
+		sw.buf = sw.buf[i+1:]
+	}
+}
+
+func (sw *sseWriter) writeEvent(data []byte) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	_, err := fmt.Fprintf(sw.ResponseWriter, "data: %s\n\n", data)
+	return err
+}
+
+func (sw *sseWriter) Flush() {
+	sw.flusher.Flush()
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSSEHandler(t *testing.T) {
+	stream := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"result":{"type":"mempool_tx"}}`))
+		w.Write([]byte("\n"))
+		w.Write([]byte(`{"result":{"type":"tx_`))
+		w.Write([]byte(`confirmed"}}` + "\n"))
+		w.Write([]byte(`{"error":{"code":1314}}`))
+	})
+
+	req := httptest.NewRequest("GET", "/v1/wallets/events", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	rec := httptest.NewRecorder()
+	sseHandler(stream).ServeHTTP(rec, req)
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("unexpected content type %s", ct)
+	}
+	expected := "data: {\"result\":{\"type\":\"mempool_tx\"}}\n\n" +
+		"data: {\"result\":{\"type\":\"tx_confirmed\"}}\n\n" +
+		"data: {\"error\":{\"code\":1314}}\n\n"
+	if body := rec.Body.String(); body != expected {
+		t.Fatalf("unexpected body %q", body)
+	}
+
+	// plain requests are left untouched
+	req = httptest.NewRequest("GET", "/v1/wallets/events", nil)
+	rec = httptest.NewRecorder()
+	sseHandler(stream).ServeHTTP(rec, req)
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("unexpected content type %s", ct)
+	}
+}
+
+func TestIncomingHeaderMatcher(t *testing.T) {
+	tests := []struct {
+		header string
+		key    string
+		ok     bool
+	}{
+		{"Wallet-Id", WalletIDMetadataKey, true},
+		{"wallet-id", WalletIDMetadataKey, true},
+		{"Grpc-Metadata-Wallet-Id", "Wallet-Id", true},
+		{"Authorization", "grpcgateway-Authorization", true},
+		{"X-Custom", "", false},
+	}
+	for _, test := range tests {
+		key, ok := incomingHeaderMatcher(test.header)
+		if ok != test.ok || key != test.key {
+			t.Fatalf("header %s: expected (%s, %v), got (%s, %v)", test.header, test.key, test.ok, key, ok)
+		}
+	}
+}
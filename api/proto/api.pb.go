@@ -18,10 +18,14 @@ It has these top-level messages:
 	ImportWalletRequest
 	ImportWalletResponse
 	ImportMnemonicRequest
+	ImportMultisigWalletRequest
+	ImportWatchOnlyWalletRequest
 	ExportWalletRequest
 	ExportWalletResponse
 	RemoveWalletRequest
 	RemoveWalletResponse
+	SubscribeWalletEventsRequest
+	WalletEvent
 	GetAddressBalanceRequest
 	AddressAndBalance
 	GetAddressBalanceResponse
@@ -36,6 +40,10 @@ It has these top-level messages:
 	TxHistoryDetails
 	TxHistoryResponse
 	TxHistoryRequest
+	ListTransactionsRequest
+	ListTransactionsResponse
+	ExportTxHistoryRequest
+	ExportTxHistoryResponse
 	TransactionInput
 	DecodeRawTransactionRequest
 	DecodeRawTransactionResponse
@@ -43,14 +51,23 @@ It has these top-level messages:
 	AutoCreateTransactionRequest
 	CreateRawTransactionResponse
 	CreateStakingTransactionRequest
+	CreateStakingWithdrawTransactionRequest
 	GetBlockStakingRewardRequest
 	GetBlockStakingRewardResponse
+	EstimateStakingRewardRequest
+	EstimateStakingRewardResponse
 	GetStakingHistoryRequest
 	GetStakingHistoryResponse
+	GetStakingRewardHistoryRequest
+	GetStakingRewardHistoryResponse
 	SendRawTransactionRequest
 	SendRawTransactionResponse
+	BumpFeeRequest
+	BumpFeeResponse
 	GetTransactionFeeRequest
 	GetTransactionFeeResponse
+	EstimateFeeRateRequest
+	EstimateFeeRateResponse
 	BlockInfoForTx
 	Vin
 	Vout
@@ -58,20 +75,66 @@ It has these top-level messages:
 	GetRawTransactionResponse
 	GetTxStatusRequest
 	GetTxStatusResponse
+	GetMempoolInfoResponse
+	MempoolEntry
+	GetRawMempoolRequest
+	GetRawMempoolResponse
+	GetMempoolEntryRequest
+	GetMempoolEntryResponse
+	EvictMempoolTransactionRequest
+	EvictMempoolTransactionResponse
 	SignRawTransactionRequest
 	SignRawTransactionResponse
+	CreatePsbtRequest
+	PsbtResponse
+	DecodePsbtRequest
+	DecodePsbtResponse
+	SignPsbtRequest
+	CombinePsbtRequest
+	FinalizePsbtRequest
+	FinalizePsbtResponse
 	GetUtxoRequest
 	UTXO
 	AddressUTXO
 	GetUtxoResponse
+	SearchAddressTransactionsRequest
+	SearchAddressTransactionsResponse
+	GetAddressUtxosRequest
+	GetAddressUtxosResponse
+	GetAddressReceivedRequest
+	GetAddressReceivedResponse
+	SetUtxoFrozenRequest
+	SetUtxoFrozenResponse
+	SetUtxoLabelRequest
+	SetUtxoLabelResponse
+	ConsolidateUtxosRequest
+	ConsolidateUtxosResponse
+	SweepPrivateKeyRequest
+	SweepPrivateKeyResponse
 	GetAddressBindingRequest
 	GetAddressBindingResponse
+	CheckBindingRequirementRequest
+	CheckBindingRequirementResponse
 	GetBindingHistoryRequest
 	GetBindingHistoryResponse
 	CreateBindingTransactionRequest
+	CreateBindingWithdrawTransactionRequest
 	GetBestBlockResponse
+	BlockHeader
+	GetBlockRequest
+	GetBlockResponse
+	GetBlockHeaderRequest
+	GetBlockHashRequest
+	GetBlockHashResponse
+	GetChainTipsResponse
+	GetMinedBlocksRequest
+	GetMinedBlocksResponse
 	GetWalletMnemonicRequest
 	GetWalletMnemonicResponse
+	UnlockWalletRequest
+	UnlockWalletResponse
+	LockWalletResponse
+	GetWalletXpubResponse
 */
 package rpcprotobuf
 
@@ -317,6 +380,11 @@ type WalletsResponse_WalletSummary struct {
 	Remarks   string `protobuf:"bytes,4,opt,name=remarks,proto3" json:"remarks,omitempty"`
 	Status    uint32 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
 	StatusMsg string `protobuf:"bytes,6,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	// "removing" - when status=2
+	// {synced_height} - when status=1
+	RequiredSignatures uint32 `protobuf:"varint,7,opt,name=required_signatures,json=requiredSignatures,proto3" json:"required_signatures,omitempty"`
+	TotalSigners       uint32 `protobuf:"varint,8,opt,name=total_signers,json=totalSigners,proto3" json:"total_signers,omitempty"`
+	WatchOnly          bool   `protobuf:"varint,9,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
 }
 
 func (m *WalletsResponse_WalletSummary) Reset()         { *m = WalletsResponse_WalletSummary{} }
@@ -368,6 +436,27 @@ func (m *WalletsResponse_WalletSummary) GetStatusMsg() string {
 	return ""
 }
 
+func (m *WalletsResponse_WalletSummary) GetRequiredSignatures() uint32 {
+	if m != nil {
+		return m.RequiredSignatures
+	}
+	return 0
+}
+
+func (m *WalletsResponse_WalletSummary) GetTotalSigners() uint32 {
+	if m != nil {
+		return m.TotalSigners
+	}
+	return 0
+}
+
+func (m *WalletsResponse_WalletSummary) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
 type UseWalletRequest struct {
 	WalletId string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
@@ -640,6 +729,110 @@ func (m *ImportMnemonicRequest) GetInternalIndex() uint32 {
 	return 0
 }
 
+type ImportMultisigWalletRequest struct {
+	Mnemonic           string   `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Passphrase         string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Remarks            string   `protobuf:"bytes,3,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	ExternalIndex      uint32   `protobuf:"varint,4,opt,name=external_index,json=externalIndex,proto3" json:"external_index,omitempty"`
+	InternalIndex      uint32   `protobuf:"varint,5,opt,name=internal_index,json=internalIndex,proto3" json:"internal_index,omitempty"`
+	RequiredSignatures uint32   `protobuf:"varint,6,opt,name=required_signatures,json=requiredSignatures,proto3" json:"required_signatures,omitempty"`
+	CosignerXpubs      []string `protobuf:"bytes,7,rep,name=cosigner_xpubs,json=cosignerXpubs" json:"cosigner_xpubs,omitempty"`
+}
+
+func (m *ImportMultisigWalletRequest) Reset()                    { *m = ImportMultisigWalletRequest{} }
+func (m *ImportMultisigWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportMultisigWalletRequest) ProtoMessage()               {}
+func (*ImportMultisigWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+
+func (m *ImportMultisigWalletRequest) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *ImportMultisigWalletRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *ImportMultisigWalletRequest) GetRemarks() string {
+	if m != nil {
+		return m.Remarks
+	}
+	return ""
+}
+
+func (m *ImportMultisigWalletRequest) GetExternalIndex() uint32 {
+	if m != nil {
+		return m.ExternalIndex
+	}
+	return 0
+}
+
+func (m *ImportMultisigWalletRequest) GetInternalIndex() uint32 {
+	if m != nil {
+		return m.InternalIndex
+	}
+	return 0
+}
+
+func (m *ImportMultisigWalletRequest) GetRequiredSignatures() uint32 {
+	if m != nil {
+		return m.RequiredSignatures
+	}
+	return 0
+}
+
+func (m *ImportMultisigWalletRequest) GetCosignerXpubs() []string {
+	if m != nil {
+		return m.CosignerXpubs
+	}
+	return nil
+}
+
+type ImportWatchOnlyWalletRequest struct {
+	Xpub          string `protobuf:"bytes,1,opt,name=xpub,proto3" json:"xpub,omitempty"`
+	Remarks       string `protobuf:"bytes,2,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	ExternalIndex uint32 `protobuf:"varint,3,opt,name=external_index,json=externalIndex,proto3" json:"external_index,omitempty"`
+	InternalIndex uint32 `protobuf:"varint,4,opt,name=internal_index,json=internalIndex,proto3" json:"internal_index,omitempty"`
+}
+
+func (m *ImportWatchOnlyWalletRequest) Reset()                    { *m = ImportWatchOnlyWalletRequest{} }
+func (m *ImportWatchOnlyWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportWatchOnlyWalletRequest) ProtoMessage()               {}
+func (*ImportWatchOnlyWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+
+func (m *ImportWatchOnlyWalletRequest) GetXpub() string {
+	if m != nil {
+		return m.Xpub
+	}
+	return ""
+}
+
+func (m *ImportWatchOnlyWalletRequest) GetRemarks() string {
+	if m != nil {
+		return m.Remarks
+	}
+	return ""
+}
+
+func (m *ImportWatchOnlyWalletRequest) GetExternalIndex() uint32 {
+	if m != nil {
+		return m.ExternalIndex
+	}
+	return 0
+}
+
+func (m *ImportWatchOnlyWalletRequest) GetInternalIndex() uint32 {
+	if m != nil {
+		return m.InternalIndex
+	}
+	return 0
+}
+
 type ExportWalletRequest struct {
 	WalletId   string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
@@ -648,7 +841,7 @@ type ExportWalletRequest struct {
 func (m *ExportWalletRequest) Reset()                    { *m = ExportWalletRequest{} }
 func (m *ExportWalletRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExportWalletRequest) ProtoMessage()               {}
-func (*ExportWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+func (*ExportWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
 
 func (m *ExportWalletRequest) GetWalletId() string {
 	if m != nil {
@@ -671,7 +864,7 @@ type ExportWalletResponse struct {
 func (m *ExportWalletResponse) Reset()                    { *m = ExportWalletResponse{} }
 func (m *ExportWalletResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExportWalletResponse) ProtoMessage()               {}
-func (*ExportWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+func (*ExportWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
 
 func (m *ExportWalletResponse) GetKeystore() string {
 	if m != nil {
@@ -688,7 +881,7 @@ type RemoveWalletRequest struct {
 func (m *RemoveWalletRequest) Reset()                    { *m = RemoveWalletRequest{} }
 func (m *RemoveWalletRequest) String() string            { return proto.CompactTextString(m) }
 func (*RemoveWalletRequest) ProtoMessage()               {}
-func (*RemoveWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
+func (*RemoveWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
 
 func (m *RemoveWalletRequest) GetWalletId() string {
 	if m != nil {
@@ -711,7 +904,7 @@ type RemoveWalletResponse struct {
 func (m *RemoveWalletResponse) Reset()                    { *m = RemoveWalletResponse{} }
 func (m *RemoveWalletResponse) String() string            { return proto.CompactTextString(m) }
 func (*RemoveWalletResponse) ProtoMessage()               {}
-func (*RemoveWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
+func (*RemoveWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
 
 func (m *RemoveWalletResponse) GetOk() bool {
 	if m != nil {
@@ -720,6 +913,86 @@ func (m *RemoveWalletResponse) GetOk() bool {
 	return false
 }
 
+type SubscribeWalletEventsRequest struct {
+	WalletId string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *SubscribeWalletEventsRequest) Reset()                    { *m = SubscribeWalletEventsRequest{} }
+func (m *SubscribeWalletEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeWalletEventsRequest) ProtoMessage()               {}
+func (*SubscribeWalletEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
+
+func (m *SubscribeWalletEventsRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+type WalletEvent struct {
+	Type         string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	WalletId     string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	TxId         string `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BlockHash    string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height       uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	SyncedHeight uint64 `protobuf:"varint,6,opt,name=synced_height,json=syncedHeight,proto3" json:"synced_height,omitempty"`
+	BestHeight   uint64 `protobuf:"varint,7,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+}
+
+func (m *WalletEvent) Reset()                    { *m = WalletEvent{} }
+func (m *WalletEvent) String() string            { return proto.CompactTextString(m) }
+func (*WalletEvent) ProtoMessage()               {}
+func (*WalletEvent) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
+
+func (m *WalletEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WalletEvent) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *WalletEvent) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *WalletEvent) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *WalletEvent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *WalletEvent) GetSyncedHeight() uint64 {
+	if m != nil {
+		return m.SyncedHeight
+	}
+	return 0
+}
+
+func (m *WalletEvent) GetBestHeight() uint64 {
+	if m != nil {
+		return m.BestHeight
+	}
+	return 0
+}
+
 type GetAddressBalanceRequest struct {
 	RequiredConfirmations int32    `protobuf:"varint,1,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
 	Addresses             []string `protobuf:"bytes,2,rep,name=addresses" json:"addresses,omitempty"`
@@ -728,7 +1001,7 @@ type GetAddressBalanceRequest struct {
 func (m *GetAddressBalanceRequest) Reset()                    { *m = GetAddressBalanceRequest{} }
 func (m *GetAddressBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetAddressBalanceRequest) ProtoMessage()               {}
-func (*GetAddressBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
+func (*GetAddressBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
 
 func (m *GetAddressBalanceRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
@@ -755,7 +1028,7 @@ type AddressAndBalance struct {
 func (m *AddressAndBalance) Reset()                    { *m = AddressAndBalance{} }
 func (m *AddressAndBalance) String() string            { return proto.CompactTextString(m) }
 func (*AddressAndBalance) ProtoMessage()               {}
-func (*AddressAndBalance) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
+func (*AddressAndBalance) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
 
 func (m *AddressAndBalance) GetAddress() string {
 	if m != nil {
@@ -799,7 +1072,7 @@ type GetAddressBalanceResponse struct {
 func (m *GetAddressBalanceResponse) Reset()                    { *m = GetAddressBalanceResponse{} }
 func (m *GetAddressBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetAddressBalanceResponse) ProtoMessage()               {}
-func (*GetAddressBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
+func (*GetAddressBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
 
 func (m *GetAddressBalanceResponse) GetBalances() []*AddressAndBalance {
 	if m != nil {
@@ -815,7 +1088,7 @@ type ValidateAddressRequest struct {
 func (m *ValidateAddressRequest) Reset()                    { *m = ValidateAddressRequest{} }
 func (m *ValidateAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*ValidateAddressRequest) ProtoMessage()               {}
-func (*ValidateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
+func (*ValidateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
 
 func (m *ValidateAddressRequest) GetAddress() string {
 	if m != nil {
@@ -834,7 +1107,7 @@ type ValidateAddressResponse struct {
 func (m *ValidateAddressResponse) Reset()                    { *m = ValidateAddressResponse{} }
 func (m *ValidateAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*ValidateAddressResponse) ProtoMessage()               {}
-func (*ValidateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
+func (*ValidateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
 
 func (m *ValidateAddressResponse) GetIsValid() bool {
 	if m != nil {
@@ -871,7 +1144,7 @@ type CreateAddressRequest struct {
 func (m *CreateAddressRequest) Reset()                    { *m = CreateAddressRequest{} }
 func (m *CreateAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateAddressRequest) ProtoMessage()               {}
-func (*CreateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
+func (*CreateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{23} }
 
 func (m *CreateAddressRequest) GetVersion() int32 {
 	if m != nil {
@@ -887,7 +1160,7 @@ type CreateAddressResponse struct {
 func (m *CreateAddressResponse) Reset()                    { *m = CreateAddressResponse{} }
 func (m *CreateAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateAddressResponse) ProtoMessage()               {}
-func (*CreateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
+func (*CreateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{24} }
 
 func (m *CreateAddressResponse) GetAddress() string {
 	if m != nil {
@@ -903,7 +1176,7 @@ type GetAddressesRequest struct {
 func (m *GetAddressesRequest) Reset()                    { *m = GetAddressesRequest{} }
 func (m *GetAddressesRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetAddressesRequest) ProtoMessage()               {}
-func (*GetAddressesRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
+func (*GetAddressesRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25} }
 
 func (m *GetAddressesRequest) GetVersion() int32 {
 	if m != nil {
@@ -919,7 +1192,7 @@ type GetAddressesResponse struct {
 func (m *GetAddressesResponse) Reset()                    { *m = GetAddressesResponse{} }
 func (m *GetAddressesResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetAddressesResponse) ProtoMessage()               {}
-func (*GetAddressesResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
+func (*GetAddressesResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{26} }
 
 func (m *GetAddressesResponse) GetDetails() []*GetAddressesResponse_AddressDetail {
 	if m != nil {
@@ -939,7 +1212,7 @@ func (m *GetAddressesResponse_AddressDetail) Reset()         { *m = GetAddresses
 func (m *GetAddressesResponse_AddressDetail) String() string { return proto.CompactTextString(m) }
 func (*GetAddressesResponse_AddressDetail) ProtoMessage()    {}
 func (*GetAddressesResponse_AddressDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{22, 0}
+	return fileDescriptorApi, []int{26, 0}
 }
 
 func (m *GetAddressesResponse_AddressDetail) GetAddress() string {
@@ -978,7 +1251,7 @@ type GetWalletBalanceRequest struct {
 func (m *GetWalletBalanceRequest) Reset()                    { *m = GetWalletBalanceRequest{} }
 func (m *GetWalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetWalletBalanceRequest) ProtoMessage()               {}
-func (*GetWalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{23} }
+func (*GetWalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{27} }
 
 func (m *GetWalletBalanceRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
@@ -1003,7 +1276,7 @@ type GetWalletBalanceResponse struct {
 func (m *GetWalletBalanceResponse) Reset()                    { *m = GetWalletBalanceResponse{} }
 func (m *GetWalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetWalletBalanceResponse) ProtoMessage()               {}
-func (*GetWalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{24} }
+func (*GetWalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{28} }
 
 func (m *GetWalletBalanceResponse) GetWalletId() string {
 	if m != nil {
@@ -1036,7 +1309,7 @@ func (m *GetWalletBalanceResponse_Detail) Reset()         { *m = GetWalletBalanc
 func (m *GetWalletBalanceResponse_Detail) String() string { return proto.CompactTextString(m) }
 func (*GetWalletBalanceResponse_Detail) ProtoMessage()    {}
 func (*GetWalletBalanceResponse_Detail) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{24, 0}
+	return fileDescriptorApi, []int{28, 0}
 }
 
 func (m *GetWalletBalanceResponse_Detail) GetSpendable() string {
@@ -1071,7 +1344,7 @@ type TxHistoryDetails struct {
 func (m *TxHistoryDetails) Reset()                    { *m = TxHistoryDetails{} }
 func (m *TxHistoryDetails) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryDetails) ProtoMessage()               {}
-func (*TxHistoryDetails) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25} }
+func (*TxHistoryDetails) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29} }
 
 func (m *TxHistoryDetails) GetTxId() string {
 	if m != nil {
@@ -1116,7 +1389,7 @@ type TxHistoryDetails_Input struct {
 func (m *TxHistoryDetails_Input) Reset()                    { *m = TxHistoryDetails_Input{} }
 func (m *TxHistoryDetails_Input) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryDetails_Input) ProtoMessage()               {}
-func (*TxHistoryDetails_Input) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25, 0} }
+func (*TxHistoryDetails_Input) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29, 0} }
 
 func (m *TxHistoryDetails_Input) GetTxId() string {
 	if m != nil {
@@ -1140,7 +1413,7 @@ type TxHistoryDetails_Output struct {
 func (m *TxHistoryDetails_Output) Reset()                    { *m = TxHistoryDetails_Output{} }
 func (m *TxHistoryDetails_Output) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryDetails_Output) ProtoMessage()               {}
-func (*TxHistoryDetails_Output) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25, 1} }
+func (*TxHistoryDetails_Output) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29, 1} }
 
 func (m *TxHistoryDetails_Output) GetAddress() string {
 	if m != nil {
@@ -1163,7 +1436,7 @@ type TxHistoryResponse struct {
 func (m *TxHistoryResponse) Reset()                    { *m = TxHistoryResponse{} }
 func (m *TxHistoryResponse) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryResponse) ProtoMessage()               {}
-func (*TxHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{26} }
+func (*TxHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{30} }
 
 func (m *TxHistoryResponse) GetHistories() []*TxHistoryDetails {
 	if m != nil {
@@ -1180,7 +1453,7 @@ type TxHistoryRequest struct {
 func (m *TxHistoryRequest) Reset()                    { *m = TxHistoryRequest{} }
 func (m *TxHistoryRequest) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryRequest) ProtoMessage()               {}
-func (*TxHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{27} }
+func (*TxHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{31} }
 
 func (m *TxHistoryRequest) GetCount() uint32 {
 	if m != nil {
@@ -1196,2535 +1469,6462 @@ func (m *TxHistoryRequest) GetAddress() string {
 	return ""
 }
 
-type TransactionInput struct {
-	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Vout uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+type ListTransactionsRequest struct {
+	Cursor     string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count      uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	MinHeight  uint64   `protobuf:"varint,3,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight  uint64   `protobuf:"varint,4,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	StartTime  int64    `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    int64    `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Categories []string `protobuf:"bytes,7,rep,name=categories" json:"categories,omitempty"`
+	Ascending  bool     `protobuf:"varint,8,opt,name=ascending,proto3" json:"ascending,omitempty"`
 }
 
-func (m *TransactionInput) Reset()                    { *m = TransactionInput{} }
-func (m *TransactionInput) String() string            { return proto.CompactTextString(m) }
-func (*TransactionInput) ProtoMessage()               {}
-func (*TransactionInput) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{28} }
+func (m *ListTransactionsRequest) Reset()                    { *m = ListTransactionsRequest{} }
+func (m *ListTransactionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListTransactionsRequest) ProtoMessage()               {}
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{32} }
 
-func (m *TransactionInput) GetTxId() string {
+func (m *ListTransactionsRequest) GetCursor() string {
 	if m != nil {
-		return m.TxId
+		return m.Cursor
 	}
 	return ""
 }
 
-func (m *TransactionInput) GetVout() uint32 {
+func (m *ListTransactionsRequest) GetCount() uint32 {
 	if m != nil {
-		return m.Vout
+		return m.Count
 	}
 	return 0
 }
 
-type DecodeRawTransactionRequest struct {
-	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
-}
-
-func (m *DecodeRawTransactionRequest) Reset()                    { *m = DecodeRawTransactionRequest{} }
-func (m *DecodeRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*DecodeRawTransactionRequest) ProtoMessage()               {}
-func (*DecodeRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29} }
-
-func (m *DecodeRawTransactionRequest) GetHex() string {
+func (m *ListTransactionsRequest) GetMinHeight() uint64 {
 	if m != nil {
-		return m.Hex
+		return m.MinHeight
 	}
-	return ""
-}
-
-type DecodeRawTransactionResponse struct {
-	TxId     string                               `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Version  int32                                `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	LockTime int64                                `protobuf:"varint,3,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
-	Size    int32                                `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	Vin      []*DecodeRawTransactionResponse_Vin  `protobuf:"bytes,5,rep,name=vin" json:"vin,omitempty"`
-	Vout     []*DecodeRawTransactionResponse_Vout `protobuf:"bytes,6,rep,name=vout" json:"vout,omitempty"`
-	Payload  string                               `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	return 0
 }
 
-func (m *DecodeRawTransactionResponse) Reset()                    { *m = DecodeRawTransactionResponse{} }
-func (m *DecodeRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*DecodeRawTransactionResponse) ProtoMessage()               {}
-func (*DecodeRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{30} }
-
-func (m *DecodeRawTransactionResponse) GetTxId() string {
+func (m *ListTransactionsRequest) GetMaxHeight() uint64 {
 	if m != nil {
-		return m.TxId
+		return m.MaxHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *DecodeRawTransactionResponse) GetVersion() int32 {
+func (m *ListTransactionsRequest) GetStartTime() int64 {
 	if m != nil {
-		return m.Version
+		return m.StartTime
 	}
 	return 0
 }
 
-func (m *DecodeRawTransactionResponse) GetLockTime() int64 {
+func (m *ListTransactionsRequest) GetEndTime() int64 {
 	if m != nil {
-		return m.LockTime
+		return m.EndTime
 	}
 	return 0
 }
 
-func (m *DecodeRawTransactionResponse) GetSize() int32 {
+func (m *ListTransactionsRequest) GetCategories() []string {
 	if m != nil {
-		return m.Size
+		return m.Categories
 	}
-	return 0
+	return nil
 }
 
-func (m *DecodeRawTransactionResponse) GetVin() []*DecodeRawTransactionResponse_Vin {
+func (m *ListTransactionsRequest) GetAscending() bool {
 	if m != nil {
-		return m.Vin
+		return m.Ascending
 	}
-	return nil
+	return false
 }
 
-func (m *DecodeRawTransactionResponse) GetVout() []*DecodeRawTransactionResponse_Vout {
+type ListTransactionsResponse struct {
+	Transactions []*ListTransactionsResponse_Transaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+	NextCursor   string                                  `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (m *ListTransactionsResponse) Reset()                    { *m = ListTransactionsResponse{} }
+func (m *ListTransactionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListTransactionsResponse) ProtoMessage()               {}
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{33} }
+
+func (m *ListTransactionsResponse) GetTransactions() []*ListTransactionsResponse_Transaction {
 	if m != nil {
-		return m.Vout
+		return m.Transactions
 	}
 	return nil
 }
 
-func (m *DecodeRawTransactionResponse) GetPayload() string {
+func (m *ListTransactionsResponse) GetNextCursor() string {
 	if m != nil {
-		return m.Payload
+		return m.NextCursor
 	}
 	return ""
 }
 
-type DecodeRawTransactionResponse_Vin struct {
-	TxId     string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Vout     uint32   `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
-	Sequence uint64   `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Witness  []string `protobuf:"bytes,4,rep,name=witness" json:"witness,omitempty"`
+type ListTransactionsResponse_Transaction struct {
+	TxId          string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BlockHeight   uint64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash     string   `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Timestamp     int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Confirmations uint64   `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Categories    []string `protobuf:"bytes,6,rep,name=categories" json:"categories,omitempty"`
+	Received      string   `protobuf:"bytes,7,opt,name=received,proto3" json:"received,omitempty"`
+	Sent          string   `protobuf:"bytes,8,opt,name=sent,proto3" json:"sent,omitempty"`
+	NetAmount     string   `protobuf:"bytes,9,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	Fee           string   `protobuf:"bytes,10,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (m *DecodeRawTransactionResponse_Vin) Reset()         { *m = DecodeRawTransactionResponse_Vin{} }
-func (m *DecodeRawTransactionResponse_Vin) String() string { return proto.CompactTextString(m) }
-func (*DecodeRawTransactionResponse_Vin) ProtoMessage()    {}
-func (*DecodeRawTransactionResponse_Vin) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{30, 0}
+func (m *ListTransactionsResponse_Transaction) Reset()         { *m = ListTransactionsResponse_Transaction{} }
+func (m *ListTransactionsResponse_Transaction) String() string { return proto.CompactTextString(m) }
+func (*ListTransactionsResponse_Transaction) ProtoMessage()    {}
+func (*ListTransactionsResponse_Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{33, 0}
 }
 
-func (m *DecodeRawTransactionResponse_Vin) GetTxId() string {
+func (m *ListTransactionsResponse_Transaction) GetTxId() string {
 	if m != nil {
 		return m.TxId
 	}
 	return ""
 }
 
-func (m *DecodeRawTransactionResponse_Vin) GetVout() uint32 {
+func (m *ListTransactionsResponse_Transaction) GetBlockHeight() uint64 {
 	if m != nil {
-		return m.Vout
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *DecodeRawTransactionResponse_Vin) GetSequence() uint64 {
+func (m *ListTransactionsResponse_Transaction) GetBlockHash() string {
 	if m != nil {
-		return m.Sequence
+		return m.BlockHash
 	}
-	return 0
+	return ""
 }
 
-func (m *DecodeRawTransactionResponse_Vin) GetWitness() []string {
+func (m *ListTransactionsResponse_Transaction) GetTimestamp() int64 {
 	if m != nil {
-		return m.Witness
+		return m.Timestamp
 	}
-	return nil
-}
-
-type DecodeRawTransactionResponse_Vout struct {
-	Value     string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	N         uint32   `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
-	Type      uint32   `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	ScriptAsm string   `protobuf:"bytes,4,opt,name=script_asm,json=scriptAsm,proto3" json:"script_asm,omitempty"`
-	ScriptHex string   `protobuf:"bytes,5,opt,name=script_hex,json=scriptHex,proto3" json:"script_hex,omitempty"`
-	Addresses []string `protobuf:"bytes,6,rep,name=addresses" json:"addresses,omitempty"`
-}
-
-func (m *DecodeRawTransactionResponse_Vout) Reset()         { *m = DecodeRawTransactionResponse_Vout{} }
-func (m *DecodeRawTransactionResponse_Vout) String() string { return proto.CompactTextString(m) }
-func (*DecodeRawTransactionResponse_Vout) ProtoMessage()    {}
-func (*DecodeRawTransactionResponse_Vout) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{30, 1}
+	return 0
 }
 
-func (m *DecodeRawTransactionResponse_Vout) GetValue() string {
+func (m *ListTransactionsResponse_Transaction) GetConfirmations() uint64 {
 	if m != nil {
-		return m.Value
+		return m.Confirmations
 	}
-	return ""
+	return 0
 }
 
-func (m *DecodeRawTransactionResponse_Vout) GetN() uint32 {
+func (m *ListTransactionsResponse_Transaction) GetCategories() []string {
 	if m != nil {
-		return m.N
+		return m.Categories
 	}
-	return 0
+	return nil
 }
 
-func (m *DecodeRawTransactionResponse_Vout) GetType() uint32 {
+func (m *ListTransactionsResponse_Transaction) GetReceived() string {
 	if m != nil {
-		return m.Type
+		return m.Received
 	}
-	return 0
+	return ""
 }
 
-func (m *DecodeRawTransactionResponse_Vout) GetScriptAsm() string {
+func (m *ListTransactionsResponse_Transaction) GetSent() string {
 	if m != nil {
-		return m.ScriptAsm
+		return m.Sent
 	}
 	return ""
 }
 
-func (m *DecodeRawTransactionResponse_Vout) GetScriptHex() string {
+func (m *ListTransactionsResponse_Transaction) GetNetAmount() string {
 	if m != nil {
-		return m.ScriptHex
+		return m.NetAmount
 	}
 	return ""
 }
 
-func (m *DecodeRawTransactionResponse_Vout) GetAddresses() []string {
+func (m *ListTransactionsResponse_Transaction) GetFee() string {
 	if m != nil {
-		return m.Addresses
+		return m.Fee
 	}
-	return nil
+	return ""
 }
 
-type CreateRawTransactionRequest struct {
-	Inputs          []*TransactionInput `protobuf:"bytes,1,rep,name=inputs" json:"inputs,omitempty"`
-	Amounts         map[string]string   `protobuf:"bytes,2,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	LockTime        uint64              `protobuf:"varint,3,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
-	ChangeAddress   string              `protobuf:"bytes,4,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
-	Subtractfeefrom []string            `protobuf:"bytes,5,rep,name=subtractfeefrom" json:"subtractfeefrom,omitempty"`
+type ExportTxHistoryRequest struct {
+	StartTime int64  `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64  `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Format    string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
 }
 
-func (m *CreateRawTransactionRequest) Reset()                    { *m = CreateRawTransactionRequest{} }
-func (m *CreateRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*CreateRawTransactionRequest) ProtoMessage()               {}
-func (*CreateRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{31} }
-
-func (m *CreateRawTransactionRequest) GetInputs() []*TransactionInput {
-	if m != nil {
-		return m.Inputs
-	}
-	return nil
-}
+func (m *ExportTxHistoryRequest) Reset()                    { *m = ExportTxHistoryRequest{} }
+func (m *ExportTxHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportTxHistoryRequest) ProtoMessage()               {}
+func (*ExportTxHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{34} }
 
-func (m *CreateRawTransactionRequest) GetAmounts() map[string]string {
+func (m *ExportTxHistoryRequest) GetStartTime() int64 {
 	if m != nil {
-		return m.Amounts
+		return m.StartTime
 	}
-	return nil
+	return 0
 }
 
-func (m *CreateRawTransactionRequest) GetLockTime() uint64 {
+func (m *ExportTxHistoryRequest) GetEndTime() int64 {
 	if m != nil {
-		return m.LockTime
+		return m.EndTime
 	}
 	return 0
 }
 
-func (m *CreateRawTransactionRequest) GetChangeAddress() string {
+func (m *ExportTxHistoryRequest) GetFormat() string {
 	if m != nil {
-		return m.ChangeAddress
+		return m.Format
 	}
 	return ""
 }
 
-func (m *CreateRawTransactionRequest) GetSubtractfeefrom() []string {
-	if m != nil {
-		return m.Subtractfeefrom
-	}
-	return nil
-}
-
-type AutoCreateTransactionRequest struct {
-	Amounts       map[string]string `protobuf:"bytes,1,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	LockTime      uint64            `protobuf:"varint,2,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
-	Fee           string            `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
-	FromAddress   string            `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	ChangeAddress string            `protobuf:"bytes,5,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
+type ExportTxHistoryResponse struct {
+	Records []*ExportTxHistoryResponse_Record `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+	Csv     string                            `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
 }
 
-func (m *AutoCreateTransactionRequest) Reset()                    { *m = AutoCreateTransactionRequest{} }
-func (m *AutoCreateTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*AutoCreateTransactionRequest) ProtoMessage()               {}
-func (*AutoCreateTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{32} }
+func (m *ExportTxHistoryResponse) Reset()                    { *m = ExportTxHistoryResponse{} }
+func (m *ExportTxHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExportTxHistoryResponse) ProtoMessage()               {}
+func (*ExportTxHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{35} }
 
-func (m *AutoCreateTransactionRequest) GetAmounts() map[string]string {
+func (m *ExportTxHistoryResponse) GetRecords() []*ExportTxHistoryResponse_Record {
 	if m != nil {
-		return m.Amounts
+		return m.Records
 	}
 	return nil
 }
 
-func (m *AutoCreateTransactionRequest) GetLockTime() uint64 {
-	if m != nil {
-		return m.LockTime
-	}
-	return 0
-}
-
-func (m *AutoCreateTransactionRequest) GetFee() string {
-	if m != nil {
-		return m.Fee
-	}
-	return ""
-}
-
-func (m *AutoCreateTransactionRequest) GetFromAddress() string {
+func (m *ExportTxHistoryResponse) GetCsv() string {
 	if m != nil {
-		return m.FromAddress
+		return m.Csv
 	}
 	return ""
 }
 
-func (m *AutoCreateTransactionRequest) GetChangeAddress() string {
-	if m != nil {
-		return m.ChangeAddress
-	}
-	return ""
+type ExportTxHistoryResponse_Record struct {
+	Timestamp      int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TxId           string   `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Category       string   `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Amount         string   `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee            string   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Counterparties []string `protobuf:"bytes,6,rep,name=counterparties" json:"counterparties,omitempty"`
+	BlockHeight    uint64   `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-type CreateRawTransactionResponse struct {
-	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+func (m *ExportTxHistoryResponse_Record) Reset()         { *m = ExportTxHistoryResponse_Record{} }
+func (m *ExportTxHistoryResponse_Record) String() string { return proto.CompactTextString(m) }
+func (*ExportTxHistoryResponse_Record) ProtoMessage()    {}
+func (*ExportTxHistoryResponse_Record) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{35, 0}
 }
 
-func (m *CreateRawTransactionResponse) Reset()                    { *m = CreateRawTransactionResponse{} }
-func (m *CreateRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*CreateRawTransactionResponse) ProtoMessage()               {}
-func (*CreateRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{33} }
-
-func (m *CreateRawTransactionResponse) GetHex() string {
+func (m *ExportTxHistoryResponse_Record) GetTimestamp() int64 {
 	if m != nil {
-		return m.Hex
+		return m.Timestamp
 	}
-	return ""
-}
-
-type CreateStakingTransactionRequest struct {
-	FromAddress    string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	StakingAddress string `protobuf:"bytes,2,opt,name=staking_address,json=stakingAddress,proto3" json:"staking_address,omitempty"`
-	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	FrozenPeriod   uint32 `protobuf:"varint,4,opt,name=frozen_period,json=frozenPeriod,proto3" json:"frozen_period,omitempty"`
-	Fee            string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
-}
-
-func (m *CreateStakingTransactionRequest) Reset()         { *m = CreateStakingTransactionRequest{} }
-func (m *CreateStakingTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateStakingTransactionRequest) ProtoMessage()    {}
-func (*CreateStakingTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{34}
+	return 0
 }
 
-func (m *CreateStakingTransactionRequest) GetFromAddress() string {
+func (m *ExportTxHistoryResponse_Record) GetTxId() string {
 	if m != nil {
-		return m.FromAddress
+		return m.TxId
 	}
 	return ""
 }
 
-func (m *CreateStakingTransactionRequest) GetStakingAddress() string {
+func (m *ExportTxHistoryResponse_Record) GetCategory() string {
 	if m != nil {
-		return m.StakingAddress
+		return m.Category
 	}
 	return ""
 }
 
-func (m *CreateStakingTransactionRequest) GetAmount() string {
+func (m *ExportTxHistoryResponse_Record) GetAmount() string {
 	if m != nil {
 		return m.Amount
 	}
 	return ""
 }
 
-func (m *CreateStakingTransactionRequest) GetFrozenPeriod() uint32 {
-	if m != nil {
-		return m.FrozenPeriod
-	}
-	return 0
-}
-
-func (m *CreateStakingTransactionRequest) GetFee() string {
+func (m *ExportTxHistoryResponse_Record) GetFee() string {
 	if m != nil {
 		return m.Fee
 	}
 	return ""
 }
 
-type GetBlockStakingRewardRequest struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+func (m *ExportTxHistoryResponse_Record) GetCounterparties() []string {
+	if m != nil {
+		return m.Counterparties
+	}
+	return nil
 }
 
-func (m *GetBlockStakingRewardRequest) Reset()                    { *m = GetBlockStakingRewardRequest{} }
-func (m *GetBlockStakingRewardRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetBlockStakingRewardRequest) ProtoMessage()               {}
-func (*GetBlockStakingRewardRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{35} }
-
-func (m *GetBlockStakingRewardRequest) GetHeight() uint64 {
+func (m *ExportTxHistoryResponse_Record) GetBlockHeight() uint64 {
 	if m != nil {
-		return m.Height
+		return m.BlockHeight
 	}
 	return 0
 }
 
-type GetBlockStakingRewardResponse struct {
-	Details []*GetBlockStakingRewardResponse_RewardDetail `protobuf:"bytes,1,rep,name=details" json:"details,omitempty"`
-	Height  uint64                                        `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+type TransactionInput struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
 }
 
-func (m *GetBlockStakingRewardResponse) Reset()         { *m = GetBlockStakingRewardResponse{} }
-func (m *GetBlockStakingRewardResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBlockStakingRewardResponse) ProtoMessage()    {}
-func (*GetBlockStakingRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{36}
-}
+func (m *TransactionInput) Reset()                    { *m = TransactionInput{} }
+func (m *TransactionInput) String() string            { return proto.CompactTextString(m) }
+func (*TransactionInput) ProtoMessage()               {}
+func (*TransactionInput) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{36} }
 
-func (m *GetBlockStakingRewardResponse) GetDetails() []*GetBlockStakingRewardResponse_RewardDetail {
+func (m *TransactionInput) GetTxId() string {
 	if m != nil {
-		return m.Details
+		return m.TxId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetBlockStakingRewardResponse) GetHeight() uint64 {
+func (m *TransactionInput) GetVout() uint32 {
 	if m != nil {
-		return m.Height
+		return m.Vout
 	}
 	return 0
 }
 
-type GetBlockStakingRewardResponse_RewardDetail struct {
-	Rank    int32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
-	Amount  string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Weight  float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
-	Address string  `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Profit  string  `protobuf:"bytes,5,opt,name=profit,proto3" json:"profit,omitempty"`
+type DecodeRawTransactionRequest struct {
+	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
 }
 
-func (m *GetBlockStakingRewardResponse_RewardDetail) Reset() {
-	*m = GetBlockStakingRewardResponse_RewardDetail{}
-}
-func (m *GetBlockStakingRewardResponse_RewardDetail) String() string {
-	return proto.CompactTextString(m)
-}
-func (*GetBlockStakingRewardResponse_RewardDetail) ProtoMessage() {}
-func (*GetBlockStakingRewardResponse_RewardDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{36, 0}
-}
+func (m *DecodeRawTransactionRequest) Reset()                    { *m = DecodeRawTransactionRequest{} }
+func (m *DecodeRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*DecodeRawTransactionRequest) ProtoMessage()               {}
+func (*DecodeRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{37} }
 
-func (m *GetBlockStakingRewardResponse_RewardDetail) GetRank() int32 {
+func (m *DecodeRawTransactionRequest) GetHex() string {
 	if m != nil {
-		return m.Rank
+		return m.Hex
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockStakingRewardResponse_RewardDetail) GetAmount() string {
+type DecodeRawTransactionResponse struct {
+	TxId     string                               `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Version  int32                                `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	LockTime int64                                `protobuf:"varint,3,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	Size    int32                                `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Vin      []*DecodeRawTransactionResponse_Vin  `protobuf:"bytes,5,rep,name=vin" json:"vin,omitempty"`
+	Vout     []*DecodeRawTransactionResponse_Vout `protobuf:"bytes,6,rep,name=vout" json:"vout,omitempty"`
+	Payload  string                               `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *DecodeRawTransactionResponse) Reset()                    { *m = DecodeRawTransactionResponse{} }
+func (m *DecodeRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*DecodeRawTransactionResponse) ProtoMessage()               {}
+func (*DecodeRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{38} }
+
+func (m *DecodeRawTransactionResponse) GetTxId() string {
 	if m != nil {
-		return m.Amount
+		return m.TxId
 	}
 	return ""
 }
 
-func (m *GetBlockStakingRewardResponse_RewardDetail) GetWeight() float64 {
+func (m *DecodeRawTransactionResponse) GetVersion() int32 {
 	if m != nil {
-		return m.Weight
+		return m.Version
 	}
 	return 0
 }
 
-func (m *GetBlockStakingRewardResponse_RewardDetail) GetAddress() string {
+func (m *DecodeRawTransactionResponse) GetLockTime() int64 {
 	if m != nil {
-		return m.Address
+		return m.LockTime
 	}
-	return ""
+	return 0
 }
 
-func (m *GetBlockStakingRewardResponse_RewardDetail) GetProfit() string {
+func (m *DecodeRawTransactionResponse) GetSize() int32 {
 	if m != nil {
-		return m.Profit
+		return m.Size
 	}
-	return ""
-}
-
-type GetStakingHistoryRequest struct {
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	return 0
 }
 
-func (m *GetStakingHistoryRequest) Reset()                    { *m = GetStakingHistoryRequest{} }
-func (m *GetStakingHistoryRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetStakingHistoryRequest) ProtoMessage()               {}
-func (*GetStakingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{37} }
-
-func (m *GetStakingHistoryRequest) GetType() string {
+func (m *DecodeRawTransactionResponse) GetVin() []*DecodeRawTransactionResponse_Vin {
 	if m != nil {
-		return m.Type
+		return m.Vin
 	}
-	return ""
-}
-
-type GetStakingHistoryResponse struct {
-	Txs     []*GetStakingHistoryResponse_Tx `protobuf:"bytes,1,rep,name=txs" json:"txs,omitempty"`
-	Weights map[string]float64              `protobuf:"bytes,2,rep,name=weights" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	return nil
 }
 
-func (m *GetStakingHistoryResponse) Reset()                    { *m = GetStakingHistoryResponse{} }
-func (m *GetStakingHistoryResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetStakingHistoryResponse) ProtoMessage()               {}
-func (*GetStakingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{38} }
-
-func (m *GetStakingHistoryResponse) GetTxs() []*GetStakingHistoryResponse_Tx {
+func (m *DecodeRawTransactionResponse) GetVout() []*DecodeRawTransactionResponse_Vout {
 	if m != nil {
-		return m.Txs
+		return m.Vout
 	}
 	return nil
 }
 
-func (m *GetStakingHistoryResponse) GetWeights() map[string]float64 {
+func (m *DecodeRawTransactionResponse) GetPayload() string {
 	if m != nil {
-		return m.Weights
+		return m.Payload
 	}
-	return nil
+	return ""
 }
 
-type GetStakingHistoryResponse_StakingUTXO struct {
-	TxId         string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Vout         uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
-	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Amount       string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	FrozenPeriod uint32 `protobuf:"varint,5,opt,name=frozen_period,json=frozenPeriod,proto3" json:"frozen_period,omitempty"`
+type DecodeRawTransactionResponse_Vin struct {
+	TxId     string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout     uint32   `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Sequence uint64   `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Witness  []string `protobuf:"bytes,4,rep,name=witness" json:"witness,omitempty"`
 }
 
-func (m *GetStakingHistoryResponse_StakingUTXO) Reset()         { *m = GetStakingHistoryResponse_StakingUTXO{} }
-func (m *GetStakingHistoryResponse_StakingUTXO) String() string { return proto.CompactTextString(m) }
-func (*GetStakingHistoryResponse_StakingUTXO) ProtoMessage()    {}
-func (*GetStakingHistoryResponse_StakingUTXO) Descriptor() ([]byte, []int) {
+func (m *DecodeRawTransactionResponse_Vin) Reset()         { *m = DecodeRawTransactionResponse_Vin{} }
+func (m *DecodeRawTransactionResponse_Vin) String() string { return proto.CompactTextString(m) }
+func (*DecodeRawTransactionResponse_Vin) ProtoMessage()    {}
+func (*DecodeRawTransactionResponse_Vin) Descriptor() ([]byte, []int) {
 	return fileDescriptorApi, []int{38, 0}
 }
 
-func (m *GetStakingHistoryResponse_StakingUTXO) GetTxId() string {
+func (m *DecodeRawTransactionResponse_Vin) GetTxId() string {
 	if m != nil {
 		return m.TxId
 	}
 	return ""
 }
 
-func (m *GetStakingHistoryResponse_StakingUTXO) GetVout() uint32 {
+func (m *DecodeRawTransactionResponse_Vin) GetVout() uint32 {
 	if m != nil {
 		return m.Vout
 	}
 	return 0
 }
 
-func (m *GetStakingHistoryResponse_StakingUTXO) GetAddress() string {
+func (m *DecodeRawTransactionResponse_Vin) GetSequence() uint64 {
 	if m != nil {
-		return m.Address
+		return m.Sequence
 	}
-	return ""
+	return 0
 }
 
-func (m *GetStakingHistoryResponse_StakingUTXO) GetAmount() string {
+func (m *DecodeRawTransactionResponse_Vin) GetWitness() []string {
 	if m != nil {
-		return m.Amount
-	}
-	return ""
-}
-
-func (m *GetStakingHistoryResponse_StakingUTXO) GetFrozenPeriod() uint32 {
-	if m != nil {
-		return m.FrozenPeriod
+		return m.Witness
 	}
-	return 0
+	return nil
 }
 
-type GetStakingHistoryResponse_Tx struct {
-	TxId        string                                 `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Status      uint32                                 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	BlockHeight uint64                                 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Utxo        *GetStakingHistoryResponse_StakingUTXO `protobuf:"bytes,4,opt,name=utxo" json:"utxo,omitempty"`
+type DecodeRawTransactionResponse_Vout struct {
+	Value     string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	N         uint32   `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	Type      uint32   `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	ScriptAsm string   `protobuf:"bytes,4,opt,name=script_asm,json=scriptAsm,proto3" json:"script_asm,omitempty"`
+	ScriptHex string   `protobuf:"bytes,5,opt,name=script_hex,json=scriptHex,proto3" json:"script_hex,omitempty"`
+	Addresses []string `protobuf:"bytes,6,rep,name=addresses" json:"addresses,omitempty"`
 }
 
-func (m *GetStakingHistoryResponse_Tx) Reset()         { *m = GetStakingHistoryResponse_Tx{} }
-func (m *GetStakingHistoryResponse_Tx) String() string { return proto.CompactTextString(m) }
-func (*GetStakingHistoryResponse_Tx) ProtoMessage()    {}
-func (*GetStakingHistoryResponse_Tx) Descriptor() ([]byte, []int) {
+func (m *DecodeRawTransactionResponse_Vout) Reset()         { *m = DecodeRawTransactionResponse_Vout{} }
+func (m *DecodeRawTransactionResponse_Vout) String() string { return proto.CompactTextString(m) }
+func (*DecodeRawTransactionResponse_Vout) ProtoMessage()    {}
+func (*DecodeRawTransactionResponse_Vout) Descriptor() ([]byte, []int) {
 	return fileDescriptorApi, []int{38, 1}
 }
 
-func (m *GetStakingHistoryResponse_Tx) GetTxId() string {
+func (m *DecodeRawTransactionResponse_Vout) GetValue() string {
 	if m != nil {
-		return m.TxId
+		return m.Value
 	}
 	return ""
 }
 
-func (m *GetStakingHistoryResponse_Tx) GetStatus() uint32 {
+func (m *DecodeRawTransactionResponse_Vout) GetN() uint32 {
 	if m != nil {
-		return m.Status
+		return m.N
 	}
 	return 0
 }
 
-func (m *GetStakingHistoryResponse_Tx) GetBlockHeight() uint64 {
+func (m *DecodeRawTransactionResponse_Vout) GetType() uint32 {
 	if m != nil {
-		return m.BlockHeight
+		return m.Type
 	}
 	return 0
 }
 
-func (m *GetStakingHistoryResponse_Tx) GetUtxo() *GetStakingHistoryResponse_StakingUTXO {
+func (m *DecodeRawTransactionResponse_Vout) GetScriptAsm() string {
 	if m != nil {
-		return m.Utxo
+		return m.ScriptAsm
 	}
-	return nil
+	return ""
 }
 
-type SendRawTransactionRequest struct {
-	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+func (m *DecodeRawTransactionResponse_Vout) GetScriptHex() string {
+	if m != nil {
+		return m.ScriptHex
+	}
+	return ""
 }
 
-func (m *SendRawTransactionRequest) Reset()                    { *m = SendRawTransactionRequest{} }
-func (m *SendRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*SendRawTransactionRequest) ProtoMessage()               {}
-func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{39} }
-
-func (m *SendRawTransactionRequest) GetHex() string {
+func (m *DecodeRawTransactionResponse_Vout) GetAddresses() []string {
 	if m != nil {
-		return m.Hex
+		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
-type SendRawTransactionResponse struct {
-	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+type CreateRawTransactionRequest struct {
+	Inputs          []*TransactionInput `protobuf:"bytes,1,rep,name=inputs" json:"inputs,omitempty"`
+	Amounts         map[string]string   `protobuf:"bytes,2,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LockTime        uint64              `protobuf:"varint,3,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	ChangeAddress   string              `protobuf:"bytes,4,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
+	Subtractfeefrom []string            `protobuf:"bytes,5,rep,name=subtractfeefrom" json:"subtractfeefrom,omitempty"`
 }
 
-func (m *SendRawTransactionResponse) Reset()                    { *m = SendRawTransactionResponse{} }
-func (m *SendRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*SendRawTransactionResponse) ProtoMessage()               {}
-func (*SendRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{40} }
+func (m *CreateRawTransactionRequest) Reset()                    { *m = CreateRawTransactionRequest{} }
+func (m *CreateRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateRawTransactionRequest) ProtoMessage()               {}
+func (*CreateRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{39} }
 
-func (m *SendRawTransactionResponse) GetTxId() string {
+func (m *CreateRawTransactionRequest) GetInputs() []*TransactionInput {
 	if m != nil {
-		return m.TxId
+		return m.Inputs
 	}
-	return ""
-}
-
-type GetTransactionFeeRequest struct {
-	Amounts    map[string]string   `protobuf:"bytes,1,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	LockTime   uint64              `protobuf:"varint,2,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
-	Inputs     []*TransactionInput `protobuf:"bytes,3,rep,name=inputs" json:"inputs,omitempty"`
-	HasBinding bool                `protobuf:"varint,4,opt,name=has_binding,json=hasBinding,proto3" json:"has_binding,omitempty"`
+	return nil
 }
 
-func (m *GetTransactionFeeRequest) Reset()                    { *m = GetTransactionFeeRequest{} }
-func (m *GetTransactionFeeRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetTransactionFeeRequest) ProtoMessage()               {}
-func (*GetTransactionFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{41} }
-
-func (m *GetTransactionFeeRequest) GetAmounts() map[string]string {
+func (m *CreateRawTransactionRequest) GetAmounts() map[string]string {
 	if m != nil {
 		return m.Amounts
 	}
 	return nil
 }
 
-func (m *GetTransactionFeeRequest) GetLockTime() uint64 {
+func (m *CreateRawTransactionRequest) GetLockTime() uint64 {
 	if m != nil {
 		return m.LockTime
 	}
 	return 0
 }
 
-func (m *GetTransactionFeeRequest) GetInputs() []*TransactionInput {
+func (m *CreateRawTransactionRequest) GetChangeAddress() string {
 	if m != nil {
-		return m.Inputs
+		return m.ChangeAddress
 	}
-	return nil
+	return ""
 }
 
-func (m *GetTransactionFeeRequest) GetHasBinding() bool {
+func (m *CreateRawTransactionRequest) GetSubtractfeefrom() []string {
 	if m != nil {
-		return m.HasBinding
+		return m.Subtractfeefrom
 	}
-	return false
+	return nil
 }
 
-type GetTransactionFeeResponse struct {
-	Fee string `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+type AutoCreateTransactionRequest struct {
+	Amounts       map[string]string   `protobuf:"bytes,1,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LockTime      uint64              `protobuf:"varint,2,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	Fee           string              `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	FromAddress   string              `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ChangeAddress string              `protobuf:"bytes,5,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
+	Replaceable   bool                `protobuf:"varint,6,opt,name=replaceable,proto3" json:"replaceable,omitempty"`
+	IncludeUtxos  []*TransactionInput `protobuf:"bytes,7,rep,name=include_utxos,json=includeUtxos" json:"include_utxos,omitempty"`
+	ExcludeUtxos  []*TransactionInput `protobuf:"bytes,8,rep,name=exclude_utxos,json=excludeUtxos" json:"exclude_utxos,omitempty"`
+	CoinSelection string              `protobuf:"bytes,9,opt,name=coin_selection,json=coinSelection,proto3" json:"coin_selection,omitempty"`
+	FeeRate       string              `protobuf:"bytes,10,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
 }
 
-func (m *GetTransactionFeeResponse) Reset()                    { *m = GetTransactionFeeResponse{} }
-func (m *GetTransactionFeeResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetTransactionFeeResponse) ProtoMessage()               {}
-func (*GetTransactionFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{42} }
+func (m *AutoCreateTransactionRequest) Reset()                    { *m = AutoCreateTransactionRequest{} }
+func (m *AutoCreateTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*AutoCreateTransactionRequest) ProtoMessage()               {}
+func (*AutoCreateTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{40} }
 
-func (m *GetTransactionFeeResponse) GetFee() string {
+func (m *AutoCreateTransactionRequest) GetAmounts() map[string]string {
 	if m != nil {
-		return m.Fee
+		return m.Amounts
 	}
-	return ""
-}
-
-type BlockInfoForTx struct {
-	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	return nil
 }
 
-func (m *BlockInfoForTx) Reset()                    { *m = BlockInfoForTx{} }
-func (m *BlockInfoForTx) String() string            { return proto.CompactTextString(m) }
-func (*BlockInfoForTx) ProtoMessage()               {}
-func (*BlockInfoForTx) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{43} }
-
-func (m *BlockInfoForTx) GetHeight() uint64 {
+func (m *AutoCreateTransactionRequest) GetLockTime() uint64 {
 	if m != nil {
-		return m.Height
+		return m.LockTime
 	}
 	return 0
 }
 
-func (m *BlockInfoForTx) GetBlockHash() string {
+func (m *AutoCreateTransactionRequest) GetFee() string {
 	if m != nil {
-		return m.BlockHash
+		return m.Fee
 	}
 	return ""
 }
 
-func (m *BlockInfoForTx) GetTimestamp() int64 {
+func (m *AutoCreateTransactionRequest) GetFromAddress() string {
 	if m != nil {
-		return m.Timestamp
+		return m.FromAddress
 	}
-	return 0
-}
-
-type Vin struct {
-	Value        string            `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	N            uint32            `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
-	Type         uint32            `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	RedeemDetail *Vin_RedeemDetail `protobuf:"bytes,4,opt,name=redeem_detail,json=redeemDetail" json:"redeem_detail,omitempty"`
+	return ""
 }
 
-func (m *Vin) Reset()                    { *m = Vin{} }
-func (m *Vin) String() string            { return proto.CompactTextString(m) }
-func (*Vin) ProtoMessage()               {}
-func (*Vin) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44} }
-
-func (m *Vin) GetValue() string {
+func (m *AutoCreateTransactionRequest) GetChangeAddress() string {
 	if m != nil {
-		return m.Value
+		return m.ChangeAddress
 	}
 	return ""
 }
 
-func (m *Vin) GetN() uint32 {
+func (m *AutoCreateTransactionRequest) GetReplaceable() bool {
 	if m != nil {
-		return m.N
+		return m.Replaceable
 	}
-	return 0
+	return false
 }
 
-func (m *Vin) GetType() uint32 {
+func (m *AutoCreateTransactionRequest) GetIncludeUtxos() []*TransactionInput {
 	if m != nil {
-		return m.Type
+		return m.IncludeUtxos
 	}
-	return 0
+	return nil
 }
 
-func (m *Vin) GetRedeemDetail() *Vin_RedeemDetail {
+func (m *AutoCreateTransactionRequest) GetExcludeUtxos() []*TransactionInput {
 	if m != nil {
-		return m.RedeemDetail
+		return m.ExcludeUtxos
 	}
 	return nil
 }
 
-type Vin_RedeemDetail struct {
-	TxId      string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Vout      uint32   `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
-	Sequence  uint64   `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Witness   []string `protobuf:"bytes,4,rep,name=witness" json:"witness,omitempty"`
-	Addresses []string `protobuf:"bytes,5,rep,name=addresses" json:"addresses,omitempty"`
-}
-
-func (m *Vin_RedeemDetail) Reset()                    { *m = Vin_RedeemDetail{} }
-func (m *Vin_RedeemDetail) String() string            { return proto.CompactTextString(m) }
-func (*Vin_RedeemDetail) ProtoMessage()               {}
-func (*Vin_RedeemDetail) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44, 0} }
-
-func (m *Vin_RedeemDetail) GetTxId() string {
+func (m *AutoCreateTransactionRequest) GetCoinSelection() string {
 	if m != nil {
-		return m.TxId
+		return m.CoinSelection
 	}
 	return ""
 }
 
-func (m *Vin_RedeemDetail) GetVout() uint32 {
+func (m *AutoCreateTransactionRequest) GetFeeRate() string {
 	if m != nil {
-		return m.Vout
+		return m.FeeRate
 	}
-	return 0
+	return ""
 }
 
-func (m *Vin_RedeemDetail) GetSequence() uint64 {
-	if m != nil {
-		return m.Sequence
-	}
-	return 0
+type CreateRawTransactionResponse struct {
+	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
 }
 
-func (m *Vin_RedeemDetail) GetWitness() []string {
+func (m *CreateRawTransactionResponse) Reset()                    { *m = CreateRawTransactionResponse{} }
+func (m *CreateRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateRawTransactionResponse) ProtoMessage()               {}
+func (*CreateRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{41} }
+
+func (m *CreateRawTransactionResponse) GetHex() string {
 	if m != nil {
-		return m.Witness
+		return m.Hex
 	}
-	return nil
+	return ""
 }
 
-func (m *Vin_RedeemDetail) GetAddresses() []string {
-	if m != nil {
-		return m.Addresses
-	}
-	return nil
+type CreateStakingTransactionRequest struct {
+	FromAddress    string              `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	StakingAddress string              `protobuf:"bytes,2,opt,name=staking_address,json=stakingAddress,proto3" json:"staking_address,omitempty"`
+	Amount         string              `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	FrozenPeriod   uint32              `protobuf:"varint,4,opt,name=frozen_period,json=frozenPeriod,proto3" json:"frozen_period,omitempty"`
+	Fee            string              `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	IncludeUtxos   []*TransactionInput `protobuf:"bytes,6,rep,name=include_utxos,json=includeUtxos" json:"include_utxos,omitempty"`
+	ExcludeUtxos   []*TransactionInput `protobuf:"bytes,7,rep,name=exclude_utxos,json=excludeUtxos" json:"exclude_utxos,omitempty"`
+	CoinSelection  string              `protobuf:"bytes,8,opt,name=coin_selection,json=coinSelection,proto3" json:"coin_selection,omitempty"`
 }
 
-type Vout struct {
-	Value        string             `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	N            uint32             `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
-	Type         uint32             `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	ScriptDetail *Vout_ScriptDetail `protobuf:"bytes,4,opt,name=script_detail,json=scriptDetail" json:"script_detail,omitempty"`
+func (m *CreateStakingTransactionRequest) Reset()         { *m = CreateStakingTransactionRequest{} }
+func (m *CreateStakingTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateStakingTransactionRequest) ProtoMessage()    {}
+func (*CreateStakingTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{42}
 }
 
-func (m *Vout) Reset()                    { *m = Vout{} }
-func (m *Vout) String() string            { return proto.CompactTextString(m) }
-func (*Vout) ProtoMessage()               {}
-func (*Vout) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{45} }
+func (m *CreateStakingTransactionRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
 
-func (m *Vout) GetValue() string {
+func (m *CreateStakingTransactionRequest) GetStakingAddress() string {
 	if m != nil {
-		return m.Value
+		return m.StakingAddress
 	}
 	return ""
 }
 
-func (m *Vout) GetN() uint32 {
+func (m *CreateStakingTransactionRequest) GetAmount() string {
 	if m != nil {
-		return m.N
+		return m.Amount
 	}
-	return 0
+	return ""
 }
 
-func (m *Vout) GetType() uint32 {
+func (m *CreateStakingTransactionRequest) GetFrozenPeriod() uint32 {
 	if m != nil {
-		return m.Type
+		return m.FrozenPeriod
 	}
 	return 0
 }
 
-func (m *Vout) GetScriptDetail() *Vout_ScriptDetail {
+func (m *CreateStakingTransactionRequest) GetFee() string {
 	if m != nil {
-		return m.ScriptDetail
+		return m.Fee
 	}
-	return nil
+	return ""
 }
 
-type Vout_ScriptDetail struct {
-	Asm       string   `protobuf:"bytes,1,opt,name=asm,proto3" json:"asm,omitempty"`
-	Hex       string   `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
-	ReqSigs   int32    `protobuf:"varint,3,opt,name=req_sigs,json=reqSigs,proto3" json:"req_sigs,omitempty"`
-	Addresses []string `protobuf:"bytes,4,rep,name=addresses" json:"addresses,omitempty"`
+func (m *CreateStakingTransactionRequest) GetIncludeUtxos() []*TransactionInput {
+	if m != nil {
+		return m.IncludeUtxos
+	}
+	return nil
 }
 
-func (m *Vout_ScriptDetail) Reset()                    { *m = Vout_ScriptDetail{} }
-func (m *Vout_ScriptDetail) String() string            { return proto.CompactTextString(m) }
-func (*Vout_ScriptDetail) ProtoMessage()               {}
-func (*Vout_ScriptDetail) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{45, 0} }
-
-func (m *Vout_ScriptDetail) GetAsm() string {
+func (m *CreateStakingTransactionRequest) GetExcludeUtxos() []*TransactionInput {
 	if m != nil {
-		return m.Asm
+		return m.ExcludeUtxos
 	}
-	return ""
+	return nil
 }
 
-func (m *Vout_ScriptDetail) GetHex() string {
+func (m *CreateStakingTransactionRequest) GetCoinSelection() string {
 	if m != nil {
-		return m.Hex
+		return m.CoinSelection
 	}
 	return ""
 }
 
-func (m *Vout_ScriptDetail) GetReqSigs() int32 {
-	if m != nil {
-		return m.ReqSigs
-	}
-	return 0
+type CreateStakingWithdrawTransactionRequest struct {
+	Txids     []string `protobuf:"bytes,1,rep,name=txids" json:"txids,omitempty"`
+	ToAddress string   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Fee       string   `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (m *Vout_ScriptDetail) GetAddresses() []string {
+func (m *CreateStakingWithdrawTransactionRequest) Reset() {
+	*m = CreateStakingWithdrawTransactionRequest{}
+}
+func (m *CreateStakingWithdrawTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateStakingWithdrawTransactionRequest) ProtoMessage()    {}
+func (*CreateStakingWithdrawTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{43}
+}
+
+func (m *CreateStakingWithdrawTransactionRequest) GetTxids() []string {
 	if m != nil {
-		return m.Addresses
+		return m.Txids
 	}
 	return nil
 }
 
-type GetRawTransactionRequest struct {
-	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+func (m *CreateStakingWithdrawTransactionRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
 }
 
-func (m *GetRawTransactionRequest) Reset()                    { *m = GetRawTransactionRequest{} }
-func (m *GetRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetRawTransactionRequest) ProtoMessage()               {}
-func (*GetRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{46} }
-
-func (m *GetRawTransactionRequest) GetTxId() string {
+func (m *CreateStakingWithdrawTransactionRequest) GetFee() string {
 	if m != nil {
-		return m.TxId
+		return m.Fee
 	}
 	return ""
 }
 
-type GetRawTransactionResponse struct {
-	Hex           string          `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
-	TxId          string          `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Version       int32           `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	LockTime      int64           `protobuf:"varint,4,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
-	Block         *BlockInfoForTx `protobuf:"bytes,5,opt,name=block" json:"block,omitempty"`
-	Vin           []*Vin          `protobuf:"bytes,6,rep,name=vin" json:"vin,omitempty"`
-	Vout          []*Vout         `protobuf:"bytes,7,rep,name=vout" json:"vout,omitempty"`
-	Payload       string          `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
-	Confirmations uint64          `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	Size         int32           `protobuf:"varint,10,opt,name=size,proto3" json:"size,omitempty"`
-	Fee           string          `protobuf:"bytes,11,opt,name=fee,proto3" json:"fee,omitempty"`
-	Status        int32           `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
-	Coinbase      bool            `protobuf:"varint,13,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+type GetBlockStakingRewardRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *GetRawTransactionResponse) Reset()                    { *m = GetRawTransactionResponse{} }
-func (m *GetRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetRawTransactionResponse) ProtoMessage()               {}
-func (*GetRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{47} }
+func (m *GetBlockStakingRewardRequest) Reset()                    { *m = GetBlockStakingRewardRequest{} }
+func (m *GetBlockStakingRewardRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockStakingRewardRequest) ProtoMessage()               {}
+func (*GetBlockStakingRewardRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44} }
 
-func (m *GetRawTransactionResponse) GetHex() string {
+func (m *GetBlockStakingRewardRequest) GetHeight() uint64 {
 	if m != nil {
-		return m.Hex
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *GetRawTransactionResponse) GetTxId() string {
-	if m != nil {
-		return m.TxId
-	}
-	return ""
+type GetBlockStakingRewardResponse struct {
+	Details []*GetBlockStakingRewardResponse_RewardDetail `protobuf:"bytes,1,rep,name=details" json:"details,omitempty"`
+	Height  uint64                                        `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *GetRawTransactionResponse) GetVersion() int32 {
+func (m *GetBlockStakingRewardResponse) Reset()         { *m = GetBlockStakingRewardResponse{} }
+func (m *GetBlockStakingRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockStakingRewardResponse) ProtoMessage()    {}
+func (*GetBlockStakingRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{45}
+}
+
+func (m *GetBlockStakingRewardResponse) GetDetails() []*GetBlockStakingRewardResponse_RewardDetail {
 	if m != nil {
-		return m.Version
+		return m.Details
 	}
-	return 0
+	return nil
 }
 
-func (m *GetRawTransactionResponse) GetLockTime() int64 {
+func (m *GetBlockStakingRewardResponse) GetHeight() uint64 {
 	if m != nil {
-		return m.LockTime
+		return m.Height
 	}
 	return 0
 }
 
-func (m *GetRawTransactionResponse) GetBlock() *BlockInfoForTx {
-	if m != nil {
-		return m.Block
-	}
-	return nil
+type GetBlockStakingRewardResponse_RewardDetail struct {
+	Rank    int32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Amount  string  `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Weight  float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Address string  `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Profit  string  `protobuf:"bytes,5,opt,name=profit,proto3" json:"profit,omitempty"`
 }
 
-func (m *GetRawTransactionResponse) GetVin() []*Vin {
-	if m != nil {
-		return m.Vin
-	}
-	return nil
+func (m *GetBlockStakingRewardResponse_RewardDetail) Reset() {
+	*m = GetBlockStakingRewardResponse_RewardDetail{}
+}
+func (m *GetBlockStakingRewardResponse_RewardDetail) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetBlockStakingRewardResponse_RewardDetail) ProtoMessage() {}
+func (*GetBlockStakingRewardResponse_RewardDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{45, 0}
 }
 
-func (m *GetRawTransactionResponse) GetVout() []*Vout {
+func (m *GetBlockStakingRewardResponse_RewardDetail) GetRank() int32 {
 	if m != nil {
-		return m.Vout
+		return m.Rank
 	}
-	return nil
+	return 0
 }
 
-func (m *GetRawTransactionResponse) GetPayload() string {
+func (m *GetBlockStakingRewardResponse_RewardDetail) GetAmount() string {
 	if m != nil {
-		return m.Payload
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *GetRawTransactionResponse) GetConfirmations() uint64 {
+func (m *GetBlockStakingRewardResponse_RewardDetail) GetWeight() float64 {
 	if m != nil {
-		return m.Confirmations
+		return m.Weight
 	}
 	return 0
 }
 
-func (m *GetRawTransactionResponse) GetSize() int32 {
+func (m *GetBlockStakingRewardResponse_RewardDetail) GetAddress() string {
 	if m != nil {
-		return m.Size
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *GetRawTransactionResponse) GetFee() string {
+func (m *GetBlockStakingRewardResponse_RewardDetail) GetProfit() string {
 	if m != nil {
-		return m.Fee
+		return m.Profit
 	}
 	return ""
 }
 
-func (m *GetRawTransactionResponse) GetStatus() int32 {
-	if m != nil {
-		return m.Status
-	}
-	return 0
+type EstimateStakingRewardRequest struct {
+	Amount       string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	FrozenPeriod uint32 `protobuf:"varint,2,opt,name=frozen_period,json=frozenPeriod,proto3" json:"frozen_period,omitempty"`
 }
 
-func (m *GetRawTransactionResponse) GetCoinbase() bool {
+func (m *EstimateStakingRewardRequest) Reset()                    { *m = EstimateStakingRewardRequest{} }
+func (m *EstimateStakingRewardRequest) String() string            { return proto.CompactTextString(m) }
+func (*EstimateStakingRewardRequest) ProtoMessage()               {}
+func (*EstimateStakingRewardRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{46} }
+
+func (m *EstimateStakingRewardRequest) GetAmount() string {
 	if m != nil {
-		return m.Coinbase
+		return m.Amount
 	}
-	return false
-}
-
-type GetTxStatusRequest struct {
-	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	return ""
 }
 
-func (m *GetTxStatusRequest) Reset()                    { *m = GetTxStatusRequest{} }
-func (m *GetTxStatusRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetTxStatusRequest) ProtoMessage()               {}
-func (*GetTxStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{48} }
-
-func (m *GetTxStatusRequest) GetTxId() string {
+func (m *EstimateStakingRewardRequest) GetFrozenPeriod() uint32 {
 	if m != nil {
-		return m.TxId
+		return m.FrozenPeriod
 	}
-	return ""
+	return 0
 }
 
-type GetTxStatusResponse struct {
-	Code   int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+type EstimateStakingRewardResponse struct {
+	StartHeight   uint64                                      `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight     uint64                                      `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Rank          int32                                       `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Weight        float64                                     `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	BlockReward   string                                      `protobuf:"bytes,5,opt,name=block_reward,json=blockReward,proto3" json:"block_reward,omitempty"`
+	AnnualReward  string                                      `protobuf:"bytes,6,opt,name=annual_reward,json=annualReward,proto3" json:"annual_reward,omitempty"`
+	Apr           float64                                     `protobuf:"fixed64,7,opt,name=apr,proto3" json:"apr,omitempty"`
+	TotalReward   string                                      `protobuf:"bytes,8,opt,name=total_reward,json=totalReward,proto3" json:"total_reward,omitempty"`
+	BlockInterval float64                                     `protobuf:"fixed64,9,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	Projections   []*EstimateStakingRewardResponse_Projection `protobuf:"bytes,10,rep,name=projections" json:"projections,omitempty"`
 }
 
-func (m *GetTxStatusResponse) Reset()                    { *m = GetTxStatusResponse{} }
-func (m *GetTxStatusResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetTxStatusResponse) ProtoMessage()               {}
-func (*GetTxStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{49} }
+func (m *EstimateStakingRewardResponse) Reset()         { *m = EstimateStakingRewardResponse{} }
+func (m *EstimateStakingRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateStakingRewardResponse) ProtoMessage()    {}
+func (*EstimateStakingRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{47}
+}
 
-func (m *GetTxStatusResponse) GetCode() int32 {
+func (m *EstimateStakingRewardResponse) GetStartHeight() uint64 {
 	if m != nil {
-		return m.Code
+		return m.StartHeight
 	}
 	return 0
 }
 
-func (m *GetTxStatusResponse) GetStatus() string {
+func (m *EstimateStakingRewardResponse) GetEndHeight() uint64 {
 	if m != nil {
-		return m.Status
+		return m.EndHeight
 	}
-	return ""
+	return 0
 }
 
-type SignRawTransactionRequest struct {
-	RawTx      string `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
-	Flags      string `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
-	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+func (m *EstimateStakingRewardResponse) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
 }
 
-func (m *SignRawTransactionRequest) Reset()                    { *m = SignRawTransactionRequest{} }
-func (m *SignRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*SignRawTransactionRequest) ProtoMessage()               {}
-func (*SignRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{50} }
-
-func (m *SignRawTransactionRequest) GetRawTx() string {
+func (m *EstimateStakingRewardResponse) GetWeight() float64 {
 	if m != nil {
-		return m.RawTx
+		return m.Weight
 	}
-	return ""
+	return 0
 }
 
-func (m *SignRawTransactionRequest) GetFlags() string {
+func (m *EstimateStakingRewardResponse) GetBlockReward() string {
 	if m != nil {
-		return m.Flags
+		return m.BlockReward
 	}
 	return ""
 }
 
-func (m *SignRawTransactionRequest) GetPassphrase() string {
+func (m *EstimateStakingRewardResponse) GetAnnualReward() string {
 	if m != nil {
-		return m.Passphrase
+		return m.AnnualReward
 	}
 	return ""
 }
 
-type SignRawTransactionResponse struct {
-	Hex      string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
-	Complete bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+func (m *EstimateStakingRewardResponse) GetApr() float64 {
+	if m != nil {
+		return m.Apr
+	}
+	return 0
 }
 
-func (m *SignRawTransactionResponse) Reset()                    { *m = SignRawTransactionResponse{} }
-func (m *SignRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*SignRawTransactionResponse) ProtoMessage()               {}
-func (*SignRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{51} }
-
-func (m *SignRawTransactionResponse) GetHex() string {
+func (m *EstimateStakingRewardResponse) GetTotalReward() string {
 	if m != nil {
-		return m.Hex
+		return m.TotalReward
 	}
 	return ""
 }
 
-func (m *SignRawTransactionResponse) GetComplete() bool {
+func (m *EstimateStakingRewardResponse) GetBlockInterval() float64 {
 	if m != nil {
-		return m.Complete
+		return m.BlockInterval
 	}
-	return false
-}
-
-type GetUtxoRequest struct {
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	return 0
 }
 
-func (m *GetUtxoRequest) Reset()                    { *m = GetUtxoRequest{} }
-func (m *GetUtxoRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetUtxoRequest) ProtoMessage()               {}
-func (*GetUtxoRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{52} }
-
-func (m *GetUtxoRequest) GetAddresses() []string {
+func (m *EstimateStakingRewardResponse) GetProjections() []*EstimateStakingRewardResponse_Projection {
 	if m != nil {
-		return m.Addresses
+		return m.Projections
 	}
 	return nil
 }
 
-type UTXO struct {
-	TxId           string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Vout           uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
-	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	BlockHeight    uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Maturity       uint32 `protobuf:"varint,5,opt,name=maturity,proto3" json:"maturity,omitempty"`
-	Confirmations  uint32 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	SpentByUnmined bool   `protobuf:"varint,7,opt,name=spent_by_unmined,json=spentByUnmined,proto3" json:"spent_by_unmined,omitempty"`
+type EstimateStakingRewardResponse_Projection struct {
+	Height      uint64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Blocks      uint64  `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Rank        int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Weight      float64 `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	TotalWeight float64 `protobuf:"fixed64,5,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	Reward      string  `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"`
 }
 
-func (m *UTXO) Reset()                    { *m = UTXO{} }
-func (m *UTXO) String() string            { return proto.CompactTextString(m) }
-func (*UTXO) ProtoMessage()               {}
-func (*UTXO) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{53} }
+func (m *EstimateStakingRewardResponse_Projection) Reset() {
+	*m = EstimateStakingRewardResponse_Projection{}
+}
+func (m *EstimateStakingRewardResponse_Projection) String() string { return proto.CompactTextString(m) }
+func (*EstimateStakingRewardResponse_Projection) ProtoMessage()    {}
+func (*EstimateStakingRewardResponse_Projection) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{47, 0}
+}
 
-func (m *UTXO) GetTxId() string {
+func (m *EstimateStakingRewardResponse_Projection) GetHeight() uint64 {
 	if m != nil {
-		return m.TxId
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *UTXO) GetVout() uint32 {
+func (m *EstimateStakingRewardResponse_Projection) GetBlocks() uint64 {
 	if m != nil {
-		return m.Vout
+		return m.Blocks
 	}
 	return 0
 }
 
-func (m *UTXO) GetAmount() string {
+func (m *EstimateStakingRewardResponse_Projection) GetRank() int32 {
 	if m != nil {
-		return m.Amount
+		return m.Rank
 	}
-	return ""
+	return 0
 }
 
-func (m *UTXO) GetBlockHeight() uint64 {
+func (m *EstimateStakingRewardResponse_Projection) GetWeight() float64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.Weight
 	}
 	return 0
 }
 
-func (m *UTXO) GetMaturity() uint32 {
+func (m *EstimateStakingRewardResponse_Projection) GetTotalWeight() float64 {
 	if m != nil {
-		return m.Maturity
+		return m.TotalWeight
 	}
 	return 0
 }
 
-func (m *UTXO) GetConfirmations() uint32 {
+func (m *EstimateStakingRewardResponse_Projection) GetReward() string {
 	if m != nil {
-		return m.Confirmations
-	}
-	return 0
-}
-
-func (m *UTXO) GetSpentByUnmined() bool {
-	if m != nil {
-		return m.SpentByUnmined
-	}
-	return false
-}
-
-type AddressUTXO struct {
-	Address string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Utxos   []*UTXO `protobuf:"bytes,2,rep,name=utxos" json:"utxos,omitempty"`
-}
-
-func (m *AddressUTXO) Reset()                    { *m = AddressUTXO{} }
-func (m *AddressUTXO) String() string            { return proto.CompactTextString(m) }
-func (*AddressUTXO) ProtoMessage()               {}
-func (*AddressUTXO) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{54} }
-
-func (m *AddressUTXO) GetAddress() string {
-	if m != nil {
-		return m.Address
+		return m.Reward
 	}
 	return ""
 }
 
-func (m *AddressUTXO) GetUtxos() []*UTXO {
-	if m != nil {
-		return m.Utxos
-	}
-	return nil
-}
-
-type GetUtxoResponse struct {
-	AddressUtxos []*AddressUTXO `protobuf:"bytes,1,rep,name=address_utxos,json=addressUtxos" json:"address_utxos,omitempty"`
-}
-
-func (m *GetUtxoResponse) Reset()                    { *m = GetUtxoResponse{} }
-func (m *GetUtxoResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetUtxoResponse) ProtoMessage()               {}
-func (*GetUtxoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{55} }
-
-func (m *GetUtxoResponse) GetAddressUtxos() []*AddressUTXO {
-	if m != nil {
-		return m.AddressUtxos
-	}
-	return nil
-}
-
-type GetAddressBindingRequest struct {
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+type GetStakingHistoryRequest struct {
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (m *GetAddressBindingRequest) Reset()                    { *m = GetAddressBindingRequest{} }
-func (m *GetAddressBindingRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetAddressBindingRequest) ProtoMessage()               {}
-func (*GetAddressBindingRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{56} }
+func (m *GetStakingHistoryRequest) Reset()                    { *m = GetStakingHistoryRequest{} }
+func (m *GetStakingHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetStakingHistoryRequest) ProtoMessage()               {}
+func (*GetStakingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{48} }
 
-func (m *GetAddressBindingRequest) GetAddresses() []string {
+func (m *GetStakingHistoryRequest) GetType() string {
 	if m != nil {
-		return m.Addresses
+		return m.Type
 	}
-	return nil
+	return ""
 }
 
-type GetAddressBindingResponse struct {
-	Amounts map[string]string `protobuf:"bytes,1,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type GetStakingHistoryResponse struct {
+	Txs     []*GetStakingHistoryResponse_Tx `protobuf:"bytes,1,rep,name=txs" json:"txs,omitempty"`
+	Weights map[string]float64              `protobuf:"bytes,2,rep,name=weights" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 }
 
-func (m *GetAddressBindingResponse) Reset()                    { *m = GetAddressBindingResponse{} }
-func (m *GetAddressBindingResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetAddressBindingResponse) ProtoMessage()               {}
-func (*GetAddressBindingResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{57} }
+func (m *GetStakingHistoryResponse) Reset()                    { *m = GetStakingHistoryResponse{} }
+func (m *GetStakingHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetStakingHistoryResponse) ProtoMessage()               {}
+func (*GetStakingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{49} }
 
-func (m *GetAddressBindingResponse) GetAmounts() map[string]string {
+func (m *GetStakingHistoryResponse) GetTxs() []*GetStakingHistoryResponse_Tx {
 	if m != nil {
-		return m.Amounts
+		return m.Txs
 	}
 	return nil
 }
 
-type GetBindingHistoryRequest struct {
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-}
-
-func (m *GetBindingHistoryRequest) Reset()                    { *m = GetBindingHistoryRequest{} }
-func (m *GetBindingHistoryRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetBindingHistoryRequest) ProtoMessage()               {}
-func (*GetBindingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{58} }
-
-func (m *GetBindingHistoryRequest) GetType() string {
-	if m != nil {
-		return m.Type
-	}
-	return ""
-}
-
-type GetBindingHistoryResponse struct {
-	Histories []*GetBindingHistoryResponse_History `protobuf:"bytes,1,rep,name=histories" json:"histories,omitempty"`
-}
-
-func (m *GetBindingHistoryResponse) Reset()                    { *m = GetBindingHistoryResponse{} }
-func (m *GetBindingHistoryResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetBindingHistoryResponse) ProtoMessage()               {}
-func (*GetBindingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{59} }
-
-func (m *GetBindingHistoryResponse) GetHistories() []*GetBindingHistoryResponse_History {
+func (m *GetStakingHistoryResponse) GetWeights() map[string]float64 {
 	if m != nil {
-		return m.Histories
+		return m.Weights
 	}
 	return nil
 }
 
-type GetBindingHistoryResponse_BindingUTXO struct {
-	TxId           string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Vout           uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
-	HolderAddress  string `protobuf:"bytes,3,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
-	BindingAddress string `protobuf:"bytes,4,opt,name=binding_address,json=bindingAddress,proto3" json:"binding_address,omitempty"`
-	Amount         string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+type GetStakingHistoryResponse_StakingUTXO struct {
+	TxId         string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout         uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Amount       string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	FrozenPeriod uint32 `protobuf:"varint,5,opt,name=frozen_period,json=frozenPeriod,proto3" json:"frozen_period,omitempty"`
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) Reset()         { *m = GetBindingHistoryResponse_BindingUTXO{} }
-func (m *GetBindingHistoryResponse_BindingUTXO) String() string { return proto.CompactTextString(m) }
-func (*GetBindingHistoryResponse_BindingUTXO) ProtoMessage()    {}
-func (*GetBindingHistoryResponse_BindingUTXO) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{59, 0}
+func (m *GetStakingHistoryResponse_StakingUTXO) Reset()         { *m = GetStakingHistoryResponse_StakingUTXO{} }
+func (m *GetStakingHistoryResponse_StakingUTXO) String() string { return proto.CompactTextString(m) }
+func (*GetStakingHistoryResponse_StakingUTXO) ProtoMessage()    {}
+func (*GetStakingHistoryResponse_StakingUTXO) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{49, 0}
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetTxId() string {
+func (m *GetStakingHistoryResponse_StakingUTXO) GetTxId() string {
 	if m != nil {
 		return m.TxId
 	}
 	return ""
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetVout() uint32 {
+func (m *GetStakingHistoryResponse_StakingUTXO) GetVout() uint32 {
 	if m != nil {
 		return m.Vout
 	}
 	return 0
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetHolderAddress() string {
+func (m *GetStakingHistoryResponse_StakingUTXO) GetAddress() string {
 	if m != nil {
-		return m.HolderAddress
+		return m.Address
 	}
 	return ""
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetBindingAddress() string {
+func (m *GetStakingHistoryResponse_StakingUTXO) GetAmount() string {
 	if m != nil {
-		return m.BindingAddress
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetAmount() string {
+func (m *GetStakingHistoryResponse_StakingUTXO) GetFrozenPeriod() uint32 {
 	if m != nil {
-		return m.Amount
+		return m.FrozenPeriod
 	}
-	return ""
+	return 0
 }
 
-type GetBindingHistoryResponse_History struct {
-	TxId          string                                 `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Status        uint32                                 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	BlockHeight   uint64                                 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Utxo          *GetBindingHistoryResponse_BindingUTXO `protobuf:"bytes,4,opt,name=utxo" json:"utxo,omitempty"`
-	FromAddresses []string                               `protobuf:"bytes,5,rep,name=from_addresses,json=fromAddresses" json:"from_addresses,omitempty"`
+type GetStakingHistoryResponse_Tx struct {
+	TxId        string                                 `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Status      uint32                                 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	BlockHeight uint64                                 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Utxo        *GetStakingHistoryResponse_StakingUTXO `protobuf:"bytes,4,opt,name=utxo" json:"utxo,omitempty"`
 }
 
-func (m *GetBindingHistoryResponse_History) Reset()         { *m = GetBindingHistoryResponse_History{} }
-func (m *GetBindingHistoryResponse_History) String() string { return proto.CompactTextString(m) }
-func (*GetBindingHistoryResponse_History) ProtoMessage()    {}
-func (*GetBindingHistoryResponse_History) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{59, 1}
+func (m *GetStakingHistoryResponse_Tx) Reset()         { *m = GetStakingHistoryResponse_Tx{} }
+func (m *GetStakingHistoryResponse_Tx) String() string { return proto.CompactTextString(m) }
+func (*GetStakingHistoryResponse_Tx) ProtoMessage()    {}
+func (*GetStakingHistoryResponse_Tx) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{49, 1}
 }
 
-func (m *GetBindingHistoryResponse_History) GetTxId() string {
+func (m *GetStakingHistoryResponse_Tx) GetTxId() string {
 	if m != nil {
 		return m.TxId
 	}
 	return ""
 }
 
-func (m *GetBindingHistoryResponse_History) GetStatus() uint32 {
+func (m *GetStakingHistoryResponse_Tx) GetStatus() uint32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetBindingHistoryResponse_History) GetBlockHeight() uint64 {
+func (m *GetStakingHistoryResponse_Tx) GetBlockHeight() uint64 {
 	if m != nil {
 		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *GetBindingHistoryResponse_History) GetUtxo() *GetBindingHistoryResponse_BindingUTXO {
+func (m *GetStakingHistoryResponse_Tx) GetUtxo() *GetStakingHistoryResponse_StakingUTXO {
 	if m != nil {
 		return m.Utxo
 	}
 	return nil
 }
 
-func (m *GetBindingHistoryResponse_History) GetFromAddresses() []string {
-	if m != nil {
-		return m.FromAddresses
-	}
-	return nil
+type GetStakingRewardHistoryRequest struct {
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 }
 
-type CreateBindingTransactionRequest struct {
-	Outputs     []*CreateBindingTransactionRequest_Output `protobuf:"bytes,1,rep,name=outputs" json:"outputs,omitempty"`
-	FromAddress string                                    `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	Fee         string                                    `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+func (m *GetStakingRewardHistoryRequest) Reset()         { *m = GetStakingRewardHistoryRequest{} }
+func (m *GetStakingRewardHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStakingRewardHistoryRequest) ProtoMessage()    {}
+func (*GetStakingRewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{50}
 }
 
-func (m *CreateBindingTransactionRequest) Reset()         { *m = CreateBindingTransactionRequest{} }
-func (m *CreateBindingTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateBindingTransactionRequest) ProtoMessage()    {}
-func (*CreateBindingTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{60}
+func (m *GetStakingRewardHistoryRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
 }
 
-func (m *CreateBindingTransactionRequest) GetOutputs() []*CreateBindingTransactionRequest_Output {
+func (m *GetStakingRewardHistoryRequest) GetEndTime() int64 {
 	if m != nil {
-		return m.Outputs
+		return m.EndTime
 	}
-	return nil
+	return 0
 }
 
-func (m *CreateBindingTransactionRequest) GetFromAddress() string {
+type GetStakingRewardHistoryResponse struct {
+	Days   []*GetStakingRewardHistoryResponse_Day   `protobuf:"bytes,1,rep,name=days" json:"days,omitempty"`
+	Totals []*GetStakingRewardHistoryResponse_Total `protobuf:"bytes,2,rep,name=totals" json:"totals,omitempty"`
+}
+
+func (m *GetStakingRewardHistoryResponse) Reset()         { *m = GetStakingRewardHistoryResponse{} }
+func (m *GetStakingRewardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStakingRewardHistoryResponse) ProtoMessage()    {}
+func (*GetStakingRewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{51}
+}
+
+func (m *GetStakingRewardHistoryResponse) GetDays() []*GetStakingRewardHistoryResponse_Day {
 	if m != nil {
-		return m.FromAddress
+		return m.Days
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateBindingTransactionRequest) GetFee() string {
+func (m *GetStakingRewardHistoryResponse) GetTotals() []*GetStakingRewardHistoryResponse_Total {
 	if m != nil {
-		return m.Fee
+		return m.Totals
 	}
-	return ""
+	return nil
 }
 
-type CreateBindingTransactionRequest_Output struct {
-	HolderAddress  string `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
-	BindingAddress string `protobuf:"bytes,2,opt,name=binding_address,json=bindingAddress,proto3" json:"binding_address,omitempty"`
-	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+type GetStakingRewardHistoryResponse_Day struct {
+	Date           string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	StakingAddress string `protobuf:"bytes,2,opt,name=staking_address,json=stakingAddress,proto3" json:"staking_address,omitempty"`
+	Blocks         uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	FirstHeight    uint64 `protobuf:"varint,4,opt,name=first_height,json=firstHeight,proto3" json:"first_height,omitempty"`
+	LastHeight     uint64 `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	Reward         string `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"`
 }
 
-func (m *CreateBindingTransactionRequest_Output) Reset() {
-	*m = CreateBindingTransactionRequest_Output{}
-}
-func (m *CreateBindingTransactionRequest_Output) String() string { return proto.CompactTextString(m) }
-func (*CreateBindingTransactionRequest_Output) ProtoMessage()    {}
-func (*CreateBindingTransactionRequest_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{60, 0}
+func (m *GetStakingRewardHistoryResponse_Day) Reset()         { *m = GetStakingRewardHistoryResponse_Day{} }
+func (m *GetStakingRewardHistoryResponse_Day) String() string { return proto.CompactTextString(m) }
+func (*GetStakingRewardHistoryResponse_Day) ProtoMessage()    {}
+func (*GetStakingRewardHistoryResponse_Day) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{51, 0}
 }
 
-func (m *CreateBindingTransactionRequest_Output) GetHolderAddress() string {
+func (m *GetStakingRewardHistoryResponse_Day) GetDate() string {
 	if m != nil {
-		return m.HolderAddress
+		return m.Date
 	}
 	return ""
 }
 
-func (m *CreateBindingTransactionRequest_Output) GetBindingAddress() string {
+func (m *GetStakingRewardHistoryResponse_Day) GetStakingAddress() string {
 	if m != nil {
-		return m.BindingAddress
+		return m.StakingAddress
 	}
 	return ""
 }
 
-func (m *CreateBindingTransactionRequest_Output) GetAmount() string {
+func (m *GetStakingRewardHistoryResponse_Day) GetBlocks() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.Blocks
 	}
-	return ""
+	return 0
 }
 
-type GetBestBlockResponse struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+func (m *GetStakingRewardHistoryResponse_Day) GetFirstHeight() uint64 {
+	if m != nil {
+		return m.FirstHeight
+	}
+	return 0
 }
 
-func (m *GetBestBlockResponse) Reset()                    { *m = GetBestBlockResponse{} }
-func (m *GetBestBlockResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetBestBlockResponse) ProtoMessage()               {}
-func (*GetBestBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{61} }
-
-func (m *GetBestBlockResponse) GetHeight() uint64 {
+func (m *GetStakingRewardHistoryResponse_Day) GetLastHeight() uint64 {
 	if m != nil {
-		return m.Height
+		return m.LastHeight
 	}
 	return 0
 }
 
-func (m *GetBestBlockResponse) GetTarget() string {
+func (m *GetStakingRewardHistoryResponse_Day) GetReward() string {
 	if m != nil {
-		return m.Target
+		return m.Reward
 	}
 	return ""
 }
 
-type GetWalletMnemonicRequest struct {
-	WalletId   string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+type GetStakingRewardHistoryResponse_Total struct {
+	StakingAddress string `protobuf:"bytes,1,opt,name=staking_address,json=stakingAddress,proto3" json:"staking_address,omitempty"`
+	Blocks         uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Reward         string `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	BlockReward    string `protobuf:"bytes,4,opt,name=block_reward,json=blockReward,proto3" json:"block_reward,omitempty"`
 }
 
-func (m *GetWalletMnemonicRequest) Reset()                    { *m = GetWalletMnemonicRequest{} }
-func (m *GetWalletMnemonicRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetWalletMnemonicRequest) ProtoMessage()               {}
-func (*GetWalletMnemonicRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{62} }
+func (m *GetStakingRewardHistoryResponse_Total) Reset()         { *m = GetStakingRewardHistoryResponse_Total{} }
+func (m *GetStakingRewardHistoryResponse_Total) String() string { return proto.CompactTextString(m) }
+func (*GetStakingRewardHistoryResponse_Total) ProtoMessage()    {}
+func (*GetStakingRewardHistoryResponse_Total) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{51, 1}
+}
 
-func (m *GetWalletMnemonicRequest) GetWalletId() string {
+func (m *GetStakingRewardHistoryResponse_Total) GetStakingAddress() string {
 	if m != nil {
-		return m.WalletId
+		return m.StakingAddress
 	}
 	return ""
 }
 
-func (m *GetWalletMnemonicRequest) GetPassphrase() string {
+func (m *GetStakingRewardHistoryResponse_Total) GetBlocks() uint64 {
 	if m != nil {
-		return m.Passphrase
+		return m.Blocks
 	}
-	return ""
+	return 0
 }
 
-type GetWalletMnemonicResponse struct {
-	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
-	Version  uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+func (m *GetStakingRewardHistoryResponse_Total) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
 }
 
-func (m *GetWalletMnemonicResponse) Reset()                    { *m = GetWalletMnemonicResponse{} }
-func (m *GetWalletMnemonicResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetWalletMnemonicResponse) ProtoMessage()               {}
-func (*GetWalletMnemonicResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{63} }
+func (m *GetStakingRewardHistoryResponse_Total) GetBlockReward() string {
+	if m != nil {
+		return m.BlockReward
+	}
+	return ""
+}
 
-func (m *GetWalletMnemonicResponse) GetMnemonic() string {
+type SendRawTransactionRequest struct {
+	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+}
+
+func (m *SendRawTransactionRequest) Reset()                    { *m = SendRawTransactionRequest{} }
+func (m *SendRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendRawTransactionRequest) ProtoMessage()               {}
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{52} }
+
+func (m *SendRawTransactionRequest) GetHex() string {
 	if m != nil {
-		return m.Mnemonic
+		return m.Hex
 	}
 	return ""
 }
 
-func (m *GetWalletMnemonicResponse) GetVersion() uint32 {
+type SendRawTransactionResponse struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (m *SendRawTransactionResponse) Reset()                    { *m = SendRawTransactionResponse{} }
+func (m *SendRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SendRawTransactionResponse) ProtoMessage()               {}
+func (*SendRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{53} }
+
+func (m *SendRawTransactionResponse) GetTxId() string {
 	if m != nil {
-		return m.Version
+		return m.TxId
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*GetClientStatusResponse)(nil), "rpcprotobuf.GetClientStatusResponse")
-	proto.RegisterType((*GetClientStatusResponsePeerCountInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerCountInfo")
-	proto.RegisterType((*GetClientStatusResponsePeerInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerInfo")
-	proto.RegisterType((*GetClientStatusResponsePeerList)(nil), "rpcprotobuf.GetClientStatusResponse.peerList")
-	proto.RegisterType((*QuitClientResponse)(nil), "rpcprotobuf.QuitClientResponse")
-	proto.RegisterType((*WalletsResponse)(nil), "rpcprotobuf.WalletsResponse")
-	proto.RegisterType((*WalletsResponse_WalletSummary)(nil), "rpcprotobuf.WalletsResponse.WalletSummary")
-	proto.RegisterType((*UseWalletRequest)(nil), "rpcprotobuf.UseWalletRequest")
-	proto.RegisterType((*UseWalletResponse)(nil), "rpcprotobuf.UseWalletResponse")
-	proto.RegisterType((*CreateWalletRequest)(nil), "rpcprotobuf.CreateWalletRequest")
-	proto.RegisterType((*CreateWalletResponse)(nil), "rpcprotobuf.CreateWalletResponse")
-	proto.RegisterType((*ImportWalletRequest)(nil), "rpcprotobuf.ImportWalletRequest")
-	proto.RegisterType((*ImportWalletResponse)(nil), "rpcprotobuf.ImportWalletResponse")
-	proto.RegisterType((*ImportMnemonicRequest)(nil), "rpcprotobuf.ImportMnemonicRequest")
-	proto.RegisterType((*ExportWalletRequest)(nil), "rpcprotobuf.ExportWalletRequest")
-	proto.RegisterType((*ExportWalletResponse)(nil), "rpcprotobuf.ExportWalletResponse")
-	proto.RegisterType((*RemoveWalletRequest)(nil), "rpcprotobuf.RemoveWalletRequest")
-	proto.RegisterType((*RemoveWalletResponse)(nil), "rpcprotobuf.RemoveWalletResponse")
-	proto.RegisterType((*GetAddressBalanceRequest)(nil), "rpcprotobuf.GetAddressBalanceRequest")
-	proto.RegisterType((*AddressAndBalance)(nil), "rpcprotobuf.AddressAndBalance")
-	proto.RegisterType((*GetAddressBalanceResponse)(nil), "rpcprotobuf.GetAddressBalanceResponse")
-	proto.RegisterType((*ValidateAddressRequest)(nil), "rpcprotobuf.ValidateAddressRequest")
-	proto.RegisterType((*ValidateAddressResponse)(nil), "rpcprotobuf.ValidateAddressResponse")
-	proto.RegisterType((*CreateAddressRequest)(nil), "rpcprotobuf.CreateAddressRequest")
-	proto.RegisterType((*CreateAddressResponse)(nil), "rpcprotobuf.CreateAddressResponse")
-	proto.RegisterType((*GetAddressesRequest)(nil), "rpcprotobuf.GetAddressesRequest")
-	proto.RegisterType((*GetAddressesResponse)(nil), "rpcprotobuf.GetAddressesResponse")
-	proto.RegisterType((*GetAddressesResponse_AddressDetail)(nil), "rpcprotobuf.GetAddressesResponse.AddressDetail")
-	proto.RegisterType((*GetWalletBalanceRequest)(nil), "rpcprotobuf.GetWalletBalanceRequest")
-	proto.RegisterType((*GetWalletBalanceResponse)(nil), "rpcprotobuf.GetWalletBalanceResponse")
-	proto.RegisterType((*GetWalletBalanceResponse_Detail)(nil), "rpcprotobuf.GetWalletBalanceResponse.Detail")
-	proto.RegisterType((*TxHistoryDetails)(nil), "rpcprotobuf.TxHistoryDetails")
-	proto.RegisterType((*TxHistoryDetails_Input)(nil), "rpcprotobuf.TxHistoryDetails.Input")
-	proto.RegisterType((*TxHistoryDetails_Output)(nil), "rpcprotobuf.TxHistoryDetails.Output")
-	proto.RegisterType((*TxHistoryResponse)(nil), "rpcprotobuf.TxHistoryResponse")
-	proto.RegisterType((*TxHistoryRequest)(nil), "rpcprotobuf.TxHistoryRequest")
-	proto.RegisterType((*TransactionInput)(nil), "rpcprotobuf.TransactionInput")
-	proto.RegisterType((*DecodeRawTransactionRequest)(nil), "rpcprotobuf.DecodeRawTransactionRequest")
-	proto.RegisterType((*DecodeRawTransactionResponse)(nil), "rpcprotobuf.DecodeRawTransactionResponse")
-	proto.RegisterType((*DecodeRawTransactionResponse_Vin)(nil), "rpcprotobuf.DecodeRawTransactionResponse.Vin")
-	proto.RegisterType((*DecodeRawTransactionResponse_Vout)(nil), "rpcprotobuf.DecodeRawTransactionResponse.Vout")
-	proto.RegisterType((*CreateRawTransactionRequest)(nil), "rpcprotobuf.CreateRawTransactionRequest")
-	proto.RegisterType((*AutoCreateTransactionRequest)(nil), "rpcprotobuf.AutoCreateTransactionRequest")
-	proto.RegisterType((*CreateRawTransactionResponse)(nil), "rpcprotobuf.CreateRawTransactionResponse")
-	proto.RegisterType((*CreateStakingTransactionRequest)(nil), "rpcprotobuf.CreateStakingTransactionRequest")
-	proto.RegisterType((*GetBlockStakingRewardRequest)(nil), "rpcprotobuf.GetBlockStakingRewardRequest")
-	proto.RegisterType((*GetBlockStakingRewardResponse)(nil), "rpcprotobuf.GetBlockStakingRewardResponse")
-	proto.RegisterType((*GetBlockStakingRewardResponse_RewardDetail)(nil), "rpcprotobuf.GetBlockStakingRewardResponse.RewardDetail")
-	proto.RegisterType((*GetStakingHistoryRequest)(nil), "rpcprotobuf.GetStakingHistoryRequest")
-	proto.RegisterType((*GetStakingHistoryResponse)(nil), "rpcprotobuf.GetStakingHistoryResponse")
-	proto.RegisterType((*GetStakingHistoryResponse_StakingUTXO)(nil), "rpcprotobuf.GetStakingHistoryResponse.StakingUTXO")
-	proto.RegisterType((*GetStakingHistoryResponse_Tx)(nil), "rpcprotobuf.GetStakingHistoryResponse.Tx")
-	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcprotobuf.SendRawTransactionRequest")
-	proto.RegisterType((*SendRawTransactionResponse)(nil), "rpcprotobuf.SendRawTransactionResponse")
-	proto.RegisterType((*GetTransactionFeeRequest)(nil), "rpcprotobuf.GetTransactionFeeRequest")
-	proto.RegisterType((*GetTransactionFeeResponse)(nil), "rpcprotobuf.GetTransactionFeeResponse")
-	proto.RegisterType((*BlockInfoForTx)(nil), "rpcprotobuf.BlockInfoForTx")
-	proto.RegisterType((*Vin)(nil), "rpcprotobuf.Vin")
-	proto.RegisterType((*Vin_RedeemDetail)(nil), "rpcprotobuf.Vin.RedeemDetail")
-	proto.RegisterType((*Vout)(nil), "rpcprotobuf.Vout")
-	proto.RegisterType((*Vout_ScriptDetail)(nil), "rpcprotobuf.Vout.ScriptDetail")
-	proto.RegisterType((*GetRawTransactionRequest)(nil), "rpcprotobuf.GetRawTransactionRequest")
-	proto.RegisterType((*GetRawTransactionResponse)(nil), "rpcprotobuf.GetRawTransactionResponse")
-	proto.RegisterType((*GetTxStatusRequest)(nil), "rpcprotobuf.GetTxStatusRequest")
-	proto.RegisterType((*GetTxStatusResponse)(nil), "rpcprotobuf.GetTxStatusResponse")
-	proto.RegisterType((*SignRawTransactionRequest)(nil), "rpcprotobuf.SignRawTransactionRequest")
-	proto.RegisterType((*SignRawTransactionResponse)(nil), "rpcprotobuf.SignRawTransactionResponse")
-	proto.RegisterType((*GetUtxoRequest)(nil), "rpcprotobuf.GetUtxoRequest")
-	proto.RegisterType((*UTXO)(nil), "rpcprotobuf.UTXO")
-	proto.RegisterType((*AddressUTXO)(nil), "rpcprotobuf.AddressUTXO")
-	proto.RegisterType((*GetUtxoResponse)(nil), "rpcprotobuf.GetUtxoResponse")
-	proto.RegisterType((*GetAddressBindingRequest)(nil), "rpcprotobuf.GetAddressBindingRequest")
-	proto.RegisterType((*GetAddressBindingResponse)(nil), "rpcprotobuf.GetAddressBindingResponse")
-	proto.RegisterType((*GetBindingHistoryRequest)(nil), "rpcprotobuf.GetBindingHistoryRequest")
-	proto.RegisterType((*GetBindingHistoryResponse)(nil), "rpcprotobuf.GetBindingHistoryResponse")
-	proto.RegisterType((*GetBindingHistoryResponse_BindingUTXO)(nil), "rpcprotobuf.GetBindingHistoryResponse.BindingUTXO")
-	proto.RegisterType((*GetBindingHistoryResponse_History)(nil), "rpcprotobuf.GetBindingHistoryResponse.History")
-	proto.RegisterType((*CreateBindingTransactionRequest)(nil), "rpcprotobuf.CreateBindingTransactionRequest")
-	proto.RegisterType((*CreateBindingTransactionRequest_Output)(nil), "rpcprotobuf.CreateBindingTransactionRequest.Output")
-	proto.RegisterType((*GetBestBlockResponse)(nil), "rpcprotobuf.GetBestBlockResponse")
-	proto.RegisterType((*GetWalletMnemonicRequest)(nil), "rpcprotobuf.GetWalletMnemonicRequest")
-	proto.RegisterType((*GetWalletMnemonicResponse)(nil), "rpcprotobuf.GetWalletMnemonicResponse")
+type BumpFeeRequest struct {
+	TxId       string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Fee        string `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *BumpFeeRequest) Reset()                    { *m = BumpFeeRequest{} }
+func (m *BumpFeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*BumpFeeRequest) ProtoMessage()               {}
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{54} }
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *BumpFeeRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
 
-// Client API for ApiService service
+func (m *BumpFeeRequest) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
 
-type ApiServiceClient interface {
-	GetBestBlock(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetBestBlockResponse, error)
-	GetBlockStakingReward(ctx context.Context, in *GetBlockStakingRewardRequest, opts ...grpc.CallOption) (*GetBlockStakingRewardResponse, error)
-	GetClientStatus(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error)
-	QuitClient(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error)
-	// commands act on a wallet
-	Wallets(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*WalletsResponse, error)
-	// just create non-poc wallet
-	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
-	UseWallet(ctx context.Context, in *UseWalletRequest, opts ...grpc.CallOption) (*UseWalletResponse, error)
-	ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
-	ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
-	ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error)
-	RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error)
-	GetWalletMnemonic(ctx context.Context, in *GetWalletMnemonicRequest, opts ...grpc.CallOption) (*GetWalletMnemonicResponse, error)
-	GetWalletBalance(ctx context.Context, in *GetWalletBalanceRequest, opts ...grpc.CallOption) (*GetWalletBalanceResponse, error)
-	CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error)
-	GetAddresses(ctx context.Context, in *GetAddressesRequest, opts ...grpc.CallOption) (*GetAddressesResponse, error)
-	// if addresses not provided, return balances of all addresses
-	GetAddressBalance(ctx context.Context, in *GetAddressBalanceRequest, opts ...grpc.CallOption) (*GetAddressBalanceResponse, error)
-	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
-	// if addresses not provided, return utxos of all addresses
-	GetUtxo(ctx context.Context, in *GetUtxoRequest, opts ...grpc.CallOption) (*GetUtxoResponse, error)
-	DecodeRawTransaction(ctx context.Context, in *DecodeRawTransactionRequest, opts ...grpc.CallOption) (*DecodeRawTransactionResponse, error)
-	CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
-	AutoCreateTransaction(ctx context.Context, in *AutoCreateTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
-	SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error)
-	GetTransactionFee(ctx context.Context, in *GetTransactionFeeRequest, opts ...grpc.CallOption) (*GetTransactionFeeResponse, error)
-	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error)
-	// get tx from chaindb
-	GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
-	GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error)
-	CreateStakingTransaction(ctx context.Context, in *CreateStakingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
-	TxHistory(ctx context.Context, in *TxHistoryRequest, opts ...grpc.CallOption) (*TxHistoryResponse, error)
-	// query by poc addresses
-	GetAddressBinding(ctx context.Context, in *GetAddressBindingRequest, opts ...grpc.CallOption) (*GetAddressBindingResponse, error)
-	GetStakingHistory(ctx context.Context, in *GetStakingHistoryRequest, opts ...grpc.CallOption) (*GetStakingHistoryResponse, error)
-	GetBindingHistory(ctx context.Context, in *GetBindingHistoryRequest, opts ...grpc.CallOption) (*GetBindingHistoryResponse, error)
-	CreateBindingTransaction(ctx context.Context, in *CreateBindingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+func (m *BumpFeeRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
 }
 
-type apiServiceClient struct {
-	cc *grpc.ClientConn
+type BumpFeeResponse struct {
+	TxId        string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	ReplacedFee string `protobuf:"bytes,2,opt,name=replaced_fee,json=replacedFee,proto3" json:"replaced_fee,omitempty"`
+	Fee         string `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
-	return &apiServiceClient{cc}
+func (m *BumpFeeResponse) Reset()                    { *m = BumpFeeResponse{} }
+func (m *BumpFeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*BumpFeeResponse) ProtoMessage()               {}
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{55} }
+
+func (m *BumpFeeResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
 }
 
-func (c *apiServiceClient) GetBestBlock(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetBestBlockResponse, error) {
-	out := new(GetBestBlockResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBestBlock", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *BumpFeeResponse) GetReplacedFee() string {
+	if m != nil {
+		return m.ReplacedFee
 	}
-	return out, nil
+	return ""
 }
 
-func (c *apiServiceClient) GetBlockStakingReward(ctx context.Context, in *GetBlockStakingRewardRequest, opts ...grpc.CallOption) (*GetBlockStakingRewardResponse, error) {
-	out := new(GetBlockStakingRewardResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlockStakingReward", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *BumpFeeResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
 	}
-	return out, nil
+	return ""
 }
 
-func (c *apiServiceClient) GetClientStatus(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error) {
-	out := new(GetClientStatusResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetClientStatus", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type GetTransactionFeeRequest struct {
+	Amounts    map[string]string   `protobuf:"bytes,1,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LockTime   uint64              `protobuf:"varint,2,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	Inputs     []*TransactionInput `protobuf:"bytes,3,rep,name=inputs" json:"inputs,omitempty"`
+	HasBinding bool                `protobuf:"varint,4,opt,name=has_binding,json=hasBinding,proto3" json:"has_binding,omitempty"`
+}
+
+func (m *GetTransactionFeeRequest) Reset()                    { *m = GetTransactionFeeRequest{} }
+func (m *GetTransactionFeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTransactionFeeRequest) ProtoMessage()               {}
+func (*GetTransactionFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{56} }
+
+func (m *GetTransactionFeeRequest) GetAmounts() map[string]string {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
+func (m *GetTransactionFeeRequest) GetLockTime() uint64 {
+	if m != nil {
+		return m.LockTime
+	}
+	return 0
+}
+
+func (m *GetTransactionFeeRequest) GetInputs() []*TransactionInput {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *GetTransactionFeeRequest) GetHasBinding() bool {
+	if m != nil {
+		return m.HasBinding
+	}
+	return false
+}
+
+type GetTransactionFeeResponse struct {
+	Fee string `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *GetTransactionFeeResponse) Reset()                    { *m = GetTransactionFeeResponse{} }
+func (m *GetTransactionFeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetTransactionFeeResponse) ProtoMessage()               {}
+func (*GetTransactionFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{57} }
+
+func (m *GetTransactionFeeResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+type EstimateFeeRateRequest struct {
+	TargetBlocks uint32 `protobuf:"varint,1,opt,name=target_blocks,json=targetBlocks,proto3" json:"target_blocks,omitempty"`
+}
+
+func (m *EstimateFeeRateRequest) Reset()                    { *m = EstimateFeeRateRequest{} }
+func (m *EstimateFeeRateRequest) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeRateRequest) ProtoMessage()               {}
+func (*EstimateFeeRateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{58} }
+
+func (m *EstimateFeeRateRequest) GetTargetBlocks() uint32 {
+	if m != nil {
+		return m.TargetBlocks
+	}
+	return 0
+}
+
+type EstimateFeeRateResponse struct {
+	FeeRate      string `protobuf:"bytes,1,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	TargetBlocks uint32 `protobuf:"varint,2,opt,name=target_blocks,json=targetBlocks,proto3" json:"target_blocks,omitempty"`
+}
+
+func (m *EstimateFeeRateResponse) Reset()                    { *m = EstimateFeeRateResponse{} }
+func (m *EstimateFeeRateResponse) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeRateResponse) ProtoMessage()               {}
+func (*EstimateFeeRateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{59} }
+
+func (m *EstimateFeeRateResponse) GetFeeRate() string {
+	if m != nil {
+		return m.FeeRate
+	}
+	return ""
+}
+
+func (m *EstimateFeeRateResponse) GetTargetBlocks() uint32 {
+	if m != nil {
+		return m.TargetBlocks
+	}
+	return 0
+}
+
+type BlockInfoForTx struct {
+	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *BlockInfoForTx) Reset()                    { *m = BlockInfoForTx{} }
+func (m *BlockInfoForTx) String() string            { return proto.CompactTextString(m) }
+func (*BlockInfoForTx) ProtoMessage()               {}
+func (*BlockInfoForTx) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{60} }
+
+func (m *BlockInfoForTx) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockInfoForTx) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *BlockInfoForTx) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type Vin struct {
+	Value        string            `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	N            uint32            `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	Type         uint32            `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	RedeemDetail *Vin_RedeemDetail `protobuf:"bytes,4,opt,name=redeem_detail,json=redeemDetail" json:"redeem_detail,omitempty"`
+}
+
+func (m *Vin) Reset()                    { *m = Vin{} }
+func (m *Vin) String() string            { return proto.CompactTextString(m) }
+func (*Vin) ProtoMessage()               {}
+func (*Vin) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{61} }
+
+func (m *Vin) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Vin) GetN() uint32 {
+	if m != nil {
+		return m.N
+	}
+	return 0
+}
+
+func (m *Vin) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Vin) GetRedeemDetail() *Vin_RedeemDetail {
+	if m != nil {
+		return m.RedeemDetail
+	}
+	return nil
+}
+
+type Vin_RedeemDetail struct {
+	TxId      string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout      uint32   `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Sequence  uint64   `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Witness   []string `protobuf:"bytes,4,rep,name=witness" json:"witness,omitempty"`
+	Addresses []string `protobuf:"bytes,5,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *Vin_RedeemDetail) Reset()                    { *m = Vin_RedeemDetail{} }
+func (m *Vin_RedeemDetail) String() string            { return proto.CompactTextString(m) }
+func (*Vin_RedeemDetail) ProtoMessage()               {}
+func (*Vin_RedeemDetail) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{61, 0} }
+
+func (m *Vin_RedeemDetail) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *Vin_RedeemDetail) GetVout() uint32 {
+	if m != nil {
+		return m.Vout
+	}
+	return 0
+}
+
+func (m *Vin_RedeemDetail) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Vin_RedeemDetail) GetWitness() []string {
+	if m != nil {
+		return m.Witness
+	}
+	return nil
+}
+
+func (m *Vin_RedeemDetail) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type Vout struct {
+	Value        string             `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	N            uint32             `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	Type         uint32             `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	ScriptDetail *Vout_ScriptDetail `protobuf:"bytes,4,opt,name=script_detail,json=scriptDetail" json:"script_detail,omitempty"`
+}
+
+func (m *Vout) Reset()                    { *m = Vout{} }
+func (m *Vout) String() string            { return proto.CompactTextString(m) }
+func (*Vout) ProtoMessage()               {}
+func (*Vout) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{62} }
+
+func (m *Vout) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Vout) GetN() uint32 {
+	if m != nil {
+		return m.N
+	}
+	return 0
+}
+
+func (m *Vout) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Vout) GetScriptDetail() *Vout_ScriptDetail {
+	if m != nil {
+		return m.ScriptDetail
+	}
+	return nil
+}
+
+type Vout_ScriptDetail struct {
+	Asm       string   `protobuf:"bytes,1,opt,name=asm,proto3" json:"asm,omitempty"`
+	Hex       string   `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
+	ReqSigs   int32    `protobuf:"varint,3,opt,name=req_sigs,json=reqSigs,proto3" json:"req_sigs,omitempty"`
+	Addresses []string `protobuf:"bytes,4,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *Vout_ScriptDetail) Reset()                    { *m = Vout_ScriptDetail{} }
+func (m *Vout_ScriptDetail) String() string            { return proto.CompactTextString(m) }
+func (*Vout_ScriptDetail) ProtoMessage()               {}
+func (*Vout_ScriptDetail) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{62, 0} }
+
+func (m *Vout_ScriptDetail) GetAsm() string {
+	if m != nil {
+		return m.Asm
+	}
+	return ""
+}
+
+func (m *Vout_ScriptDetail) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+func (m *Vout_ScriptDetail) GetReqSigs() int32 {
+	if m != nil {
+		return m.ReqSigs
+	}
+	return 0
+}
+
+func (m *Vout_ScriptDetail) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type GetRawTransactionRequest struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (m *GetRawTransactionRequest) Reset()                    { *m = GetRawTransactionRequest{} }
+func (m *GetRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRawTransactionRequest) ProtoMessage()               {}
+func (*GetRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{63} }
+
+func (m *GetRawTransactionRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+type GetRawTransactionResponse struct {
+	Hex           string          `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	TxId          string          `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Version       int32           `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	LockTime      int64           `protobuf:"varint,4,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	Block         *BlockInfoForTx `protobuf:"bytes,5,opt,name=block" json:"block,omitempty"`
+	Vin           []*Vin          `protobuf:"bytes,6,rep,name=vin" json:"vin,omitempty"`
+	Vout          []*Vout         `protobuf:"bytes,7,rep,name=vout" json:"vout,omitempty"`
+	Payload       string          `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	Confirmations uint64          `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Size         int32           `protobuf:"varint,10,opt,name=size,proto3" json:"size,omitempty"`
+	Fee           string          `protobuf:"bytes,11,opt,name=fee,proto3" json:"fee,omitempty"`
+	Status        int32           `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
+	Coinbase      bool            `protobuf:"varint,13,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+}
+
+func (m *GetRawTransactionResponse) Reset()                    { *m = GetRawTransactionResponse{} }
+func (m *GetRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetRawTransactionResponse) ProtoMessage()               {}
+func (*GetRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{64} }
+
+func (m *GetRawTransactionResponse) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+func (m *GetRawTransactionResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *GetRawTransactionResponse) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetRawTransactionResponse) GetLockTime() int64 {
+	if m != nil {
+		return m.LockTime
+	}
+	return 0
+}
+
+func (m *GetRawTransactionResponse) GetBlock() *BlockInfoForTx {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *GetRawTransactionResponse) GetVin() []*Vin {
+	if m != nil {
+		return m.Vin
+	}
+	return nil
+}
+
+func (m *GetRawTransactionResponse) GetVout() []*Vout {
+	if m != nil {
+		return m.Vout
+	}
+	return nil
+}
+
+func (m *GetRawTransactionResponse) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *GetRawTransactionResponse) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *GetRawTransactionResponse) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetRawTransactionResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *GetRawTransactionResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetRawTransactionResponse) GetCoinbase() bool {
+	if m != nil {
+		return m.Coinbase
+	}
+	return false
+}
+
+type GetTxStatusRequest struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (m *GetTxStatusRequest) Reset()                    { *m = GetTxStatusRequest{} }
+func (m *GetTxStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTxStatusRequest) ProtoMessage()               {}
+func (*GetTxStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{65} }
+
+func (m *GetTxStatusRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+type GetTxStatusResponse struct {
+	Code   int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *GetTxStatusResponse) Reset()                    { *m = GetTxStatusResponse{} }
+func (m *GetTxStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetTxStatusResponse) ProtoMessage()               {}
+func (*GetTxStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{66} }
+
+func (m *GetTxStatusResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetTxStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type GetMempoolInfoResponse struct {
+	Size       uint32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Bytes       uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	MinRelayFee string `protobuf:"bytes,3,opt,name=min_relay_fee,json=minRelayFee,proto3" json:"min_relay_fee,omitempty"`
+	Orphans     uint32 `protobuf:"varint,4,opt,name=orphans,proto3" json:"orphans,omitempty"`
+}
+
+func (m *GetMempoolInfoResponse) Reset()                    { *m = GetMempoolInfoResponse{} }
+func (m *GetMempoolInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMempoolInfoResponse) ProtoMessage()               {}
+func (*GetMempoolInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{67} }
+
+func (m *GetMempoolInfoResponse) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetMempoolInfoResponse) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *GetMempoolInfoResponse) GetMinRelayFee() string {
+	if m != nil {
+		return m.MinRelayFee
+	}
+	return ""
+}
+
+func (m *GetMempoolInfoResponse) GetOrphans() uint32 {
+	if m != nil {
+		return m.Orphans
+	}
+	return 0
+}
+
+type MempoolEntry struct {
+	TxId             string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Size            uint64   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Fee              string   `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeRate          string   `protobuf:"bytes,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	Time             int64    `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	Height           uint64   `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	StartingPriority float64  `protobuf:"fixed64,7,opt,name=starting_priority,json=startingPriority,proto3" json:"starting_priority,omitempty"`
+	Depends          []string `protobuf:"bytes,8,rep,name=depends" json:"depends,omitempty"`
+	Replaceable      bool     `protobuf:"varint,9,opt,name=replaceable,proto3" json:"replaceable,omitempty"`
+}
+
+func (m *MempoolEntry) Reset()                    { *m = MempoolEntry{} }
+func (m *MempoolEntry) String() string            { return proto.CompactTextString(m) }
+func (*MempoolEntry) ProtoMessage()               {}
+func (*MempoolEntry) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{68} }
+
+func (m *MempoolEntry) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *MempoolEntry) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *MempoolEntry) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *MempoolEntry) GetFeeRate() string {
+	if m != nil {
+		return m.FeeRate
+	}
+	return ""
+}
+
+func (m *MempoolEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *MempoolEntry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MempoolEntry) GetStartingPriority() float64 {
+	if m != nil {
+		return m.StartingPriority
+	}
+	return 0
+}
+
+func (m *MempoolEntry) GetDepends() []string {
+	if m != nil {
+		return m.Depends
+	}
+	return nil
+}
+
+func (m *MempoolEntry) GetReplaceable() bool {
+	if m != nil {
+		return m.Replaceable
+	}
+	return false
+}
+
+type GetRawMempoolRequest struct {
+	Verbose bool `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (m *GetRawMempoolRequest) Reset()                    { *m = GetRawMempoolRequest{} }
+func (m *GetRawMempoolRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRawMempoolRequest) ProtoMessage()               {}
+func (*GetRawMempoolRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{69} }
+
+func (m *GetRawMempoolRequest) GetVerbose() bool {
+	if m != nil {
+		return m.Verbose
+	}
+	return false
+}
+
+type GetRawMempoolResponse struct {
+	TxIds   []string        `protobuf:"bytes,1,rep,name=tx_ids,json=txIds" json:"tx_ids,omitempty"`
+	Entries []*MempoolEntry `protobuf:"bytes,2,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *GetRawMempoolResponse) Reset()                    { *m = GetRawMempoolResponse{} }
+func (m *GetRawMempoolResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetRawMempoolResponse) ProtoMessage()               {}
+func (*GetRawMempoolResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{70} }
+
+func (m *GetRawMempoolResponse) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
+}
+
+func (m *GetRawMempoolResponse) GetEntries() []*MempoolEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type GetMempoolEntryRequest struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (m *GetMempoolEntryRequest) Reset()                    { *m = GetMempoolEntryRequest{} }
+func (m *GetMempoolEntryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMempoolEntryRequest) ProtoMessage()               {}
+func (*GetMempoolEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{71} }
+
+func (m *GetMempoolEntryRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+type GetMempoolEntryResponse struct {
+	Entry          *MempoolEntry `protobuf:"bytes,1,opt,name=entry" json:"entry,omitempty"`
+	Ancestors      []string      `protobuf:"bytes,2,rep,name=ancestors" json:"ancestors,omitempty"`
+	AncestorSize   uint64        `protobuf:"varint,3,opt,name=ancestor_size,json=ancestorSize,proto3" json:"ancestor_size,omitempty"`
+	AncestorFees   string        `protobuf:"bytes,4,opt,name=ancestor_fees,json=ancestorFees,proto3" json:"ancestor_fees,omitempty"`
+	Descendants    []string      `protobuf:"bytes,5,rep,name=descendants" json:"descendants,omitempty"`
+	DescendantSize uint64        `protobuf:"varint,6,opt,name=descendant_size,json=descendantSize,proto3" json:"descendant_size,omitempty"`
+	DescendantFees string        `protobuf:"bytes,7,opt,name=descendant_fees,json=descendantFees,proto3" json:"descendant_fees,omitempty"`
+}
+
+func (m *GetMempoolEntryResponse) Reset()                    { *m = GetMempoolEntryResponse{} }
+func (m *GetMempoolEntryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMempoolEntryResponse) ProtoMessage()               {}
+func (*GetMempoolEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{72} }
+
+func (m *GetMempoolEntryResponse) GetEntry() *MempoolEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *GetMempoolEntryResponse) GetAncestors() []string {
+	if m != nil {
+		return m.Ancestors
+	}
+	return nil
+}
+
+func (m *GetMempoolEntryResponse) GetAncestorSize() uint64 {
+	if m != nil {
+		return m.AncestorSize
+	}
+	return 0
+}
+
+func (m *GetMempoolEntryResponse) GetAncestorFees() string {
+	if m != nil {
+		return m.AncestorFees
+	}
+	return ""
+}
+
+func (m *GetMempoolEntryResponse) GetDescendants() []string {
+	if m != nil {
+		return m.Descendants
+	}
+	return nil
+}
+
+func (m *GetMempoolEntryResponse) GetDescendantSize() uint64 {
+	if m != nil {
+		return m.DescendantSize
+	}
+	return 0
+}
+
+func (m *GetMempoolEntryResponse) GetDescendantFees() string {
+	if m != nil {
+		return m.DescendantFees
+	}
+	return ""
+}
+
+type EvictMempoolTransactionRequest struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (m *EvictMempoolTransactionRequest) Reset()         { *m = EvictMempoolTransactionRequest{} }
+func (m *EvictMempoolTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*EvictMempoolTransactionRequest) ProtoMessage()    {}
+func (*EvictMempoolTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{73}
+}
+
+func (m *EvictMempoolTransactionRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+type EvictMempoolTransactionResponse struct {
+	Evicted []string `protobuf:"bytes,1,rep,name=evicted" json:"evicted,omitempty"`
+}
+
+func (m *EvictMempoolTransactionResponse) Reset()         { *m = EvictMempoolTransactionResponse{} }
+func (m *EvictMempoolTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*EvictMempoolTransactionResponse) ProtoMessage()    {}
+func (*EvictMempoolTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{74}
+}
+
+func (m *EvictMempoolTransactionResponse) GetEvicted() []string {
+	if m != nil {
+		return m.Evicted
+	}
+	return nil
+}
+
+type SignRawTransactionRequest struct {
+	RawTx      string `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	Flags      string `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *SignRawTransactionRequest) Reset()                    { *m = SignRawTransactionRequest{} }
+func (m *SignRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignRawTransactionRequest) ProtoMessage()               {}
+func (*SignRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{75} }
+
+func (m *SignRawTransactionRequest) GetRawTx() string {
+	if m != nil {
+		return m.RawTx
+	}
+	return ""
+}
+
+func (m *SignRawTransactionRequest) GetFlags() string {
+	if m != nil {
+		return m.Flags
+	}
+	return ""
+}
+
+func (m *SignRawTransactionRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type SignRawTransactionResponse struct {
+	Hex      string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	Complete bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (m *SignRawTransactionResponse) Reset()                    { *m = SignRawTransactionResponse{} }
+func (m *SignRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignRawTransactionResponse) ProtoMessage()               {}
+func (*SignRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{76} }
+
+func (m *SignRawTransactionResponse) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+func (m *SignRawTransactionResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+type CreatePsbtRequest struct {
+	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+}
+
+func (m *CreatePsbtRequest) Reset()                    { *m = CreatePsbtRequest{} }
+func (m *CreatePsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreatePsbtRequest) ProtoMessage()               {}
+func (*CreatePsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{77} }
+
+func (m *CreatePsbtRequest) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+type PsbtResponse struct {
+	Psbt     string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Complete bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (m *PsbtResponse) Reset()                    { *m = PsbtResponse{} }
+func (m *PsbtResponse) String() string            { return proto.CompactTextString(m) }
+func (*PsbtResponse) ProtoMessage()               {}
+func (*PsbtResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{78} }
+
+func (m *PsbtResponse) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+func (m *PsbtResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+type DecodePsbtRequest struct {
+	Psbt string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (m *DecodePsbtRequest) Reset()                    { *m = DecodePsbtRequest{} }
+func (m *DecodePsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*DecodePsbtRequest) ProtoMessage()               {}
+func (*DecodePsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{79} }
+
+func (m *DecodePsbtRequest) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+type DecodePsbtResponse struct {
+	Tx       *DecodeRawTransactionResponse `protobuf:"bytes,1,opt,name=tx" json:"tx,omitempty"`
+	Inputs   []*DecodePsbtResponse_Input   `protobuf:"bytes,2,rep,name=inputs" json:"inputs,omitempty"`
+	Outputs  []*DecodePsbtResponse_Output  `protobuf:"bytes,3,rep,name=outputs" json:"outputs,omitempty"`
+	Fee      string                        `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Complete bool                          `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (m *DecodePsbtResponse) Reset()                    { *m = DecodePsbtResponse{} }
+func (m *DecodePsbtResponse) String() string            { return proto.CompactTextString(m) }
+func (*DecodePsbtResponse) ProtoMessage()               {}
+func (*DecodePsbtResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{80} }
+
+func (m *DecodePsbtResponse) GetTx() *DecodeRawTransactionResponse {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *DecodePsbtResponse) GetInputs() []*DecodePsbtResponse_Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *DecodePsbtResponse) GetOutputs() []*DecodePsbtResponse_Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *DecodePsbtResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *DecodePsbtResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+type DecodePsbtResponse_PartialSig struct {
+	PubKey    string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *DecodePsbtResponse_PartialSig) Reset()         { *m = DecodePsbtResponse_PartialSig{} }
+func (m *DecodePsbtResponse_PartialSig) String() string { return proto.CompactTextString(m) }
+func (*DecodePsbtResponse_PartialSig) ProtoMessage()    {}
+func (*DecodePsbtResponse_PartialSig) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{80, 0}
+}
+
+func (m *DecodePsbtResponse_PartialSig) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *DecodePsbtResponse_PartialSig) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type DecodePsbtResponse_Derivation struct {
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Path   string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *DecodePsbtResponse_Derivation) Reset()         { *m = DecodePsbtResponse_Derivation{} }
+func (m *DecodePsbtResponse_Derivation) String() string { return proto.CompactTextString(m) }
+func (*DecodePsbtResponse_Derivation) ProtoMessage()    {}
+func (*DecodePsbtResponse_Derivation) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{80, 1}
+}
+
+func (m *DecodePsbtResponse_Derivation) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *DecodePsbtResponse_Derivation) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type DecodePsbtResponse_Input struct {
+	Amount             string                           `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Address            string                           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	WitnessScript      string                           `protobuf:"bytes,3,opt,name=witness_script,json=witnessScript,proto3" json:"witness_script,omitempty"`
+	Signatures         uint32                           `protobuf:"varint,4,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	RequiredSignatures uint32                           `protobuf:"varint,5,opt,name=required_signatures,json=requiredSignatures,proto3" json:"required_signatures,omitempty"`
+	PartialSigs        []*DecodePsbtResponse_PartialSig `protobuf:"bytes,6,rep,name=partial_sigs,json=partialSigs" json:"partial_sigs,omitempty"`
+	Derivations        []*DecodePsbtResponse_Derivation `protobuf:"bytes,7,rep,name=derivations" json:"derivations,omitempty"`
+	Finalized          bool                             `protobuf:"varint,8,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (m *DecodePsbtResponse_Input) Reset()                    { *m = DecodePsbtResponse_Input{} }
+func (m *DecodePsbtResponse_Input) String() string            { return proto.CompactTextString(m) }
+func (*DecodePsbtResponse_Input) ProtoMessage()               {}
+func (*DecodePsbtResponse_Input) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{80, 2} }
+
+func (m *DecodePsbtResponse_Input) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *DecodePsbtResponse_Input) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DecodePsbtResponse_Input) GetWitnessScript() string {
+	if m != nil {
+		return m.WitnessScript
+	}
+	return ""
+}
+
+func (m *DecodePsbtResponse_Input) GetSignatures() uint32 {
+	if m != nil {
+		return m.Signatures
+	}
+	return 0
+}
+
+func (m *DecodePsbtResponse_Input) GetRequiredSignatures() uint32 {
+	if m != nil {
+		return m.RequiredSignatures
+	}
+	return 0
+}
+
+func (m *DecodePsbtResponse_Input) GetPartialSigs() []*DecodePsbtResponse_PartialSig {
+	if m != nil {
+		return m.PartialSigs
+	}
+	return nil
+}
+
+func (m *DecodePsbtResponse_Input) GetDerivations() []*DecodePsbtResponse_Derivation {
+	if m != nil {
+		return m.Derivations
+	}
+	return nil
+}
+
+func (m *DecodePsbtResponse_Input) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+type DecodePsbtResponse_Output struct {
+	WitnessScript string                           `protobuf:"bytes,1,opt,name=witness_script,json=witnessScript,proto3" json:"witness_script,omitempty"`
+	Derivations   []*DecodePsbtResponse_Derivation `protobuf:"bytes,2,rep,name=derivations" json:"derivations,omitempty"`
+}
+
+func (m *DecodePsbtResponse_Output) Reset()                    { *m = DecodePsbtResponse_Output{} }
+func (m *DecodePsbtResponse_Output) String() string            { return proto.CompactTextString(m) }
+func (*DecodePsbtResponse_Output) ProtoMessage()               {}
+func (*DecodePsbtResponse_Output) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{80, 3} }
+
+func (m *DecodePsbtResponse_Output) GetWitnessScript() string {
+	if m != nil {
+		return m.WitnessScript
+	}
+	return ""
+}
+
+func (m *DecodePsbtResponse_Output) GetDerivations() []*DecodePsbtResponse_Derivation {
+	if m != nil {
+		return m.Derivations
+	}
+	return nil
+}
+
+type SignPsbtRequest struct {
+	Psbt       string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Flags      string `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *SignPsbtRequest) Reset()                    { *m = SignPsbtRequest{} }
+func (m *SignPsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignPsbtRequest) ProtoMessage()               {}
+func (*SignPsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{81} }
+
+func (m *SignPsbtRequest) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+func (m *SignPsbtRequest) GetFlags() string {
+	if m != nil {
+		return m.Flags
+	}
+	return ""
+}
+
+func (m *SignPsbtRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type CombinePsbtRequest struct {
+	Psbts []string `protobuf:"bytes,1,rep,name=psbts" json:"psbts,omitempty"`
+}
+
+func (m *CombinePsbtRequest) Reset()                    { *m = CombinePsbtRequest{} }
+func (m *CombinePsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*CombinePsbtRequest) ProtoMessage()               {}
+func (*CombinePsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{82} }
+
+func (m *CombinePsbtRequest) GetPsbts() []string {
+	if m != nil {
+		return m.Psbts
+	}
+	return nil
+}
+
+type FinalizePsbtRequest struct {
+	Psbt string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (m *FinalizePsbtRequest) Reset()                    { *m = FinalizePsbtRequest{} }
+func (m *FinalizePsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*FinalizePsbtRequest) ProtoMessage()               {}
+func (*FinalizePsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{83} }
+
+func (m *FinalizePsbtRequest) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+type FinalizePsbtResponse struct {
+	Psbt     string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Hex      string `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
+	Complete bool   `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (m *FinalizePsbtResponse) Reset()                    { *m = FinalizePsbtResponse{} }
+func (m *FinalizePsbtResponse) String() string            { return proto.CompactTextString(m) }
+func (*FinalizePsbtResponse) ProtoMessage()               {}
+func (*FinalizePsbtResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{84} }
+
+func (m *FinalizePsbtResponse) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+func (m *FinalizePsbtResponse) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+func (m *FinalizePsbtResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+type GetUtxoRequest struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *GetUtxoRequest) Reset()                    { *m = GetUtxoRequest{} }
+func (m *GetUtxoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetUtxoRequest) ProtoMessage()               {}
+func (*GetUtxoRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{85} }
+
+func (m *GetUtxoRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type UTXO struct {
+	TxId           string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout           uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockHeight    uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Maturity       uint32 `protobuf:"varint,5,opt,name=maturity,proto3" json:"maturity,omitempty"`
+	Confirmations  uint32 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	SpentByUnmined bool   `protobuf:"varint,7,opt,name=spent_by_unmined,json=spentByUnmined,proto3" json:"spent_by_unmined,omitempty"`
+	Frozen         bool   `protobuf:"varint,8,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Label          string `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *UTXO) Reset()                    { *m = UTXO{} }
+func (m *UTXO) String() string            { return proto.CompactTextString(m) }
+func (*UTXO) ProtoMessage()               {}
+func (*UTXO) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{86} }
+
+func (m *UTXO) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *UTXO) GetVout() uint32 {
+	if m != nil {
+		return m.Vout
+	}
+	return 0
+}
+
+func (m *UTXO) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *UTXO) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *UTXO) GetMaturity() uint32 {
+	if m != nil {
+		return m.Maturity
+	}
+	return 0
+}
+
+func (m *UTXO) GetConfirmations() uint32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *UTXO) GetSpentByUnmined() bool {
+	if m != nil {
+		return m.SpentByUnmined
+	}
+	return false
+}
+
+func (m *UTXO) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *UTXO) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type AddressUTXO struct {
+	Address string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Utxos   []*UTXO `protobuf:"bytes,2,rep,name=utxos" json:"utxos,omitempty"`
+}
+
+func (m *AddressUTXO) Reset()                    { *m = AddressUTXO{} }
+func (m *AddressUTXO) String() string            { return proto.CompactTextString(m) }
+func (*AddressUTXO) ProtoMessage()               {}
+func (*AddressUTXO) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{87} }
+
+func (m *AddressUTXO) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressUTXO) GetUtxos() []*UTXO {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+type GetUtxoResponse struct {
+	AddressUtxos []*AddressUTXO `protobuf:"bytes,1,rep,name=address_utxos,json=addressUtxos" json:"address_utxos,omitempty"`
+}
+
+func (m *GetUtxoResponse) Reset()                    { *m = GetUtxoResponse{} }
+func (m *GetUtxoResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetUtxoResponse) ProtoMessage()               {}
+func (*GetUtxoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{88} }
+
+func (m *GetUtxoResponse) GetAddressUtxos() []*AddressUTXO {
+	if m != nil {
+		return m.AddressUtxos
+	}
+	return nil
+}
+
+type SearchAddressTransactionsRequest struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	MinHeight uint64 `protobuf:"varint,2,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight uint64 `protobuf:"varint,3,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	Skip      uint32 `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
+	Count     uint32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Ascending bool   `protobuf:"varint,6,opt,name=ascending,proto3" json:"ascending,omitempty"`
+}
+
+func (m *SearchAddressTransactionsRequest) Reset()         { *m = SearchAddressTransactionsRequest{} }
+func (m *SearchAddressTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchAddressTransactionsRequest) ProtoMessage()    {}
+func (*SearchAddressTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{89}
+}
+
+func (m *SearchAddressTransactionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SearchAddressTransactionsRequest) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *SearchAddressTransactionsRequest) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *SearchAddressTransactionsRequest) GetSkip() uint32 {
+	if m != nil {
+		return m.Skip
+	}
+	return 0
+}
+
+func (m *SearchAddressTransactionsRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SearchAddressTransactionsRequest) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
+}
+
+type SearchAddressTransactionsResponse struct {
+	Transactions []*SearchAddressTransactionsResponse_Transaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+	Total        uint32                                           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *SearchAddressTransactionsResponse) Reset()         { *m = SearchAddressTransactionsResponse{} }
+func (m *SearchAddressTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchAddressTransactionsResponse) ProtoMessage()    {}
+func (*SearchAddressTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{90}
+}
+
+func (m *SearchAddressTransactionsResponse) GetTransactions() []*SearchAddressTransactionsResponse_Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *SearchAddressTransactionsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type SearchAddressTransactionsResponse_Transaction struct {
+	TxId          string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BlockHeight   uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash     string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Timestamp     int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Confirmations uint64 `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Received      string `protobuf:"bytes,6,opt,name=received,proto3" json:"received,omitempty"`
+	Sent          string `protobuf:"bytes,7,opt,name=sent,proto3" json:"sent,omitempty"`
+}
+
+func (m *SearchAddressTransactionsResponse_Transaction) Reset() {
+	*m = SearchAddressTransactionsResponse_Transaction{}
+}
+func (m *SearchAddressTransactionsResponse_Transaction) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SearchAddressTransactionsResponse_Transaction) ProtoMessage() {}
+func (*SearchAddressTransactionsResponse_Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{90, 0}
+}
+
+func (m *SearchAddressTransactionsResponse_Transaction) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *SearchAddressTransactionsResponse_Transaction) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SearchAddressTransactionsResponse_Transaction) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *SearchAddressTransactionsResponse_Transaction) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SearchAddressTransactionsResponse_Transaction) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *SearchAddressTransactionsResponse_Transaction) GetReceived() string {
+	if m != nil {
+		return m.Received
+	}
+	return ""
+}
+
+func (m *SearchAddressTransactionsResponse_Transaction) GetSent() string {
+	if m != nil {
+		return m.Sent
+	}
+	return ""
+}
+
+type GetAddressUtxosRequest struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	MinHeight uint64 `protobuf:"varint,2,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight uint64 `protobuf:"varint,3,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	Skip      uint32 `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
+	Count     uint32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GetAddressUtxosRequest) Reset()                    { *m = GetAddressUtxosRequest{} }
+func (m *GetAddressUtxosRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAddressUtxosRequest) ProtoMessage()               {}
+func (*GetAddressUtxosRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{91} }
+
+func (m *GetAddressUtxosRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetAddressUtxosRequest) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *GetAddressUtxosRequest) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *GetAddressUtxosRequest) GetSkip() uint32 {
+	if m != nil {
+		return m.Skip
+	}
+	return 0
+}
+
+func (m *GetAddressUtxosRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetAddressUtxosResponse struct {
+	Address   string                          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Utxos     []*GetAddressUtxosResponse_Utxo `protobuf:"bytes,2,rep,name=utxos" json:"utxos,omitempty"`
+	Total     string                          `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	UtxoCount uint32                          `protobuf:"varint,4,opt,name=utxo_count,json=utxoCount,proto3" json:"utxo_count,omitempty"`
+}
+
+func (m *GetAddressUtxosResponse) Reset()                    { *m = GetAddressUtxosResponse{} }
+func (m *GetAddressUtxosResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAddressUtxosResponse) ProtoMessage()               {}
+func (*GetAddressUtxosResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{92} }
+
+func (m *GetAddressUtxosResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetAddressUtxosResponse) GetUtxos() []*GetAddressUtxosResponse_Utxo {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func (m *GetAddressUtxosResponse) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *GetAddressUtxosResponse) GetUtxoCount() uint32 {
+	if m != nil {
+		return m.UtxoCount
+	}
+	return 0
+}
+
+type GetAddressUtxosResponse_Utxo struct {
+	TxId           string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout           uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockHeight    uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Confirmations  uint64 `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	IsCoinbase     bool   `protobuf:"varint,6,opt,name=is_coinbase,json=isCoinbase,proto3" json:"is_coinbase,omitempty"`
+	SpentByUnmined bool   `protobuf:"varint,7,opt,name=spent_by_unmined,json=spentByUnmined,proto3" json:"spent_by_unmined,omitempty"`
+}
+
+func (m *GetAddressUtxosResponse_Utxo) Reset()         { *m = GetAddressUtxosResponse_Utxo{} }
+func (m *GetAddressUtxosResponse_Utxo) String() string { return proto.CompactTextString(m) }
+func (*GetAddressUtxosResponse_Utxo) ProtoMessage()    {}
+func (*GetAddressUtxosResponse_Utxo) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{92, 0}
+}
+
+func (m *GetAddressUtxosResponse_Utxo) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *GetAddressUtxosResponse_Utxo) GetVout() uint32 {
+	if m != nil {
+		return m.Vout
+	}
+	return 0
+}
+
+func (m *GetAddressUtxosResponse_Utxo) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *GetAddressUtxosResponse_Utxo) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GetAddressUtxosResponse_Utxo) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *GetAddressUtxosResponse_Utxo) GetIsCoinbase() bool {
+	if m != nil {
+		return m.IsCoinbase
+	}
+	return false
+}
+
+func (m *GetAddressUtxosResponse_Utxo) GetSpentByUnmined() bool {
+	if m != nil {
+		return m.SpentByUnmined
+	}
+	return false
+}
+
+type GetAddressReceivedRequest struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	MinHeight uint64 `protobuf:"varint,2,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight uint64 `protobuf:"varint,3,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+}
+
+func (m *GetAddressReceivedRequest) Reset()                    { *m = GetAddressReceivedRequest{} }
+func (m *GetAddressReceivedRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAddressReceivedRequest) ProtoMessage()               {}
+func (*GetAddressReceivedRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{93} }
+
+func (m *GetAddressReceivedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetAddressReceivedRequest) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *GetAddressReceivedRequest) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+type GetAddressReceivedResponse struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Received string `protobuf:"bytes,2,opt,name=received,proto3" json:"received,omitempty"`
+	Sent     string `protobuf:"bytes,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	TxCount  uint32 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+}
+
+func (m *GetAddressReceivedResponse) Reset()                    { *m = GetAddressReceivedResponse{} }
+func (m *GetAddressReceivedResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAddressReceivedResponse) ProtoMessage()               {}
+func (*GetAddressReceivedResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{94} }
+
+func (m *GetAddressReceivedResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetAddressReceivedResponse) GetReceived() string {
+	if m != nil {
+		return m.Received
+	}
+	return ""
+}
+
+func (m *GetAddressReceivedResponse) GetSent() string {
+	if m != nil {
+		return m.Sent
+	}
+	return ""
+}
+
+func (m *GetAddressReceivedResponse) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+type SetUtxoFrozenRequest struct {
+	Utxos  []*TransactionInput `protobuf:"bytes,1,rep,name=utxos" json:"utxos,omitempty"`
+	Frozen bool                `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (m *SetUtxoFrozenRequest) Reset()                    { *m = SetUtxoFrozenRequest{} }
+func (m *SetUtxoFrozenRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetUtxoFrozenRequest) ProtoMessage()               {}
+func (*SetUtxoFrozenRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{95} }
+
+func (m *SetUtxoFrozenRequest) GetUtxos() []*TransactionInput {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func (m *SetUtxoFrozenRequest) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+type SetUtxoFrozenResponse struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (m *SetUtxoFrozenResponse) Reset()                    { *m = SetUtxoFrozenResponse{} }
+func (m *SetUtxoFrozenResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetUtxoFrozenResponse) ProtoMessage()               {}
+func (*SetUtxoFrozenResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{96} }
+
+func (m *SetUtxoFrozenResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type SetUtxoLabelRequest struct {
+	TxId  string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout  uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *SetUtxoLabelRequest) Reset()                    { *m = SetUtxoLabelRequest{} }
+func (m *SetUtxoLabelRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetUtxoLabelRequest) ProtoMessage()               {}
+func (*SetUtxoLabelRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{97} }
+
+func (m *SetUtxoLabelRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *SetUtxoLabelRequest) GetVout() uint32 {
+	if m != nil {
+		return m.Vout
+	}
+	return 0
+}
+
+func (m *SetUtxoLabelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetUtxoLabelResponse struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (m *SetUtxoLabelResponse) Reset()                    { *m = SetUtxoLabelResponse{} }
+func (m *SetUtxoLabelResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetUtxoLabelResponse) ProtoMessage()               {}
+func (*SetUtxoLabelResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{98} }
+
+func (m *SetUtxoLabelResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type ConsolidateUtxosRequest struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	MaxInputs uint32   `protobuf:"varint,2,opt,name=max_inputs,json=maxInputs,proto3" json:"max_inputs,omitempty"`
+	FeeRate   string   `protobuf:"bytes,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	ToAddress string   `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	DryRun    bool     `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *ConsolidateUtxosRequest) Reset()                    { *m = ConsolidateUtxosRequest{} }
+func (m *ConsolidateUtxosRequest) String() string            { return proto.CompactTextString(m) }
+func (*ConsolidateUtxosRequest) ProtoMessage()               {}
+func (*ConsolidateUtxosRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{99} }
+
+func (m *ConsolidateUtxosRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *ConsolidateUtxosRequest) GetMaxInputs() uint32 {
+	if m != nil {
+		return m.MaxInputs
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosRequest) GetFeeRate() string {
+	if m != nil {
+		return m.FeeRate
+	}
+	return ""
+}
+
+func (m *ConsolidateUtxosRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *ConsolidateUtxosRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ConsolidateUtxosResponse struct {
+	Transactions []*ConsolidateUtxosResponse_Transaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+	TotalInputs  uint32                                  `protobuf:"varint,2,opt,name=total_inputs,json=totalInputs,proto3" json:"total_inputs,omitempty"`
+	TotalFee     string                                  `protobuf:"bytes,3,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+}
+
+func (m *ConsolidateUtxosResponse) Reset()                    { *m = ConsolidateUtxosResponse{} }
+func (m *ConsolidateUtxosResponse) String() string            { return proto.CompactTextString(m) }
+func (*ConsolidateUtxosResponse) ProtoMessage()               {}
+func (*ConsolidateUtxosResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{100} }
+
+func (m *ConsolidateUtxosResponse) GetTransactions() []*ConsolidateUtxosResponse_Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *ConsolidateUtxosResponse) GetTotalInputs() uint32 {
+	if m != nil {
+		return m.TotalInputs
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosResponse) GetTotalFee() string {
+	if m != nil {
+		return m.TotalFee
+	}
+	return ""
+}
+
+type ConsolidateUtxosResponse_Transaction struct {
+	Hex    string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	Inputs uint32 `protobuf:"varint,2,opt,name=inputs,proto3" json:"inputs,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee    string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *ConsolidateUtxosResponse_Transaction) Reset()         { *m = ConsolidateUtxosResponse_Transaction{} }
+func (m *ConsolidateUtxosResponse_Transaction) String() string { return proto.CompactTextString(m) }
+func (*ConsolidateUtxosResponse_Transaction) ProtoMessage()    {}
+func (*ConsolidateUtxosResponse_Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{100, 0}
+}
+
+func (m *ConsolidateUtxosResponse_Transaction) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+func (m *ConsolidateUtxosResponse_Transaction) GetInputs() uint32 {
+	if m != nil {
+		return m.Inputs
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosResponse_Transaction) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *ConsolidateUtxosResponse_Transaction) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+type SweepPrivateKeyRequest struct {
+	Wif     string `protobuf:"bytes,1,opt,name=wif,proto3" json:"wif,omitempty"`
+	FeeRate string `protobuf:"bytes,2,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+}
+
+func (m *SweepPrivateKeyRequest) Reset()                    { *m = SweepPrivateKeyRequest{} }
+func (m *SweepPrivateKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*SweepPrivateKeyRequest) ProtoMessage()               {}
+func (*SweepPrivateKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{101} }
+
+func (m *SweepPrivateKeyRequest) GetWif() string {
+	if m != nil {
+		return m.Wif
+	}
+	return ""
+}
+
+func (m *SweepPrivateKeyRequest) GetFeeRate() string {
+	if m != nil {
+		return m.FeeRate
+	}
+	return ""
+}
+
+type SweepPrivateKeyResponse struct {
+	TxId    string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee     string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Inputs  uint32 `protobuf:"varint,5,opt,name=inputs,proto3" json:"inputs,omitempty"`
+}
+
+func (m *SweepPrivateKeyResponse) Reset()                    { *m = SweepPrivateKeyResponse{} }
+func (m *SweepPrivateKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*SweepPrivateKeyResponse) ProtoMessage()               {}
+func (*SweepPrivateKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{102} }
+
+func (m *SweepPrivateKeyResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *SweepPrivateKeyResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SweepPrivateKeyResponse) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *SweepPrivateKeyResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *SweepPrivateKeyResponse) GetInputs() uint32 {
+	if m != nil {
+		return m.Inputs
+	}
+	return 0
+}
+
+type GetAddressBindingRequest struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *GetAddressBindingRequest) Reset()                    { *m = GetAddressBindingRequest{} }
+func (m *GetAddressBindingRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAddressBindingRequest) ProtoMessage()               {}
+func (*GetAddressBindingRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{103} }
+
+func (m *GetAddressBindingRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type GetAddressBindingResponse struct {
+	Amounts map[string]string `protobuf:"bytes,1,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *GetAddressBindingResponse) Reset()                    { *m = GetAddressBindingResponse{} }
+func (m *GetAddressBindingResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAddressBindingResponse) ProtoMessage()               {}
+func (*GetAddressBindingResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{104} }
+
+func (m *GetAddressBindingResponse) GetAmounts() map[string]string {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
+type CheckBindingRequirementRequest struct {
+	PocAddresses []string `protobuf:"bytes,1,rep,name=poc_addresses,json=pocAddresses" json:"poc_addresses,omitempty"`
+	BitLength    uint32   `protobuf:"varint,2,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	PlotCount    uint64   `protobuf:"varint,3,opt,name=plot_count,json=plotCount,proto3" json:"plot_count,omitempty"`
+}
+
+func (m *CheckBindingRequirementRequest) Reset()         { *m = CheckBindingRequirementRequest{} }
+func (m *CheckBindingRequirementRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckBindingRequirementRequest) ProtoMessage()    {}
+func (*CheckBindingRequirementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{105}
+}
+
+func (m *CheckBindingRequirementRequest) GetPocAddresses() []string {
+	if m != nil {
+		return m.PocAddresses
+	}
+	return nil
+}
+
+func (m *CheckBindingRequirementRequest) GetBitLength() uint32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+func (m *CheckBindingRequirementRequest) GetPlotCount() uint64 {
+	if m != nil {
+		return m.PlotCount
+	}
+	return 0
+}
+
+type CheckBindingRequirementResponse struct {
+	BitLength    uint32                                         `protobuf:"varint,1,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	PlotCount    uint64                                         `protobuf:"varint,2,opt,name=plot_count,json=plotCount,proto3" json:"plot_count,omitempty"`
+	Requirements []*CheckBindingRequirementResponse_Requirement `protobuf:"bytes,3,rep,name=requirements" json:"requirements,omitempty"`
+}
+
+func (m *CheckBindingRequirementResponse) Reset()         { *m = CheckBindingRequirementResponse{} }
+func (m *CheckBindingRequirementResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckBindingRequirementResponse) ProtoMessage()    {}
+func (*CheckBindingRequirementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{106}
+}
+
+func (m *CheckBindingRequirementResponse) GetBitLength() uint32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+func (m *CheckBindingRequirementResponse) GetPlotCount() uint64 {
+	if m != nil {
+		return m.PlotCount
+	}
+	return 0
+}
+
+func (m *CheckBindingRequirementResponse) GetRequirements() []*CheckBindingRequirementResponse_Requirement {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+type CheckBindingRequirementResponse_Requirement struct {
+	PocAddress string `protobuf:"bytes,1,opt,name=poc_address,json=pocAddress,proto3" json:"poc_address,omitempty"`
+	Required   string `protobuf:"bytes,2,opt,name=required,proto3" json:"required,omitempty"`
+	Current    string `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	Mature     string `protobuf:"bytes,4,opt,name=mature,proto3" json:"mature,omitempty"`
+	Shortfall  string `protobuf:"bytes,5,opt,name=shortfall,proto3" json:"shortfall,omitempty"`
+}
+
+func (m *CheckBindingRequirementResponse_Requirement) Reset() {
+	*m = CheckBindingRequirementResponse_Requirement{}
+}
+func (m *CheckBindingRequirementResponse_Requirement) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CheckBindingRequirementResponse_Requirement) ProtoMessage() {}
+func (*CheckBindingRequirementResponse_Requirement) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{106, 0}
+}
+
+func (m *CheckBindingRequirementResponse_Requirement) GetPocAddress() string {
+	if m != nil {
+		return m.PocAddress
+	}
+	return ""
+}
+
+func (m *CheckBindingRequirementResponse_Requirement) GetRequired() string {
+	if m != nil {
+		return m.Required
+	}
+	return ""
+}
+
+func (m *CheckBindingRequirementResponse_Requirement) GetCurrent() string {
+	if m != nil {
+		return m.Current
+	}
+	return ""
+}
+
+func (m *CheckBindingRequirementResponse_Requirement) GetMature() string {
+	if m != nil {
+		return m.Mature
+	}
+	return ""
+}
+
+func (m *CheckBindingRequirementResponse_Requirement) GetShortfall() string {
+	if m != nil {
+		return m.Shortfall
+	}
+	return ""
+}
+
+type GetBindingHistoryRequest struct {
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *GetBindingHistoryRequest) Reset()                    { *m = GetBindingHistoryRequest{} }
+func (m *GetBindingHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBindingHistoryRequest) ProtoMessage()               {}
+func (*GetBindingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{107} }
+
+func (m *GetBindingHistoryRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type GetBindingHistoryResponse struct {
+	Histories []*GetBindingHistoryResponse_History `protobuf:"bytes,1,rep,name=histories" json:"histories,omitempty"`
+}
+
+func (m *GetBindingHistoryResponse) Reset()                    { *m = GetBindingHistoryResponse{} }
+func (m *GetBindingHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBindingHistoryResponse) ProtoMessage()               {}
+func (*GetBindingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{108} }
+
+func (m *GetBindingHistoryResponse) GetHistories() []*GetBindingHistoryResponse_History {
+	if m != nil {
+		return m.Histories
+	}
+	return nil
+}
+
+type GetBindingHistoryResponse_BindingUTXO struct {
+	TxId           string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout           uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	HolderAddress  string `protobuf:"bytes,3,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	BindingAddress string `protobuf:"bytes,4,opt,name=binding_address,json=bindingAddress,proto3" json:"binding_address,omitempty"`
+	Amount         string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *GetBindingHistoryResponse_BindingUTXO) Reset()         { *m = GetBindingHistoryResponse_BindingUTXO{} }
+func (m *GetBindingHistoryResponse_BindingUTXO) String() string { return proto.CompactTextString(m) }
+func (*GetBindingHistoryResponse_BindingUTXO) ProtoMessage()    {}
+func (*GetBindingHistoryResponse_BindingUTXO) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{108, 0}
+}
+
+func (m *GetBindingHistoryResponse_BindingUTXO) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *GetBindingHistoryResponse_BindingUTXO) GetVout() uint32 {
+	if m != nil {
+		return m.Vout
+	}
+	return 0
+}
+
+func (m *GetBindingHistoryResponse_BindingUTXO) GetHolderAddress() string {
+	if m != nil {
+		return m.HolderAddress
+	}
+	return ""
+}
+
+func (m *GetBindingHistoryResponse_BindingUTXO) GetBindingAddress() string {
+	if m != nil {
+		return m.BindingAddress
+	}
+	return ""
+}
+
+func (m *GetBindingHistoryResponse_BindingUTXO) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type GetBindingHistoryResponse_History struct {
+	TxId          string                                 `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Status        uint32                                 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	BlockHeight   uint64                                 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Utxo          *GetBindingHistoryResponse_BindingUTXO `protobuf:"bytes,4,opt,name=utxo" json:"utxo,omitempty"`
+	FromAddresses []string                               `protobuf:"bytes,5,rep,name=from_addresses,json=fromAddresses" json:"from_addresses,omitempty"`
+}
+
+func (m *GetBindingHistoryResponse_History) Reset()         { *m = GetBindingHistoryResponse_History{} }
+func (m *GetBindingHistoryResponse_History) String() string { return proto.CompactTextString(m) }
+func (*GetBindingHistoryResponse_History) ProtoMessage()    {}
+func (*GetBindingHistoryResponse_History) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{108, 1}
+}
+
+func (m *GetBindingHistoryResponse_History) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *GetBindingHistoryResponse_History) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetBindingHistoryResponse_History) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GetBindingHistoryResponse_History) GetUtxo() *GetBindingHistoryResponse_BindingUTXO {
+	if m != nil {
+		return m.Utxo
+	}
+	return nil
+}
+
+func (m *GetBindingHistoryResponse_History) GetFromAddresses() []string {
+	if m != nil {
+		return m.FromAddresses
+	}
+	return nil
+}
+
+type CreateBindingTransactionRequest struct {
+	Outputs     []*CreateBindingTransactionRequest_Output `protobuf:"bytes,1,rep,name=outputs" json:"outputs,omitempty"`
+	FromAddress string                                    `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Fee         string                                    `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *CreateBindingTransactionRequest) Reset()         { *m = CreateBindingTransactionRequest{} }
+func (m *CreateBindingTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateBindingTransactionRequest) ProtoMessage()    {}
+func (*CreateBindingTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{109}
+}
+
+func (m *CreateBindingTransactionRequest) GetOutputs() []*CreateBindingTransactionRequest_Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *CreateBindingTransactionRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *CreateBindingTransactionRequest) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+type CreateBindingTransactionRequest_Output struct {
+	HolderAddress  string `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	BindingAddress string `protobuf:"bytes,2,opt,name=binding_address,json=bindingAddress,proto3" json:"binding_address,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *CreateBindingTransactionRequest_Output) Reset() {
+	*m = CreateBindingTransactionRequest_Output{}
+}
+func (m *CreateBindingTransactionRequest_Output) String() string { return proto.CompactTextString(m) }
+func (*CreateBindingTransactionRequest_Output) ProtoMessage()    {}
+func (*CreateBindingTransactionRequest_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{109, 0}
+}
+
+func (m *CreateBindingTransactionRequest_Output) GetHolderAddress() string {
+	if m != nil {
+		return m.HolderAddress
+	}
+	return ""
+}
+
+func (m *CreateBindingTransactionRequest_Output) GetBindingAddress() string {
+	if m != nil {
+		return m.BindingAddress
+	}
+	return ""
+}
+
+func (m *CreateBindingTransactionRequest_Output) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type CreateBindingWithdrawTransactionRequest struct {
+	HolderAddress  string `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	BindingAddress string `protobuf:"bytes,2,opt,name=binding_address,json=bindingAddress,proto3" json:"binding_address,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ToAddress      string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Fee            string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *CreateBindingWithdrawTransactionRequest) Reset() {
+	*m = CreateBindingWithdrawTransactionRequest{}
+}
+func (m *CreateBindingWithdrawTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateBindingWithdrawTransactionRequest) ProtoMessage()    {}
+func (*CreateBindingWithdrawTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{110}
+}
+
+func (m *CreateBindingWithdrawTransactionRequest) GetHolderAddress() string {
+	if m != nil {
+		return m.HolderAddress
+	}
+	return ""
+}
+
+func (m *CreateBindingWithdrawTransactionRequest) GetBindingAddress() string {
+	if m != nil {
+		return m.BindingAddress
+	}
+	return ""
+}
+
+func (m *CreateBindingWithdrawTransactionRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *CreateBindingWithdrawTransactionRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *CreateBindingWithdrawTransactionRequest) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+type GetBestBlockResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *GetBestBlockResponse) Reset()                    { *m = GetBestBlockResponse{} }
+func (m *GetBestBlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBestBlockResponse) ProtoMessage()               {}
+func (*GetBestBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{111} }
+
+func (m *GetBestBlockResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBestBlockResponse) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+type BlockHeader struct {
+	Hash            string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ChainId         string   `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Version         uint64   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Height          uint64   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp       int64    `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Previous        string   `protobuf:"bytes,6,opt,name=previous,proto3" json:"previous,omitempty"`
+	Next            string   `protobuf:"bytes,7,opt,name=next,proto3" json:"next,omitempty"`
+	TransactionRoot string   `protobuf:"bytes,8,opt,name=transaction_root,json=transactionRoot,proto3" json:"transaction_root,omitempty"`
+	WitnessRoot     string   `protobuf:"bytes,9,opt,name=witness_root,json=witnessRoot,proto3" json:"witness_root,omitempty"`
+	ProposalRoot    string   `protobuf:"bytes,10,opt,name=proposal_root,json=proposalRoot,proto3" json:"proposal_root,omitempty"`
+	Target          string   `protobuf:"bytes,11,opt,name=target,proto3" json:"target,omitempty"`
+	Challenge       string   `protobuf:"bytes,12,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	PubKey          string   `protobuf:"bytes,13,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	BitLength       uint32   `protobuf:"varint,14,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	Quality         string   `protobuf:"bytes,15,opt,name=quality,proto3" json:"quality,omitempty"`
+	Signature       string   `protobuf:"bytes,16,opt,name=signature,proto3" json:"signature,omitempty"`
+	BanList         []string `protobuf:"bytes,17,rep,name=ban_list,json=banList" json:"ban_list,omitempty"`
+	InMainChain     bool     `protobuf:"varint,18,opt,name=in_main_chain,json=inMainChain,proto3" json:"in_main_chain,omitempty"`
+	Confirmations   uint64   `protobuf:"varint,19,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (m *BlockHeader) Reset()                    { *m = BlockHeader{} }
+func (m *BlockHeader) String() string            { return proto.CompactTextString(m) }
+func (*BlockHeader) ProtoMessage()               {}
+func (*BlockHeader) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{112} }
+
+func (m *BlockHeader) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetTransactionRoot() string {
+	if m != nil {
+		return m.TransactionRoot
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetWitnessRoot() string {
+	if m != nil {
+		return m.WitnessRoot
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetProposalRoot() string {
+	if m != nil {
+		return m.ProposalRoot
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetBitLength() uint32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetQuality() string {
+	if m != nil {
+		return m.Quality
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *BlockHeader) GetBanList() []string {
+	if m != nil {
+		return m.BanList
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetInMainChain() bool {
+	if m != nil {
+		return m.InMainChain
+	}
+	return false
+}
+
+func (m *BlockHeader) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+type GetBlockRequest struct {
+	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Verbose bool   `protobuf:"varint,3,opt,name=verbose,proto3" json:"verbose,omitempty"`
+}
+
+func (m *GetBlockRequest) Reset()                    { *m = GetBlockRequest{} }
+func (m *GetBlockRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockRequest) ProtoMessage()               {}
+func (*GetBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{113} }
+
+func (m *GetBlockRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetBlockRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockRequest) GetVerbose() bool {
+	if m != nil {
+		return m.Verbose
+	}
+	return false
+}
+
+type GetBlockResponse struct {
+	Header *BlockHeader                 `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Size  uint32                       `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	TxIds  []string                     `protobuf:"bytes,3,rep,name=tx_ids,json=txIds" json:"tx_ids,omitempty"`
+	Txs    []*GetRawTransactionResponse `protobuf:"bytes,4,rep,name=txs" json:"txs,omitempty"`
+	Hex    string                       `protobuf:"bytes,5,opt,name=hex,proto3" json:"hex,omitempty"`
+}
+
+func (m *GetBlockResponse) Reset()                    { *m = GetBlockResponse{} }
+func (m *GetBlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockResponse) ProtoMessage()               {}
+func (*GetBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{114} }
+
+func (m *GetBlockResponse) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetBlockResponse) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetBlockResponse) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
+}
+
+func (m *GetBlockResponse) GetTxs() []*GetRawTransactionResponse {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *GetBlockResponse) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+type GetBlockHeaderRequest struct {
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetBlockHeaderRequest) Reset()                    { *m = GetBlockHeaderRequest{} }
+func (m *GetBlockHeaderRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockHeaderRequest) ProtoMessage()               {}
+func (*GetBlockHeaderRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{115} }
+
+func (m *GetBlockHeaderRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetBlockHeaderRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GetBlockHashRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetBlockHashRequest) Reset()                    { *m = GetBlockHashRequest{} }
+func (m *GetBlockHashRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockHashRequest) ProtoMessage()               {}
+func (*GetBlockHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{116} }
+
+func (m *GetBlockHashRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GetBlockHashResponse struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetBlockHashResponse) Reset()                    { *m = GetBlockHashResponse{} }
+func (m *GetBlockHashResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockHashResponse) ProtoMessage()               {}
+func (*GetBlockHashResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{117} }
+
+func (m *GetBlockHashResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type GetChainTipsResponse struct {
+	Tips []*GetChainTipsResponse_ChainTip `protobuf:"bytes,1,rep,name=tips" json:"tips,omitempty"`
+}
+
+func (m *GetChainTipsResponse) Reset()                    { *m = GetChainTipsResponse{} }
+func (m *GetChainTipsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetChainTipsResponse) ProtoMessage()               {}
+func (*GetChainTipsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{118} }
+
+func (m *GetChainTipsResponse) GetTips() []*GetChainTipsResponse_ChainTip {
+	if m != nil {
+		return m.Tips
+	}
+	return nil
+}
+
+type GetChainTipsResponse_ChainTip struct {
+	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	BranchLen uint64 `protobuf:"varint,3,opt,name=branch_len,json=branchLen,proto3" json:"branch_len,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *GetChainTipsResponse_ChainTip) Reset()         { *m = GetChainTipsResponse_ChainTip{} }
+func (m *GetChainTipsResponse_ChainTip) String() string { return proto.CompactTextString(m) }
+func (*GetChainTipsResponse_ChainTip) ProtoMessage()    {}
+func (*GetChainTipsResponse_ChainTip) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{118, 0}
+}
+
+func (m *GetChainTipsResponse_ChainTip) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetChainTipsResponse_ChainTip) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetChainTipsResponse_ChainTip) GetBranchLen() uint64 {
+	if m != nil {
+		return m.BranchLen
+	}
+	return 0
+}
+
+func (m *GetChainTipsResponse_ChainTip) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type GetMinedBlocksRequest struct {
+	PocPubKey string `protobuf:"bytes,1,opt,name=poc_pub_key,json=pocPubKey,proto3" json:"poc_pub_key,omitempty"`
+}
+
+func (m *GetMinedBlocksRequest) Reset()                    { *m = GetMinedBlocksRequest{} }
+func (m *GetMinedBlocksRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMinedBlocksRequest) ProtoMessage()               {}
+func (*GetMinedBlocksRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{119} }
+
+func (m *GetMinedBlocksRequest) GetPocPubKey() string {
+	if m != nil {
+		return m.PocPubKey
+	}
+	return ""
+}
+
+type GetMinedBlocksResponse struct {
+	Heights []uint64 `protobuf:"varint,1,rep,packed,name=heights" json:"heights,omitempty"`
+}
+
+func (m *GetMinedBlocksResponse) Reset()                    { *m = GetMinedBlocksResponse{} }
+func (m *GetMinedBlocksResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMinedBlocksResponse) ProtoMessage()               {}
+func (*GetMinedBlocksResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{120} }
+
+func (m *GetMinedBlocksResponse) GetHeights() []uint64 {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+type GetWalletMnemonicRequest struct {
+	WalletId   string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *GetWalletMnemonicRequest) Reset()                    { *m = GetWalletMnemonicRequest{} }
+func (m *GetWalletMnemonicRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetWalletMnemonicRequest) ProtoMessage()               {}
+func (*GetWalletMnemonicRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{121} }
+
+func (m *GetWalletMnemonicRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *GetWalletMnemonicRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type GetWalletMnemonicResponse struct {
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Version  uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *GetWalletMnemonicResponse) Reset()                    { *m = GetWalletMnemonicResponse{} }
+func (m *GetWalletMnemonicResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetWalletMnemonicResponse) ProtoMessage()               {}
+func (*GetWalletMnemonicResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{122} }
+
+func (m *GetWalletMnemonicResponse) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *GetWalletMnemonicResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type UnlockWalletRequest struct {
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Timeout    uint32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *UnlockWalletRequest) Reset()                    { *m = UnlockWalletRequest{} }
+func (m *UnlockWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnlockWalletRequest) ProtoMessage()               {}
+func (*UnlockWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{123} }
+
+func (m *UnlockWalletRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *UnlockWalletRequest) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type UnlockWalletResponse struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (m *UnlockWalletResponse) Reset()                    { *m = UnlockWalletResponse{} }
+func (m *UnlockWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnlockWalletResponse) ProtoMessage()               {}
+func (*UnlockWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{124} }
+
+func (m *UnlockWalletResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type LockWalletResponse struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (m *LockWalletResponse) Reset()                    { *m = LockWalletResponse{} }
+func (m *LockWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*LockWalletResponse) ProtoMessage()               {}
+func (*LockWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{125} }
+
+func (m *LockWalletResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type GetWalletXpubResponse struct {
+	WalletId           string   `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Xpub               string   `protobuf:"bytes,2,opt,name=xpub,proto3" json:"xpub,omitempty"`
+	RequiredSignatures uint32   `protobuf:"varint,3,opt,name=required_signatures,json=requiredSignatures,proto3" json:"required_signatures,omitempty"`
+	CosignerXpubs      []string `protobuf:"bytes,4,rep,name=cosigner_xpubs,json=cosignerXpubs" json:"cosigner_xpubs,omitempty"`
+}
+
+func (m *GetWalletXpubResponse) Reset()                    { *m = GetWalletXpubResponse{} }
+func (m *GetWalletXpubResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetWalletXpubResponse) ProtoMessage()               {}
+func (*GetWalletXpubResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{126} }
+
+func (m *GetWalletXpubResponse) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *GetWalletXpubResponse) GetXpub() string {
+	if m != nil {
+		return m.Xpub
+	}
+	return ""
+}
+
+func (m *GetWalletXpubResponse) GetRequiredSignatures() uint32 {
+	if m != nil {
+		return m.RequiredSignatures
+	}
+	return 0
+}
+
+func (m *GetWalletXpubResponse) GetCosignerXpubs() []string {
+	if m != nil {
+		return m.CosignerXpubs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetClientStatusResponse)(nil), "rpcprotobuf.GetClientStatusResponse")
+	proto.RegisterType((*GetClientStatusResponsePeerCountInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerCountInfo")
+	proto.RegisterType((*GetClientStatusResponsePeerInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerInfo")
+	proto.RegisterType((*GetClientStatusResponsePeerList)(nil), "rpcprotobuf.GetClientStatusResponse.peerList")
+	proto.RegisterType((*QuitClientResponse)(nil), "rpcprotobuf.QuitClientResponse")
+	proto.RegisterType((*WalletsResponse)(nil), "rpcprotobuf.WalletsResponse")
+	proto.RegisterType((*WalletsResponse_WalletSummary)(nil), "rpcprotobuf.WalletsResponse.WalletSummary")
+	proto.RegisterType((*UseWalletRequest)(nil), "rpcprotobuf.UseWalletRequest")
+	proto.RegisterType((*UseWalletResponse)(nil), "rpcprotobuf.UseWalletResponse")
+	proto.RegisterType((*CreateWalletRequest)(nil), "rpcprotobuf.CreateWalletRequest")
+	proto.RegisterType((*CreateWalletResponse)(nil), "rpcprotobuf.CreateWalletResponse")
+	proto.RegisterType((*ImportWalletRequest)(nil), "rpcprotobuf.ImportWalletRequest")
+	proto.RegisterType((*ImportWalletResponse)(nil), "rpcprotobuf.ImportWalletResponse")
+	proto.RegisterType((*ImportMnemonicRequest)(nil), "rpcprotobuf.ImportMnemonicRequest")
+	proto.RegisterType((*ImportMultisigWalletRequest)(nil), "rpcprotobuf.ImportMultisigWalletRequest")
+	proto.RegisterType((*ImportWatchOnlyWalletRequest)(nil), "rpcprotobuf.ImportWatchOnlyWalletRequest")
+	proto.RegisterType((*ExportWalletRequest)(nil), "rpcprotobuf.ExportWalletRequest")
+	proto.RegisterType((*ExportWalletResponse)(nil), "rpcprotobuf.ExportWalletResponse")
+	proto.RegisterType((*RemoveWalletRequest)(nil), "rpcprotobuf.RemoveWalletRequest")
+	proto.RegisterType((*RemoveWalletResponse)(nil), "rpcprotobuf.RemoveWalletResponse")
+	proto.RegisterType((*SubscribeWalletEventsRequest)(nil), "rpcprotobuf.SubscribeWalletEventsRequest")
+	proto.RegisterType((*WalletEvent)(nil), "rpcprotobuf.WalletEvent")
+	proto.RegisterType((*GetAddressBalanceRequest)(nil), "rpcprotobuf.GetAddressBalanceRequest")
+	proto.RegisterType((*AddressAndBalance)(nil), "rpcprotobuf.AddressAndBalance")
+	proto.RegisterType((*GetAddressBalanceResponse)(nil), "rpcprotobuf.GetAddressBalanceResponse")
+	proto.RegisterType((*ValidateAddressRequest)(nil), "rpcprotobuf.ValidateAddressRequest")
+	proto.RegisterType((*ValidateAddressResponse)(nil), "rpcprotobuf.ValidateAddressResponse")
+	proto.RegisterType((*CreateAddressRequest)(nil), "rpcprotobuf.CreateAddressRequest")
+	proto.RegisterType((*CreateAddressResponse)(nil), "rpcprotobuf.CreateAddressResponse")
+	proto.RegisterType((*GetAddressesRequest)(nil), "rpcprotobuf.GetAddressesRequest")
+	proto.RegisterType((*GetAddressesResponse)(nil), "rpcprotobuf.GetAddressesResponse")
+	proto.RegisterType((*GetAddressesResponse_AddressDetail)(nil), "rpcprotobuf.GetAddressesResponse.AddressDetail")
+	proto.RegisterType((*GetWalletBalanceRequest)(nil), "rpcprotobuf.GetWalletBalanceRequest")
+	proto.RegisterType((*GetWalletBalanceResponse)(nil), "rpcprotobuf.GetWalletBalanceResponse")
+	proto.RegisterType((*GetWalletBalanceResponse_Detail)(nil), "rpcprotobuf.GetWalletBalanceResponse.Detail")
+	proto.RegisterType((*TxHistoryDetails)(nil), "rpcprotobuf.TxHistoryDetails")
+	proto.RegisterType((*TxHistoryDetails_Input)(nil), "rpcprotobuf.TxHistoryDetails.Input")
+	proto.RegisterType((*TxHistoryDetails_Output)(nil), "rpcprotobuf.TxHistoryDetails.Output")
+	proto.RegisterType((*TxHistoryResponse)(nil), "rpcprotobuf.TxHistoryResponse")
+	proto.RegisterType((*TxHistoryRequest)(nil), "rpcprotobuf.TxHistoryRequest")
+	proto.RegisterType((*ListTransactionsRequest)(nil), "rpcprotobuf.ListTransactionsRequest")
+	proto.RegisterType((*ListTransactionsResponse)(nil), "rpcprotobuf.ListTransactionsResponse")
+	proto.RegisterType((*ListTransactionsResponse_Transaction)(nil), "rpcprotobuf.ListTransactionsResponse.Transaction")
+	proto.RegisterType((*ExportTxHistoryRequest)(nil), "rpcprotobuf.ExportTxHistoryRequest")
+	proto.RegisterType((*ExportTxHistoryResponse)(nil), "rpcprotobuf.ExportTxHistoryResponse")
+	proto.RegisterType((*ExportTxHistoryResponse_Record)(nil), "rpcprotobuf.ExportTxHistoryResponse.Record")
+	proto.RegisterType((*TransactionInput)(nil), "rpcprotobuf.TransactionInput")
+	proto.RegisterType((*DecodeRawTransactionRequest)(nil), "rpcprotobuf.DecodeRawTransactionRequest")
+	proto.RegisterType((*DecodeRawTransactionResponse)(nil), "rpcprotobuf.DecodeRawTransactionResponse")
+	proto.RegisterType((*DecodeRawTransactionResponse_Vin)(nil), "rpcprotobuf.DecodeRawTransactionResponse.Vin")
+	proto.RegisterType((*DecodeRawTransactionResponse_Vout)(nil), "rpcprotobuf.DecodeRawTransactionResponse.Vout")
+	proto.RegisterType((*CreateRawTransactionRequest)(nil), "rpcprotobuf.CreateRawTransactionRequest")
+	proto.RegisterType((*AutoCreateTransactionRequest)(nil), "rpcprotobuf.AutoCreateTransactionRequest")
+	proto.RegisterType((*CreateRawTransactionResponse)(nil), "rpcprotobuf.CreateRawTransactionResponse")
+	proto.RegisterType((*CreateStakingTransactionRequest)(nil), "rpcprotobuf.CreateStakingTransactionRequest")
+	proto.RegisterType((*CreateStakingWithdrawTransactionRequest)(nil), "rpcprotobuf.CreateStakingWithdrawTransactionRequest")
+	proto.RegisterType((*GetBlockStakingRewardRequest)(nil), "rpcprotobuf.GetBlockStakingRewardRequest")
+	proto.RegisterType((*GetBlockStakingRewardResponse)(nil), "rpcprotobuf.GetBlockStakingRewardResponse")
+	proto.RegisterType((*GetBlockStakingRewardResponse_RewardDetail)(nil), "rpcprotobuf.GetBlockStakingRewardResponse.RewardDetail")
+	proto.RegisterType((*EstimateStakingRewardRequest)(nil), "rpcprotobuf.EstimateStakingRewardRequest")
+	proto.RegisterType((*EstimateStakingRewardResponse)(nil), "rpcprotobuf.EstimateStakingRewardResponse")
+	proto.RegisterType((*EstimateStakingRewardResponse_Projection)(nil), "rpcprotobuf.EstimateStakingRewardResponse.Projection")
+	proto.RegisterType((*GetStakingHistoryRequest)(nil), "rpcprotobuf.GetStakingHistoryRequest")
+	proto.RegisterType((*GetStakingHistoryResponse)(nil), "rpcprotobuf.GetStakingHistoryResponse")
+	proto.RegisterType((*GetStakingHistoryResponse_StakingUTXO)(nil), "rpcprotobuf.GetStakingHistoryResponse.StakingUTXO")
+	proto.RegisterType((*GetStakingHistoryResponse_Tx)(nil), "rpcprotobuf.GetStakingHistoryResponse.Tx")
+	proto.RegisterType((*GetStakingRewardHistoryRequest)(nil), "rpcprotobuf.GetStakingRewardHistoryRequest")
+	proto.RegisterType((*GetStakingRewardHistoryResponse)(nil), "rpcprotobuf.GetStakingRewardHistoryResponse")
+	proto.RegisterType((*GetStakingRewardHistoryResponse_Day)(nil), "rpcprotobuf.GetStakingRewardHistoryResponse.Day")
+	proto.RegisterType((*GetStakingRewardHistoryResponse_Total)(nil), "rpcprotobuf.GetStakingRewardHistoryResponse.Total")
+	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcprotobuf.SendRawTransactionRequest")
+	proto.RegisterType((*SendRawTransactionResponse)(nil), "rpcprotobuf.SendRawTransactionResponse")
+	proto.RegisterType((*BumpFeeRequest)(nil), "rpcprotobuf.BumpFeeRequest")
+	proto.RegisterType((*BumpFeeResponse)(nil), "rpcprotobuf.BumpFeeResponse")
+	proto.RegisterType((*GetTransactionFeeRequest)(nil), "rpcprotobuf.GetTransactionFeeRequest")
+	proto.RegisterType((*GetTransactionFeeResponse)(nil), "rpcprotobuf.GetTransactionFeeResponse")
+	proto.RegisterType((*EstimateFeeRateRequest)(nil), "rpcprotobuf.EstimateFeeRateRequest")
+	proto.RegisterType((*EstimateFeeRateResponse)(nil), "rpcprotobuf.EstimateFeeRateResponse")
+	proto.RegisterType((*BlockInfoForTx)(nil), "rpcprotobuf.BlockInfoForTx")
+	proto.RegisterType((*Vin)(nil), "rpcprotobuf.Vin")
+	proto.RegisterType((*Vin_RedeemDetail)(nil), "rpcprotobuf.Vin.RedeemDetail")
+	proto.RegisterType((*Vout)(nil), "rpcprotobuf.Vout")
+	proto.RegisterType((*Vout_ScriptDetail)(nil), "rpcprotobuf.Vout.ScriptDetail")
+	proto.RegisterType((*GetRawTransactionRequest)(nil), "rpcprotobuf.GetRawTransactionRequest")
+	proto.RegisterType((*GetRawTransactionResponse)(nil), "rpcprotobuf.GetRawTransactionResponse")
+	proto.RegisterType((*GetTxStatusRequest)(nil), "rpcprotobuf.GetTxStatusRequest")
+	proto.RegisterType((*GetTxStatusResponse)(nil), "rpcprotobuf.GetTxStatusResponse")
+	proto.RegisterType((*GetMempoolInfoResponse)(nil), "rpcprotobuf.GetMempoolInfoResponse")
+	proto.RegisterType((*MempoolEntry)(nil), "rpcprotobuf.MempoolEntry")
+	proto.RegisterType((*GetRawMempoolRequest)(nil), "rpcprotobuf.GetRawMempoolRequest")
+	proto.RegisterType((*GetRawMempoolResponse)(nil), "rpcprotobuf.GetRawMempoolResponse")
+	proto.RegisterType((*GetMempoolEntryRequest)(nil), "rpcprotobuf.GetMempoolEntryRequest")
+	proto.RegisterType((*GetMempoolEntryResponse)(nil), "rpcprotobuf.GetMempoolEntryResponse")
+	proto.RegisterType((*EvictMempoolTransactionRequest)(nil), "rpcprotobuf.EvictMempoolTransactionRequest")
+	proto.RegisterType((*EvictMempoolTransactionResponse)(nil), "rpcprotobuf.EvictMempoolTransactionResponse")
+	proto.RegisterType((*SignRawTransactionRequest)(nil), "rpcprotobuf.SignRawTransactionRequest")
+	proto.RegisterType((*SignRawTransactionResponse)(nil), "rpcprotobuf.SignRawTransactionResponse")
+	proto.RegisterType((*CreatePsbtRequest)(nil), "rpcprotobuf.CreatePsbtRequest")
+	proto.RegisterType((*PsbtResponse)(nil), "rpcprotobuf.PsbtResponse")
+	proto.RegisterType((*DecodePsbtRequest)(nil), "rpcprotobuf.DecodePsbtRequest")
+	proto.RegisterType((*DecodePsbtResponse)(nil), "rpcprotobuf.DecodePsbtResponse")
+	proto.RegisterType((*DecodePsbtResponse_PartialSig)(nil), "rpcprotobuf.DecodePsbtResponse.PartialSig")
+	proto.RegisterType((*DecodePsbtResponse_Derivation)(nil), "rpcprotobuf.DecodePsbtResponse.Derivation")
+	proto.RegisterType((*DecodePsbtResponse_Input)(nil), "rpcprotobuf.DecodePsbtResponse.Input")
+	proto.RegisterType((*DecodePsbtResponse_Output)(nil), "rpcprotobuf.DecodePsbtResponse.Output")
+	proto.RegisterType((*SignPsbtRequest)(nil), "rpcprotobuf.SignPsbtRequest")
+	proto.RegisterType((*CombinePsbtRequest)(nil), "rpcprotobuf.CombinePsbtRequest")
+	proto.RegisterType((*FinalizePsbtRequest)(nil), "rpcprotobuf.FinalizePsbtRequest")
+	proto.RegisterType((*FinalizePsbtResponse)(nil), "rpcprotobuf.FinalizePsbtResponse")
+	proto.RegisterType((*GetUtxoRequest)(nil), "rpcprotobuf.GetUtxoRequest")
+	proto.RegisterType((*UTXO)(nil), "rpcprotobuf.UTXO")
+	proto.RegisterType((*AddressUTXO)(nil), "rpcprotobuf.AddressUTXO")
+	proto.RegisterType((*GetUtxoResponse)(nil), "rpcprotobuf.GetUtxoResponse")
+	proto.RegisterType((*SearchAddressTransactionsRequest)(nil), "rpcprotobuf.SearchAddressTransactionsRequest")
+	proto.RegisterType((*SearchAddressTransactionsResponse)(nil), "rpcprotobuf.SearchAddressTransactionsResponse")
+	proto.RegisterType((*SearchAddressTransactionsResponse_Transaction)(nil), "rpcprotobuf.SearchAddressTransactionsResponse.Transaction")
+	proto.RegisterType((*GetAddressUtxosRequest)(nil), "rpcprotobuf.GetAddressUtxosRequest")
+	proto.RegisterType((*GetAddressUtxosResponse)(nil), "rpcprotobuf.GetAddressUtxosResponse")
+	proto.RegisterType((*GetAddressUtxosResponse_Utxo)(nil), "rpcprotobuf.GetAddressUtxosResponse.Utxo")
+	proto.RegisterType((*GetAddressReceivedRequest)(nil), "rpcprotobuf.GetAddressReceivedRequest")
+	proto.RegisterType((*GetAddressReceivedResponse)(nil), "rpcprotobuf.GetAddressReceivedResponse")
+	proto.RegisterType((*SetUtxoFrozenRequest)(nil), "rpcprotobuf.SetUtxoFrozenRequest")
+	proto.RegisterType((*SetUtxoFrozenResponse)(nil), "rpcprotobuf.SetUtxoFrozenResponse")
+	proto.RegisterType((*SetUtxoLabelRequest)(nil), "rpcprotobuf.SetUtxoLabelRequest")
+	proto.RegisterType((*SetUtxoLabelResponse)(nil), "rpcprotobuf.SetUtxoLabelResponse")
+	proto.RegisterType((*ConsolidateUtxosRequest)(nil), "rpcprotobuf.ConsolidateUtxosRequest")
+	proto.RegisterType((*ConsolidateUtxosResponse)(nil), "rpcprotobuf.ConsolidateUtxosResponse")
+	proto.RegisterType((*ConsolidateUtxosResponse_Transaction)(nil), "rpcprotobuf.ConsolidateUtxosResponse.Transaction")
+	proto.RegisterType((*SweepPrivateKeyRequest)(nil), "rpcprotobuf.SweepPrivateKeyRequest")
+	proto.RegisterType((*SweepPrivateKeyResponse)(nil), "rpcprotobuf.SweepPrivateKeyResponse")
+	proto.RegisterType((*GetAddressBindingRequest)(nil), "rpcprotobuf.GetAddressBindingRequest")
+	proto.RegisterType((*GetAddressBindingResponse)(nil), "rpcprotobuf.GetAddressBindingResponse")
+	proto.RegisterType((*CheckBindingRequirementRequest)(nil), "rpcprotobuf.CheckBindingRequirementRequest")
+	proto.RegisterType((*CheckBindingRequirementResponse)(nil), "rpcprotobuf.CheckBindingRequirementResponse")
+	proto.RegisterType((*CheckBindingRequirementResponse_Requirement)(nil), "rpcprotobuf.CheckBindingRequirementResponse.Requirement")
+	proto.RegisterType((*GetBindingHistoryRequest)(nil), "rpcprotobuf.GetBindingHistoryRequest")
+	proto.RegisterType((*GetBindingHistoryResponse)(nil), "rpcprotobuf.GetBindingHistoryResponse")
+	proto.RegisterType((*GetBindingHistoryResponse_BindingUTXO)(nil), "rpcprotobuf.GetBindingHistoryResponse.BindingUTXO")
+	proto.RegisterType((*GetBindingHistoryResponse_History)(nil), "rpcprotobuf.GetBindingHistoryResponse.History")
+	proto.RegisterType((*CreateBindingTransactionRequest)(nil), "rpcprotobuf.CreateBindingTransactionRequest")
+	proto.RegisterType((*CreateBindingTransactionRequest_Output)(nil), "rpcprotobuf.CreateBindingTransactionRequest.Output")
+	proto.RegisterType((*CreateBindingWithdrawTransactionRequest)(nil), "rpcprotobuf.CreateBindingWithdrawTransactionRequest")
+	proto.RegisterType((*GetBestBlockResponse)(nil), "rpcprotobuf.GetBestBlockResponse")
+	proto.RegisterType((*BlockHeader)(nil), "rpcprotobuf.BlockHeader")
+	proto.RegisterType((*GetBlockRequest)(nil), "rpcprotobuf.GetBlockRequest")
+	proto.RegisterType((*GetBlockResponse)(nil), "rpcprotobuf.GetBlockResponse")
+	proto.RegisterType((*GetBlockHeaderRequest)(nil), "rpcprotobuf.GetBlockHeaderRequest")
+	proto.RegisterType((*GetBlockHashRequest)(nil), "rpcprotobuf.GetBlockHashRequest")
+	proto.RegisterType((*GetBlockHashResponse)(nil), "rpcprotobuf.GetBlockHashResponse")
+	proto.RegisterType((*GetChainTipsResponse)(nil), "rpcprotobuf.GetChainTipsResponse")
+	proto.RegisterType((*GetChainTipsResponse_ChainTip)(nil), "rpcprotobuf.GetChainTipsResponse.ChainTip")
+	proto.RegisterType((*GetMinedBlocksRequest)(nil), "rpcprotobuf.GetMinedBlocksRequest")
+	proto.RegisterType((*GetMinedBlocksResponse)(nil), "rpcprotobuf.GetMinedBlocksResponse")
+	proto.RegisterType((*GetWalletMnemonicRequest)(nil), "rpcprotobuf.GetWalletMnemonicRequest")
+	proto.RegisterType((*GetWalletMnemonicResponse)(nil), "rpcprotobuf.GetWalletMnemonicResponse")
+	proto.RegisterType((*UnlockWalletRequest)(nil), "rpcprotobuf.UnlockWalletRequest")
+	proto.RegisterType((*UnlockWalletResponse)(nil), "rpcprotobuf.UnlockWalletResponse")
+	proto.RegisterType((*LockWalletResponse)(nil), "rpcprotobuf.LockWalletResponse")
+	proto.RegisterType((*GetWalletXpubResponse)(nil), "rpcprotobuf.GetWalletXpubResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiService service
+
+type ApiServiceClient interface {
+	GetBestBlock(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetBestBlockResponse, error)
+	GetBlockStakingReward(ctx context.Context, in *GetBlockStakingRewardRequest, opts ...grpc.CallOption) (*GetBlockStakingRewardResponse, error)
+	EstimateStakingReward(ctx context.Context, in *EstimateStakingRewardRequest, opts ...grpc.CallOption) (*EstimateStakingRewardResponse, error)
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	GetBlockHeader(ctx context.Context, in *GetBlockHeaderRequest, opts ...grpc.CallOption) (*BlockHeader, error)
+	GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error)
+	GetChainTips(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetChainTipsResponse, error)
+	GetMinedBlocks(ctx context.Context, in *GetMinedBlocksRequest, opts ...grpc.CallOption) (*GetMinedBlocksResponse, error)
+	GetClientStatus(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error)
+	QuitClient(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error)
+	// commands act on a wallet
+	Wallets(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*WalletsResponse, error)
+	// just create non-poc wallet
+	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
+	UseWallet(ctx context.Context, in *UseWalletRequest, opts ...grpc.CallOption) (*UseWalletResponse, error)
+	ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
+	ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
+	ImportMultisigWallet(ctx context.Context, in *ImportMultisigWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
+	ImportWatchOnlyWallet(ctx context.Context, in *ImportWatchOnlyWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
+	ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error)
+	RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error)
+	SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsRequest, opts ...grpc.CallOption) (ApiService_SubscribeWalletEventsClient, error)
+	GetWalletMnemonic(ctx context.Context, in *GetWalletMnemonicRequest, opts ...grpc.CallOption) (*GetWalletMnemonicResponse, error)
+	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
+	LockWallet(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*LockWalletResponse, error)
+	GetWalletXpub(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetWalletXpubResponse, error)
+	GetWalletBalance(ctx context.Context, in *GetWalletBalanceRequest, opts ...grpc.CallOption) (*GetWalletBalanceResponse, error)
+	CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error)
+	GetAddresses(ctx context.Context, in *GetAddressesRequest, opts ...grpc.CallOption) (*GetAddressesResponse, error)
+	// if addresses not provided, return balances of all addresses
+	GetAddressBalance(ctx context.Context, in *GetAddressBalanceRequest, opts ...grpc.CallOption) (*GetAddressBalanceResponse, error)
+	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
+	// if addresses not provided, return utxos of all addresses
+	GetUtxo(ctx context.Context, in *GetUtxoRequest, opts ...grpc.CallOption) (*GetUtxoResponse, error)
+	// transactions and utxos of any address by the address index of the node
+	SearchAddressTransactions(ctx context.Context, in *SearchAddressTransactionsRequest, opts ...grpc.CallOption) (*SearchAddressTransactionsResponse, error)
+	GetAddressUtxos(ctx context.Context, in *GetAddressUtxosRequest, opts ...grpc.CallOption) (*GetAddressUtxosResponse, error)
+	GetAddressReceived(ctx context.Context, in *GetAddressReceivedRequest, opts ...grpc.CallOption) (*GetAddressReceivedResponse, error)
+	SetUtxoFrozen(ctx context.Context, in *SetUtxoFrozenRequest, opts ...grpc.CallOption) (*SetUtxoFrozenResponse, error)
+	SetUtxoLabel(ctx context.Context, in *SetUtxoLabelRequest, opts ...grpc.CallOption) (*SetUtxoLabelResponse, error)
+	ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error)
+	SweepPrivateKey(ctx context.Context, in *SweepPrivateKeyRequest, opts ...grpc.CallOption) (*SweepPrivateKeyResponse, error)
+	DecodeRawTransaction(ctx context.Context, in *DecodeRawTransactionRequest, opts ...grpc.CallOption) (*DecodeRawTransactionResponse, error)
+	CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	AutoCreateTransaction(ctx context.Context, in *AutoCreateTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error)
+	CreatePsbt(ctx context.Context, in *CreatePsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error)
+	DecodePsbt(ctx context.Context, in *DecodePsbtRequest, opts ...grpc.CallOption) (*DecodePsbtResponse, error)
+	SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error)
+	CombinePsbt(ctx context.Context, in *CombinePsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error)
+	FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error)
+	GetTransactionFee(ctx context.Context, in *GetTransactionFeeRequest, opts ...grpc.CallOption) (*GetTransactionFeeResponse, error)
+	EstimateFeeRate(ctx context.Context, in *EstimateFeeRateRequest, opts ...grpc.CallOption) (*EstimateFeeRateResponse, error)
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error)
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	// get tx from chaindb
+	GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error)
+	GetMempoolInfo(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetMempoolInfoResponse, error)
+	GetRawMempool(ctx context.Context, in *GetRawMempoolRequest, opts ...grpc.CallOption) (*GetRawMempoolResponse, error)
+	GetMempoolEntry(ctx context.Context, in *GetMempoolEntryRequest, opts ...grpc.CallOption) (*GetMempoolEntryResponse, error)
+	EvictMempoolTransaction(ctx context.Context, in *EvictMempoolTransactionRequest, opts ...grpc.CallOption) (*EvictMempoolTransactionResponse, error)
+	CreateStakingTransaction(ctx context.Context, in *CreateStakingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	CreateStakingWithdrawTransaction(ctx context.Context, in *CreateStakingWithdrawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	TxHistory(ctx context.Context, in *TxHistoryRequest, opts ...grpc.CallOption) (*TxHistoryResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	ExportTxHistory(ctx context.Context, in *ExportTxHistoryRequest, opts ...grpc.CallOption) (*ExportTxHistoryResponse, error)
+	// query by poc addresses
+	GetAddressBinding(ctx context.Context, in *GetAddressBindingRequest, opts ...grpc.CallOption) (*GetAddressBindingResponse, error)
+	CheckBindingRequirement(ctx context.Context, in *CheckBindingRequirementRequest, opts ...grpc.CallOption) (*CheckBindingRequirementResponse, error)
+	GetStakingRewardHistory(ctx context.Context, in *GetStakingRewardHistoryRequest, opts ...grpc.CallOption) (*GetStakingRewardHistoryResponse, error)
+	GetStakingHistory(ctx context.Context, in *GetStakingHistoryRequest, opts ...grpc.CallOption) (*GetStakingHistoryResponse, error)
+	GetBindingHistory(ctx context.Context, in *GetBindingHistoryRequest, opts ...grpc.CallOption) (*GetBindingHistoryResponse, error)
+	CreateBindingTransaction(ctx context.Context, in *CreateBindingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	CreateBindingWithdrawTransaction(ctx context.Context, in *CreateBindingWithdrawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+}
+
+type apiServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
+	return &apiServiceClient{cc}
+}
+
+func (c *apiServiceClient) GetBestBlock(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetBestBlockResponse, error) {
+	out := new(GetBestBlockResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBestBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockStakingReward(ctx context.Context, in *GetBlockStakingRewardRequest, opts ...grpc.CallOption) (*GetBlockStakingRewardResponse, error) {
+	out := new(GetBlockStakingRewardResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlockStakingReward", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EstimateStakingReward(ctx context.Context, in *EstimateStakingRewardRequest, opts ...grpc.CallOption) (*EstimateStakingRewardResponse, error) {
+	out := new(EstimateStakingRewardResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/EstimateStakingReward", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
+	out := new(GetBlockResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockHeader(ctx context.Context, in *GetBlockHeaderRequest, opts ...grpc.CallOption) (*BlockHeader, error) {
+	out := new(BlockHeader)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlockHeader", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error) {
+	out := new(GetBlockHashResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlockHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetChainTips(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetChainTipsResponse, error) {
+	out := new(GetChainTipsResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetChainTips", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetMinedBlocks(ctx context.Context, in *GetMinedBlocksRequest, opts ...grpc.CallOption) (*GetMinedBlocksResponse, error) {
+	out := new(GetMinedBlocksResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetMinedBlocks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetClientStatus(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error) {
+	out := new(GetClientStatusResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetClientStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) QuitClient(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error) {
+	out := new(QuitClientResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/QuitClient", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Wallets(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*WalletsResponse, error) {
+	out := new(WalletsResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/Wallets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
+	out := new(CreateWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UseWallet(ctx context.Context, in *UseWalletRequest, opts ...grpc.CallOption) (*UseWalletResponse, error) {
+	out := new(UseWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/UseWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
+	out := new(ImportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
+	out := new(ImportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportMnemonic", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ImportMultisigWallet(ctx context.Context, in *ImportMultisigWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
+	out := new(ImportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportMultisigWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ImportWatchOnlyWallet(ctx context.Context, in *ImportWatchOnlyWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
+	out := new(ImportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportWatchOnlyWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error) {
+	out := new(ExportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ExportWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error) {
+	out := new(RemoveWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/RemoveWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsRequest, opts ...grpc.CallOption) (ApiService_SubscribeWalletEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcprotobuf.ApiService/SubscribeWalletEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeWalletEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeWalletEventsClient interface {
+	Recv() (*WalletEvent, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeWalletEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeWalletEventsClient) Recv() (*WalletEvent, error) {
+	m := new(WalletEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetWalletMnemonic(ctx context.Context, in *GetWalletMnemonicRequest, opts ...grpc.CallOption) (*GetWalletMnemonicResponse, error) {
+	out := new(GetWalletMnemonicResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetWalletMnemonic", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
+	out := new(UnlockWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/UnlockWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) LockWallet(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*LockWalletResponse, error) {
+	out := new(LockWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/LockWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetWalletXpub(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetWalletXpubResponse, error) {
+	out := new(GetWalletXpubResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetWalletXpub", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetWalletBalance(ctx context.Context, in *GetWalletBalanceRequest, opts ...grpc.CallOption) (*GetWalletBalanceResponse, error) {
+	out := new(GetWalletBalanceResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetWalletBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error) {
+	out := new(CreateAddressResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAddresses(ctx context.Context, in *GetAddressesRequest, opts ...grpc.CallOption) (*GetAddressesResponse, error) {
+	out := new(GetAddressesResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddresses", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAddressBalance(ctx context.Context, in *GetAddressBalanceRequest, opts ...grpc.CallOption) (*GetAddressBalanceResponse, error) {
+	out := new(GetAddressBalanceResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddressBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
+	out := new(ValidateAddressResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ValidateAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetUtxo(ctx context.Context, in *GetUtxoRequest, opts ...grpc.CallOption) (*GetUtxoResponse, error) {
+	out := new(GetUtxoResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetUtxo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SearchAddressTransactions(ctx context.Context, in *SearchAddressTransactionsRequest, opts ...grpc.CallOption) (*SearchAddressTransactionsResponse, error) {
+	out := new(SearchAddressTransactionsResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SearchAddressTransactions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAddressUtxos(ctx context.Context, in *GetAddressUtxosRequest, opts ...grpc.CallOption) (*GetAddressUtxosResponse, error) {
+	out := new(GetAddressUtxosResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddressUtxos", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAddressReceived(ctx context.Context, in *GetAddressReceivedRequest, opts ...grpc.CallOption) (*GetAddressReceivedResponse, error) {
+	out := new(GetAddressReceivedResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddressReceived", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SetUtxoFrozen(ctx context.Context, in *SetUtxoFrozenRequest, opts ...grpc.CallOption) (*SetUtxoFrozenResponse, error) {
+	out := new(SetUtxoFrozenResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SetUtxoFrozen", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SetUtxoLabel(ctx context.Context, in *SetUtxoLabelRequest, opts ...grpc.CallOption) (*SetUtxoLabelResponse, error) {
+	out := new(SetUtxoLabelResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SetUtxoLabel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error) {
+	out := new(ConsolidateUtxosResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ConsolidateUtxos", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SweepPrivateKey(ctx context.Context, in *SweepPrivateKeyRequest, opts ...grpc.CallOption) (*SweepPrivateKeyResponse, error) {
+	out := new(SweepPrivateKeyResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SweepPrivateKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) DecodeRawTransaction(ctx context.Context, in *DecodeRawTransactionRequest, opts ...grpc.CallOption) (*DecodeRawTransactionResponse, error) {
+	out := new(DecodeRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/DecodeRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AutoCreateTransaction(ctx context.Context, in *AutoCreateTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/AutoCreateTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error) {
+	out := new(SignRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SignRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreatePsbt(ctx context.Context, in *CreatePsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error) {
+	out := new(PsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreatePsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) DecodePsbt(ctx context.Context, in *DecodePsbtRequest, opts ...grpc.CallOption) (*DecodePsbtResponse, error) {
+	out := new(DecodePsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/DecodePsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error) {
+	out := new(PsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SignPsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CombinePsbt(ctx context.Context, in *CombinePsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error) {
+	out := new(PsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CombinePsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error) {
+	out := new(FinalizePsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/FinalizePsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTransactionFee(ctx context.Context, in *GetTransactionFeeRequest, opts ...grpc.CallOption) (*GetTransactionFeeResponse, error) {
+	out := new(GetTransactionFeeResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTransactionFee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EstimateFeeRate(ctx context.Context, in *EstimateFeeRateRequest, opts ...grpc.CallOption) (*EstimateFeeRateResponse, error) {
+	out := new(EstimateFeeRateResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/EstimateFeeRate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error) {
+	out := new(SendRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SendRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/BumpFee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
+	out := new(GetRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error) {
+	out := new(GetTxStatusResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTxStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetMempoolInfo(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetMempoolInfoResponse, error) {
+	out := new(GetMempoolInfoResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetMempoolInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetRawMempool(ctx context.Context, in *GetRawMempoolRequest, opts ...grpc.CallOption) (*GetRawMempoolResponse, error) {
+	out := new(GetRawMempoolResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetRawMempool", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetMempoolEntry(ctx context.Context, in *GetMempoolEntryRequest, opts ...grpc.CallOption) (*GetMempoolEntryResponse, error) {
+	out := new(GetMempoolEntryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetMempoolEntry", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EvictMempoolTransaction(ctx context.Context, in *EvictMempoolTransactionRequest, opts ...grpc.CallOption) (*EvictMempoolTransactionResponse, error) {
+	out := new(EvictMempoolTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/EvictMempoolTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateStakingTransaction(ctx context.Context, in *CreateStakingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateStakingTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateStakingWithdrawTransaction(ctx context.Context, in *CreateStakingWithdrawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateStakingWithdrawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) TxHistory(ctx context.Context, in *TxHistoryRequest, opts ...grpc.CallOption) (*TxHistoryResponse, error) {
+	out := new(TxHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/TxHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ListTransactions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ExportTxHistory(ctx context.Context, in *ExportTxHistoryRequest, opts ...grpc.CallOption) (*ExportTxHistoryResponse, error) {
+	out := new(ExportTxHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ExportTxHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAddressBinding(ctx context.Context, in *GetAddressBindingRequest, opts ...grpc.CallOption) (*GetAddressBindingResponse, error) {
+	out := new(GetAddressBindingResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddressBinding", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CheckBindingRequirement(ctx context.Context, in *CheckBindingRequirementRequest, opts ...grpc.CallOption) (*CheckBindingRequirementResponse, error) {
+	out := new(CheckBindingRequirementResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CheckBindingRequirement", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetStakingRewardHistory(ctx context.Context, in *GetStakingRewardHistoryRequest, opts ...grpc.CallOption) (*GetStakingRewardHistoryResponse, error) {
+	out := new(GetStakingRewardHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetStakingRewardHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetStakingHistory(ctx context.Context, in *GetStakingHistoryRequest, opts ...grpc.CallOption) (*GetStakingHistoryResponse, error) {
+	out := new(GetStakingHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetStakingHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBindingHistory(ctx context.Context, in *GetBindingHistoryRequest, opts ...grpc.CallOption) (*GetBindingHistoryResponse, error) {
+	out := new(GetBindingHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBindingHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateBindingTransaction(ctx context.Context, in *CreateBindingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateBindingTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateBindingWithdrawTransaction(ctx context.Context, in *CreateBindingWithdrawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateBindingWithdrawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ApiService service
+
+type ApiServiceServer interface {
+	GetBestBlock(context.Context, *google_protobuf2.Empty) (*GetBestBlockResponse, error)
+	GetBlockStakingReward(context.Context, *GetBlockStakingRewardRequest) (*GetBlockStakingRewardResponse, error)
+	EstimateStakingReward(context.Context, *EstimateStakingRewardRequest) (*EstimateStakingRewardResponse, error)
+	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
+	GetBlockHeader(context.Context, *GetBlockHeaderRequest) (*BlockHeader, error)
+	GetBlockHash(context.Context, *GetBlockHashRequest) (*GetBlockHashResponse, error)
+	GetChainTips(context.Context, *google_protobuf2.Empty) (*GetChainTipsResponse, error)
+	GetMinedBlocks(context.Context, *GetMinedBlocksRequest) (*GetMinedBlocksResponse, error)
+	GetClientStatus(context.Context, *google_protobuf2.Empty) (*GetClientStatusResponse, error)
+	QuitClient(context.Context, *google_protobuf2.Empty) (*QuitClientResponse, error)
+	// commands act on a wallet
+	Wallets(context.Context, *google_protobuf2.Empty) (*WalletsResponse, error)
+	// just create non-poc wallet
+	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
+	UseWallet(context.Context, *UseWalletRequest) (*UseWalletResponse, error)
+	ImportWallet(context.Context, *ImportWalletRequest) (*ImportWalletResponse, error)
+	ImportMnemonic(context.Context, *ImportMnemonicRequest) (*ImportWalletResponse, error)
+	ImportMultisigWallet(context.Context, *ImportMultisigWalletRequest) (*ImportWalletResponse, error)
+	ImportWatchOnlyWallet(context.Context, *ImportWatchOnlyWalletRequest) (*ImportWalletResponse, error)
+	ExportWallet(context.Context, *ExportWalletRequest) (*ExportWalletResponse, error)
+	RemoveWallet(context.Context, *RemoveWalletRequest) (*RemoveWalletResponse, error)
+	SubscribeWalletEvents(*SubscribeWalletEventsRequest, ApiService_SubscribeWalletEventsServer) error
+	GetWalletMnemonic(context.Context, *GetWalletMnemonicRequest) (*GetWalletMnemonicResponse, error)
+	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
+	LockWallet(context.Context, *google_protobuf2.Empty) (*LockWalletResponse, error)
+	GetWalletXpub(context.Context, *google_protobuf2.Empty) (*GetWalletXpubResponse, error)
+	GetWalletBalance(context.Context, *GetWalletBalanceRequest) (*GetWalletBalanceResponse, error)
+	CreateAddress(context.Context, *CreateAddressRequest) (*CreateAddressResponse, error)
+	GetAddresses(context.Context, *GetAddressesRequest) (*GetAddressesResponse, error)
+	// if addresses not provided, return balances of all addresses
+	GetAddressBalance(context.Context, *GetAddressBalanceRequest) (*GetAddressBalanceResponse, error)
+	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
+	// if addresses not provided, return utxos of all addresses
+	GetUtxo(context.Context, *GetUtxoRequest) (*GetUtxoResponse, error)
+	// transactions and utxos of any address by the address index of the node
+	SearchAddressTransactions(context.Context, *SearchAddressTransactionsRequest) (*SearchAddressTransactionsResponse, error)
+	GetAddressUtxos(context.Context, *GetAddressUtxosRequest) (*GetAddressUtxosResponse, error)
+	GetAddressReceived(context.Context, *GetAddressReceivedRequest) (*GetAddressReceivedResponse, error)
+	SetUtxoFrozen(context.Context, *SetUtxoFrozenRequest) (*SetUtxoFrozenResponse, error)
+	SetUtxoLabel(context.Context, *SetUtxoLabelRequest) (*SetUtxoLabelResponse, error)
+	ConsolidateUtxos(context.Context, *ConsolidateUtxosRequest) (*ConsolidateUtxosResponse, error)
+	SweepPrivateKey(context.Context, *SweepPrivateKeyRequest) (*SweepPrivateKeyResponse, error)
+	DecodeRawTransaction(context.Context, *DecodeRawTransactionRequest) (*DecodeRawTransactionResponse, error)
+	CreateRawTransaction(context.Context, *CreateRawTransactionRequest) (*CreateRawTransactionResponse, error)
+	AutoCreateTransaction(context.Context, *AutoCreateTransactionRequest) (*CreateRawTransactionResponse, error)
+	SignRawTransaction(context.Context, *SignRawTransactionRequest) (*SignRawTransactionResponse, error)
+	CreatePsbt(context.Context, *CreatePsbtRequest) (*PsbtResponse, error)
+	DecodePsbt(context.Context, *DecodePsbtRequest) (*DecodePsbtResponse, error)
+	SignPsbt(context.Context, *SignPsbtRequest) (*PsbtResponse, error)
+	CombinePsbt(context.Context, *CombinePsbtRequest) (*PsbtResponse, error)
+	FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error)
+	GetTransactionFee(context.Context, *GetTransactionFeeRequest) (*GetTransactionFeeResponse, error)
+	EstimateFeeRate(context.Context, *EstimateFeeRateRequest) (*EstimateFeeRateResponse, error)
+	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error)
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	// get tx from chaindb
+	GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error)
+	GetTxStatus(context.Context, *GetTxStatusRequest) (*GetTxStatusResponse, error)
+	GetMempoolInfo(context.Context, *google_protobuf2.Empty) (*GetMempoolInfoResponse, error)
+	GetRawMempool(context.Context, *GetRawMempoolRequest) (*GetRawMempoolResponse, error)
+	GetMempoolEntry(context.Context, *GetMempoolEntryRequest) (*GetMempoolEntryResponse, error)
+	EvictMempoolTransaction(context.Context, *EvictMempoolTransactionRequest) (*EvictMempoolTransactionResponse, error)
+	CreateStakingTransaction(context.Context, *CreateStakingTransactionRequest) (*CreateRawTransactionResponse, error)
+	CreateStakingWithdrawTransaction(context.Context, *CreateStakingWithdrawTransactionRequest) (*CreateRawTransactionResponse, error)
+	TxHistory(context.Context, *TxHistoryRequest) (*TxHistoryResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	ExportTxHistory(context.Context, *ExportTxHistoryRequest) (*ExportTxHistoryResponse, error)
+	// query by poc addresses
+	GetAddressBinding(context.Context, *GetAddressBindingRequest) (*GetAddressBindingResponse, error)
+	CheckBindingRequirement(context.Context, *CheckBindingRequirementRequest) (*CheckBindingRequirementResponse, error)
+	GetStakingRewardHistory(context.Context, *GetStakingRewardHistoryRequest) (*GetStakingRewardHistoryResponse, error)
+	GetStakingHistory(context.Context, *GetStakingHistoryRequest) (*GetStakingHistoryResponse, error)
+	GetBindingHistory(context.Context, *GetBindingHistoryRequest) (*GetBindingHistoryResponse, error)
+	CreateBindingTransaction(context.Context, *CreateBindingTransactionRequest) (*CreateRawTransactionResponse, error)
+	CreateBindingWithdrawTransaction(context.Context, *CreateBindingWithdrawTransactionRequest) (*CreateRawTransactionResponse, error)
+}
+
+func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
+	s.RegisterService(&_ApiService_serviceDesc, srv)
+}
+
+func _ApiService_GetBestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBestBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBestBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBestBlock(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockStakingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockStakingRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockStakingReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockStakingReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockStakingReward(ctx, req.(*GetBlockStakingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_EstimateStakingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateStakingRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).EstimateStakingReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/EstimateStakingReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).EstimateStakingReward(ctx, req.(*EstimateStakingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockHeader(ctx, req.(*GetBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockHash(ctx, req.(*GetBlockHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetChainTips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetChainTips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetChainTips",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetChainTips(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetMinedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetMinedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetMinedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetMinedBlocks(ctx, req.(*GetMinedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetClientStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetClientStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetClientStatus(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_QuitClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).QuitClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/QuitClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).QuitClient(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_Wallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Wallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/Wallets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Wallets(ctx, req.(*google_protobuf2.Empty))
 	}
-	return out, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) QuitClient(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error) {
-	out := new(QuitClientResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/QuitClient", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *apiServiceClient) Wallets(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*WalletsResponse, error) {
-	out := new(WalletsResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/Wallets", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CreateWallet(ctx, in)
 	}
-	return out, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/CreateWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
-	out := new(CreateWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateWallet", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_UseWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UseWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/UseWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UseWallet(ctx, req.(*UseWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) UseWallet(ctx context.Context, in *UseWalletRequest, opts ...grpc.CallOption) (*UseWalletResponse, error) {
-	out := new(UseWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/UseWallet", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ImportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ImportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ImportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ImportWallet(ctx, req.(*ImportWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
-	out := new(ImportWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportWallet", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ImportMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMnemonicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ImportMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ImportMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ImportMnemonic(ctx, req.(*ImportMnemonicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
-	out := new(ImportWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportMnemonic", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ImportMultisigWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMultisigWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ImportMultisigWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ImportMultisigWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ImportMultisigWallet(ctx, req.(*ImportMultisigWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error) {
-	out := new(ExportWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ExportWallet", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ImportWatchOnlyWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWatchOnlyWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ImportWatchOnlyWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ImportWatchOnlyWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ImportWatchOnlyWallet(ctx, req.(*ImportWatchOnlyWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error) {
-	out := new(RemoveWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/RemoveWallet", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ExportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ExportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ExportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ExportWallet(ctx, req.(*ExportWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetWalletMnemonic(ctx context.Context, in *GetWalletMnemonicRequest, opts ...grpc.CallOption) (*GetWalletMnemonicResponse, error) {
-	out := new(GetWalletMnemonicResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetWalletMnemonic", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_RemoveWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).RemoveWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/RemoveWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).RemoveWallet(ctx, req.(*RemoveWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetWalletBalance(ctx context.Context, in *GetWalletBalanceRequest, opts ...grpc.CallOption) (*GetWalletBalanceResponse, error) {
-	out := new(GetWalletBalanceResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetWalletBalance", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func _ApiService_SubscribeWalletEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeWalletEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(ApiServiceServer).SubscribeWalletEvents(m, &apiServiceSubscribeWalletEventsServer{stream})
 }
 
-func (c *apiServiceClient) CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error) {
-	out := new(CreateAddressResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateAddress", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ApiService_SubscribeWalletEventsServer interface {
+	Send(*WalletEvent) error
+	grpc.ServerStream
 }
 
-func (c *apiServiceClient) GetAddresses(ctx context.Context, in *GetAddressesRequest, opts ...grpc.CallOption) (*GetAddressesResponse, error) {
-	out := new(GetAddressesResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddresses", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type apiServiceSubscribeWalletEventsServer struct {
+	grpc.ServerStream
 }
 
-func (c *apiServiceClient) GetAddressBalance(ctx context.Context, in *GetAddressBalanceRequest, opts ...grpc.CallOption) (*GetAddressBalanceResponse, error) {
-	out := new(GetAddressBalanceResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddressBalance", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *apiServiceSubscribeWalletEventsServer) Send(m *WalletEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *apiServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
-	out := new(ValidateAddressResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ValidateAddress", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetWalletMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletMnemonicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetWalletMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetWalletMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetWalletMnemonic(ctx, req.(*GetWalletMnemonicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetUtxo(ctx context.Context, in *GetUtxoRequest, opts ...grpc.CallOption) (*GetUtxoResponse, error) {
-	out := new(GetUtxoResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetUtxo", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UnlockWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/UnlockWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) DecodeRawTransaction(ctx context.Context, in *DecodeRawTransactionRequest, opts ...grpc.CallOption) (*DecodeRawTransactionResponse, error) {
-	out := new(DecodeRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/DecodeRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_LockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).LockWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/LockWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).LockWallet(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
-	out := new(CreateRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetWalletXpub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetWalletXpub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetWalletXpub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetWalletXpub(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) AutoCreateTransaction(ctx context.Context, in *AutoCreateTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
-	out := new(CreateRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/AutoCreateTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetWalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetWalletBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetWalletBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetWalletBalance(ctx, req.(*GetWalletBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error) {
-	out := new(SignRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SignRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_CreateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *apiServiceClient) GetTransactionFee(ctx context.Context, in *GetTransactionFeeRequest, opts ...grpc.CallOption) (*GetTransactionFeeResponse, error) {
-	out := new(GetTransactionFeeResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTransactionFee", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CreateAddress(ctx, in)
 	}
-	return out, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/CreateAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CreateAddress(ctx, req.(*CreateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error) {
-	out := new(SendRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SendRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAddresses(ctx, req.(*GetAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
-	out := new(GetRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetAddressBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAddressBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetAddressBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAddressBalance(ctx, req.(*GetAddressBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error) {
-	out := new(GetTxStatusResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTxStatus", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ValidateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ValidateAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CreateStakingTransaction(ctx context.Context, in *CreateStakingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
-	out := new(CreateRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateStakingTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUtxoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetUtxo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetUtxo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetUtxo(ctx, req.(*GetUtxoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) TxHistory(ctx context.Context, in *TxHistoryRequest, opts ...grpc.CallOption) (*TxHistoryResponse, error) {
-	out := new(TxHistoryResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/TxHistory", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_SearchAddressTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAddressTransactionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SearchAddressTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/SearchAddressTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SearchAddressTransactions(ctx, req.(*SearchAddressTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetAddressBinding(ctx context.Context, in *GetAddressBindingRequest, opts ...grpc.CallOption) (*GetAddressBindingResponse, error) {
-	out := new(GetAddressBindingResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddressBinding", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetAddressUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressUtxosRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAddressUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetAddressUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAddressUtxos(ctx, req.(*GetAddressUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetStakingHistory(ctx context.Context, in *GetStakingHistoryRequest, opts ...grpc.CallOption) (*GetStakingHistoryResponse, error) {
-	out := new(GetStakingHistoryResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetStakingHistory", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetAddressReceived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressReceivedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAddressReceived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetAddressReceived",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAddressReceived(ctx, req.(*GetAddressReceivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetBindingHistory(ctx context.Context, in *GetBindingHistoryRequest, opts ...grpc.CallOption) (*GetBindingHistoryResponse, error) {
-	out := new(GetBindingHistoryResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBindingHistory", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_SetUtxoFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUtxoFrozenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SetUtxoFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/SetUtxoFrozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SetUtxoFrozen(ctx, req.(*SetUtxoFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CreateBindingTransaction(ctx context.Context, in *CreateBindingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
-	out := new(CreateRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateBindingTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_SetUtxoLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUtxoLabelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// Server API for ApiService service
-
-type ApiServiceServer interface {
-	GetBestBlock(context.Context, *google_protobuf2.Empty) (*GetBestBlockResponse, error)
-	GetBlockStakingReward(context.Context, *GetBlockStakingRewardRequest) (*GetBlockStakingRewardResponse, error)
-	GetClientStatus(context.Context, *google_protobuf2.Empty) (*GetClientStatusResponse, error)
-	QuitClient(context.Context, *google_protobuf2.Empty) (*QuitClientResponse, error)
-	// commands act on a wallet
-	Wallets(context.Context, *google_protobuf2.Empty) (*WalletsResponse, error)
-	// just create non-poc wallet
-	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
-	UseWallet(context.Context, *UseWalletRequest) (*UseWalletResponse, error)
-	ImportWallet(context.Context, *ImportWalletRequest) (*ImportWalletResponse, error)
-	ImportMnemonic(context.Context, *ImportMnemonicRequest) (*ImportWalletResponse, error)
-	ExportWallet(context.Context, *ExportWalletRequest) (*ExportWalletResponse, error)
-	RemoveWallet(context.Context, *RemoveWalletRequest) (*RemoveWalletResponse, error)
-	GetWalletMnemonic(context.Context, *GetWalletMnemonicRequest) (*GetWalletMnemonicResponse, error)
-	GetWalletBalance(context.Context, *GetWalletBalanceRequest) (*GetWalletBalanceResponse, error)
-	CreateAddress(context.Context, *CreateAddressRequest) (*CreateAddressResponse, error)
-	GetAddresses(context.Context, *GetAddressesRequest) (*GetAddressesResponse, error)
-	// if addresses not provided, return balances of all addresses
-	GetAddressBalance(context.Context, *GetAddressBalanceRequest) (*GetAddressBalanceResponse, error)
-	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
-	// if addresses not provided, return utxos of all addresses
-	GetUtxo(context.Context, *GetUtxoRequest) (*GetUtxoResponse, error)
-	DecodeRawTransaction(context.Context, *DecodeRawTransactionRequest) (*DecodeRawTransactionResponse, error)
-	CreateRawTransaction(context.Context, *CreateRawTransactionRequest) (*CreateRawTransactionResponse, error)
-	AutoCreateTransaction(context.Context, *AutoCreateTransactionRequest) (*CreateRawTransactionResponse, error)
-	SignRawTransaction(context.Context, *SignRawTransactionRequest) (*SignRawTransactionResponse, error)
-	GetTransactionFee(context.Context, *GetTransactionFeeRequest) (*GetTransactionFeeResponse, error)
-	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error)
-	// get tx from chaindb
-	GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error)
-	GetTxStatus(context.Context, *GetTxStatusRequest) (*GetTxStatusResponse, error)
-	CreateStakingTransaction(context.Context, *CreateStakingTransactionRequest) (*CreateRawTransactionResponse, error)
-	TxHistory(context.Context, *TxHistoryRequest) (*TxHistoryResponse, error)
-	// query by poc addresses
-	GetAddressBinding(context.Context, *GetAddressBindingRequest) (*GetAddressBindingResponse, error)
-	GetStakingHistory(context.Context, *GetStakingHistoryRequest) (*GetStakingHistoryResponse, error)
-	GetBindingHistory(context.Context, *GetBindingHistoryRequest) (*GetBindingHistoryResponse, error)
-	CreateBindingTransaction(context.Context, *CreateBindingTransactionRequest) (*CreateRawTransactionResponse, error)
-}
-
-func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
-	s.RegisterService(&_ApiService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SetUtxoLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/SetUtxoLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SetUtxoLabel(ctx, req.(*SetUtxoLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf2.Empty)
+func _ApiService_ConsolidateUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateUtxosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBestBlock(ctx, in)
+		return srv.(ApiServiceServer).ConsolidateUtxos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBestBlock",
+		FullMethod: "/rpcprotobuf.ApiService/ConsolidateUtxos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBestBlock(ctx, req.(*google_protobuf2.Empty))
+		return srv.(ApiServiceServer).ConsolidateUtxos(ctx, req.(*ConsolidateUtxosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockStakingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockStakingRewardRequest)
+func _ApiService_SweepPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepPrivateKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockStakingReward(ctx, in)
+		return srv.(ApiServiceServer).SweepPrivateKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockStakingReward",
+		FullMethod: "/rpcprotobuf.ApiService/SweepPrivateKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockStakingReward(ctx, req.(*GetBlockStakingRewardRequest))
+		return srv.(ApiServiceServer).SweepPrivateKey(ctx, req.(*SweepPrivateKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf2.Empty)
+func _ApiService_DecodeRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetClientStatus(ctx, in)
+		return srv.(ApiServiceServer).DecodeRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetClientStatus",
+		FullMethod: "/rpcprotobuf.ApiService/DecodeRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetClientStatus(ctx, req.(*google_protobuf2.Empty))
+		return srv.(ApiServiceServer).DecodeRawTransaction(ctx, req.(*DecodeRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_QuitClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf2.Empty)
+func _ApiService_CreateRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).QuitClient(ctx, in)
+		return srv.(ApiServiceServer).CreateRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/QuitClient",
+		FullMethod: "/rpcprotobuf.ApiService/CreateRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).QuitClient(ctx, req.(*google_protobuf2.Empty))
+		return srv.(ApiServiceServer).CreateRawTransaction(ctx, req.(*CreateRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
-
-func _ApiService_Wallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf2.Empty)
+
+func _ApiService_AutoCreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoCreateTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).Wallets(ctx, in)
+		return srv.(ApiServiceServer).AutoCreateTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/Wallets",
+		FullMethod: "/rpcprotobuf.ApiService/AutoCreateTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).Wallets(ctx, req.(*google_protobuf2.Empty))
+		return srv.(ApiServiceServer).AutoCreateTransaction(ctx, req.(*AutoCreateTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWalletRequest)
+func _ApiService_SignRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).CreateWallet(ctx, in)
+		return srv.(ApiServiceServer).SignRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/CreateWallet",
+		FullMethod: "/rpcprotobuf.ApiService/SignRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+		return srv.(ApiServiceServer).SignRawTransaction(ctx, req.(*SignRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_UseWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UseWalletRequest)
+func _ApiService_CreatePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).UseWallet(ctx, in)
+		return srv.(ApiServiceServer).CreatePsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/UseWallet",
+		FullMethod: "/rpcprotobuf.ApiService/CreatePsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).UseWallet(ctx, req.(*UseWalletRequest))
+		return srv.(ApiServiceServer).CreatePsbt(ctx, req.(*CreatePsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ImportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportWalletRequest)
+func _ApiService_DecodePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodePsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ImportWallet(ctx, in)
+		return srv.(ApiServiceServer).DecodePsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ImportWallet",
+		FullMethod: "/rpcprotobuf.ApiService/DecodePsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ImportWallet(ctx, req.(*ImportWalletRequest))
+		return srv.(ApiServiceServer).DecodePsbt(ctx, req.(*DecodePsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ImportMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportMnemonicRequest)
+func _ApiService_SignPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ImportMnemonic(ctx, in)
+		return srv.(ApiServiceServer).SignPsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ImportMnemonic",
+		FullMethod: "/rpcprotobuf.ApiService/SignPsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ImportMnemonic(ctx, req.(*ImportMnemonicRequest))
+		return srv.(ApiServiceServer).SignPsbt(ctx, req.(*SignPsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ExportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportWalletRequest)
+func _ApiService_CombinePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinePsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ExportWallet(ctx, in)
+		return srv.(ApiServiceServer).CombinePsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ExportWallet",
+		FullMethod: "/rpcprotobuf.ApiService/CombinePsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ExportWallet(ctx, req.(*ExportWalletRequest))
+		return srv.(ApiServiceServer).CombinePsbt(ctx, req.(*CombinePsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_RemoveWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveWalletRequest)
+func _ApiService_FinalizePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).RemoveWallet(ctx, in)
+		return srv.(ApiServiceServer).FinalizePsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/RemoveWallet",
+		FullMethod: "/rpcprotobuf.ApiService/FinalizePsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).RemoveWallet(ctx, req.(*RemoveWalletRequest))
+		return srv.(ApiServiceServer).FinalizePsbt(ctx, req.(*FinalizePsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetWalletMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWalletMnemonicRequest)
+func _ApiService_GetTransactionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetWalletMnemonic(ctx, in)
+		return srv.(ApiServiceServer).GetTransactionFee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetWalletMnemonic",
+		FullMethod: "/rpcprotobuf.ApiService/GetTransactionFee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetWalletMnemonic(ctx, req.(*GetWalletMnemonicRequest))
+		return srv.(ApiServiceServer).GetTransactionFee(ctx, req.(*GetTransactionFeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetWalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWalletBalanceRequest)
+func _ApiService_EstimateFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetWalletBalance(ctx, in)
+		return srv.(ApiServiceServer).EstimateFeeRate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetWalletBalance",
+		FullMethod: "/rpcprotobuf.ApiService/EstimateFeeRate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetWalletBalance(ctx, req.(*GetWalletBalanceRequest))
+		return srv.(ApiServiceServer).EstimateFeeRate(ctx, req.(*EstimateFeeRateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_CreateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAddressRequest)
+func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).CreateAddress(ctx, in)
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/CreateAddress",
+		FullMethod: "/rpcprotobuf.ApiService/SendRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).CreateAddress(ctx, req.(*CreateAddressRequest))
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAddressesRequest)
+func _ApiService_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetAddresses(ctx, in)
+		return srv.(ApiServiceServer).BumpFee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetAddresses",
+		FullMethod: "/rpcprotobuf.ApiService/BumpFee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetAddresses(ctx, req.(*GetAddressesRequest))
+		return srv.(ApiServiceServer).BumpFee(ctx, req.(*BumpFeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetAddressBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAddressBalanceRequest)
+func _ApiService_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetAddressBalance(ctx, in)
+		return srv.(ApiServiceServer).GetRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetAddressBalance",
+		FullMethod: "/rpcprotobuf.ApiService/GetRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetAddressBalance(ctx, req.(*GetAddressBalanceRequest))
+		return srv.(ApiServiceServer).GetRawTransaction(ctx, req.(*GetRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateAddressRequest)
+func _ApiService_GetTxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ValidateAddress(ctx, in)
+		return srv.(ApiServiceServer).GetTxStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ValidateAddress",
+		FullMethod: "/rpcprotobuf.ApiService/GetTxStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
+		return srv.(ApiServiceServer).GetTxStatus(ctx, req.(*GetTxStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUtxoRequest)
+func _ApiService_GetMempoolInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetUtxo(ctx, in)
+		return srv.(ApiServiceServer).GetMempoolInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetUtxo",
+		FullMethod: "/rpcprotobuf.ApiService/GetMempoolInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetUtxo(ctx, req.(*GetUtxoRequest))
+		return srv.(ApiServiceServer).GetMempoolInfo(ctx, req.(*google_protobuf2.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_DecodeRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecodeRawTransactionRequest)
+func _ApiService_GetRawMempool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawMempoolRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).DecodeRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).GetRawMempool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/DecodeRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/GetRawMempool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).DecodeRawTransaction(ctx, req.(*DecodeRawTransactionRequest))
+		return srv.(ApiServiceServer).GetRawMempool(ctx, req.(*GetRawMempoolRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_CreateRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRawTransactionRequest)
+func _ApiService_GetMempoolEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMempoolEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).CreateRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).GetMempoolEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/CreateRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/GetMempoolEntry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).CreateRawTransaction(ctx, req.(*CreateRawTransactionRequest))
+		return srv.(ApiServiceServer).GetMempoolEntry(ctx, req.(*GetMempoolEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_AutoCreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AutoCreateTransactionRequest)
+func _ApiService_EvictMempoolTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictMempoolTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).AutoCreateTransaction(ctx, in)
+		return srv.(ApiServiceServer).EvictMempoolTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/AutoCreateTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/EvictMempoolTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).AutoCreateTransaction(ctx, req.(*AutoCreateTransactionRequest))
+		return srv.(ApiServiceServer).EvictMempoolTransaction(ctx, req.(*EvictMempoolTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_SignRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignRawTransactionRequest)
+func _ApiService_CreateStakingTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStakingTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).SignRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).CreateStakingTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/SignRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/CreateStakingTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SignRawTransaction(ctx, req.(*SignRawTransactionRequest))
+		return srv.(ApiServiceServer).CreateStakingTransaction(ctx, req.(*CreateStakingTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTransactionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionFeeRequest)
+func _ApiService_CreateStakingWithdrawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStakingWithdrawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTransactionFee(ctx, in)
+		return srv.(ApiServiceServer).CreateStakingWithdrawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetTransactionFee",
+		FullMethod: "/rpcprotobuf.ApiService/CreateStakingWithdrawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTransactionFee(ctx, req.(*GetTransactionFeeRequest))
+		return srv.(ApiServiceServer).CreateStakingWithdrawTransaction(ctx, req.(*CreateStakingWithdrawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendRawTransactionRequest)
+func _ApiService_TxHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).TxHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/SendRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/TxHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+		return srv.(ApiServiceServer).TxHistory(ctx, req.(*TxHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRawTransactionRequest)
+func _ApiService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).ListTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/ListTransactions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetRawTransaction(ctx, req.(*GetRawTransactionRequest))
+		return srv.(ApiServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTxStatusRequest)
+func _ApiService_ExportTxHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTxHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTxStatus(ctx, in)
+		return srv.(ApiServiceServer).ExportTxHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetTxStatus",
+		FullMethod: "/rpcprotobuf.ApiService/ExportTxHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTxStatus(ctx, req.(*GetTxStatusRequest))
+		return srv.(ApiServiceServer).ExportTxHistory(ctx, req.(*ExportTxHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_CreateStakingTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStakingTransactionRequest)
+func _ApiService_GetAddressBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressBindingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).CreateStakingTransaction(ctx, in)
+		return srv.(ApiServiceServer).GetAddressBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/CreateStakingTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/GetAddressBinding",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).CreateStakingTransaction(ctx, req.(*CreateStakingTransactionRequest))
+		return srv.(ApiServiceServer).GetAddressBinding(ctx, req.(*GetAddressBindingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_TxHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxHistoryRequest)
+func _ApiService_CheckBindingRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBindingRequirementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).TxHistory(ctx, in)
+		return srv.(ApiServiceServer).CheckBindingRequirement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/TxHistory",
+		FullMethod: "/rpcprotobuf.ApiService/CheckBindingRequirement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).TxHistory(ctx, req.(*TxHistoryRequest))
+		return srv.(ApiServiceServer).CheckBindingRequirement(ctx, req.(*CheckBindingRequirementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetAddressBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAddressBindingRequest)
+func _ApiService_GetStakingRewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakingRewardHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetAddressBinding(ctx, in)
+		return srv.(ApiServiceServer).GetStakingRewardHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetAddressBinding",
+		FullMethod: "/rpcprotobuf.ApiService/GetStakingRewardHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetAddressBinding(ctx, req.(*GetAddressBindingRequest))
+		return srv.(ApiServiceServer).GetStakingRewardHistory(ctx, req.(*GetStakingRewardHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3783,6 +7983,24 @@ func _ApiService_CreateBindingTransaction_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_CreateBindingWithdrawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBindingWithdrawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CreateBindingWithdrawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/CreateBindingWithdrawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CreateBindingWithdrawTransaction(ctx, req.(*CreateBindingWithdrawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApiService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcprotobuf.ApiService",
 	HandlerType: (*ApiServiceServer)(nil),
@@ -3795,6 +8013,30 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockStakingReward",
 			Handler:    _ApiService_GetBlockStakingReward_Handler,
 		},
+		{
+			MethodName: "EstimateStakingReward",
+			Handler:    _ApiService_EstimateStakingReward_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _ApiService_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetBlockHeader",
+			Handler:    _ApiService_GetBlockHeader_Handler,
+		},
+		{
+			MethodName: "GetBlockHash",
+			Handler:    _ApiService_GetBlockHash_Handler,
+		},
+		{
+			MethodName: "GetChainTips",
+			Handler:    _ApiService_GetChainTips_Handler,
+		},
+		{
+			MethodName: "GetMinedBlocks",
+			Handler:    _ApiService_GetMinedBlocks_Handler,
+		},
 		{
 			MethodName: "GetClientStatus",
 			Handler:    _ApiService_GetClientStatus_Handler,
@@ -3823,6 +8065,14 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportMnemonic",
 			Handler:    _ApiService_ImportMnemonic_Handler,
 		},
+		{
+			MethodName: "ImportMultisigWallet",
+			Handler:    _ApiService_ImportMultisigWallet_Handler,
+		},
+		{
+			MethodName: "ImportWatchOnlyWallet",
+			Handler:    _ApiService_ImportWatchOnlyWallet_Handler,
+		},
 		{
 			MethodName: "ExportWallet",
 			Handler:    _ApiService_ExportWallet_Handler,
@@ -3835,6 +8085,18 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWalletMnemonic",
 			Handler:    _ApiService_GetWalletMnemonic_Handler,
 		},
+		{
+			MethodName: "UnlockWallet",
+			Handler:    _ApiService_UnlockWallet_Handler,
+		},
+		{
+			MethodName: "LockWallet",
+			Handler:    _ApiService_LockWallet_Handler,
+		},
+		{
+			MethodName: "GetWalletXpub",
+			Handler:    _ApiService_GetWalletXpub_Handler,
+		},
 		{
 			MethodName: "GetWalletBalance",
 			Handler:    _ApiService_GetWalletBalance_Handler,
@@ -3859,6 +8121,34 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUtxo",
 			Handler:    _ApiService_GetUtxo_Handler,
 		},
+		{
+			MethodName: "SearchAddressTransactions",
+			Handler:    _ApiService_SearchAddressTransactions_Handler,
+		},
+		{
+			MethodName: "GetAddressUtxos",
+			Handler:    _ApiService_GetAddressUtxos_Handler,
+		},
+		{
+			MethodName: "GetAddressReceived",
+			Handler:    _ApiService_GetAddressReceived_Handler,
+		},
+		{
+			MethodName: "SetUtxoFrozen",
+			Handler:    _ApiService_SetUtxoFrozen_Handler,
+		},
+		{
+			MethodName: "SetUtxoLabel",
+			Handler:    _ApiService_SetUtxoLabel_Handler,
+		},
+		{
+			MethodName: "ConsolidateUtxos",
+			Handler:    _ApiService_ConsolidateUtxos_Handler,
+		},
+		{
+			MethodName: "SweepPrivateKey",
+			Handler:    _ApiService_SweepPrivateKey_Handler,
+		},
 		{
 			MethodName: "DecodeRawTransaction",
 			Handler:    _ApiService_DecodeRawTransaction_Handler,
@@ -3875,14 +8165,42 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SignRawTransaction",
 			Handler:    _ApiService_SignRawTransaction_Handler,
 		},
+		{
+			MethodName: "CreatePsbt",
+			Handler:    _ApiService_CreatePsbt_Handler,
+		},
+		{
+			MethodName: "DecodePsbt",
+			Handler:    _ApiService_DecodePsbt_Handler,
+		},
+		{
+			MethodName: "SignPsbt",
+			Handler:    _ApiService_SignPsbt_Handler,
+		},
+		{
+			MethodName: "CombinePsbt",
+			Handler:    _ApiService_CombinePsbt_Handler,
+		},
+		{
+			MethodName: "FinalizePsbt",
+			Handler:    _ApiService_FinalizePsbt_Handler,
+		},
 		{
 			MethodName: "GetTransactionFee",
 			Handler:    _ApiService_GetTransactionFee_Handler,
 		},
+		{
+			MethodName: "EstimateFeeRate",
+			Handler:    _ApiService_EstimateFeeRate_Handler,
+		},
 		{
 			MethodName: "SendRawTransaction",
 			Handler:    _ApiService_SendRawTransaction_Handler,
 		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _ApiService_BumpFee_Handler,
+		},
 		{
 			MethodName: "GetRawTransaction",
 			Handler:    _ApiService_GetRawTransaction_Handler,
@@ -3891,18 +8209,54 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxStatus",
 			Handler:    _ApiService_GetTxStatus_Handler,
 		},
+		{
+			MethodName: "GetMempoolInfo",
+			Handler:    _ApiService_GetMempoolInfo_Handler,
+		},
+		{
+			MethodName: "GetRawMempool",
+			Handler:    _ApiService_GetRawMempool_Handler,
+		},
+		{
+			MethodName: "GetMempoolEntry",
+			Handler:    _ApiService_GetMempoolEntry_Handler,
+		},
+		{
+			MethodName: "EvictMempoolTransaction",
+			Handler:    _ApiService_EvictMempoolTransaction_Handler,
+		},
 		{
 			MethodName: "CreateStakingTransaction",
 			Handler:    _ApiService_CreateStakingTransaction_Handler,
 		},
+		{
+			MethodName: "CreateStakingWithdrawTransaction",
+			Handler:    _ApiService_CreateStakingWithdrawTransaction_Handler,
+		},
 		{
 			MethodName: "TxHistory",
 			Handler:    _ApiService_TxHistory_Handler,
 		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _ApiService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "ExportTxHistory",
+			Handler:    _ApiService_ExportTxHistory_Handler,
+		},
 		{
 			MethodName: "GetAddressBinding",
 			Handler:    _ApiService_GetAddressBinding_Handler,
 		},
+		{
+			MethodName: "CheckBindingRequirement",
+			Handler:    _ApiService_CheckBindingRequirement_Handler,
+		},
+		{
+			MethodName: "GetStakingRewardHistory",
+			Handler:    _ApiService_GetStakingRewardHistory_Handler,
+		},
 		{
 			MethodName: "GetStakingHistory",
 			Handler:    _ApiService_GetStakingHistory_Handler,
@@ -3915,265 +8269,511 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBindingTransaction",
 			Handler:    _ApiService_CreateBindingTransaction_Handler,
 		},
+		{
+			MethodName: "CreateBindingWithdrawTransaction",
+			Handler:    _ApiService_CreateBindingWithdrawTransaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeWalletEvents",
+			Handler:       _ApiService_SubscribeWalletEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 4029 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7b, 0xcd, 0x73, 0x1c, 0x49,
-	0x56, 0xf8, 0xaf, 0xaa, 0x3f, 0xd4, 0xfd, 0xa4, 0x96, 0xe5, 0x94, 0x6c, 0xcb, 0x65, 0x79, 0xac,
-	0x29, 0x8f, 0xc7, 0x1f, 0x61, 0x77, 0x8f, 0x35, 0xeb, 0xfd, 0x2d, 0x9e, 0xe0, 0x43, 0xb2, 0x67,
-	0x3c, 0x82, 0xf1, 0x8e, 0x5d, 0x92, 0x67, 0x08, 0x38, 0x74, 0x94, 0xba, 0x53, 0xea, 0x1a, 0x75,
-	0x57, 0xb5, 0xab, 0xb2, 0xa5, 0xee, 0x99, 0x70, 0x10, 0xc0, 0x02, 0x87, 0x5d, 0x62, 0x63, 0x17,
-	0x02, 0xd8, 0x0d, 0x82, 0x00, 0x22, 0x96, 0x03, 0xfc, 0x01, 0x1c, 0xe0, 0x0e, 0x37, 0x0e, 0x04,
-	0x5c, 0x88, 0xe0, 0x00, 0xfc, 0x01, 0xfc, 0x09, 0x44, 0x7e, 0x55, 0x57, 0x66, 0x65, 0xb5, 0xda,
-	0xe3, 0x81, 0x93, 0x3a, 0xb3, 0xde, 0xcb, 0xf7, 0xf2, 0x7d, 0xbf, 0xcc, 0x14, 0xd4, 0xfd, 0x61,
-	0xd0, 0x1c, 0xc6, 0x11, 0x89, 0xd0, 0x62, 0x3c, 0xec, 0xb0, 0x5f, 0x07, 0xa3, 0x43, 0x67, 0xe3,
-	0x28, 0x8a, 0x8e, 0xfa, 0xb8, 0xe5, 0x0f, 0x83, 0x96, 0x1f, 0x86, 0x11, 0xf1, 0x49, 0x10, 0x85,
-	0x09, 0x07, 0x75, 0xee, 0xb2, 0x3f, 0x9d, 0x7b, 0x47, 0x38, 0xbc, 0x97, 0x9c, 0xfa, 0x47, 0x47,
-	0x38, 0x6e, 0x45, 0x43, 0x06, 0x61, 0x80, 0xbe, 0x22, 0xd6, 0x92, 0x8b, 0xb7, 0xf0, 0x60, 0x48,
-	0x26, 0xfc, 0xa3, 0xfb, 0xd7, 0x55, 0xb8, 0xf4, 0x04, 0x93, 0x47, 0xfd, 0x00, 0x87, 0x64, 0x8f,
-	0xf8, 0x64, 0x94, 0x78, 0x38, 0x19, 0x46, 0x61, 0x82, 0xd1, 0x0d, 0x58, 0x1e, 0x62, 0x1c, 0xb7,
-	0xfb, 0x41, 0x42, 0x70, 0x18, 0x84, 0x47, 0xeb, 0xd6, 0xa6, 0x75, 0xab, 0xe6, 0x35, 0xe8, 0xec,
-	0x27, 0x72, 0x12, 0xad, 0xc3, 0x42, 0x32, 0x09, 0x3b, 0xf4, 0xbb, 0xcd, 0xbe, 0xcb, 0x21, 0xba,
-	0x0c, 0xb5, 0x4e, 0xcf, 0x0f, 0xc2, 0x76, 0xd0, 0x5d, 0x2f, 0x6d, 0x5a, 0xb7, 0xea, 0xde, 0x02,
-	0x1b, 0xef, 0x76, 0xd1, 0x1d, 0x38, 0xdf, 0x8f, 0x3a, 0x7e, 0xbf, 0x7d, 0x80, 0x13, 0xd2, 0xee,
-	0xe1, 0xe0, 0xa8, 0x47, 0xd6, 0xcb, 0x9b, 0xd6, 0xad, 0xb2, 0x77, 0x8e, 0x7d, 0xd8, 0xc1, 0x09,
-	0xf9, 0x98, 0x4d, 0x53, 0xd8, 0xe3, 0x30, 0x3a, 0x0d, 0x15, 0xd8, 0x0a, 0x87, 0x65, 0x1f, 0x32,
-	0xb0, 0x77, 0x01, 0x9d, 0xfa, 0xfd, 0x3e, 0x26, 0x6d, 0xca, 0x84, 0x04, 0xae, 0x32, 0xe0, 0x15,
-	0xfe, 0x65, 0x6f, 0x12, 0x76, 0x04, 0xf4, 0x73, 0x00, 0xb6, 0xc3, 0x4e, 0x34, 0x0a, 0xc9, 0xfa,
-	0xc2, 0xa6, 0x75, 0x6b, 0x71, 0x6b, 0xab, 0x99, 0x51, 0x44, 0xb3, 0x40, 0x36, 0x4d, 0x8a, 0xf6,
-	0x88, 0x62, 0xed, 0x86, 0x87, 0x91, 0x57, 0x4f, 0x87, 0xe8, 0x11, 0x54, 0xe8, 0x20, 0x59, 0xaf,
-	0xb1, 0xd5, 0xee, 0xcd, 0xbd, 0x1a, 0x15, 0xa8, 0xc7, 0x71, 0x9d, 0x5f, 0x87, 0x86, 0x42, 0x00,
-	0xad, 0x41, 0x85, 0x44, 0xc4, 0xef, 0x33, 0x0d, 0x34, 0x3c, 0x3e, 0x40, 0x0e, 0xd4, 0xa2, 0x11,
-	0x39, 0x88, 0x46, 0x61, 0x97, 0x89, 0xbe, 0xe1, 0xa5, 0x63, 0xaa, 0x95, 0x20, 0xe4, 0x9f, 0x4a,
-	0xec, 0x93, 0x1c, 0x3a, 0x1e, 0xd4, 0xe8, 0xe2, 0x6c, 0xdd, 0x65, 0xb0, 0x83, 0x2e, 0x5b, 0xb4,
-	0xee, 0xd9, 0x01, 0xc3, 0xf2, 0xbb, 0xdd, 0x18, 0x27, 0x09, 0x5b, 0xb0, 0xee, 0xc9, 0x21, 0xda,
-	0x80, 0x7a, 0x37, 0x88, 0x71, 0x87, 0x5a, 0x96, 0x50, 0xe6, 0x74, 0xc2, 0xf9, 0x4f, 0x8b, 0x2f,
-	0x4a, 0x37, 0x81, 0x76, 0x33, 0x6c, 0x59, 0x9b, 0xa5, 0xd7, 0x92, 0x02, 0x13, 0xe7, 0x74, 0x17,
-	0x4f, 0xa6, 0xbb, 0xb0, 0xbf, 0xce, 0x4a, 0x12, 0x9b, 0xaa, 0x25, 0x22, 0x3d, 0x1c, 0xaf, 0x97,
-	0xbe, 0xce, 0x32, 0x1c, 0xd7, 0x7d, 0x08, 0xe8, 0xf9, 0x28, 0x10, 0xb0, 0xa9, 0x9b, 0x20, 0x28,
-	0x77, 0xa2, 0x2e, 0x66, 0x52, 0x2c, 0x79, 0xec, 0x37, 0x5a, 0x81, 0xd2, 0x20, 0x39, 0x12, 0x32,
-	0xa4, 0x3f, 0xdd, 0xdf, 0xb1, 0xe1, 0xdc, 0xe7, 0xcc, 0xfe, 0xa6, 0x0e, 0xf6, 0x18, 0x16, 0xb8,
-	0x49, 0x26, 0x42, 0x4e, 0x77, 0x14, 0xb6, 0x34, 0x70, 0x31, 0xde, 0x1b, 0x0d, 0x06, 0x7e, 0x3c,
-	0xf1, 0x24, 0xaa, 0xf3, 0x37, 0x16, 0x34, 0x94, 0x4f, 0xe8, 0x0a, 0xd4, 0x85, 0x13, 0xa4, 0xca,
-	0xad, 0xf1, 0x89, 0xdd, 0x2e, 0x65, 0x97, 0x4c, 0x86, 0x58, 0x18, 0x0c, 0xfb, 0x4d, 0xd5, 0x7e,
-	0x82, 0xe3, 0x44, 0xaa, 0xb6, 0xe1, 0xc9, 0x21, 0xfd, 0x12, 0xe3, 0x81, 0x1f, 0x1f, 0x27, 0xcc,
-	0x3b, 0xeb, 0x9e, 0x1c, 0xa2, 0x8b, 0x50, 0x4d, 0x98, 0xb8, 0x98, 0x2b, 0x36, 0x3c, 0x31, 0x42,
-	0x57, 0x01, 0xf8, 0xaf, 0x36, 0x95, 0x40, 0x95, 0x5b, 0x0a, 0x9f, 0x79, 0x9a, 0x1c, 0xb9, 0x2d,
-	0x58, 0x79, 0x91, 0x60, 0xce, 0xaf, 0x87, 0x5f, 0x8e, 0x70, 0x42, 0x66, 0xf2, 0xeb, 0xfe, 0xa1,
-	0x0d, 0xe7, 0x33, 0x18, 0x42, 0x74, 0xd9, 0xd0, 0x62, 0xa9, 0xa1, 0x45, 0x59, 0xcd, 0x2e, 0xd8,
-	0x7d, 0xc9, 0xbc, 0xfb, 0xb2, 0xba, 0xfb, 0xeb, 0xd0, 0x60, 0x9e, 0xd6, 0x3e, 0xf0, 0xfb, 0x7e,
-	0xd8, 0xc1, 0x6c, 0xab, 0x75, 0x6f, 0x89, 0x4d, 0xee, 0xf0, 0x39, 0x1a, 0x72, 0xf0, 0x98, 0xe0,
-	0x38, 0xf4, 0xfb, 0xed, 0x63, 0x3c, 0x11, 0xc1, 0x84, 0x6e, 0xbc, 0xe2, 0xad, 0xc8, 0x2f, 0xbf,
-	0x82, 0x27, 0x3c, 0x3e, 0xdc, 0x05, 0x14, 0x84, 0x39, 0xe8, 0x05, 0x0e, 0x2d, 0xbf, 0xa4, 0xd0,
-	0x19, 0xf1, 0xd7, 0x14, 0xf1, 0xbb, 0x5f, 0xc0, 0xea, 0xa3, 0x18, 0xfb, 0x44, 0x13, 0xe5, 0x5b,
-	0x00, 0x43, 0x3f, 0x49, 0x86, 0xbd, 0xd8, 0x4f, 0xb0, 0x90, 0x4c, 0x66, 0x26, 0xbb, 0xa0, 0xad,
-	0xea, 0xf3, 0x32, 0xd4, 0x0e, 0x02, 0xd2, 0x4e, 0x82, 0x2f, 0xb9, 0x74, 0x2a, 0xde, 0xc2, 0x41,
-	0x40, 0xf6, 0x82, 0x2f, 0xb1, 0x1b, 0xc0, 0x9a, 0x4a, 0x4b, 0x28, 0x61, 0xa6, 0x9d, 0x39, 0x50,
-	0x1b, 0x84, 0x78, 0x10, 0x85, 0x41, 0x47, 0x6a, 0x41, 0x8e, 0x8b, 0xed, 0xcd, 0x7d, 0x0e, 0xab,
-	0xbb, 0x83, 0x61, 0x14, 0x13, 0x75, 0x5b, 0x0e, 0xd4, 0x8e, 0xf1, 0x24, 0x21, 0x51, 0x2c, 0x37,
-	0x95, 0x8e, 0xb5, 0x2d, 0xdb, 0xfa, 0x96, 0xdd, 0xef, 0x5b, 0xb0, 0xa6, 0xae, 0x29, 0xd8, 0x5f,
-	0x06, 0x3b, 0x3a, 0x16, 0x39, 0xcd, 0x8e, 0x8e, 0xbf, 0x49, 0xc3, 0xc9, 0x88, 0xb9, 0xa2, 0xea,
-	0xed, 0xef, 0x2c, 0xb8, 0xc0, 0xb9, 0x79, 0x2a, 0xa4, 0x91, 0xd9, 0x63, 0x2a, 0x30, 0x4b, 0x13,
-	0xd8, 0x19, 0x7b, 0xcc, 0xd2, 0x2b, 0xa9, 0x6a, 0xbd, 0x01, 0xcb, 0xa9, 0x75, 0x06, 0x61, 0x17,
-	0x8f, 0x05, 0xab, 0x0d, 0x39, 0xbb, 0x4b, 0x27, 0x29, 0x58, 0x6a, 0x96, 0x1c, 0x8c, 0x7b, 0x75,
-	0x43, 0xce, 0x32, 0x30, 0xd7, 0x83, 0xd5, 0x0f, 0xc7, 0x79, 0xf5, 0xcc, 0x34, 0x84, 0xb3, 0xf4,
-	0xb3, 0x05, 0x6b, 0xea, 0x9a, 0x42, 0x3d, 0x33, 0x74, 0x4e, 0xf9, 0xf0, 0xf0, 0x20, 0x3a, 0xc1,
-	0xdf, 0x20, 0x1f, 0xef, 0xc2, 0x9a, 0xba, 0xa6, 0xd9, 0x4c, 0xdc, 0x08, 0xd6, 0x9f, 0x60, 0xb2,
-	0xcd, 0xf3, 0xa2, 0x08, 0x02, 0x92, 0x81, 0x07, 0x70, 0x31, 0xc6, 0x2f, 0x47, 0x41, 0x8c, 0xbb,
-	0xed, 0x4e, 0x14, 0x1e, 0x06, 0xf1, 0x80, 0xd7, 0x62, 0x0c, 0xbf, 0xe2, 0x5d, 0x90, 0x5f, 0x1f,
-	0x65, 0x3f, 0xd2, 0xe4, 0x2a, 0xf2, 0x2c, 0x4e, 0x58, 0xa2, 0xab, 0x7b, 0xd3, 0x09, 0xf7, 0x1f,
-	0x2c, 0x38, 0x2f, 0xc8, 0x6d, 0x87, 0x5d, 0x19, 0x76, 0x32, 0xa9, 0xda, 0x52, 0x53, 0x75, 0x5a,
-	0x2c, 0xf0, 0x3d, 0x8a, 0x62, 0x61, 0x03, 0xea, 0xc9, 0x10, 0x87, 0x5d, 0xff, 0xa0, 0x8f, 0x65,
-	0x02, 0x4f, 0x27, 0xd0, 0x7d, 0x58, 0x3b, 0x0d, 0x48, 0xaf, 0x1b, 0xfb, 0xa7, 0x74, 0xdc, 0x4e,
-	0x88, 0x7f, 0x4c, 0x2b, 0x3a, 0x1e, 0xf4, 0x57, 0xb3, 0xdf, 0xf6, 0xf8, 0xa7, 0x1c, 0xca, 0x41,
-	0x10, 0x76, 0x29, 0x4a, 0x25, 0x8f, 0xb2, 0xc3, 0x3f, 0xb9, 0x9f, 0xc3, 0x65, 0x83, 0xe8, 0x84,
-	0x9c, 0x1f, 0x42, 0x4d, 0x84, 0x59, 0x99, 0x0e, 0xdf, 0x52, 0xd2, 0x61, 0x4e, 0x04, 0x5e, 0x0a,
-	0xef, 0x6e, 0xc1, 0xc5, 0xcf, 0xfc, 0x7e, 0xd0, 0xf5, 0x09, 0x16, 0x60, 0x52, 0x23, 0x85, 0x62,
-	0x72, 0x7f, 0xd3, 0x82, 0x4b, 0x39, 0xa4, 0x69, 0x7a, 0x09, 0x92, 0xf6, 0x09, 0xfd, 0x2a, 0x34,
-	0xbf, 0x10, 0x24, 0x0c, 0x18, 0x5d, 0x82, 0x85, 0x20, 0x69, 0x0f, 0x82, 0x10, 0x8b, 0x72, 0xb7,
-	0x1a, 0x24, 0x4f, 0x83, 0x50, 0x51, 0x48, 0x49, 0x55, 0x88, 0x16, 0x27, 0x2a, 0xd3, 0x70, 0xf7,
-	0x9e, 0x8c, 0xac, 0x79, 0xae, 0x25, 0x86, 0xa5, 0x62, 0xdc, 0x87, 0x0b, 0x1a, 0x86, 0x60, 0xb9,
-	0x78, 0xa3, 0x2d, 0x58, 0x9d, 0x4a, 0x1d, 0xcf, 0x41, 0xe3, 0xdf, 0x2c, 0x58, 0x53, 0x31, 0x04,
-	0x8d, 0x5d, 0x58, 0xe8, 0x62, 0xe2, 0x07, 0x7d, 0xa9, 0xa1, 0x96, 0x5e, 0x47, 0xe5, 0x70, 0xa4,
-	0xda, 0x1e, 0x33, 0x3c, 0x4f, 0xe2, 0x3b, 0x63, 0x68, 0x28, 0x5f, 0x66, 0xd8, 0x73, 0x86, 0x51,
-	0x5b, 0x61, 0x94, 0x06, 0xe5, 0x51, 0x82, 0x79, 0x85, 0x5b, 0xf3, 0xd8, 0x6f, 0x74, 0x0d, 0x16,
-	0x13, 0xd2, 0x6d, 0xcb, 0xb5, 0xb8, 0x01, 0x43, 0x42, 0xba, 0x82, 0x9c, 0xdb, 0x63, 0x1d, 0x0f,
-	0x77, 0xf2, 0x6f, 0xc6, 0x7d, 0x2f, 0x42, 0x95, 0x6f, 0x4b, 0x5a, 0x04, 0x1f, 0xb9, 0x7f, 0x69,
-	0xb3, 0x50, 0xa1, 0x91, 0x9a, 0x27, 0x79, 0x9a, 0x5d, 0xf8, 0x71, 0x4a, 0xa7, 0xc4, 0x9a, 0x8b,
-	0xbb, 0xba, 0xf4, 0x8d, 0x94, 0x9a, 0x42, 0xf4, 0x02, 0xd7, 0xf9, 0x81, 0x05, 0x55, 0x21, 0x73,
-	0x25, 0x26, 0x58, 0xf3, 0xc6, 0x04, 0xfb, 0xf5, 0x63, 0x42, 0xa9, 0x38, 0x26, 0xfc, 0xbb, 0x0d,
-	0x2b, 0xfb, 0xe3, 0x8f, 0x03, 0x1a, 0xd8, 0x27, 0x9c, 0xaf, 0x04, 0xad, 0x42, 0x85, 0x8c, 0xa7,
-	0x82, 0x29, 0x93, 0xf1, 0x6e, 0x17, 0xbd, 0x0d, 0x4b, 0x07, 0xfd, 0xa8, 0x73, 0x2c, 0xbb, 0x3a,
-	0x9b, 0x75, 0x75, 0x8b, 0x6c, 0x4e, 0x34, 0x74, 0x1f, 0x40, 0x35, 0x08, 0x87, 0x23, 0x92, 0x88,
-	0x3a, 0xff, 0xba, 0x22, 0x21, 0x9d, 0x4c, 0x73, 0x97, 0xc2, 0x7a, 0x02, 0x05, 0xfd, 0x02, 0x2c,
-	0x44, 0x23, 0xc2, 0xb0, 0xcb, 0x0c, 0xfb, 0x9d, 0xd9, 0xd8, 0x9f, 0x32, 0x60, 0x4f, 0x22, 0xd1,
-	0x1c, 0x7a, 0x18, 0x47, 0x83, 0xf6, 0x34, 0x94, 0x57, 0x58, 0x28, 0x6f, 0xd0, 0xd9, 0xd4, 0x31,
-	0x9c, 0x2d, 0xa8, 0x30, 0xba, 0xe6, 0x4d, 0xae, 0x41, 0x85, 0xe7, 0x5f, 0x9b, 0xb5, 0x13, 0x7c,
-	0xe0, 0x3c, 0x84, 0x2a, 0xa7, 0x36, 0xc3, 0x4d, 0x2e, 0x42, 0xd5, 0x1f, 0xb0, 0x6a, 0x92, 0x2b,
-	0x48, 0x8c, 0xdc, 0x67, 0x70, 0x3e, 0x65, 0x3d, 0xb5, 0xbe, 0x0f, 0xa0, 0xde, 0x63, 0x53, 0x41,
-	0x1a, 0x6d, 0xaf, 0xce, 0xdc, 0xad, 0x37, 0x85, 0x77, 0x77, 0x32, 0x1a, 0x93, 0xae, 0xb3, 0x06,
-	0x15, 0x5e, 0xca, 0x8a, 0x0e, 0xb5, 0x23, 0xeb, 0x57, 0x73, 0x3f, 0xe9, 0x7e, 0x00, 0x2b, 0xfb,
-	0xb1, 0x1f, 0x26, 0x3e, 0x6b, 0x20, 0x67, 0x08, 0x04, 0x41, 0xf9, 0x24, 0x1a, 0x11, 0xd9, 0xaf,
-	0xd0, 0xdf, 0x6e, 0x0b, 0xae, 0x3c, 0xc6, 0xb4, 0xd1, 0xf2, 0xfc, 0xd3, 0xcc, 0x2a, 0x92, 0x97,
-	0x15, 0x28, 0xf5, 0xf0, 0x58, 0xac, 0x42, 0x7f, 0xba, 0x7f, 0x5e, 0x86, 0x0d, 0x33, 0x86, 0x90,
-	0x87, 0x91, 0x74, 0x71, 0xe0, 0xb9, 0x02, 0x75, 0x66, 0x89, 0x24, 0x18, 0xf0, 0x64, 0x5a, 0xf2,
-	0x6a, 0x74, 0x62, 0x3f, 0x18, 0xb0, 0x86, 0x90, 0x55, 0xd1, 0x3c, 0xd6, 0xb3, 0xdf, 0xe8, 0x17,
-	0xa1, 0x74, 0x12, 0x84, 0xcc, 0x20, 0xf4, 0xee, 0x73, 0x16, 0x5f, 0xcd, 0xcf, 0x82, 0xd0, 0xa3,
-	0x98, 0x68, 0x47, 0x88, 0xa1, 0xca, 0x56, 0x68, 0xbe, 0xc6, 0x0a, 0xd1, 0x88, 0x70, 0xb1, 0xd1,
-	0xfd, 0x0c, 0xfd, 0x49, 0x3f, 0xf2, 0xbb, 0xac, 0xe1, 0xa8, 0x7b, 0x72, 0xe8, 0x74, 0xa1, 0xf4,
-	0x59, 0x10, 0xce, 0xad, 0x00, 0x5a, 0x9b, 0x25, 0x54, 0xd8, 0xb4, 0x27, 0x2a, 0x31, 0x37, 0x4c,
-	0xc7, 0x94, 0xca, 0x69, 0x40, 0x42, 0x1e, 0x7c, 0xa9, 0xfd, 0xcb, 0xa1, 0xf3, 0x53, 0x0b, 0xca,
-	0x94, 0x1d, 0x6a, 0x2c, 0x27, 0x7e, 0x7f, 0x24, 0x63, 0x0e, 0x1f, 0xa0, 0x25, 0xb0, 0x42, 0x41,
-	0xc5, 0x0a, 0x8d, 0x05, 0x37, 0xed, 0x2d, 0x3b, 0x71, 0x30, 0x24, 0x6d, 0x3f, 0x19, 0x88, 0xd0,
-	0x5e, 0xe7, 0x33, 0xdb, 0xc9, 0x20, 0xf3, 0xb9, 0x27, 0x0a, 0xd8, 0xf4, 0xf3, 0xc7, 0x78, 0xac,
-	0x56, 0x59, 0x55, 0xbd, 0xca, 0xfa, 0x27, 0x1b, 0xae, 0xf0, 0xcc, 0x6a, 0x36, 0xaa, 0x07, 0x69,
-	0x68, 0x31, 0xba, 0x8b, 0x66, 0xcb, 0x69, 0x50, 0xf9, 0x14, 0x16, 0xb8, 0x1f, 0x26, 0xe2, 0x04,
-	0xe3, 0x81, 0x82, 0x37, 0x83, 0x62, 0x73, 0x9b, 0xe3, 0x7d, 0x18, 0x12, 0xda, 0xee, 0x8b, 0x55,
-	0xf2, 0xa6, 0x57, 0xce, 0x98, 0xde, 0x0d, 0x58, 0xee, 0xf4, 0xfc, 0xf0, 0x08, 0x6b, 0xf9, 0xaf,
-	0xc1, 0x67, 0x45, 0x10, 0x42, 0xb7, 0xe0, 0x5c, 0x32, 0x3a, 0x20, 0xb1, 0xdf, 0x21, 0x87, 0x18,
-	0xd3, 0xf0, 0x24, 0x42, 0x95, 0x3e, 0xed, 0x3c, 0x84, 0xa5, 0x2c, 0x1b, 0xd4, 0xb5, 0x8e, 0xf1,
-	0x44, 0xba, 0xd6, 0x31, 0x9e, 0x4c, 0x75, 0x69, 0x67, 0x74, 0xf9, 0xd0, 0xfe, 0x8e, 0xe5, 0xfe,
-	0xcc, 0x86, 0x8d, 0xed, 0x11, 0x89, 0xf8, 0x1e, 0x0d, 0x22, 0x7d, 0x36, 0x95, 0x0d, 0x97, 0xe9,
-	0xb7, 0xd5, 0x82, 0x6f, 0x06, 0xee, 0x3c, 0xc2, 0xb1, 0x35, 0xe1, 0xac, 0x40, 0xe9, 0x10, 0xcb,
-	0xda, 0x97, 0xfe, 0xa4, 0x19, 0x25, 0x1b, 0xb1, 0x85, 0xb0, 0x16, 0x33, 0xf1, 0xda, 0x20, 0xd1,
-	0x8a, 0x41, 0xa2, 0x6f, 0x24, 0xa7, 0xf7, 0x60, 0xc3, 0x6c, 0x06, 0x22, 0x36, 0xe5, 0xc3, 0xd9,
-	0xdf, 0x5b, 0x70, 0x8d, 0xa3, 0x88, 0xc4, 0x6b, 0x10, 0xae, 0xbe, 0x37, 0x2b, 0xbf, 0xb7, 0x9b,
-	0x70, 0x4e, 0xe4, 0xf4, 0xb6, 0x1a, 0xa5, 0x97, 0xc5, 0xf4, 0x76, 0x2e, 0xb5, 0x94, 0xb2, 0xa9,
-	0x05, 0x5d, 0x07, 0x9a, 0xdb, 0xbe, 0xc4, 0x61, 0x7b, 0x88, 0xe3, 0x20, 0xea, 0x8a, 0xde, 0x72,
-	0x89, 0x4f, 0x3e, 0x63, 0x73, 0x52, 0xec, 0x95, 0x54, 0xec, 0xee, 0xb7, 0x61, 0xe3, 0x09, 0x26,
-	0x3b, 0x54, 0x31, 0x82, 0x7f, 0x0f, 0x9f, 0xfa, 0x71, 0x57, 0xb2, 0x7e, 0x11, 0xaa, 0x22, 0xc5,
-	0x5b, 0x4c, 0x85, 0x62, 0xe4, 0xfe, 0xc8, 0x86, 0xab, 0x05, 0x88, 0x42, 0x54, 0xcf, 0xf5, 0x02,
-	0xf5, 0xff, 0xeb, 0x25, 0x52, 0x31, 0x72, 0x93, 0x0f, 0xb5, 0x42, 0x35, 0xc3, 0x8c, 0x9d, 0x65,
-	0xc6, 0xf9, 0x9e, 0x05, 0x4b, 0x59, 0x0c, 0x1a, 0xb0, 0x62, 0x3f, 0x3c, 0x16, 0xa5, 0x22, 0xfb,
-	0x5d, 0x94, 0x93, 0xe9, 0xfc, 0x29, 0x5f, 0x94, 0x0a, 0xd4, 0xf2, 0xc4, 0x28, 0x9b, 0x2f, 0xcb,
-	0xb9, 0xec, 0x3e, 0x8c, 0xa3, 0xc3, 0x80, 0x08, 0x41, 0x8a, 0x91, 0xdb, 0x64, 0x25, 0xa6, 0xd8,
-	0x90, 0x96, 0x93, 0x65, 0x08, 0x95, 0xd1, 0x7c, 0x32, 0xc4, 0xee, 0x8f, 0xcb, 0xac, 0x07, 0xd3,
-	0x11, 0xd2, 0xb2, 0xa0, 0x44, 0xc6, 0x52, 0x76, 0xb7, 0x75, 0xd9, 0x99, 0x91, 0x9a, 0xfb, 0x63,
-	0x8f, 0x62, 0xa1, 0xa7, 0xb0, 0xc0, 0xb7, 0x21, 0x43, 0xdd, 0xfb, 0x73, 0x2e, 0xf0, 0x39, 0xc7,
-	0x12, 0xbe, 0x2c, 0xd6, 0x70, 0x7e, 0xdf, 0x82, 0x45, 0x81, 0xf0, 0x62, 0xff, 0x57, 0x3f, 0x9d,
-	0x3f, 0x39, 0x15, 0x37, 0x62, 0x53, 0x75, 0x94, 0x67, 0xdb, 0x71, 0x25, 0x6f, 0xc7, 0xce, 0x9f,
-	0x5a, 0x60, 0xef, 0x8f, 0xcd, 0x6c, 0x4c, 0x0f, 0x43, 0x6d, 0xe5, 0x30, 0x54, 0x2f, 0x59, 0x4b,
-	0xf9, 0x92, 0xf5, 0x23, 0x28, 0x8f, 0xc8, 0x38, 0x62, 0x1c, 0x19, 0x6e, 0x1f, 0x0a, 0x44, 0x96,
-	0x11, 0x8c, 0xc7, 0xf0, 0x69, 0x04, 0xca, 0xca, 0xf1, 0xac, 0x08, 0x64, 0x65, 0x23, 0xd0, 0x3d,
-	0xb8, 0xbc, 0x87, 0xc3, 0xee, 0xbc, 0xd5, 0xd4, 0x7d, 0x70, 0x4c, 0xe0, 0x33, 0x4a, 0x29, 0xf7,
-	0x27, 0xbc, 0x15, 0xca, 0xc0, 0x7f, 0x84, 0xd3, 0xb6, 0xeb, 0x13, 0x3d, 0x0f, 0xe4, 0xa4, 0x60,
-	0xc4, 0xfb, 0x3a, 0x39, 0xe0, 0x81, 0xd6, 0x20, 0xcc, 0x99, 0xc5, 0xaf, 0xc1, 0x62, 0xcf, 0x4f,
-	0xd2, 0x76, 0xa6, 0xcc, 0xda, 0x3c, 0xe8, 0xf9, 0x89, 0xe8, 0x62, 0xde, 0x28, 0xfe, 0xdf, 0x63,
-	0x1e, 0xa9, 0x6f, 0x71, 0x1a, 0xfc, 0x69, 0xf4, 0xb4, 0xa6, 0xd1, 0x13, 0xc3, 0x32, 0x0b, 0x62,
-	0xbb, 0xe1, 0x61, 0xf4, 0x51, 0x14, 0xef, 0x8f, 0x8b, 0xe2, 0x25, 0xad, 0x87, 0x84, 0xf5, 0xf9,
-	0x49, 0x4f, 0xd0, 0xad, 0x73, 0xdb, 0xf3, 0x93, 0x1e, 0xad, 0x87, 0xa8, 0x8c, 0x12, 0xe2, 0x0f,
-	0x86, 0xa2, 0x88, 0x9d, 0x4e, 0xb8, 0x3f, 0xb4, 0x79, 0x4d, 0xf8, 0x75, 0x6b, 0xb5, 0x1d, 0x68,
-	0xc4, 0xb8, 0x8b, 0xf1, 0xa0, 0x2d, 0x7a, 0x56, 0x6e, 0xe0, 0xaa, 0xc0, 0x3f, 0x0b, 0xc2, 0xa6,
-	0xc7, 0xa0, 0x44, 0xd8, 0x5d, 0x8a, 0x33, 0x23, 0xe7, 0xfb, 0x2c, 0xc6, 0x4e, 0x27, 0xfe, 0x97,
-	0x0b, 0x54, 0xb5, 0x42, 0xac, 0xe8, 0x15, 0xe2, 0x7f, 0xbf, 0x69, 0xf9, 0xfa, 0x08, 0x1a, 0xa2,
-	0x3e, 0x55, 0x44, 0xa2, 0x1e, 0x73, 0x51, 0x0a, 0xcd, 0x3d, 0x06, 0x26, 0x65, 0x92, 0x64, 0x46,
-	0xce, 0x31, 0x2c, 0x65, 0xbf, 0x52, 0x03, 0xa1, 0xc5, 0xb0, 0x30, 0x10, 0x3f, 0x19, 0x48, 0x87,
-	0xb5, 0x53, 0x87, 0x45, 0x97, 0xa1, 0x16, 0xe3, 0x97, 0xed, 0x24, 0x38, 0x4a, 0xe4, 0xd9, 0x7e,
-	0x8c, 0x5f, 0xee, 0x05, 0x47, 0xda, 0x96, 0xcb, 0xfa, 0x96, 0x5b, 0xcc, 0x6b, 0xcd, 0x71, 0xc1,
-	0xe8, 0xe7, 0x3f, 0x2a, 0x31, 0x63, 0x9e, 0xb7, 0x92, 0x99, 0x2e, 0x62, 0x9b, 0xfb, 0xae, 0xd2,
-	0x8c, 0xbe, 0xab, 0xac, 0xf5, 0x5d, 0xf7, 0xa1, 0xc2, 0x8c, 0x9b, 0x45, 0xef, 0xc5, 0xad, 0x2b,
-	0x8a, 0x58, 0x55, 0x97, 0xf1, 0x38, 0x24, 0x72, 0x79, 0x5b, 0xc6, 0x9b, 0xaa, 0x15, 0xdd, 0x34,
-	0x79, 0xe7, 0x75, 0x43, 0x98, 0xd7, 0x02, 0x03, 0x3a, 0x9f, 0x53, 0x56, 0xbe, 0xb9, 0xaa, 0x29,
-	0xcd, 0x15, 0x7a, 0x07, 0x1a, 0xea, 0x61, 0x52, 0x9d, 0x19, 0xa4, 0x3a, 0x99, 0x76, 0x8d, 0x90,
-	0xe9, 0x1a, 0x85, 0xf3, 0x2f, 0x4e, 0x2b, 0xd6, 0x69, 0xa2, 0x59, 0x62, 0x70, 0x32, 0xd1, 0x38,
-	0x50, 0xeb, 0x44, 0x41, 0x78, 0xe0, 0x27, 0x78, 0xbd, 0xc1, 0xa2, 0x53, 0x3a, 0x76, 0x6f, 0x03,
-	0xa2, 0xf1, 0x65, 0x2c, 0x6f, 0x37, 0x67, 0xa8, 0x6f, 0x9b, 0x1d, 0x15, 0x4e, 0x41, 0x0d, 0x57,
-	0x9c, 0x15, 0x71, 0xc5, 0xa9, 0xa6, 0xbc, 0xba, 0xe4, 0xc4, 0xed, 0xc1, 0xe5, 0xbd, 0xe0, 0x28,
-	0x34, 0xdb, 0xcc, 0x05, 0xa8, 0xc6, 0xfe, 0x69, 0x9b, 0x48, 0x1b, 0xa8, 0xc4, 0xfe, 0xe9, 0xfe,
-	0x98, 0x3a, 0xd4, 0x61, 0xdf, 0x3f, 0x92, 0x4b, 0xf1, 0x81, 0x76, 0x60, 0x5f, 0xca, 0x1d, 0xd8,
-	0xff, 0x32, 0x38, 0x26, 0x4a, 0x85, 0xb6, 0xc6, 0x64, 0x34, 0x18, 0xf6, 0x31, 0x91, 0x47, 0xb7,
-	0xe9, 0xd8, 0x6d, 0xc2, 0xf2, 0x13, 0x4c, 0x5e, 0x90, 0x71, 0x24, 0x59, 0x55, 0x1c, 0xc3, 0xd2,
-	0x1d, 0xe3, 0x5f, 0x2d, 0x28, 0xbf, 0x5e, 0x55, 0x52, 0x54, 0x43, 0xeb, 0x25, 0x42, 0x39, 0x5f,
-	0x22, 0x38, 0x50, 0x1b, 0xf8, 0x64, 0x14, 0x07, 0x64, 0x22, 0x2a, 0x93, 0x74, 0x9c, 0x37, 0xae,
-	0x2a, 0xbf, 0xb7, 0x51, 0x8d, 0xeb, 0x16, 0xac, 0x24, 0x43, 0x1c, 0x92, 0xf6, 0xc1, 0xa4, 0x3d,
-	0x0a, 0x07, 0x41, 0x88, 0xf9, 0x11, 0x40, 0xcd, 0x5b, 0x66, 0xf3, 0x3b, 0x93, 0x17, 0x7c, 0xd6,
-	0x7d, 0x06, 0x8b, 0xa2, 0xea, 0x67, 0xdb, 0x2b, 0x3e, 0x6e, 0xba, 0x09, 0x15, 0x5a, 0x77, 0xc8,
-	0x5a, 0x4f, 0xf5, 0x0b, 0x56, 0x97, 0xf0, 0xef, 0xee, 0x33, 0x38, 0x97, 0x8a, 0x56, 0xe8, 0xe6,
-	0xe7, 0xa1, 0x21, 0x96, 0x69, 0xf3, 0x35, 0x78, 0xda, 0x5f, 0x37, 0x9d, 0xf7, 0xb3, 0xa5, 0x96,
-	0x04, 0xf8, 0x0b, 0xb6, 0xe2, 0x77, 0x94, 0x1b, 0x18, 0x9e, 0x81, 0xe7, 0x53, 0xdb, 0x5f, 0x59,
-	0xca, 0x0d, 0x84, 0x44, 0x15, 0x6c, 0x3d, 0xd5, 0xeb, 0x90, 0xf7, 0x0b, 0x8e, 0xb7, 0x35, 0x44,
-	0x73, 0x21, 0xf2, 0x46, 0x35, 0x01, 0x2f, 0xeb, 0x05, 0x9d, 0x39, 0xca, 0xfa, 0x7f, 0xe1, 0x71,
-	0x57, 0x47, 0x10, 0x1b, 0xfb, 0x24, 0x7f, 0xda, 0xd7, 0xcc, 0x35, 0x46, 0x46, 0xd4, 0xa6, 0x1c,
-	0x4f, 0x17, 0x70, 0xfe, 0xcc, 0x82, 0x45, 0x01, 0xfd, 0x7a, 0x2e, 0x70, 0x03, 0x96, 0x7b, 0x51,
-	0xbf, 0x8b, 0xe3, 0xb6, 0x5a, 0x9f, 0x37, 0xf8, 0x6c, 0xa6, 0x2d, 0x15, 0x85, 0x96, 0xd6, 0x98,
-	0x2f, 0x8b, 0xe9, 0x7c, 0x5b, 0x5a, 0xc9, 0xba, 0x94, 0xf3, 0x8f, 0x16, 0x2c, 0x08, 0xbe, 0xff,
-	0xaf, 0xcb, 0xf5, 0x02, 0x29, 0x66, 0xc4, 0xc5, 0xcb, 0xf5, 0x39, 0x0f, 0x8b, 0xdd, 0x3f, 0xb6,
-	0x65, 0xa7, 0x2f, 0x96, 0x30, 0x04, 0xd5, 0xa7, 0xd3, 0x73, 0x6b, 0x93, 0xd9, 0x9e, 0x81, 0x9e,
-	0x3b, 0xc6, 0xd6, 0x0f, 0x0e, 0xec, 0xfc, 0xc1, 0x41, 0xee, 0x24, 0xc5, 0x19, 0xa6, 0x07, 0xd4,
-	0x79, 0x25, 0x5b, 0x73, 0x2a, 0xd9, 0x3e, 0x43, 0xc9, 0x4a, 0xdc, 0x74, 0x3f, 0x62, 0x77, 0x54,
-	0x3b, 0x38, 0xe1, 0x2d, 0x7d, 0x6a, 0xeb, 0x45, 0xc5, 0xf0, 0x45, 0xa8, 0x12, 0x3f, 0x3e, 0xc2,
-	0x69, 0x2b, 0xce, 0x47, 0xee, 0xe7, 0x99, 0x3b, 0x1a, 0xfd, 0x4a, 0xfe, 0x8d, 0xee, 0x93, 0x9f,
-	0x33, 0x8f, 0xd4, 0x17, 0x9e, 0x5e, 0x6e, 0x17, 0x5e, 0xf6, 0x6b, 0xc7, 0xce, 0xd3, 0x67, 0x05,
-	0x5b, 0xff, 0xb1, 0x09, 0xb0, 0x3d, 0x0c, 0xf6, 0x70, 0x7c, 0x12, 0x74, 0x30, 0x3a, 0x80, 0xa5,
-	0xac, 0x08, 0xd0, 0xc5, 0x26, 0x7f, 0xea, 0xd7, 0x4c, 0x75, 0xff, 0xe1, 0x60, 0x48, 0x26, 0xce,
-	0xdb, 0x39, 0x2b, 0xd5, 0xa5, 0xe6, 0x5e, 0xfa, 0xad, 0x7f, 0xfe, 0xaf, 0x3f, 0xb0, 0xcf, 0xa3,
-	0x73, 0xad, 0x93, 0xfb, 0x2d, 0x66, 0xef, 0x49, 0xeb, 0x80, 0x8a, 0xe0, 0x27, 0x16, 0x5c, 0x30,
-	0x1e, 0x9b, 0xa0, 0xdb, 0xf3, 0x1c, 0xad, 0x30, 0x39, 0x3a, 0x77, 0xe6, 0x3f, 0x85, 0x71, 0x6f,
-	0x33, 0x4e, 0xae, 0xa3, 0xb7, 0x33, 0x9c, 0x7c, 0xc5, 0x75, 0xf8, 0xaa, 0x25, 0xce, 0xa5, 0x62,
-	0xce, 0xc1, 0x17, 0x2c, 0xb3, 0x64, 0x9f, 0x6e, 0x15, 0x8a, 0xe0, 0x9d, 0x79, 0x1e, 0x7c, 0xb9,
-	0x97, 0x19, 0xed, 0x55, 0x74, 0x9e, 0xd2, 0xee, 0x30, 0x88, 0x96, 0x08, 0x0d, 0x3e, 0xc0, 0xf4,
-	0xed, 0x57, 0x21, 0x99, 0x6b, 0x0a, 0x99, 0xfc, 0x63, 0x31, 0xd7, 0x61, 0x14, 0xd6, 0xdc, 0x73,
-	0x19, 0x0a, 0x2f, 0x47, 0x01, 0x79, 0x68, 0xdd, 0x41, 0xfb, 0xb0, 0x20, 0x9e, 0x7c, 0x15, 0xae,
-	0xbf, 0x31, 0xeb, 0x81, 0x98, 0xbb, 0xca, 0x16, 0x6f, 0xa0, 0x45, 0xba, 0xb8, 0x78, 0x1e, 0x86,
-	0x62, 0x58, 0xca, 0x3e, 0xde, 0x41, 0x9b, 0x86, 0xe0, 0xa0, 0xbc, 0xa2, 0xd0, 0xcc, 0xc5, 0xf4,
-	0xf2, 0xc7, 0xbd, 0xca, 0x28, 0x5d, 0x72, 0x51, 0x86, 0x52, 0xab, 0xc3, 0x20, 0xe9, 0x4e, 0x0e,
-	0xa1, 0x9e, 0x3e, 0xd9, 0x42, 0x6a, 0xc7, 0xa7, 0x3f, 0xfe, 0x72, 0xde, 0x2a, 0xfa, 0x6c, 0x92,
-	0x98, 0x24, 0x35, 0x4a, 0x18, 0x9d, 0x18, 0x96, 0xb2, 0x2f, 0x7b, 0xb4, 0xbd, 0x19, 0x1e, 0x12,
-	0x69, 0x7b, 0x33, 0x3d, 0x0b, 0x32, 0xef, 0x2d, 0x60, 0x90, 0x94, 0xe6, 0x6f, 0xc0, 0xb2, 0xfa,
-	0x7e, 0x07, 0xb9, 0x86, 0x35, 0xb5, 0x48, 0x32, 0x0f, 0xdd, 0x77, 0x19, 0xdd, 0x4d, 0xf7, 0x4a,
-	0x9e, 0x6e, 0x4b, 0xc6, 0x06, 0xb1, 0xe9, 0xec, 0x7b, 0x19, 0x6d, 0xd3, 0x86, 0xe7, 0x39, 0x1a,
-	0x71, 0xd3, 0x63, 0x1b, 0xf3, 0xa6, 0xf1, 0x58, 0x6e, 0x3a, 0xa6, 0x7d, 0xf8, 0xf4, 0x6d, 0x8c,
-	0x46, 0xd3, 0xf0, 0x14, 0x47, 0xa3, 0x69, 0x7a, 0x58, 0x63, 0xa6, 0x19, 0x33, 0x48, 0x4a, 0xf3,
-	0xb7, 0x2d, 0x38, 0x9f, 0x0b, 0xa0, 0xe8, 0x86, 0xf9, 0xce, 0x5b, 0x97, 0xf7, 0xbb, 0x67, 0x81,
-	0x09, 0x1e, 0xae, 0x31, 0x1e, 0x2e, 0xbb, 0x6b, 0x59, 0x1e, 0xb2, 0xd2, 0xfe, 0x3d, 0x0b, 0x56,
-	0xf4, 0x9b, 0x75, 0xf4, 0xce, 0x19, 0x17, 0xef, 0x9c, 0x87, 0x1b, 0x73, 0x5d, 0xcf, 0x9b, 0xf5,
-	0xde, 0x19, 0xc5, 0x31, 0x8d, 0x0d, 0xe2, 0x85, 0x0b, 0xe5, 0xe4, 0x14, 0x1a, 0xca, 0xcb, 0x0f,
-	0x64, 0xf2, 0x53, 0xf5, 0x1d, 0x89, 0xe3, 0xce, 0x02, 0x31, 0x89, 0x20, 0x2d, 0x47, 0x32, 0xde,
-	0x4c, 0x58, 0x9a, 0x49, 0x6b, 0x12, 0x4d, 0xf9, 0x86, 0xa7, 0x25, 0xf9, 0x84, 0x93, 0x7b, 0x16,
-	0x22, 0xa9, 0xa2, 0x4b, 0x2a, 0xd5, 0xaf, 0x44, 0xaa, 0x7b, 0x85, 0xbe, 0xc7, 0xd5, 0xaf, 0x3e,
-	0x16, 0xca, 0xab, 0xdf, 0xf8, 0x0e, 0x2b, 0xaf, 0x7e, 0xf3, 0x9b, 0x23, 0x77, 0x93, 0x71, 0xe1,
-	0xb8, 0x17, 0x54, 0x2e, 0x32, 0x52, 0xff, 0x5d, 0x0b, 0xce, 0x69, 0xaf, 0x84, 0x90, 0xfa, 0xaa,
-	0xc0, 0xfc, 0xf0, 0x48, 0xcb, 0x38, 0x05, 0x0f, 0x8d, 0xdc, 0x5b, 0x8c, 0x01, 0x17, 0x6d, 0x6a,
-	0x62, 0x10, 0x3f, 0x5f, 0xb5, 0x4e, 0x04, 0x22, 0xea, 0xc2, 0x82, 0x68, 0xa3, 0xd0, 0x15, 0x7d,
-	0x77, 0x99, 0xbe, 0x55, 0x4b, 0x11, 0x5a, 0xe7, 0xe5, 0xbe, 0xc5, 0xe8, 0xad, 0xbb, 0xab, 0x2a,
-	0x3d, 0xd6, 0x85, 0xd1, 0xed, 0xfe, 0xd0, 0x82, 0x35, 0xd3, 0x75, 0x32, 0xba, 0x35, 0xc7, 0x8d,
-	0x33, 0x67, 0xe0, 0xf6, 0xdc, 0x77, 0xd3, 0xae, 0xcb, 0xb8, 0xd9, 0x70, 0x99, 0x11, 0x90, 0x29,
-	0x40, 0xd2, 0xea, 0x32, 0x34, 0xc9, 0x91, 0xe9, 0x7a, 0x4c, 0xe3, 0x68, 0xc6, 0x45, 0xaa, 0xc6,
-	0xd1, 0xac, 0xbb, 0xb6, 0x19, 0x1c, 0x4d, 0xfd, 0xe1, 0x8f, 0x2c, 0xb8, 0x60, 0xbc, 0x9b, 0xd4,
-	0x4a, 0xa2, 0x59, 0xf7, 0x97, 0xaf, 0xc3, 0xd3, 0x4d, 0xc6, 0xd3, 0xdb, 0xee, 0x46, 0x01, 0x4f,
-	0x2d, 0x7f, 0x44, 0x22, 0x11, 0xab, 0x50, 0xfe, 0x44, 0x04, 0xa9, 0xce, 0x50, 0x78, 0x38, 0xe3,
-	0xdc, 0x3c, 0x13, 0xce, 0xe4, 0x35, 0x0a, 0x43, 0x49, 0x70, 0x14, 0x66, 0x62, 0xb7, 0x7a, 0xa6,
-	0x9d, 0x77, 0x5e, 0xe3, 0xb1, 0x7e, 0xde, 0x79, 0xcd, 0x47, 0xe3, 0x6a, 0xe0, 0x52, 0xd8, 0x38,
-	0xc4, 0x38, 0x95, 0x47, 0xee, 0xa2, 0x42, 0x97, 0x47, 0xd1, 0xc5, 0x87, 0x2e, 0x8f, 0xc2, 0x1b,
-	0x8f, 0x59, 0xf2, 0xc0, 0x61, 0x97, 0x72, 0xf2, 0x03, 0x2e, 0x0f, 0x8d, 0x91, 0x9c, 0x3c, 0xcc,
-	0x7c, 0xbc, 0x7b, 0x16, 0x98, 0x29, 0x96, 0x28, 0x6c, 0x7c, 0xc5, 0x5a, 0xe0, 0x57, 0x2d, 0x79,
-	0xa7, 0x39, 0x81, 0xc5, 0xcc, 0x31, 0x1f, 0xba, 0x96, 0x13, 0xb8, 0x7a, 0x56, 0xe8, 0x6c, 0x16,
-	0x03, 0xa8, 0x36, 0x8a, 0xae, 0x15, 0xd2, 0x16, 0x75, 0xf4, 0x9f, 0x58, 0xb0, 0x5e, 0x74, 0x75,
-	0x8d, 0xee, 0x1a, 0x9c, 0xa2, 0xf0, 0x86, 0xfb, 0x75, 0x5c, 0xe8, 0x3a, 0x63, 0xef, 0xaa, 0xbb,
-	0x9e, 0xd7, 0x10, 0x5f, 0x9e, 0x2a, 0x29, 0x82, 0x7a, 0xfa, 0xac, 0x09, 0x15, 0xbc, 0x86, 0x32,
-	0x57, 0xad, 0xb9, 0xf7, 0x55, 0x33, 0x08, 0xf2, 0x73, 0x94, 0x09, 0x25, 0xa8, 0xa5, 0x38, 0xde,
-	0xf7, 0x16, 0xa7, 0x38, 0xe5, 0xa0, 0xab, 0x38, 0xc5, 0xa9, 0x67, 0x53, 0x85, 0x29, 0x8e, 0x83,
-	0x51, 0x36, 0xfe, 0x96, 0xb3, 0xa1, 0xde, 0x34, 0xe6, 0xd9, 0x30, 0xde, 0x31, 0xe7, 0xd9, 0x30,
-	0x5f, 0x58, 0xba, 0x7b, 0x8c, 0x8d, 0xa7, 0xe8, 0x66, 0x91, 0x06, 0xa4, 0x60, 0x5a, 0x5f, 0x91,
-	0xc9, 0x10, 0xbf, 0xfa, 0x35, 0x93, 0x1d, 0x6b, 0xa0, 0x92, 0x73, 0xf5, 0xd0, 0x25, 0xcf, 0xb9,
-	0xf1, 0x18, 0x2d, 0xcf, 0xb9, 0xf9, 0xec, 0x66, 0x06, 0xe7, 0x42, 0x86, 0xf3, 0x70, 0xae, 0x81,
-	0x66, 0xdc, 0x20, 0x7f, 0x30, 0x63, 0x74, 0x83, 0xc2, 0xf3, 0x9b, 0x6f, 0xc6, 0x0d, 0xa6, 0xe6,
-	0xb0, 0xf3, 0x33, 0xfb, 0xc7, 0xdb, 0x7f, 0x61, 0xa3, 0x3d, 0x38, 0xf7, 0x74, 0x7b, 0x6f, 0xef,
-	0x1e, 0x2f, 0x5a, 0x37, 0xb7, 0x9f, 0xed, 0xba, 0x3f, 0x07, 0x4b, 0x74, 0x6a, 0x73, 0x18, 0x47,
-	0x5f, 0xe0, 0x0e, 0x41, 0x6b, 0x3d, 0x42, 0x86, 0xc9, 0xc3, 0x56, 0x6b, 0xe0, 0x27, 0x49, 0x88,
-	0x49, 0x33, 0x8a, 0x8f, 0x5a, 0xce, 0x6a, 0x27, 0x0a, 0x89, 0xdf, 0x21, 0xbf, 0x94, 0x99, 0xbd,
-	0xf3, 0xff, 0xb6, 0x4a, 0xf7, 0x9b, 0xef, 0xdd, 0xb1, 0xec, 0xad, 0x15, 0x7f, 0x38, 0xec, 0x07,
-	0x1d, 0x76, 0x30, 0xdd, 0xfa, 0x22, 0x89, 0xc2, 0xad, 0x8b, 0xd9, 0x99, 0xf1, 0xbd, 0xc3, 0x28,
-	0xba, 0x37, 0x08, 0x06, 0xf8, 0x61, 0x0e, 0xf2, 0x61, 0x01, 0xa4, 0x77, 0x0d, 0x4a, 0xdf, 0x7a,
-	0xef, 0x7d, 0xb4, 0x0e, 0xcb, 0xdf, 0x8d, 0x36, 0x87, 0x38, 0x1e, 0x04, 0x09, 0x2d, 0x22, 0x9b,
-	0xa8, 0x0a, 0xe5, 0x9f, 0xda, 0xd6, 0x82, 0x77, 0x85, 0x02, 0x7c, 0x0b, 0xad, 0x01, 0x7c, 0x37,
-	0x22, 0x9b, 0x87, 0xd1, 0x28, 0xec, 0xa6, 0x1f, 0xe3, 0x07, 0x70, 0x55, 0xdb, 0xe9, 0xe6, 0xe3,
-	0xa8, 0x33, 0x1a, 0xe0, 0x90, 0xff, 0x23, 0xa5, 0x79, 0x9f, 0x07, 0x55, 0x26, 0xf3, 0xf7, 0xff,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xfb, 0x8b, 0xef, 0xc4, 0x39, 0x00, 0x00,
+	// 7801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x6d, 0x8c, 0x1c, 0xc9,
+	0x55, 0x74, 0xcf, 0xc7, 0xee, 0xbc, 0x9d, 0x59, 0xaf, 0x7b, 0xd7, 0xbb, 0xeb, 0xf6, 0xda, 0xde,
+	0xed, 0xf3, 0xfa, 0x2b, 0xf6, 0xcc, 0x9d, 0x2f, 0x97, 0x5c, 0x7c, 0xe4, 0xc3, 0x9f, 0x77, 0x4e,
+	0xec, 0x9c, 0xaf, 0xd7, 0x77, 0x0e, 0x17, 0xc4, 0xa8, 0x67, 0xa6, 0x76, 0xb7, 0xcf, 0x33, 0xdd,
+	0x73, 0xdd, 0x3d, 0xbb, 0xb3, 0x77, 0x18, 0x12, 0x2e, 0x24, 0x12, 0x09, 0x44, 0x09, 0x08, 0xc8,
+	0x29, 0x42, 0x21, 0x88, 0x88, 0x44, 0xfc, 0x80, 0x3f, 0x20, 0x11, 0x09, 0x09, 0x24, 0x04, 0x3f,
+	0x90, 0x40, 0x42, 0x48, 0x88, 0x48, 0xfc, 0x81, 0x7f, 0xe4, 0x07, 0x42, 0xfc, 0x01, 0x09, 0x09,
+	0xd5, 0x57, 0x77, 0x55, 0x77, 0x75, 0xcf, 0xec, 0x9d, 0x13, 0x85, 0x3f, 0xbb, 0x53, 0xd5, 0xaf,
+	0xea, 0xbd, 0x7a, 0xf5, 0xea, 0xd5, 0xab, 0x57, 0xaf, 0x1e, 0xd4, 0x9c, 0xa1, 0xdb, 0x1c, 0x06,
+	0x7e, 0xe4, 0x1b, 0x73, 0xc1, 0xb0, 0x4b, 0x7e, 0x75, 0x46, 0xdb, 0xe6, 0xda, 0x8e, 0xef, 0xef,
+	0xf4, 0x51, 0xcb, 0x19, 0xba, 0x2d, 0xc7, 0xf3, 0xfc, 0xc8, 0x89, 0x5c, 0xdf, 0x0b, 0x29, 0xa8,
+	0x79, 0x89, 0xfc, 0xeb, 0x5e, 0xde, 0x41, 0xde, 0xe5, 0x70, 0xdf, 0xd9, 0xd9, 0x41, 0x41, 0xcb,
+	0x1f, 0x12, 0x08, 0x05, 0xf4, 0x09, 0xd6, 0x17, 0xef, 0xbc, 0x85, 0x06, 0xc3, 0xe8, 0x80, 0x7e,
+	0xb4, 0xbe, 0x57, 0x85, 0x95, 0x17, 0x51, 0x74, 0xa3, 0xef, 0x22, 0x2f, 0xda, 0x8a, 0x9c, 0x68,
+	0x14, 0xda, 0x28, 0x1c, 0xfa, 0x5e, 0x88, 0x8c, 0x4d, 0x98, 0x1f, 0x22, 0x14, 0xb4, 0xfb, 0x6e,
+	0x18, 0x21, 0xcf, 0xf5, 0x76, 0x56, 0xb5, 0x75, 0xed, 0xfc, 0xac, 0xdd, 0xc0, 0xb5, 0x77, 0x79,
+	0xa5, 0xb1, 0x0a, 0x33, 0xe1, 0x81, 0xd7, 0xc5, 0xdf, 0x75, 0xf2, 0x9d, 0x17, 0x8d, 0xe3, 0x30,
+	0xdb, 0xdd, 0x75, 0x5c, 0xaf, 0xed, 0xf6, 0x56, 0x4b, 0xeb, 0xda, 0xf9, 0x9a, 0x3d, 0x43, 0xca,
+	0x77, 0x7a, 0xc6, 0x45, 0x38, 0xda, 0xf7, 0xbb, 0x4e, 0xbf, 0xdd, 0x41, 0x61, 0xd4, 0xde, 0x45,
+	0xee, 0xce, 0x6e, 0xb4, 0x5a, 0x5e, 0xd7, 0xce, 0x97, 0xed, 0x23, 0xe4, 0xc3, 0x75, 0x14, 0x46,
+	0x2f, 0x91, 0x6a, 0x0c, 0xfb, 0xc8, 0xf3, 0xf7, 0x3d, 0x09, 0xb6, 0x42, 0x61, 0xc9, 0x07, 0x01,
+	0xf6, 0x12, 0x18, 0xfb, 0x4e, 0xbf, 0x8f, 0xa2, 0x36, 0x26, 0x82, 0x03, 0x57, 0x09, 0xf0, 0x02,
+	0xfd, 0xb2, 0x75, 0xe0, 0x75, 0x19, 0xf4, 0x2b, 0x00, 0x64, 0x84, 0x5d, 0x7f, 0xe4, 0x45, 0xab,
+	0x33, 0xeb, 0xda, 0xf9, 0xb9, 0x2b, 0x57, 0x9a, 0xc2, 0x44, 0x34, 0x73, 0x78, 0xd3, 0xc4, 0xcd,
+	0x6e, 0xe0, 0x56, 0x77, 0xbc, 0x6d, 0xdf, 0xae, 0xc5, 0x45, 0xe3, 0x06, 0x54, 0x70, 0x21, 0x5c,
+	0x9d, 0x25, 0xbd, 0x5d, 0x9e, 0xba, 0x37, 0xcc, 0x50, 0x9b, 0xb6, 0x35, 0x3f, 0x0b, 0x0d, 0x09,
+	0x81, 0xb1, 0x04, 0x95, 0xc8, 0x8f, 0x9c, 0x3e, 0x99, 0x81, 0x86, 0x4d, 0x0b, 0x86, 0x09, 0xb3,
+	0xfe, 0x28, 0xea, 0xf8, 0x23, 0xaf, 0x47, 0x58, 0xdf, 0xb0, 0xe3, 0x32, 0x9e, 0x15, 0xd7, 0xa3,
+	0x9f, 0x4a, 0xe4, 0x13, 0x2f, 0x9a, 0x36, 0xcc, 0xe2, 0xce, 0x49, 0xbf, 0xf3, 0xa0, 0xbb, 0x3d,
+	0xd2, 0x69, 0xcd, 0xd6, 0x5d, 0xd2, 0xca, 0xe9, 0xf5, 0x02, 0x14, 0x86, 0xa4, 0xc3, 0x9a, 0xcd,
+	0x8b, 0xc6, 0x1a, 0xd4, 0x7a, 0x6e, 0x80, 0xba, 0x58, 0xb2, 0xd8, 0x64, 0x26, 0x15, 0xe6, 0xbf,
+	0x6a, 0x30, 0xcb, 0x07, 0x61, 0xdc, 0x11, 0xc8, 0xd2, 0xd6, 0x4b, 0x87, 0xe2, 0x02, 0x61, 0x67,
+	0x32, 0x8a, 0x17, 0x93, 0x51, 0xe8, 0xef, 0xa5, 0x27, 0xde, 0x1a, 0x4f, 0x8b, 0x1f, 0xed, 0xa2,
+	0x60, 0xb5, 0xf4, 0x5e, 0xba, 0xa1, 0x6d, 0xad, 0xab, 0x60, 0xbc, 0x32, 0x72, 0x19, 0x6c, 0xbc,
+	0x4c, 0x0c, 0x28, 0x77, 0xfd, 0x1e, 0x22, 0x5c, 0x2c, 0xd9, 0xe4, 0xb7, 0xb1, 0x00, 0xa5, 0x41,
+	0xb8, 0xc3, 0x78, 0x88, 0x7f, 0x5a, 0xff, 0xa3, 0xc3, 0x91, 0x87, 0x44, 0xfe, 0x92, 0x05, 0x76,
+	0x13, 0x66, 0xa8, 0x48, 0x86, 0x8c, 0x4f, 0x17, 0x25, 0xb2, 0x52, 0xe0, 0xac, 0xbc, 0x35, 0x1a,
+	0x0c, 0x9c, 0xe0, 0xc0, 0xe6, 0x4d, 0xcd, 0x6f, 0xe9, 0xd0, 0x90, 0x3e, 0x19, 0x27, 0xa0, 0xc6,
+	0x16, 0x41, 0x3c, 0xb9, 0xb3, 0xb4, 0xe2, 0x4e, 0x0f, 0x93, 0x1b, 0x1d, 0x0c, 0x11, 0x13, 0x18,
+	0xf2, 0x1b, 0x4f, 0xfb, 0x1e, 0x0a, 0x42, 0x3e, 0xb5, 0x0d, 0x9b, 0x17, 0xf1, 0x97, 0x00, 0x0d,
+	0x9c, 0xe0, 0x51, 0x48, 0x56, 0x67, 0xcd, 0xe6, 0x45, 0x63, 0x19, 0xaa, 0x21, 0x61, 0x17, 0x59,
+	0x8a, 0x0d, 0x9b, 0x95, 0x8c, 0x93, 0x00, 0xf4, 0x57, 0x1b, 0x73, 0xa0, 0x4a, 0x25, 0x85, 0xd6,
+	0xdc, 0x0b, 0x77, 0x8c, 0x16, 0x2c, 0x06, 0xe8, 0xcd, 0x91, 0x1b, 0xa0, 0x5e, 0x3b, 0x74, 0x77,
+	0x3c, 0x27, 0x1a, 0x05, 0x28, 0x24, 0x6b, 0xaf, 0x61, 0x1b, 0xfc, 0xd3, 0x56, 0xfc, 0xc5, 0x78,
+	0x0a, 0x1a, 0x44, 0xda, 0x09, 0x34, 0x5f, 0x58, 0x0d, 0xbb, 0x4e, 0x2a, 0xb7, 0x68, 0x1d, 0x46,
+	0xba, 0xef, 0x44, 0xdd, 0xdd, 0xb6, 0xef, 0xf5, 0x0f, 0x56, 0x6b, 0x44, 0x0d, 0xd5, 0x48, 0xcd,
+	0xcb, 0x5e, 0xff, 0xc0, 0x6a, 0xc1, 0xc2, 0xab, 0x21, 0xa2, 0x4c, 0xb2, 0xd1, 0x9b, 0x23, 0x14,
+	0x46, 0x85, 0x4c, 0xb2, 0x7e, 0x43, 0x87, 0xa3, 0x42, 0x0b, 0x36, 0x5f, 0xa2, 0x3e, 0xd3, 0x64,
+	0x7d, 0x26, 0xf5, 0xa6, 0xe7, 0xb0, 0xbc, 0xa4, 0x66, 0x79, 0x59, 0x66, 0x79, 0x3c, 0xe0, 0x8e,
+	0xd3, 0x77, 0xbc, 0x2e, 0x22, 0xfc, 0xad, 0xb1, 0x01, 0x5f, 0xa7, 0x75, 0x58, 0xcf, 0xa1, 0x71,
+	0x84, 0x02, 0xcf, 0xe9, 0xb7, 0x1f, 0xa1, 0x03, 0xa6, 0xc1, 0x30, 0xb7, 0x2b, 0xf6, 0x02, 0xff,
+	0xf2, 0x29, 0x74, 0x40, 0x95, 0xd2, 0x25, 0x30, 0x5c, 0x2f, 0x03, 0x3d, 0x43, 0xa1, 0x5d, 0x2f,
+	0x05, 0x2d, 0xcc, 0xf9, 0xac, 0x34, 0xe7, 0xd6, 0x1b, 0xb0, 0x78, 0x23, 0x40, 0x4e, 0x94, 0x62,
+	0xe5, 0x29, 0x80, 0xa1, 0x13, 0x86, 0xc3, 0xdd, 0xc0, 0x09, 0x11, 0xe3, 0x8c, 0x50, 0x23, 0x76,
+	0xa8, 0xcb, 0x42, 0x74, 0x1c, 0x66, 0x3b, 0x6e, 0xd4, 0x0e, 0xdd, 0xb7, 0x28, 0x77, 0x2a, 0xf6,
+	0x4c, 0xc7, 0x8d, 0xb6, 0xdc, 0xb7, 0x90, 0xe5, 0xc2, 0x92, 0x8c, 0x8b, 0x4d, 0x42, 0xa1, 0x70,
+	0x9b, 0x30, 0x3b, 0xf0, 0xd0, 0xc0, 0xf7, 0xdc, 0x2e, 0x9f, 0x05, 0x5e, 0xce, 0x17, 0x72, 0xeb,
+	0x15, 0x58, 0xbc, 0x33, 0x18, 0xfa, 0x41, 0x24, 0x0f, 0xcb, 0x84, 0xd9, 0x47, 0xe8, 0x20, 0x8c,
+	0xfc, 0x80, 0x0f, 0x2a, 0x2e, 0xa7, 0x86, 0xac, 0xa7, 0x87, 0x6c, 0x7d, 0x59, 0x83, 0x25, 0xb9,
+	0x4f, 0x46, 0xfe, 0x3c, 0xe8, 0xfe, 0x23, 0xb6, 0x91, 0xea, 0xfe, 0xa3, 0x27, 0x29, 0x38, 0x02,
+	0x9b, 0x2b, 0xf2, 0xbc, 0x7d, 0x5f, 0x83, 0x63, 0x94, 0x9a, 0x7b, 0x8c, 0x1b, 0xc2, 0x18, 0x63,
+	0x86, 0x69, 0x29, 0x86, 0x4d, 0x18, 0xa3, 0x88, 0xaf, 0x24, 0x4f, 0xeb, 0x26, 0xcc, 0xc7, 0xd2,
+	0xe9, 0x7a, 0x3d, 0x34, 0x66, 0xa4, 0x36, 0x78, 0xed, 0x1d, 0x5c, 0x89, 0xc1, 0x5c, 0x4f, 0x02,
+	0xa3, 0xaa, 0xa4, 0xe1, 0x7a, 0x02, 0x98, 0xf5, 0x0d, 0x1d, 0x4e, 0x30, 0xea, 0x47, 0xfd, 0xc8,
+	0x0d, 0xdd, 0x9d, 0xcc, 0x3c, 0xfd, 0xa4, 0x8f, 0x21, 0x4f, 0xed, 0x55, 0x73, 0xd5, 0xde, 0x26,
+	0xcc, 0x77, 0x7d, 0xaa, 0xf2, 0xda, 0xe3, 0xe1, 0xa8, 0x83, 0x55, 0x64, 0xe9, 0x7c, 0xcd, 0x6e,
+	0xf0, 0xda, 0xcf, 0xe0, 0x4a, 0xeb, 0x5d, 0x0d, 0xd6, 0xb8, 0x9c, 0x31, 0x6d, 0x27, 0x33, 0xc7,
+	0x80, 0x32, 0x6e, 0xce, 0x18, 0x43, 0x7e, 0x17, 0xac, 0xc7, 0xec, 0xa0, 0x4b, 0xd3, 0x0d, 0xba,
+	0xac, 0x9a, 0x38, 0x1b, 0x16, 0x6f, 0x8d, 0xb3, 0xeb, 0xaa, 0x70, 0x05, 0x4f, 0x5a, 0x58, 0x57,
+	0x60, 0xe9, 0xd6, 0x58, 0xb1, 0xae, 0x0a, 0x16, 0x2b, 0xa6, 0xc3, 0x46, 0x03, 0x7f, 0x0f, 0x3d,
+	0x41, 0x3a, 0xce, 0xc2, 0x92, 0xdc, 0xa7, 0x7a, 0x7d, 0x5b, 0x2f, 0xc0, 0xda, 0xd6, 0xa8, 0x13,
+	0x76, 0x03, 0xb7, 0xc3, 0x40, 0x6f, 0xed, 0x21, 0x2f, 0x0a, 0xa7, 0x21, 0xc2, 0xfa, 0x7b, 0x0d,
+	0xe6, 0x84, 0x46, 0xb1, 0x3e, 0x60, 0x93, 0x89, 0x7f, 0x17, 0x2b, 0x90, 0x45, 0xa8, 0x44, 0xe3,
+	0xc4, 0xfc, 0x2e, 0x47, 0xe3, 0x3b, 0x3d, 0xbc, 0x59, 0x76, 0xfa, 0x7e, 0xf7, 0x51, 0x7b, 0xd7,
+	0x09, 0x77, 0xd9, 0xb6, 0x5e, 0x23, 0x35, 0x2f, 0x39, 0xe1, 0x2e, 0xde, 0xd8, 0x25, 0x1b, 0x9b,
+	0x95, 0xf0, 0xbe, 0x84, 0x6d, 0x6a, 0xd4, 0x93, 0xad, 0xea, 0x3a, 0xad, 0x64, 0x16, 0xf5, 0x69,
+	0x98, 0x13, 0xad, 0xf4, 0x19, 0x02, 0x02, 0x9d, 0xd8, 0x40, 0xb7, 0x7c, 0x58, 0x7d, 0x11, 0x45,
+	0xd7, 0xa8, 0x55, 0xc9, 0x76, 0x33, 0xce, 0x8b, 0xe7, 0x60, 0x39, 0x5e, 0x24, 0x5d, 0xdf, 0xdb,
+	0x76, 0x83, 0x01, 0x3d, 0xc9, 0x90, 0x01, 0x57, 0xec, 0x63, 0xfc, 0xeb, 0x0d, 0xf1, 0x23, 0x36,
+	0x4d, 0x99, 0x95, 0x8a, 0x42, 0x62, 0x26, 0xd6, 0xec, 0xa4, 0xc2, 0xfa, 0x6b, 0x0d, 0x8e, 0x32,
+	0x74, 0xd7, 0xbc, 0x1e, 0xdf, 0x3f, 0x05, 0x43, 0x57, 0x93, 0x0d, 0xdd, 0xd8, 0xd4, 0xa6, 0xbc,
+	0xa4, 0x05, 0x8c, 0x23, 0x1c, 0x22, 0xaf, 0xe7, 0x74, 0xfa, 0x88, 0x9b, 0xbf, 0x71, 0x85, 0xf1,
+	0x0c, 0x2c, 0xed, 0xbb, 0xd1, 0x6e, 0x2f, 0x70, 0xf6, 0x71, 0xb9, 0x1d, 0x46, 0xce, 0x23, 0x7c,
+	0x1e, 0xa2, 0xbc, 0x5d, 0x14, 0xbf, 0x6d, 0xd1, 0x4f, 0x99, 0x26, 0x1d, 0xd7, 0xeb, 0xe1, 0x26,
+	0x95, 0x6c, 0x93, 0xeb, 0xf4, 0x93, 0xf5, 0x10, 0x8e, 0x2b, 0x58, 0xc7, 0xe4, 0xee, 0x2a, 0xcc,
+	0x32, 0x7b, 0x81, 0x1b, 0x93, 0xa7, 0x24, 0x63, 0x32, 0xc3, 0x02, 0x3b, 0x86, 0xb7, 0xae, 0xc0,
+	0xf2, 0x6b, 0x4e, 0xdf, 0xed, 0x39, 0x11, 0x62, 0x60, 0x7c, 0x46, 0x72, 0xd9, 0x64, 0x7d, 0x5e,
+	0x83, 0x95, 0x4c, 0xa3, 0xc4, 0x4e, 0x72, 0xc3, 0xf6, 0x1e, 0xfe, 0xca, 0x56, 0xc2, 0x8c, 0x1b,
+	0x12, 0x60, 0x63, 0x05, 0x66, 0xdc, 0xb0, 0x3d, 0x70, 0x3d, 0xc4, 0x0e, 0x8b, 0x55, 0x37, 0xbc,
+	0xe7, 0x7a, 0xd2, 0x84, 0x94, 0xe4, 0x09, 0x49, 0x6d, 0x78, 0x95, 0x64, 0xdf, 0x7e, 0x9a, 0x9b,
+	0x08, 0x59, 0xaa, 0x79, 0x0b, 0x4d, 0x6e, 0xf1, 0x0c, 0x1c, 0x4b, 0xb5, 0x60, 0x24, 0xe7, 0x0f,
+	0xb4, 0x05, 0x8b, 0x09, 0xd7, 0xd1, 0x14, 0x38, 0x7e, 0xa0, 0xc1, 0x92, 0xdc, 0x82, 0xe1, 0xb8,
+	0x03, 0x33, 0x3d, 0x14, 0x39, 0x6e, 0x9f, 0xcf, 0x50, 0x2b, 0x7d, 0x0a, 0xc9, 0xb4, 0xe1, 0xd3,
+	0x76, 0x93, 0xb4, 0xb3, 0x79, 0x7b, 0x73, 0x0c, 0x0d, 0xe9, 0x4b, 0x81, 0x3c, 0x0b, 0x84, 0xea,
+	0x12, 0xa1, 0x58, 0x9b, 0x8c, 0x42, 0x44, 0x75, 0xc3, 0xac, 0x4d, 0x7e, 0xe3, 0xf5, 0x1b, 0x46,
+	0xbd, 0x36, 0xef, 0x8b, 0x0a, 0x30, 0x84, 0x51, 0x8f, 0xa1, 0xb3, 0x76, 0x89, 0xbf, 0x80, 0x2a,
+	0xa5, 0x27, 0xb3, 0x7c, 0x97, 0xa1, 0x4a, 0x87, 0xc5, 0x25, 0x82, 0x96, 0xac, 0x6f, 0xeb, 0xb0,
+	0x9a, 0x45, 0x35, 0x8d, 0x15, 0xa8, 0x5e, 0xc2, 0x37, 0x63, 0x3c, 0x25, 0x72, 0x34, 0xbf, 0x94,
+	0xe6, 0xbe, 0x12, 0x53, 0x93, 0xb1, 0x9e, 0xb5, 0x35, 0xbf, 0xa2, 0x41, 0x95, 0xf1, 0x5c, 0xd2,
+	0x09, 0xda, 0xb4, 0x3a, 0x41, 0x3f, 0xbc, 0x4e, 0x28, 0xe5, 0xeb, 0x84, 0x7f, 0xd1, 0x61, 0xe1,
+	0xc1, 0xf8, 0x25, 0x17, 0x6f, 0x74, 0x07, 0x94, 0xae, 0x30, 0xd1, 0xfa, 0x9a, 0xa0, 0xf5, 0x37,
+	0xa0, 0xce, 0xb4, 0x3e, 0x55, 0xcd, 0x3a, 0x51, 0xcd, 0x73, 0x54, 0xef, 0x93, 0x2a, 0xe3, 0x05,
+	0xa8, 0xba, 0xde, 0x70, 0x14, 0x85, 0xec, 0x94, 0xfc, 0x94, 0xc4, 0xa1, 0x34, 0x9a, 0xe6, 0x1d,
+	0x0c, 0x6b, 0xb3, 0x26, 0xc6, 0xc7, 0x60, 0xc6, 0x1f, 0x45, 0xa4, 0x75, 0x99, 0xb4, 0x3e, 0x53,
+	0xdc, 0xfa, 0x65, 0x02, 0x6c, 0xf3, 0x46, 0xd8, 0xa6, 0xd8, 0x0e, 0xfc, 0x41, 0x3b, 0x51, 0xe5,
+	0x15, 0x6a, 0xf0, 0xe0, 0xda, 0x78, 0x61, 0x98, 0x57, 0xa0, 0x42, 0xf0, 0xaa, 0x07, 0xb9, 0x04,
+	0x15, 0x6a, 0x8f, 0xe8, 0xe4, 0x30, 0x4e, 0x0b, 0xe6, 0x55, 0xa8, 0x52, 0x6c, 0x05, 0xcb, 0x64,
+	0x19, 0xaa, 0xce, 0x80, 0x1c, 0x8b, 0xe8, 0x04, 0xb1, 0x92, 0x75, 0x1f, 0x8e, 0xc6, 0xa4, 0xc7,
+	0xd2, 0xf7, 0x02, 0xd4, 0x76, 0x49, 0x95, 0x1b, 0x6b, 0xdb, 0x93, 0x85, 0xa3, 0xb5, 0x13, 0x78,
+	0xeb, 0xba, 0x30, 0x63, 0x7c, 0xe9, 0x2c, 0x41, 0x85, 0x9e, 0xc9, 0x98, 0x7f, 0xa7, 0xcb, 0x0f,
+	0x62, 0x6a, 0x6f, 0x8c, 0xf5, 0xbf, 0x1a, 0xac, 0x60, 0x5f, 0xcb, 0x83, 0xc0, 0xf1, 0x42, 0x87,
+	0xf8, 0x60, 0x62, 0xcd, 0xb4, 0x0c, 0xd5, 0xee, 0x28, 0x08, 0xfd, 0x80, 0x0d, 0x91, 0x95, 0x12,
+	0x1c, 0xba, 0x88, 0xe3, 0x24, 0xc0, 0xc0, 0xf5, 0xb8, 0x50, 0x94, 0x88, 0x50, 0xd4, 0x06, 0xae,
+	0xc7, 0x44, 0x02, 0x7f, 0x76, 0xc6, 0xb2, 0x83, 0xae, 0x36, 0x70, 0xc6, 0xc9, 0xe7, 0x30, 0x72,
+	0x82, 0xa8, 0x1d, 0xb9, 0x03, 0x7a, 0x50, 0x2d, 0x91, 0xc3, 0x7e, 0x10, 0x3d, 0x70, 0x07, 0x64,
+	0x23, 0x40, 0x5e, 0x8f, 0x7e, 0xac, 0x92, 0x8f, 0x33, 0xc8, 0xeb, 0x91, 0x4f, 0xa7, 0x00, 0xba,
+	0x4e, 0x84, 0x76, 0x28, 0x0f, 0xa9, 0x6d, 0x2b, 0xd4, 0x90, 0x4d, 0x3d, 0xec, 0x22, 0xba, 0x00,
+	0x66, 0xe9, 0x81, 0x3e, 0xae, 0xb0, 0xfe, 0xbc, 0x04, 0xab, 0xd9, 0xf1, 0xb3, 0xd9, 0x79, 0x15,
+	0xea, 0x91, 0x50, 0xcf, 0x26, 0xe8, 0x19, 0x69, 0x82, 0xf2, 0x1a, 0x37, 0x85, 0x4a, 0x5b, 0xea,
+	0x06, 0xab, 0x46, 0x0f, 0x8d, 0xa3, 0x36, 0x63, 0x2e, 0x33, 0x09, 0x71, 0xd5, 0x0d, 0x52, 0x63,
+	0x7e, 0x57, 0x87, 0x39, 0xa1, 0xf9, 0x7b, 0x5e, 0x86, 0xb2, 0x7d, 0x56, 0x4a, 0xdb, 0x67, 0x6b,
+	0x50, 0xc3, 0x0c, 0x0d, 0x23, 0x67, 0x30, 0x24, 0x33, 0x52, 0xb2, 0x93, 0x0a, 0xe3, 0x0c, 0x34,
+	0x64, 0xdd, 0x4b, 0x8d, 0x38, 0xb9, 0x32, 0xc5, 0xfd, 0x6a, 0x86, 0xfb, 0x26, 0xcc, 0x06, 0xa8,
+	0x8b, 0xdc, 0x3d, 0xd4, 0x23, 0x36, 0x5c, 0xcd, 0x8e, 0xcb, 0x78, 0xdb, 0x08, 0x91, 0x17, 0x31,
+	0xdf, 0x00, 0xf9, 0x8d, 0x49, 0xf6, 0x50, 0xd4, 0x66, 0x2b, 0xa8, 0x46, 0x49, 0xf6, 0x50, 0x74,
+	0x8d, 0x54, 0x60, 0x77, 0xd8, 0x36, 0x42, 0xab, 0x40, 0xea, 0xf1, 0x4f, 0xeb, 0x0d, 0x58, 0xa6,
+	0x66, 0x7c, 0x66, 0x29, 0xc8, 0x22, 0xa5, 0x15, 0x89, 0x94, 0x2e, 0x8b, 0xd4, 0x32, 0x54, 0xb7,
+	0x7d, 0x3c, 0x44, 0xc6, 0x33, 0x56, 0xb2, 0xfe, 0x42, 0x87, 0x95, 0x0c, 0x32, 0x26, 0x2b, 0xb7,
+	0xf0, 0x51, 0xa8, 0xeb, 0x07, 0x3d, 0x2e, 0x26, 0x1f, 0x90, 0xc4, 0x24, 0xa7, 0x59, 0xd3, 0x26,
+	0x6d, 0x6c, 0xde, 0x16, 0x0f, 0xb0, 0x1b, 0xee, 0x71, 0x7f, 0x5f, 0x37, 0xdc, 0x33, 0xff, 0x56,
+	0x83, 0x2a, 0x85, 0x92, 0x27, 0x4c, 0x4b, 0x4f, 0x58, 0x2c, 0x25, 0xba, 0x20, 0x25, 0x26, 0xcc,
+	0xb2, 0xd9, 0x38, 0x60, 0x83, 0x89, 0xcb, 0x82, 0xa6, 0x2a, 0x8b, 0x9a, 0x8a, 0x33, 0xb9, 0x12,
+	0x33, 0xd9, 0x38, 0x8b, 0xcf, 0x90, 0x23, 0x7c, 0x24, 0x1b, 0x3a, 0x41, 0x94, 0xcc, 0x74, 0xaa,
+	0x36, 0x23, 0x93, 0x33, 0x19, 0x99, 0xb4, 0x5e, 0x80, 0x05, 0x41, 0xb4, 0x0b, 0x34, 0xb0, 0x01,
+	0xe5, 0x3d, 0x7f, 0xc4, 0x95, 0x0c, 0xf9, 0x6d, 0xb5, 0xe0, 0xc4, 0x4d, 0xd4, 0xf5, 0x7b, 0xc8,
+	0x76, 0xf6, 0xc5, 0xf5, 0xc5, 0x66, 0x7c, 0x01, 0x4a, 0xbb, 0x68, 0xcc, 0x7a, 0xc1, 0x3f, 0xad,
+	0x6f, 0x95, 0x61, 0x4d, 0xdd, 0x82, 0x4d, 0x9b, 0x12, 0x75, 0xbe, 0xa5, 0x73, 0x02, 0x6a, 0x64,
+	0x7c, 0x44, 0x6a, 0x4a, 0x64, 0x06, 0x66, 0x71, 0x05, 0x11, 0x1b, 0x2c, 0xcf, 0xd8, 0xff, 0x44,
+	0x8d, 0x4b, 0xf2, 0xdb, 0xf8, 0x38, 0x94, 0xf6, 0x5c, 0x6f, 0xb5, 0xa2, 0x70, 0x16, 0x17, 0xd1,
+	0xd5, 0x7c, 0xcd, 0xf5, 0x6c, 0xdc, 0xd2, 0xb8, 0xce, 0xd8, 0x50, 0x25, 0x3d, 0x34, 0x0f, 0xd1,
+	0x83, 0x3f, 0x8a, 0x28, 0xdb, 0xf0, 0x78, 0x86, 0xce, 0x41, 0xdf, 0x77, 0xf8, 0x1a, 0xe4, 0x45,
+	0xb3, 0x07, 0xa5, 0xd7, 0x5c, 0x6f, 0xea, 0x09, 0xc0, 0xe2, 0x14, 0x62, 0x66, 0x7b, 0x5d, 0x3a,
+	0xfc, 0xb2, 0x1d, 0x97, 0x31, 0x96, 0x7d, 0x37, 0xf2, 0xa8, 0xb5, 0x87, 0xa5, 0x83, 0x17, 0xcd,
+	0x77, 0x35, 0x28, 0x63, 0x72, 0xf0, 0xce, 0xb1, 0xe7, 0xf4, 0x47, 0xdc, 0xc8, 0xa1, 0x05, 0xa3,
+	0x0e, 0x9a, 0xc7, 0xb0, 0x68, 0x9e, 0xd2, 0x55, 0x85, 0x97, 0x72, 0x37, 0x70, 0x87, 0x51, 0xdb,
+	0x09, 0x07, 0xfc, 0xa0, 0x49, 0x6b, 0xae, 0x85, 0x03, 0xe1, 0xf3, 0x2e, 0x73, 0x9b, 0xc4, 0x9f,
+	0x5f, 0x42, 0x63, 0xf9, 0x58, 0x57, 0x4d, 0x1f, 0xeb, 0xfe, 0x4e, 0x87, 0x13, 0xd4, 0x94, 0x57,
+	0x0b, 0xd5, 0x73, 0xb1, 0x2d, 0xa3, 0xdc, 0x9f, 0x53, 0xb2, 0x1c, 0x5b, 0x31, 0x2f, 0xc3, 0x0c,
+	0x5d, 0x4e, 0x21, 0xbb, 0x70, 0x78, 0x4e, 0x6a, 0x57, 0x80, 0xb1, 0x49, 0x75, 0x5d, 0x78, 0xcb,
+	0x8b, 0xb0, 0x77, 0x9e, 0xf5, 0x92, 0x15, 0xbd, 0xb2, 0x20, 0x7a, 0xd8, 0xc9, 0xb3, 0xeb, 0x78,
+	0x3b, 0x28, 0x65, 0x70, 0x37, 0x68, 0x2d, 0xb3, 0x7a, 0x8c, 0xf3, 0x70, 0x24, 0x1c, 0x75, 0xa2,
+	0xc0, 0xe9, 0x46, 0xdb, 0x08, 0x61, 0x7b, 0x88, 0xd9, 0x46, 0xe9, 0x6a, 0xf3, 0x2a, 0xd4, 0x45,
+	0x32, 0xf0, 0xd2, 0x7a, 0x84, 0x0e, 0xf8, 0xd2, 0x7a, 0x84, 0x0e, 0x92, 0xb9, 0xd4, 0x85, 0xb9,
+	0xbc, 0xaa, 0x3f, 0xaf, 0x59, 0x5f, 0x2b, 0xc3, 0xda, 0xb5, 0x51, 0xe4, 0xd3, 0x31, 0x2a, 0x58,
+	0x7a, 0x3f, 0xe1, 0x0d, 0xe5, 0xe9, 0x87, 0xe4, 0x13, 0x66, 0x41, 0xdb, 0x69, 0x98, 0xa3, 0xa7,
+	0x98, 0xc3, 0xf4, 0x59, 0x29, 0xd1, 0x67, 0x1b, 0x50, 0x17, 0x4d, 0x44, 0xc6, 0xac, 0x39, 0xc1,
+	0x40, 0x54, 0x70, 0xb4, 0xa2, 0xe2, 0xe8, 0x3a, 0xcc, 0x05, 0x68, 0xd8, 0x77, 0xba, 0x88, 0x18,
+	0xef, 0x55, 0x62, 0x5f, 0x88, 0x55, 0xc6, 0x75, 0x68, 0xb8, 0x5e, 0xb7, 0x3f, 0xea, 0xa1, 0xf6,
+	0x28, 0x1a, 0xfb, 0xd4, 0x44, 0x99, 0x28, 0x46, 0x75, 0xd6, 0xe6, 0x55, 0xdc, 0x04, 0xf7, 0x81,
+	0xc6, 0x62, 0x1f, 0xb3, 0x53, 0xf5, 0x81, 0xc6, 0x42, 0x1f, 0x78, 0x40, 0xbe, 0xeb, 0xb5, 0x43,
+	0xd4, 0x67, 0x97, 0x6f, 0x35, 0x36, 0x20, 0xdf, 0xf5, 0xb6, 0x78, 0x25, 0xde, 0x16, 0xb7, 0x11,
+	0x6a, 0x07, 0x4e, 0xc4, 0xb7, 0xd9, 0x99, 0x6d, 0x84, 0x6c, 0x27, 0x42, 0xef, 0x4b, 0x26, 0x9e,
+	0x86, 0x35, 0xb5, 0xc8, 0x33, 0x3d, 0x9c, 0x55, 0xdd, 0xff, 0xae, 0xc3, 0x69, 0xda, 0x84, 0x9d,
+	0x6a, 0x14, 0x82, 0x94, 0x9e, 0x47, 0x2d, 0x3b, 0x8f, 0xe7, 0xe0, 0x08, 0x3b, 0x30, 0xb5, 0x65,
+	0x13, 0x78, 0x9e, 0x55, 0x5f, 0xcb, 0xd8, 0xed, 0x25, 0x69, 0x37, 0x7c, 0x0a, 0xf0, 0xc1, 0xe1,
+	0x2d, 0xe4, 0xb5, 0x87, 0x28, 0x70, 0xfd, 0x1e, 0xf3, 0x50, 0xd6, 0x69, 0xe5, 0x7d, 0x52, 0xa7,
+	0xd8, 0x32, 0x33, 0xd3, 0x5e, 0x7d, 0x02, 0xd3, 0x3e, 0xf3, 0x24, 0xa6, 0x7d, 0x56, 0x31, 0xed,
+	0xd6, 0x10, 0xce, 0x49, 0xcc, 0x7e, 0xc8, 0x8e, 0x88, 0x0a, 0xa6, 0xe3, 0x43, 0xf1, 0xd8, 0x65,
+	0x76, 0x4e, 0xcd, 0xa6, 0x05, 0xac, 0x83, 0x23, 0x3f, 0xc5, 0xe2, 0x5a, 0xe4, 0x73, 0xee, 0x66,
+	0xd6, 0xa0, 0xf5, 0x21, 0x58, 0x7b, 0x11, 0x45, 0xd7, 0xf1, 0x2a, 0x65, 0x38, 0x6d, 0xb4, 0xef,
+	0x04, 0x3d, 0xe1, 0xf4, 0xc1, 0xac, 0x08, 0x4d, 0xf4, 0x1e, 0x5a, 0x5f, 0xd3, 0xe1, 0x64, 0x4e,
+	0x43, 0x26, 0x4b, 0xaf, 0xa4, 0xdd, 0x23, 0x1f, 0x4e, 0x1f, 0xd0, 0xf3, 0x1b, 0x37, 0x69, 0x31,
+	0xe5, 0x26, 0x11, 0x88, 0xd1, 0x45, 0x62, 0xcc, 0x2f, 0x68, 0x50, 0x17, 0x5b, 0xe0, 0xdd, 0x2b,
+	0x70, 0xbc, 0x47, 0xcc, 0x51, 0x41, 0x7e, 0xe7, 0x9d, 0x08, 0x71, 0xfd, 0x7e, 0x72, 0x5a, 0xd2,
+	0x6c, 0x56, 0x12, 0x4f, 0x6b, 0xe5, 0xcc, 0xd9, 0x72, 0x18, 0xf8, 0xdb, 0x6e, 0xc4, 0x24, 0x8d,
+	0x95, 0xac, 0xcf, 0xc2, 0xda, 0xad, 0x30, 0x72, 0x07, 0xc9, 0xfc, 0x65, 0x78, 0xc9, 0x28, 0xd0,
+	0x8a, 0x65, 0x5b, 0xcf, 0xca, 0xb6, 0xf5, 0x47, 0x65, 0x38, 0x99, 0xd3, 0x3b, 0x63, 0xf8, 0x06,
+	0xd4, 0xa9, 0xa5, 0x2d, 0x4d, 0xd8, 0x1c, 0xa9, 0x4b, 0x8e, 0x22, 0xd8, 0xda, 0x96, 0x98, 0x58,
+	0x43, 0x1e, 0xf7, 0xf6, 0x72, 0xb6, 0x95, 0x64, 0xb6, 0xed, 0x27, 0xa7, 0xc5, 0x84, 0x3d, 0xb1,
+	0x91, 0x19, 0x10, 0x2a, 0x18, 0x2b, 0xa8, 0x91, 0x49, 0x09, 0xc3, 0xe3, 0x72, 0x3c, 0x6f, 0xe4,
+	0xf4, 0x39, 0x0c, 0xbd, 0x3d, 0xae, 0xd3, 0x4a, 0x06, 0xb4, 0x00, 0x25, 0x67, 0x18, 0x10, 0x8b,
+	0x48, 0xb3, 0xf1, 0x4f, 0xdc, 0x33, 0xbd, 0x30, 0x65, 0xad, 0xe8, 0x4a, 0x99, 0x23, 0x75, 0xac,
+	0xd1, 0x26, 0xcc, 0x53, 0xe4, 0xe4, 0x82, 0x62, 0xcf, 0xe9, 0x13, 0x2d, 0xaa, 0xd9, 0x0d, 0x52,
+	0x7b, 0x87, 0x55, 0x1a, 0x0f, 0x61, 0x6e, 0x18, 0xf8, 0x6f, 0x20, 0x76, 0x70, 0x04, 0x85, 0x05,
+	0x50, 0xc8, 0xd2, 0xe6, 0xfd, 0xb8, 0xb5, 0x2d, 0xf6, 0x64, 0xfe, 0x9e, 0x06, 0x90, 0x7c, 0xcb,
+	0x5b, 0x24, 0xb8, 0x9e, 0x10, 0x14, 0x72, 0x79, 0xa5, 0xa5, 0xc3, 0xf2, 0x99, 0x72, 0x63, 0x3f,
+	0x71, 0xe2, 0x6b, 0x8c, 0x1b, 0x0f, 0x63, 0x34, 0x12, 0x83, 0x59, 0xc9, 0x6a, 0x12, 0x87, 0x1b,
+	0x1b, 0x59, 0xea, 0x58, 0xa6, 0xb8, 0x7a, 0xb0, 0xbe, 0x5e, 0x86, 0xe3, 0x8a, 0x06, 0xb1, 0x93,
+	0xa4, 0x14, 0x8d, 0xf9, 0x5a, 0xbe, 0x90, 0x5e, 0xcb, 0xea, 0x46, 0xcd, 0x07, 0x63, 0x1b, 0xb7,
+	0x32, 0xee, 0xc1, 0x0c, 0xa5, 0x9f, 0xdb, 0x61, 0xcf, 0x4e, 0xd9, 0x01, 0x1d, 0x22, 0x37, 0x34,
+	0x58, 0x1f, 0xe6, 0xaf, 0x6a, 0x30, 0xc7, 0x1a, 0xbc, 0xfa, 0xe0, 0x33, 0x2f, 0x4f, 0x6f, 0x39,
+	0xe7, 0xbb, 0xa5, 0xf3, 0x8e, 0x61, 0x99, 0xc5, 0x59, 0xc9, 0x2e, 0x4e, 0xf3, 0x9b, 0x1a, 0xe8,
+	0x0f, 0xc6, 0x6a, 0x32, 0x92, 0xc0, 0x0a, 0x5d, 0x0a, 0xac, 0x48, 0x9f, 0xd2, 0x4a, 0x59, 0xcf,
+	0xc1, 0x6d, 0x28, 0xe3, 0x1d, 0x67, 0xb5, 0xac, 0x8e, 0x64, 0xca, 0x61, 0x99, 0xc0, 0x18, 0x9b,
+	0xb4, 0xc7, 0x26, 0x83, 0xc8, 0xc7, 0x49, 0x26, 0x83, 0x26, 0x9a, 0x0c, 0xaf, 0xc3, 0xa9, 0x04,
+	0x15, 0x5d, 0x1e, 0x4f, 0xea, 0x84, 0x6f, 0xfd, 0x67, 0x09, 0x4e, 0xe7, 0x76, 0x1e, 0x07, 0xd5,
+	0x94, 0x7b, 0xce, 0x01, 0x97, 0xbb, 0xa7, 0x73, 0x78, 0xa0, 0x6c, 0xdb, 0xbc, 0xe9, 0x1c, 0xd8,
+	0xa4, 0xb5, 0xf1, 0x49, 0xa8, 0x92, 0x15, 0xc3, 0xc5, 0xef, 0xca, 0xa1, 0xfa, 0x79, 0x80, 0x9b,
+	0xda, 0xac, 0x07, 0xf3, 0x4f, 0x34, 0x28, 0xdd, 0x74, 0x0e, 0xb0, 0x7c, 0xf5, 0x9c, 0x88, 0x8e,
+	0xb8, 0x66, 0x93, 0xdf, 0x87, 0xb2, 0x73, 0x98, 0x6a, 0x28, 0x49, 0xaa, 0x01, 0xdb, 0x52, 0x6e,
+	0x90, 0x8e, 0xa1, 0x9b, 0x23, 0x75, 0xc9, 0x9d, 0x5c, 0xdf, 0x49, 0x47, 0xce, 0x41, 0xdf, 0x89,
+	0x01, 0x72, 0xf4, 0x81, 0xf9, 0x8e, 0x06, 0x15, 0x32, 0x14, 0x15, 0x99, 0xda, 0x04, 0x32, 0x65,
+	0x0d, 0x96, 0xa0, 0x28, 0x89, 0x28, 0x32, 0xbb, 0x42, 0x39, 0xb3, 0x2b, 0x58, 0x97, 0xe1, 0xf8,
+	0x16, 0xf2, 0x7a, 0xd3, 0xfa, 0x0e, 0x9e, 0x01, 0x53, 0x05, 0x5e, 0xe0, 0x38, 0xb0, 0x1e, 0xc2,
+	0xfc, 0xf5, 0xd1, 0x60, 0x78, 0x1b, 0xc5, 0x57, 0x19, 0xca, 0x85, 0xc9, 0x8c, 0x21, 0x3d, 0xb1,
+	0x16, 0xe5, 0x4b, 0xe2, 0x52, 0xe6, 0x92, 0xf8, 0xb3, 0x70, 0x24, 0xee, 0xb8, 0x80, 0x00, 0xcc,
+	0x05, 0x76, 0xf6, 0xe8, 0xb5, 0x13, 0x14, 0xfc, 0x3c, 0xd2, 0xbb, 0x8d, 0x14, 0xa7, 0x21, 0x1c,
+	0x16, 0x81, 0xd5, 0xb5, 0x30, 0x4a, 0x61, 0x00, 0x77, 0xd3, 0x67, 0xb5, 0x8c, 0x00, 0x2b, 0xdb,
+	0xbd, 0x97, 0x73, 0xda, 0x73, 0xa9, 0x5b, 0x83, 0x29, 0x4f, 0xda, 0xa7, 0x61, 0x6e, 0xd7, 0x09,
+	0xe3, 0x3b, 0x8e, 0x32, 0x39, 0x82, 0xc1, 0xae, 0x13, 0xb2, 0xab, 0x8d, 0xf7, 0x75, 0x6e, 0xb9,
+	0x4c, 0x36, 0xa6, 0xf4, 0x10, 0x93, 0x43, 0x0b, 0x66, 0xa5, 0x96, 0xb0, 0xf2, 0xa3, 0xb0, 0xcc,
+	0xf7, 0xf5, 0xdb, 0xf4, 0xd4, 0xc4, 0xf9, 0x88, 0x83, 0xb1, 0x9c, 0x60, 0x07, 0x45, 0x6d, 0x26,
+	0xd6, 0x1a, 0x8b, 0x3e, 0x23, 0x95, 0xc4, 0x20, 0x0d, 0xad, 0x9f, 0x81, 0x95, 0x4c, 0xf3, 0xe4,
+	0x2a, 0x34, 0x3e, 0x97, 0x69, 0xd2, 0xb9, 0x2c, 0xdb, 0xb5, 0xae, 0xe8, 0x1a, 0xc1, 0xfc, 0x75,
+	0x6a, 0xa2, 0x6c, 0xfb, 0xb7, 0xfd, 0xe0, 0xc1, 0x38, 0xd7, 0x76, 0x90, 0xbd, 0xc6, 0x7a, 0xa1,
+	0xd7, 0xb8, 0x94, 0x72, 0x42, 0x5a, 0x5f, 0xd5, 0xa9, 0x47, 0xe9, 0xbd, 0x7a, 0x7a, 0xae, 0x43,
+	0x23, 0x40, 0x3d, 0x84, 0x06, 0x6d, 0x76, 0xc5, 0x46, 0x77, 0x20, 0x59, 0x14, 0x5e, 0x73, 0xbd,
+	0xa6, 0x4d, 0xa0, 0x98, 0x9d, 0x5e, 0x0f, 0x84, 0x92, 0xf9, 0x65, 0x62, 0x94, 0x27, 0x15, 0x3f,
+	0x62, 0xf7, 0x96, 0xec, 0x5f, 0xaa, 0xa4, 0xfd, 0x4b, 0xff, 0xf1, 0x7e, 0x9d, 0x5f, 0x37, 0xa0,
+	0xc1, 0xbc, 0x5b, 0x12, 0x4b, 0xe4, 0x5b, 0x79, 0x8c, 0xa1, 0xb9, 0x45, 0xc0, 0x38, 0x4f, 0x42,
+	0xa1, 0x64, 0x3e, 0x82, 0xba, 0xf8, 0x95, 0x18, 0xbf, 0xe1, 0x80, 0x8b, 0xae, 0x13, 0x0e, 0xb8,
+	0x02, 0xd4, 0x63, 0x05, 0x88, 0x45, 0x2e, 0x40, 0x6f, 0xe2, 0x28, 0xa3, 0x90, 0xc7, 0xd4, 0x05,
+	0xe8, 0xcd, 0x2d, 0x77, 0x27, 0x35, 0xe4, 0x72, 0x7a, 0xc8, 0x2d, 0xa2, 0x4f, 0xd4, 0x7a, 0x56,
+	0xa9, 0x37, 0xbf, 0x56, 0x82, 0xe3, 0x8a, 0x16, 0x79, 0xbe, 0x01, 0xb5, 0xab, 0x3b, 0x15, 0x96,
+	0x97, 0xe7, 0xb5, 0x2d, 0xa7, 0xbc, 0xb6, 0xcf, 0x40, 0x85, 0x08, 0x37, 0xd9, 0xce, 0xe6, 0xae,
+	0x9c, 0x90, 0xd8, 0x2a, 0x2f, 0x19, 0x9b, 0x42, 0x1a, 0x16, 0x75, 0xea, 0xd2, 0x13, 0xfd, 0x42,
+	0x5a, 0x34, 0xa9, 0xdf, 0x76, 0x93, 0x89, 0x17, 0x3d, 0xb2, 0x1f, 0xcd, 0x4c, 0x56, 0xd6, 0x35,
+	0x3b, 0x2b, 0xb9, 0x66, 0xb3, 0xf7, 0x2f, 0x35, 0xd5, 0xfd, 0x0b, 0xf7, 0x39, 0x83, 0xe0, 0x73,
+	0x66, 0x6a, 0x69, 0x2e, 0xd9, 0x5e, 0x12, 0x4b, 0xb0, 0x4e, 0xe0, 0x58, 0x89, 0xdc, 0x0e, 0xf8,
+	0xae, 0xd7, 0xc1, 0x9b, 0x4e, 0x83, 0xe8, 0xcd, 0xb8, 0x6c, 0x5d, 0x00, 0x03, 0x6b, 0xbe, 0x31,
+	0x0f, 0x65, 0x2e, 0x98, 0xbe, 0x6b, 0xb0, 0x28, 0x81, 0x2a, 0xe2, 0x99, 0x2b, 0x2c, 0x9e, 0x59,
+	0xb6, 0x49, 0x6b, 0x9c, 0x12, 0xeb, 0x73, 0x1a, 0x2c, 0xbf, 0x88, 0xa2, 0x7b, 0x68, 0x30, 0xf4,
+	0xfd, 0x3e, 0xe6, 0xb8, 0xd8, 0x0d, 0x19, 0x22, 0x55, 0x98, 0x74, 0x88, 0x4b, 0x50, 0xe9, 0x1c,
+	0x44, 0x88, 0x1b, 0x07, 0xb4, 0x60, 0x58, 0xd0, 0xc0, 0x57, 0x90, 0x01, 0xea, 0x3b, 0x07, 0xed,
+	0x64, 0x93, 0x9b, 0x1b, 0xb8, 0x9e, 0x8d, 0xeb, 0xf0, 0xf6, 0xb7, 0x0a, 0x33, 0x7e, 0x30, 0xdc,
+	0x75, 0xbc, 0x90, 0x47, 0x3d, 0xb2, 0xa2, 0xf5, 0xdf, 0x1a, 0xd4, 0x19, 0x7e, 0xba, 0x4f, 0xe4,
+	0xa9, 0x0d, 0x42, 0x0d, 0x45, 0x2c, 0x31, 0x5c, 0x70, 0x30, 0x8a, 0xda, 0xba, 0x2c, 0x6b, 0x6b,
+	0xbc, 0xc4, 0x93, 0x3b, 0x4e, 0xf2, 0x5b, 0x50, 0xc5, 0x55, 0x49, 0x15, 0x7f, 0x00, 0x8e, 0x12,
+	0x73, 0x16, 0x9b, 0x4b, 0xc3, 0xc0, 0xf5, 0x03, 0x37, 0x3a, 0x60, 0x07, 0xd6, 0x05, 0xfe, 0xe1,
+	0x3e, 0xab, 0xc7, 0x23, 0xeb, 0xa1, 0x21, 0xf2, 0x7a, 0xd4, 0x3d, 0x58, 0xb3, 0x79, 0x31, 0xed,
+	0xa4, 0xac, 0x65, 0x9c, 0x94, 0x38, 0x00, 0x86, 0xae, 0x3f, 0xc6, 0x00, 0x39, 0x38, 0xa5, 0xe3,
+	0xb3, 0x68, 0xdc, 0x59, 0x9b, 0x17, 0xad, 0x2e, 0x1c, 0x4b, 0xb5, 0x60, 0xd3, 0x75, 0x0c, 0xaa,
+	0x84, 0x6b, 0x82, 0x7f, 0xe8, 0x4e, 0x2f, 0x34, 0x9e, 0x85, 0x19, 0xe4, 0x45, 0xe4, 0x96, 0x90,
+	0x1a, 0xc2, 0xc7, 0xa5, 0x25, 0x21, 0x32, 0xde, 0xe6, 0x90, 0xd6, 0x65, 0x51, 0x28, 0xe8, 0xb7,
+	0x22, 0x39, 0xfc, 0x8e, 0x0e, 0x2b, 0x19, 0x78, 0x46, 0x56, 0x0b, 0x2a, 0xb8, 0x57, 0xba, 0xed,
+	0x17, 0x62, 0xa7, 0x70, 0x44, 0xc5, 0x79, 0x5d, 0x14, 0x46, 0x7e, 0x90, 0x04, 0x83, 0xf1, 0x0a,
+	0xea, 0x61, 0xa0, 0x85, 0x24, 0xe8, 0xb8, 0x6c, 0xd7, 0x79, 0x25, 0x8e, 0x3c, 0x96, 0x80, 0xb6,
+	0x11, 0xe2, 0xee, 0x9c, 0x18, 0xe8, 0x36, 0x42, 0x64, 0x72, 0x7a, 0x88, 0xdc, 0x47, 0x3b, 0x5e,
+	0xc4, 0xf7, 0x0f, 0xb1, 0x0a, 0xdb, 0xcc, 0x49, 0x91, 0x62, 0xa3, 0x62, 0x32, 0x9f, 0x54, 0x13,
+	0x7c, 0x32, 0x20, 0xc1, 0x48, 0xef, 0x7b, 0x04, 0x40, 0x8c, 0xd3, 0x7a, 0x0e, 0x4e, 0xdd, 0xda,
+	0x73, 0xbb, 0x9c, 0x53, 0xd3, 0xaa, 0xe9, 0x17, 0xe0, 0x74, 0x6e, 0xb3, 0x24, 0xfc, 0x09, 0x61,
+	0x10, 0xd4, 0x63, 0xd3, 0xcf, 0x8b, 0xd6, 0x2e, 0x1c, 0xc7, 0x51, 0xa9, 0xea, 0x5d, 0xe1, 0x18,
+	0x54, 0x03, 0x67, 0xbf, 0x1d, 0x71, 0x2d, 0x5f, 0xc1, 0xae, 0xc7, 0x31, 0x5e, 0xe6, 0xdb, 0x7d,
+	0x67, 0x87, 0x2b, 0x0b, 0x5a, 0x98, 0x68, 0x2c, 0x7f, 0x12, 0x4c, 0x15, 0xa6, 0xdc, 0xdd, 0x84,
+	0x68, 0xc1, 0xc1, 0xb0, 0x8f, 0x22, 0x1e, 0x4b, 0x16, 0x97, 0xad, 0x4d, 0x38, 0x4a, 0xfd, 0xa2,
+	0xf7, 0xc3, 0x4e, 0x94, 0x7f, 0x56, 0xf8, 0x18, 0xd4, 0x29, 0x40, 0xa2, 0xb3, 0x86, 0x61, 0x87,
+	0xbb, 0xdb, 0xc8, 0xef, 0x42, 0x34, 0xe7, 0xe0, 0x28, 0xbd, 0xcc, 0x13, 0xd1, 0x28, 0x3a, 0xb1,
+	0x7e, 0x58, 0x05, 0x43, 0x84, 0x64, 0xf8, 0x3e, 0x02, 0x3a, 0xe3, 0x5d, 0xda, 0x43, 0x52, 0x74,
+	0x47, 0x68, 0xeb, 0xd1, 0xd8, 0xf8, 0x68, 0x6c, 0x75, 0xd3, 0x75, 0xb9, 0xa9, 0x68, 0x2e, 0xe2,
+	0x4a, 0x45, 0xeb, 0x7c, 0x22, 0x89, 0xd6, 0xa1, 0x56, 0xfb, 0xd9, 0x49, 0xed, 0xd3, 0xf1, 0x3a,
+	0x4c, 0x7b, 0x96, 0x13, 0xed, 0x29, 0x72, 0xaa, 0x22, 0x73, 0xca, 0xbc, 0x01, 0x70, 0x1f, 0x2b,
+	0x3e, 0xf2, 0x64, 0x03, 0x47, 0x01, 0x0e, 0x47, 0x9d, 0x76, 0x62, 0xce, 0x57, 0x87, 0xa3, 0xce,
+	0xa7, 0x10, 0x59, 0xbd, 0x71, 0x74, 0x34, 0xb7, 0x61, 0xe3, 0x0a, 0xf3, 0x23, 0x00, 0x37, 0x51,
+	0xe0, 0xee, 0x91, 0x4d, 0x34, 0xbf, 0x13, 0x3c, 0x01, 0x4e, 0xc4, 0x6d, 0x60, 0xf2, 0xdb, 0xfc,
+	0xa1, 0xce, 0xe3, 0x86, 0xf2, 0x9c, 0xaa, 0xf9, 0x4f, 0x9f, 0x36, 0x61, 0x9e, 0xd9, 0x8c, 0x6d,
+	0x6a, 0x9c, 0x31, 0xe1, 0x6d, 0xb0, 0x5a, 0x6a, 0xa1, 0x61, 0xf9, 0x16, 0x22, 0xbb, 0xe9, 0x2e,
+	0x25, 0xd4, 0xe4, 0x85, 0x80, 0x57, 0x72, 0x43, 0xc0, 0xef, 0x41, 0x7d, 0x48, 0x79, 0x46, 0x8d,
+	0xb9, 0xaa, 0xe2, 0x8d, 0x90, 0x62, 0xa2, 0x12, 0x3e, 0xdb, 0x73, 0xc3, 0xf8, 0x77, 0x68, 0xdc,
+	0xc5, 0x1a, 0x8b, 0x73, 0x8f, 0x5f, 0x4a, 0x4c, 0xec, 0x2d, 0x61, 0xb8, 0x2d, 0x36, 0xc7, 0x33,
+	0xb5, 0xed, 0x7a, 0x4e, 0xdf, 0x7d, 0x0b, 0xf5, 0x78, 0x7c, 0x4e, 0x5c, 0x61, 0x3e, 0x8e, 0x23,
+	0xae, 0xb2, 0xcc, 0xd3, 0x54, 0xcc, 0x4b, 0x11, 0xa7, 0xbf, 0x2f, 0xe2, 0xf0, 0xb9, 0x1b, 0xf3,
+	0x71, 0xc2, 0xaa, 0x7c, 0x8f, 0x7a, 0xea, 0x22, 0x18, 0x37, 0xfc, 0x41, 0xc7, 0xf5, 0xa4, 0x55,
+	0xbf, 0x04, 0x15, 0xdc, 0x67, 0xbc, 0x7d, 0x92, 0x82, 0x75, 0x01, 0x16, 0x6f, 0x33, 0xa6, 0x4c,
+	0x52, 0x11, 0x9f, 0x81, 0x25, 0x19, 0xb4, 0x40, 0x27, 0x65, 0x8d, 0x7e, 0x71, 0xed, 0x95, 0x52,
+	0x5a, 0xaa, 0x09, 0xf3, 0x2f, 0xa2, 0x08, 0xdf, 0x2b, 0x71, 0xfc, 0xd2, 0x39, 0x40, 0x4b, 0x9f,
+	0x03, 0xbe, 0xa0, 0x43, 0xf9, 0x70, 0x5e, 0xd2, 0xbc, 0x4b, 0xb8, 0xb4, 0xcb, 0xb2, 0x9c, 0x75,
+	0x59, 0xe2, 0xc7, 0x1b, 0x58, 0xde, 0xb1, 0x89, 0x44, 0x97, 0x42, 0x5c, 0xce, 0xda, 0xd2, 0xf4,
+	0xb9, 0x84, 0x5c, 0x69, 0x9c, 0x87, 0x85, 0x70, 0x88, 0xbc, 0xa8, 0xdd, 0x39, 0x68, 0x8f, 0x3c,
+	0x1c, 0x5a, 0x4c, 0xe3, 0x25, 0x66, 0xed, 0x79, 0x52, 0x7f, 0xfd, 0xe0, 0x55, 0x5a, 0x4b, 0x02,
+	0x84, 0x88, 0x17, 0x96, 0x09, 0x2c, 0x2b, 0xe1, 0xb9, 0xeb, 0x3b, 0x1d, 0xd4, 0x67, 0x57, 0xab,
+	0xb4, 0x60, 0xdd, 0x87, 0x39, 0xe6, 0xd5, 0x22, 0xcc, 0xc8, 0x0f, 0x1d, 0x3c, 0x07, 0x15, 0x7a,
+	0xcf, 0xa7, 0x2b, 0x0e, 0x0d, 0xb8, 0xad, 0x4d, 0xbf, 0x5b, 0xf7, 0xe1, 0x48, 0x3c, 0x11, 0x6c,
+	0x76, 0x3f, 0x0a, 0x0d, 0xd6, 0x0d, 0xbb, 0x2b, 0xa4, 0xde, 0x9a, 0x55, 0x55, 0xec, 0x36, 0xe9,
+	0xaa, 0xce, 0xc0, 0x5f, 0x25, 0x3d, 0xfe, 0xa5, 0x06, 0xeb, 0x5b, 0xc8, 0x09, 0xba, 0xbb, 0x0c,
+	0x46, 0x15, 0x10, 0x98, 0x4f, 0xb9, 0x1c, 0xfc, 0xa7, 0x17, 0x07, 0xff, 0x95, 0xd2, 0xc1, 0x7f,
+	0xd8, 0xa6, 0x7e, 0xe4, 0x0e, 0x99, 0xaa, 0x23, 0xbf, 0x93, 0x20, 0xc3, 0x8a, 0x18, 0x64, 0x28,
+	0x05, 0xf3, 0x55, 0xd3, 0xc1, 0x7c, 0xff, 0xa5, 0xc3, 0x46, 0xc1, 0x20, 0x18, 0xa7, 0x7e, 0x4e,
+	0x19, 0xd5, 0x77, 0x55, 0x62, 0xd4, 0xc4, 0x5e, 0x0a, 0xc2, 0xfb, 0xa4, 0xa0, 0x61, 0xfe, 0xc4,
+	0xd6, 0xfc, 0x27, 0xed, 0xff, 0x4d, 0x4c, 0x9f, 0x18, 0xb3, 0x57, 0xcd, 0x89, 0xd9, 0x9b, 0x49,
+	0x62, 0xf6, 0xf0, 0xd3, 0xa1, 0xe5, 0x24, 0xe6, 0x9c, 0xc8, 0xd3, 0x4f, 0x8c, 0xc4, 0x58, 0x5f,
+	0x2a, 0xc1, 0x4a, 0x86, 0xb8, 0x49, 0xb1, 0xfa, 0xc6, 0xc7, 0xe5, 0x95, 0x78, 0x21, 0x27, 0xbe,
+	0x5e, 0xea, 0xae, 0x89, 0x4b, 0x6c, 0x85, 0x26, 0x42, 0x50, 0x12, 0x23, 0xc7, 0x4f, 0x02, 0xe0,
+	0xcf, 0xec, 0xd9, 0x24, 0x25, 0xbe, 0x86, 0x6b, 0xc8, 0x7b, 0x49, 0xf3, 0x07, 0x1a, 0x94, 0x71,
+	0x27, 0x3f, 0x16, 0x7d, 0x39, 0x9d, 0x2c, 0x9c, 0x86, 0x39, 0x37, 0x6c, 0xc7, 0x4e, 0x02, 0xba,
+	0xe4, 0xc0, 0x0d, 0x6f, 0xb0, 0x9a, 0xe9, 0x95, 0xa6, 0x15, 0x8a, 0xaf, 0x4e, 0x6c, 0x26, 0x50,
+	0x3f, 0x62, 0x41, 0xc1, 0xaf, 0x4b, 0x4c, 0x15, 0xd6, 0x89, 0x12, 0x20, 0x2e, 0x02, 0x3d, 0x67,
+	0x11, 0x94, 0x92, 0x45, 0x80, 0x4f, 0xfc, 0xd1, 0x58, 0x9a, 0xd8, 0x99, 0x68, 0x4c, 0xa6, 0xd5,
+	0xea, 0xc2, 0xd2, 0x16, 0xd5, 0xd6, 0xb7, 0xc9, 0x36, 0xc1, 0xc7, 0xfc, 0x2c, 0x17, 0xb2, 0xa9,
+	0x02, 0xcb, 0x98, 0x60, 0x25, 0x5b, 0x8f, 0x2e, 0x6e, 0x3d, 0xd6, 0x39, 0x38, 0x96, 0x42, 0x92,
+	0xf3, 0x8e, 0xec, 0x01, 0x2c, 0x32, 0xc0, 0xbb, 0x78, 0x77, 0x2a, 0xbc, 0xa8, 0x50, 0x89, 0x5c,
+	0xbc, 0xc7, 0x95, 0xc4, 0x3d, 0xee, 0x2c, 0x2c, 0xc9, 0xbd, 0xe6, 0x60, 0xff, 0xae, 0x06, 0x2b,
+	0x37, 0x7c, 0x2f, 0xf4, 0xe9, 0x83, 0x1f, 0x49, 0x59, 0x14, 0x1a, 0x13, 0x7c, 0xa2, 0xe3, 0xb3,
+	0x0a, 0x59, 0x3b, 0x03, 0x67, 0x4c, 0xb8, 0x13, 0x4a, 0x1e, 0x97, 0x92, 0xec, 0x71, 0x91, 0x43,
+	0x53, 0xca, 0xe9, 0xd0, 0x94, 0x15, 0x98, 0xe9, 0x05, 0x07, 0xed, 0x60, 0xe4, 0xb1, 0xc3, 0x46,
+	0xb5, 0x17, 0x1c, 0xd8, 0x23, 0x0f, 0xbf, 0xdd, 0x5e, 0xcd, 0xd2, 0x7a, 0x88, 0xd8, 0xf0, 0xbc,
+	0xc6, 0x05, 0x9b, 0x47, 0x7c, 0xe9, 0x2e, 0x8d, 0x93, 0x5e, 0xba, 0xb3, 0x91, 0x9e, 0x80, 0x1a,
+	0x05, 0x49, 0x7c, 0x4e, 0xb3, 0xa4, 0xe2, 0x36, 0x42, 0xa6, 0x23, 0xef, 0x32, 0xd9, 0xc3, 0xee,
+	0x32, 0x54, 0xa5, 0xae, 0x59, 0x29, 0x57, 0x93, 0x64, 0x4e, 0x67, 0xd6, 0x2d, 0x58, 0xde, 0xda,
+	0x47, 0x68, 0x78, 0x9f, 0x98, 0xc9, 0xe8, 0x53, 0xe8, 0x40, 0x38, 0x17, 0xef, 0xbb, 0xdb, 0x1c,
+	0xdb, 0xbe, 0xbb, 0x2d, 0xcd, 0x8a, 0x2e, 0xcd, 0x8a, 0xf5, 0x2b, 0x1a, 0xac, 0x64, 0xfa, 0x99,
+	0x10, 0x95, 0x9b, 0x73, 0xae, 0x9a, 0x9a, 0x76, 0x61, 0xf4, 0x15, 0x71, 0xf4, 0xd6, 0xf3, 0xd2,
+	0x63, 0x42, 0x7a, 0x6f, 0x34, 0x9d, 0x8d, 0xfb, 0x1d, 0x0d, 0x8e, 0x2b, 0x9a, 0xb2, 0x81, 0xdc,
+	0x4b, 0xdf, 0x9e, 0x3d, 0x9b, 0xb3, 0x93, 0xa4, 0x1a, 0xaa, 0xaf, 0xcf, 0xde, 0xd7, 0x4d, 0xd6,
+	0x3b, 0x1a, 0x9c, 0xba, 0xb1, 0x8b, 0xba, 0x8f, 0x84, 0xe1, 0xb9, 0x01, 0x1a, 0x90, 0x0c, 0x14,
+	0xf1, 0x1d, 0xd5, 0xd0, 0xef, 0xb6, 0xd3, 0xa3, 0xad, 0x0f, 0xfd, 0xee, 0x35, 0x71, 0x1d, 0xe2,
+	0x97, 0xf6, 0x7d, 0xe4, 0xed, 0xb0, 0xa3, 0x71, 0xc3, 0xae, 0x75, 0xdc, 0xe8, 0x2e, 0xa9, 0xc0,
+	0x9f, 0x87, 0x7d, 0x3f, 0x6a, 0x77, 0xe3, 0xf9, 0x28, 0xdb, 0x35, 0x5c, 0x43, 0x75, 0xe1, 0x3f,
+	0xe3, 0xa8, 0xbe, 0x3c, 0x2a, 0x18, 0xd3, 0x64, 0x0c, 0x5a, 0x31, 0x06, 0x3d, 0x85, 0xc1, 0xf8,
+	0x59, 0x7c, 0x05, 0x1a, 0x77, 0xca, 0xbd, 0x12, 0xcf, 0xcb, 0x0b, 0xb3, 0x98, 0x82, 0xa6, 0x58,
+	0x27, 0xf5, 0x86, 0x43, 0x99, 0xe7, 0x84, 0xaf, 0x78, 0x7f, 0x14, 0x58, 0x16, 0xa7, 0x2c, 0x88,
+	0x19, 0x46, 0xf7, 0x11, 0x02, 0x2f, 0xec, 0x23, 0xb4, 0x8c, 0x25, 0xba, 0x3b, 0x0a, 0x82, 0x64,
+	0x2b, 0xe1, 0x45, 0x2c, 0xa7, 0x03, 0xea, 0xbb, 0x60, 0x31, 0x21, 0xb4, 0x44, 0xdc, 0x1a, 0xbb,
+	0x7e, 0x10, 0x6d, 0x3b, 0xfd, 0x7e, 0x1c, 0xe8, 0xcc, 0x2b, 0x58, 0xd8, 0x0d, 0x1b, 0xd7, 0x14,
+	0x61, 0x37, 0xff, 0x48, 0xaf, 0x5d, 0xd2, 0x0d, 0xd8, 0x34, 0xdc, 0xcd, 0xbe, 0x4d, 0x6a, 0x66,
+	0x02, 0xe9, 0x94, 0x4d, 0x9b, 0xbc, 0x9c, 0x74, 0x60, 0xfe, 0x8e, 0x06, 0x73, 0x0c, 0xfa, 0x70,
+	0x47, 0xc2, 0x4d, 0x98, 0xdf, 0xf5, 0xfb, 0x3d, 0x14, 0xb4, 0xe5, 0xf8, 0x99, 0x06, 0xad, 0x15,
+	0xe2, 0x3c, 0xd9, 0x0d, 0x70, 0x4a, 0xd3, 0xcf, 0xb3, 0xea, 0x6c, 0x9c, 0x67, 0x45, 0x54, 0x16,
+	0xe6, 0xdf, 0x68, 0x30, 0xc3, 0xe8, 0xfe, 0x71, 0x87, 0xd3, 0xe4, 0x70, 0x51, 0x60, 0x17, 0x0d,
+	0xa7, 0x99, 0xf2, 0x69, 0x9b, 0xf5, 0x5b, 0x71, 0xe8, 0x2c, 0xeb, 0x42, 0xe1, 0x71, 0xbd, 0x97,
+	0xf8, 0xed, 0x54, 0x9a, 0x69, 0x42, 0xf3, 0x8c, 0x13, 0x2f, 0x1d, 0x89, 0xab, 0x67, 0x23, 0x71,
+	0x33, 0xb7, 0x24, 0xe6, 0x50, 0x74, 0xee, 0xa4, 0x26, 0x59, 0x9b, 0x72, 0x92, 0xf5, 0x09, 0x93,
+	0x2c, 0xed, 0x08, 0xf8, 0x98, 0x7b, 0x4e, 0x1a, 0x5a, 0x41, 0x9c, 0xeb, 0x8f, 0x89, 0xa6, 0x49,
+	0xe6, 0x49, 0x26, 0xb4, 0xd8, 0xba, 0x4d, 0x2e, 0x6b, 0xae, 0xa3, 0x90, 0xde, 0xed, 0xc7, 0x0b,
+	0xb6, 0x20, 0x18, 0x90, 0x86, 0x02, 0xf0, 0x3b, 0x37, 0x5a, 0xb2, 0xfe, 0xac, 0x0c, 0x73, 0xd7,
+	0xa9, 0x94, 0x3a, 0x3d, 0x14, 0xe0, 0xd5, 0x47, 0x0e, 0x95, 0x4c, 0xe8, 0xf1, 0x6f, 0x29, 0x53,
+	0x8d, 0x2e, 0x67, 0xaa, 0x49, 0xdd, 0xb7, 0x96, 0x93, 0xfb, 0xd6, 0x84, 0x90, 0xb2, 0x44, 0x88,
+	0x74, 0x38, 0xad, 0xa4, 0x0f, 0xa7, 0x26, 0xcc, 0x0e, 0x03, 0xb4, 0xe7, 0xfa, 0xa3, 0x90, 0x1f,
+	0x3b, 0x79, 0x19, 0x93, 0x86, 0xdf, 0xc7, 0xf1, 0x63, 0x27, 0xfe, 0x6d, 0x5c, 0x80, 0x05, 0xc1,
+	0x74, 0x6a, 0x07, 0xbe, 0xcf, 0x9f, 0x92, 0x1d, 0x11, 0xea, 0x6d, 0xdf, 0x27, 0xc7, 0x21, 0xee,
+	0x3b, 0x24, 0x60, 0xd4, 0x3d, 0x33, 0xc7, 0xea, 0x08, 0x08, 0xde, 0xfb, 0x02, 0x7f, 0xe8, 0x87,
+	0x4e, 0x9f, 0xc2, 0xd0, 0xe0, 0xf7, 0x3a, 0xaf, 0x24, 0x40, 0x09, 0x27, 0xe7, 0x44, 0x4e, 0xe2,
+	0x81, 0x75, 0x77, 0xf1, 0xa3, 0x5f, 0x6f, 0x07, 0x91, 0x2b, 0xd6, 0x9a, 0x9d, 0x54, 0x88, 0xfe,
+	0xe5, 0x86, 0xe4, 0x5f, 0x96, 0x37, 0xba, 0xf9, 0xf4, 0x46, 0xb7, 0x0a, 0x33, 0x6f, 0x8e, 0x9c,
+	0x3e, 0x76, 0x68, 0x1d, 0xa1, 0xac, 0x67, 0x45, 0xd9, 0xbb, 0xbd, 0x90, 0xf2, 0x6e, 0xe3, 0x39,
+	0xeb, 0x38, 0x1e, 0xc9, 0xb6, 0xb6, 0x7a, 0x94, 0x5e, 0xc2, 0x74, 0x1c, 0x8f, 0x64, 0xd4, 0xb2,
+	0x70, 0x54, 0x7a, 0x7b, 0x80, 0x27, 0x94, 0x4c, 0xe3, 0xaa, 0x41, 0xef, 0x02, 0x5d, 0xef, 0x9e,
+	0xe3, 0x7a, 0x37, 0x70, 0x55, 0xf6, 0x60, 0xb8, 0xa8, 0x38, 0x18, 0x5a, 0x0f, 0x89, 0x0b, 0x8a,
+	0x09, 0x60, 0xbc, 0xc5, 0x64, 0xe4, 0x27, 0x27, 0x70, 0x5a, 0xbc, 0x58, 0x2c, 0xc9, 0x17, 0x8b,
+	0xdf, 0xd7, 0x60, 0xe1, 0x45, 0x94, 0x12, 0xed, 0xa7, 0x71, 0x37, 0x58, 0x48, 0xd9, 0x1d, 0xc7,
+	0x6a, 0xf6, 0x96, 0x9e, 0x0a, 0xb1, 0xcd, 0xe0, 0xa4, 0x7b, 0x5a, 0x7e, 0x6b, 0x9c, 0x5c, 0x4d,
+	0x96, 0xc4, 0xab, 0xc9, 0xe7, 0x69, 0x7c, 0x69, 0x59, 0x71, 0x7d, 0x91, 0x1b, 0x94, 0x40, 0x83,
+	0x4b, 0x99, 0x79, 0x5d, 0x49, 0x2e, 0x82, 0x6e, 0x90, 0x6b, 0x51, 0x91, 0xa0, 0xc3, 0x33, 0xc7,
+	0xba, 0x0c, 0x8b, 0x71, 0x27, 0x4e, 0xb8, 0x3b, 0x29, 0x22, 0xfe, 0x22, 0x2c, 0xc9, 0xe0, 0x89,
+	0xc3, 0x37, 0x8d, 0xd2, 0xfa, 0x2b, 0x9a, 0x53, 0x80, 0xcc, 0xf4, 0x03, 0x77, 0x98, 0x9c, 0x67,
+	0x3e, 0x86, 0xaf, 0xa5, 0x87, 0xea, 0xfc, 0x61, 0xaa, 0x06, 0x4d, 0x5e, 0x63, 0x93, 0x76, 0xe6,
+	0x00, 0x66, 0x79, 0xcd, 0xa1, 0x04, 0x01, 0xaf, 0x81, 0xc0, 0xf1, 0xba, 0xbb, 0x78, 0x19, 0x70,
+	0x7b, 0x91, 0xd6, 0xdc, 0x45, 0x9e, 0xb0, 0xe9, 0x96, 0xa5, 0x78, 0x81, 0x0f, 0x13, 0x3e, 0xe3,
+	0x84, 0x0f, 0x3d, 0x32, 0xf0, 0x30, 0x49, 0x21, 0x45, 0x0c, 0x32, 0xf9, 0x42, 0xa7, 0x36, 0xf4,
+	0xbb, 0xf7, 0xc9, 0x9a, 0xc3, 0x29, 0x2a, 0xd2, 0x0d, 0x13, 0x5f, 0xc0, 0x2e, 0x8b, 0x14, 0xc6,
+	0x4c, 0x28, 0xdb, 0xbc, 0x68, 0x3d, 0x14, 0xf2, 0x07, 0xa4, 0xf3, 0x1e, 0xbd, 0xaf, 0xdc, 0x2f,
+	0xaf, 0xc0, 0x71, 0x45, 0xc7, 0x49, 0x22, 0x9a, 0xdc, 0x6c, 0x44, 0xa9, 0x17, 0x8a, 0x42, 0x0a,
+	0xaa, 0x97, 0x61, 0xf1, 0x55, 0x0f, 0x0f, 0xec, 0xd0, 0x99, 0xb5, 0xb0, 0x26, 0x4e, 0x8c, 0x2f,
+	0x5e, 0xc4, 0x27, 0x7b, 0xb9, 0xc3, 0x9c, 0x93, 0xfd, 0x19, 0x30, 0xee, 0x4e, 0x86, 0xfa, 0x96,
+	0x46, 0x26, 0x8e, 0x42, 0xe1, 0xcc, 0x43, 0xd3, 0x25, 0x62, 0xe0, 0xc9, 0x87, 0x74, 0x21, 0xf9,
+	0x50, 0xce, 0x35, 0x58, 0xe9, 0x10, 0x99, 0x90, 0xca, 0x8a, 0x4c, 0x48, 0x57, 0xfe, 0xf0, 0x36,
+	0xc0, 0xb5, 0xa1, 0xbb, 0x85, 0x82, 0x3d, 0xb7, 0x8b, 0x8c, 0x0e, 0xd4, 0xc5, 0xdd, 0xd6, 0x58,
+	0x6e, 0xd2, 0x34, 0x98, 0xcd, 0x24, 0x92, 0x1f, 0xa7, 0xc1, 0x34, 0x37, 0x32, 0x56, 0x5d, 0x7a,
+	0x83, 0xb6, 0x56, 0x7e, 0xe9, 0x1f, 0xfe, 0xed, 0xd7, 0xf5, 0xa3, 0xc6, 0x91, 0xd6, 0xde, 0x33,
+	0x2d, 0x1a, 0xab, 0xd7, 0xea, 0xe0, 0xd9, 0xf9, 0x86, 0x96, 0xa8, 0x0d, 0x29, 0x1e, 0xd8, 0xb8,
+	0x30, 0xcd, 0xd3, 0x15, 0x32, 0xc5, 0xe6, 0xc5, 0xe9, 0x5f, 0xb9, 0x58, 0x17, 0x08, 0x25, 0x4f,
+	0x19, 0x1b, 0x02, 0x25, 0x6f, 0x53, 0x71, 0x7f, 0xdc, 0x62, 0x91, 0xb8, 0x2c, 0xb0, 0xf6, 0xab,
+	0x1a, 0x1c, 0x53, 0xbe, 0x55, 0x48, 0xd1, 0x56, 0xf4, 0x00, 0xc5, 0xbc, 0x38, 0x0d, 0x28, 0xa3,
+	0xed, 0x34, 0xa1, 0xed, 0xb8, 0xb5, 0x84, 0x69, 0x63, 0xb4, 0xb4, 0x10, 0x6b, 0x72, 0x55, 0xbb,
+	0x68, 0xfc, 0xb2, 0x06, 0xb3, 0x7c, 0x78, 0xc6, 0x9a, 0x72, 0xd4, 0x1c, 0xef, 0xc9, 0x9c, 0xaf,
+	0x0c, 0xd5, 0x4f, 0x13, 0x54, 0x1f, 0x32, 0x96, 0x05, 0x36, 0x60, 0x6d, 0xd5, 0x7a, 0x1b, 0xff,
+	0x7d, 0xfc, 0xfa, 0x9a, 0x61, 0x8a, 0x5f, 0x08, 0x7f, 0x62, 0x3e, 0x19, 0xef, 0x6a, 0x30, 0xcf,
+	0xbb, 0x64, 0x26, 0x94, 0xa5, 0xc4, 0x27, 0xed, 0x04, 0x66, 0xee, 0xde, 0x65, 0x7d, 0x92, 0x90,
+	0x73, 0xd3, 0x38, 0xa9, 0x26, 0xa7, 0x45, 0xb7, 0xb6, 0xd7, 0xe5, 0x69, 0x4b, 0x51, 0xc5, 0x80,
+	0x8c, 0x9f, 0xa7, 0x62, 0x1b, 0x5f, 0x0c, 0xac, 0xab, 0x29, 0x4b, 0xb6, 0x17, 0x73, 0xa3, 0x00,
+	0x82, 0xf1, 0xeb, 0x1c, 0x21, 0x70, 0xc3, 0x38, 0x5d, 0x84, 0x1f, 0x63, 0xa3, 0x8b, 0x26, 0xde,
+	0x34, 0xa6, 0x5f, 0x34, 0x99, 0x7d, 0x46, 0xb9, 0x68, 0xf0, 0x8e, 0x63, 0x7c, 0x9e, 0xb2, 0x5f,
+	0x50, 0xe5, 0x59, 0xf6, 0x67, 0x37, 0x08, 0xf3, 0xa9, 0x42, 0x18, 0x86, 0xf4, 0x2c, 0x41, 0xba,
+	0x6e, 0x9c, 0x12, 0x90, 0x12, 0x2f, 0x76, 0xeb, 0x6d, 0x61, 0x77, 0x79, 0x6c, 0xbc, 0x41, 0xac,
+	0x20, 0x31, 0xe7, 0x67, 0xee, 0x50, 0xcf, 0x4c, 0x93, 0x29, 0xd4, 0x3a, 0x4e, 0x10, 0x2f, 0x1a,
+	0x47, 0x31, 0xe2, 0x2e, 0x81, 0x68, 0xb1, 0x73, 0xa6, 0x03, 0x90, 0x24, 0x0d, 0xcd, 0x45, 0x73,
+	0x5a, 0x42, 0x93, 0xcd, 0x32, 0x6a, 0x99, 0x04, 0xc3, 0x92, 0x75, 0x44, 0xc0, 0xf0, 0xe6, 0xc8,
+	0x8d, 0xf0, 0xca, 0x7a, 0x00, 0x33, 0x54, 0x33, 0xe7, 0x0f, 0x63, 0xad, 0x28, 0xb3, 0xa8, 0xb5,
+	0x48, 0x3a, 0x6f, 0x18, 0x73, 0xb8, 0xf3, 0x7d, 0xd6, 0x55, 0x00, 0x75, 0x31, 0x01, 0x63, 0x4a,
+	0x14, 0x15, 0x79, 0x20, 0xcd, 0x8d, 0x02, 0x08, 0x86, 0xe9, 0x24, 0xc1, 0xb4, 0x62, 0x19, 0x02,
+	0xa6, 0x56, 0x97, 0x40, 0xe2, 0x91, 0x6c, 0x43, 0x2d, 0x4e, 0xbb, 0x69, 0xc8, 0x5a, 0x20, 0x9d,
+	0xc0, 0xd3, 0x3c, 0x95, 0xf7, 0x59, 0xc5, 0x31, 0x8e, 0x6a, 0x14, 0x12, 0x3c, 0x01, 0xd4, 0xc5,
+	0xec, 0x8c, 0xa9, 0xb1, 0x29, 0x92, 0x41, 0x9a, 0x1b, 0x05, 0x10, 0x45, 0x63, 0x73, 0x09, 0x24,
+	0xc6, 0xf9, 0x8b, 0x30, 0x2f, 0xe7, 0x60, 0x4c, 0xc9, 0xbd, 0x32, 0x41, 0xe3, 0x34, 0x78, 0x99,
+	0xd4, 0x5b, 0x27, 0xb2, 0x78, 0x5b, 0xdc, 0xf4, 0xc0, 0x04, 0x24, 0x39, 0x29, 0xe5, 0x3c, 0x8a,
+	0xc6, 0x79, 0x15, 0x1d, 0xaa, 0x54, 0x8b, 0xef, 0x9b, 0x1a, 0xd6, 0x29, 0xa6, 0xe6, 0xd7, 0xe2,
+	0x9c, 0x94, 0xa9, 0xcc, 0x85, 0xa9, 0x0d, 0xaa, 0x28, 0xbb, 0xe1, 0x34, 0xf4, 0x30, 0xe5, 0x67,
+	0xad, 0x29, 0xe8, 0x21, 0x19, 0x62, 0x71, 0xca, 0x58, 0x26, 0x13, 0xb7, 0xc6, 0xb9, 0x32, 0xa1,
+	0x48, 0x64, 0x68, 0x6e, 0x14, 0x40, 0x14, 0xc9, 0x04, 0x1a, 0x73, 0x99, 0x08, 0xa0, 0x2e, 0x66,
+	0x11, 0x4c, 0xe1, 0x54, 0x24, 0x2d, 0x34, 0x37, 0x0a, 0x20, 0x8a, 0x70, 0x06, 0x04, 0x12, 0xe3,
+	0x7c, 0x0b, 0x8e, 0x29, 0x33, 0x12, 0xa6, 0xf8, 0x5e, 0x94, 0xb5, 0x30, 0xb5, 0x19, 0x0a, 0x10,
+	0x7c, 0xd5, 0x19, 0xf2, 0x80, 0x49, 0xe3, 0xa7, 0x35, 0xe3, 0x1d, 0x0d, 0x8e, 0x66, 0x4c, 0x67,
+	0x63, 0x53, 0x9d, 0x89, 0x2b, 0xbd, 0x14, 0xce, 0x4e, 0x02, 0x53, 0x59, 0x22, 0x9c, 0x04, 0x71,
+	0x21, 0xbc, 0x05, 0x75, 0xd1, 0x36, 0x4e, 0x71, 0x5d, 0x61, 0x87, 0x9b, 0x1b, 0x05, 0x10, 0x0c,
+	0xeb, 0x26, 0xc1, 0x7a, 0xda, 0x32, 0x25, 0xcd, 0x46, 0x1d, 0xc3, 0xad, 0x11, 0x69, 0x81, 0x71,
+	0xbf, 0x01, 0x90, 0xd8, 0xdb, 0x53, 0x6e, 0x07, 0x59, 0x03, 0xdd, 0x7a, 0x8a, 0x60, 0x3b, 0x69,
+	0xad, 0xaa, 0xb0, 0x71, 0x5c, 0x03, 0x68, 0x48, 0x46, 0x7b, 0x2e, 0x3a, 0x4b, 0xcd, 0x59, 0xd1,
+	0xd0, 0xb7, 0xd6, 0x09, 0x46, 0xd3, 0x50, 0x62, 0x24, 0x96, 0xfd, 0x97, 0xa8, 0x0b, 0x40, 0x4a,
+	0xa3, 0x66, 0x9c, 0x99, 0x90, 0x65, 0x8d, 0xf2, 0x77, 0x73, 0xaa, 0x5c, 0x6c, 0x6a, 0xdd, 0xc2,
+	0x69, 0x60, 0xe9, 0x0c, 0xf1, 0xc0, 0xf7, 0xa1, 0x21, 0xa5, 0xf9, 0x33, 0x54, 0x3b, 0x93, 0x9c,
+	0x34, 0xd0, 0xb4, 0x8a, 0x40, 0x54, 0x92, 0x15, 0x7b, 0x73, 0x85, 0xfd, 0x2b, 0x22, 0x06, 0x54,
+	0x72, 0xeb, 0xb2, 0x5e, 0x90, 0xe1, 0x2f, 0xc7, 0x7c, 0xcb, 0xe4, 0x00, 0xe4, 0x58, 0x8d, 0x15,
+	0x19, 0xeb, 0xdb, 0xec, 0xec, 0xf8, 0xd8, 0xf8, 0x02, 0x5d, 0x55, 0x72, 0x66, 0xc8, 0xec, 0xaa,
+	0x52, 0x26, 0xdd, 0x34, 0xcf, 0x4e, 0x02, 0x93, 0xe7, 0xdf, 0x3a, 0x26, 0x53, 0x21, 0x70, 0xfd,
+	0x8b, 0x1a, 0x1c, 0x49, 0xa5, 0x84, 0x34, 0x64, 0xb3, 0x4d, 0x9d, 0x65, 0xd2, 0x3c, 0x53, 0x0c,
+	0xc4, 0x08, 0x38, 0x4f, 0x08, 0xb0, 0x8c, 0xf5, 0x14, 0x1b, 0xd8, 0xcf, 0xc7, 0xad, 0x3d, 0xd6,
+	0xd0, 0xe8, 0xc1, 0x0c, 0x8b, 0xb3, 0x32, 0x4e, 0xa4, 0x47, 0x27, 0x84, 0xc1, 0x99, 0x6b, 0xea,
+	0x8f, 0x0c, 0xdf, 0x29, 0x82, 0x6f, 0xd5, 0x5a, 0x94, 0xf1, 0x91, 0xfb, 0x7c, 0x3c, 0xdc, 0x3f,
+	0xd0, 0xe0, 0x78, 0x6e, 0xc0, 0x91, 0x71, 0x79, 0xda, 0xc0, 0x24, 0x4a, 0x4a, 0xf3, 0x70, 0x71,
+	0x4c, 0xd6, 0x25, 0x42, 0xdc, 0x59, 0xe3, 0x4c, 0x1e, 0x33, 0xa4, 0xeb, 0xe9, 0x77, 0x34, 0x62,
+	0xf0, 0x8a, 0xe1, 0x2f, 0xc6, 0x53, 0xc5, 0xc1, 0x31, 0xaa, 0x99, 0xc9, 0x89, 0xa0, 0xe1, 0xaa,
+	0x8f, 0x1e, 0x80, 0x54, 0xc4, 0x10, 0x9e, 0xe1, 0x23, 0xa9, 0x91, 0x0d, 0xea, 0x30, 0xf2, 0x04,
+	0x30, 0x15, 0x6b, 0x62, 0x9e, 0x9b, 0x08, 0x37, 0xad, 0xa0, 0xc4, 0x11, 0x21, 0x21, 0x34, 0xa4,
+	0xe8, 0x8b, 0x94, 0x9e, 0x50, 0x85, 0x7f, 0x98, 0x56, 0x11, 0x08, 0xa3, 0xe0, 0x04, 0xa1, 0xe0,
+	0x98, 0xb5, 0x80, 0x29, 0x20, 0x83, 0x6f, 0x6d, 0x07, 0x08, 0xbd, 0x45, 0x96, 0x89, 0x0f, 0x75,
+	0x31, 0xe6, 0x22, 0xa5, 0x23, 0x14, 0x41, 0x1e, 0xe6, 0x46, 0x01, 0x84, 0xca, 0xd8, 0xa5, 0x18,
+	0x49, 0x84, 0x07, 0x46, 0xf8, 0x79, 0x0d, 0x16, 0xd2, 0x31, 0x0d, 0x29, 0xbd, 0x9c, 0x13, 0xdb,
+	0x61, 0x6e, 0x4e, 0x80, 0x52, 0xe9, 0x06, 0x8a, 0xbd, 0x9b, 0xc0, 0xd2, 0x2d, 0xf7, 0x48, 0x2a,
+	0x6a, 0x20, 0x25, 0x80, 0xea, 0xd8, 0x04, 0xf3, 0x4c, 0x31, 0x10, 0xc3, 0xbf, 0x46, 0xf0, 0x2f,
+	0x5b, 0x47, 0xc5, 0x7d, 0x21, 0xc4, 0xc0, 0x18, 0xf7, 0x57, 0x35, 0x58, 0x52, 0xc5, 0xd3, 0xa7,
+	0xec, 0xde, 0x82, 0x14, 0x65, 0xe6, 0xf4, 0xc1, 0xf9, 0x96, 0x45, 0x68, 0x59, 0xb3, 0x88, 0xb6,
+	0x16, 0x57, 0x61, 0xab, 0x47, 0x9a, 0x71, 0x8a, 0x54, 0x79, 0x75, 0x52, 0x14, 0x15, 0x64, 0x9b,
+	0x32, 0x2f, 0x4c, 0x01, 0x39, 0x91, 0xa2, 0x64, 0xe3, 0xfa, 0x4d, 0x0d, 0x8e, 0x29, 0x13, 0x38,
+	0xa5, 0xac, 0xc2, 0xa2, 0x24, 0x4f, 0x87, 0xa1, 0x49, 0xb2, 0xca, 0x15, 0x34, 0xb5, 0x9c, 0x51,
+	0xe4, 0x63, 0xc2, 0xbe, 0xa4, 0x81, 0x91, 0x7d, 0x16, 0x92, 0x52, 0x1a, 0xb9, 0x2f, 0x54, 0xcc,
+	0x73, 0x13, 0xe1, 0x54, 0x22, 0x2c, 0x11, 0x84, 0x9d, 0x8c, 0x98, 0x92, 0x21, 0x40, 0xf2, 0xa6,
+	0xc4, 0x38, 0xa5, 0x18, 0xab, 0x10, 0xe2, 0x6d, 0xca, 0x2f, 0x95, 0xc4, 0x88, 0xee, 0x82, 0xb1,
+	0x0f, 0xc3, 0x4e, 0x24, 0x4c, 0xca, 0x1e, 0x7e, 0xef, 0xc0, 0x83, 0xde, 0x53, 0x18, 0x33, 0xef,
+	0x4e, 0xcc, 0xd3, 0xb9, 0xdf, 0xa7, 0xc3, 0x9b, 0x88, 0xe7, 0x1b, 0x30, 0xcb, 0xc3, 0xe7, 0x53,
+	0x8e, 0xba, 0x54, 0x54, 0x7d, 0xd1, 0x28, 0x25, 0x7b, 0x38, 0x8b, 0x8d, 0x73, 0x35, 0x84, 0x39,
+	0x21, 0x9a, 0xde, 0x38, 0x9d, 0x52, 0x38, 0xe9, 0x38, 0xfb, 0x22, 0x8c, 0x4c, 0xef, 0x5b, 0x27,
+	0x73, 0xf8, 0x4a, 0x3b, 0xc3, 0x48, 0x7f, 0x01, 0xea, 0x62, 0xac, 0x7d, 0x4a, 0x05, 0x2b, 0x22,
+	0xf6, 0xcd, 0x8d, 0x02, 0x08, 0xd9, 0x39, 0x6b, 0x9d, 0x52, 0xa3, 0xe7, 0x8f, 0x23, 0x30, 0x7e,
+	0x76, 0x0c, 0x92, 0xdf, 0xa7, 0x67, 0x0d, 0x36, 0xe5, 0x13, 0x7d, 0xf3, 0xec, 0x24, 0x30, 0x95,
+	0xb1, 0x2a, 0xd1, 0xb3, 0x8d, 0x08, 0x15, 0xdf, 0xd3, 0xe0, 0x48, 0xea, 0xdd, 0x7a, 0x4a, 0x29,
+	0xab, 0x1f, 0xc5, 0x9b, 0x67, 0x8a, 0x81, 0x18, 0xfe, 0xbb, 0x04, 0xff, 0x6d, 0xe3, 0xbc, 0x0a,
+	0x7f, 0x80, 0xd7, 0xf8, 0xdb, 0xd2, 0xfb, 0xf7, 0xc7, 0xaf, 0xb3, 0xc3, 0x85, 0x0a, 0x96, 0xea,
+	0x81, 0x4c, 0x5e, 0x87, 0xb4, 0x1e, 0xc8, 0xcb, 0x13, 0x61, 0x9e, 0x9b, 0x08, 0x37, 0x59, 0x0f,
+	0x20, 0xaf, 0x87, 0xd9, 0xe6, 0xc2, 0x0c, 0x4b, 0xea, 0x90, 0xb2, 0x2e, 0xe5, 0x1c, 0x12, 0xe6,
+	0x9a, 0xfa, 0xa3, 0xea, 0x00, 0x27, 0xe1, 0xe9, 0x8c, 0x06, 0x43, 0x36, 0x43, 0x5f, 0xa1, 0x72,
+	0x92, 0x1a, 0xf3, 0xe6, 0xa4, 0xbb, 0xce, 0x1c, 0x39, 0xc9, 0x19, 0xb1, 0x64, 0x2e, 0x49, 0x94,
+	0xbc, 0x4d, 0xae, 0x5d, 0x1f, 0xb7, 0x78, 0x3a, 0xad, 0x03, 0x98, 0x13, 0x1e, 0x0c, 0xa7, 0xd6,
+	0x6a, 0xf6, 0xd5, 0xb1, 0xb9, 0x9e, 0x0f, 0xa0, 0xf2, 0x4c, 0x2b, 0x71, 0x33, 0x2f, 0x6a, 0x87,
+	0x3a, 0x8d, 0x93, 0x77, 0xc6, 0xb9, 0x67, 0xd9, 0xac, 0xa3, 0x38, 0xfb, 0x38, 0x59, 0x76, 0x78,
+	0x0e, 0x28, 0x80, 0x31, 0x86, 0x86, 0xf4, 0x36, 0xd6, 0xd8, 0x50, 0x70, 0x50, 0x7e, 0x69, 0x6b,
+	0x5a, 0x45, 0x20, 0xaa, 0x93, 0x33, 0x43, 0x26, 0xdb, 0xe7, 0x5f, 0xa4, 0xf6, 0xb9, 0xf4, 0x8c,
+	0x39, 0x6f, 0x1c, 0xe2, 0x7b, 0x5a, 0xf3, 0x4c, 0x31, 0x90, 0x6a, 0x86, 0x55, 0x04, 0x70, 0x6e,
+	0x1b, 0x5f, 0xd7, 0x60, 0x25, 0xe7, 0xad, 0xa8, 0x91, 0xca, 0x8c, 0x5b, 0xf8, 0x10, 0xd5, 0xbc,
+	0x34, 0x1d, 0xb0, 0xca, 0x7e, 0xe3, 0x04, 0x92, 0x17, 0xa8, 0x78, 0x15, 0xfc, 0xb6, 0x06, 0xab,
+	0x79, 0x29, 0x05, 0x8d, 0x4b, 0x8a, 0x7d, 0x38, 0x37, 0xf3, 0xe0, 0x61, 0x2c, 0x94, 0xfc, 0x05,
+	0xca, 0x2e, 0xb7, 0x30, 0x69, 0x7f, 0xac, 0xc1, 0xfa, 0xa4, 0x04, 0x7c, 0xc6, 0x07, 0xf3, 0x49,
+	0xcc, 0x8f, 0x63, 0x3a, 0x0c, 0xa9, 0xec, 0x30, 0x68, 0x6d, 0xe4, 0x91, 0xda, 0xe2, 0xa9, 0xe3,
+	0xe9, 0xf9, 0xa3, 0x16, 0x27, 0x34, 0x36, 0x72, 0xd2, 0x96, 0xab, 0x7d, 0xec, 0x99, 0x3c, 0xc8,
+	0x05, 0x4c, 0xa2, 0x21, 0x84, 0x07, 0xfc, 0xfc, 0x91, 0xce, 0xb7, 0x9d, 0x3a, 0x7f, 0xe4, 0xe4,
+	0x32, 0x37, 0x37, 0x27, 0x40, 0x4d, 0x54, 0xda, 0x7d, 0x37, 0x24, 0x32, 0xf4, 0x39, 0xbc, 0xd7,
+	0xc9, 0xc9, 0x9c, 0xd3, 0x7b, 0x9d, 0x32, 0x1d, 0xb5, 0x79, 0xa6, 0x18, 0x68, 0xa2, 0x89, 0x9d,
+	0xf8, 0x7a, 0x53, 0x5e, 0x1a, 0x1a, 0x65, 0x96, 0xef, 0xa5, 0x91, 0xa2, 0x99, 0xcd, 0xb3, 0x93,
+	0xc0, 0x26, 0x78, 0x69, 0x28, 0x18, 0x26, 0xe3, 0xdb, 0x38, 0x94, 0x5f, 0x1d, 0x48, 0x9b, 0x5a,
+	0xe2, 0xc5, 0x61, 0xc7, 0xe6, 0xa5, 0xe9, 0x80, 0x55, 0x32, 0x9a, 0x21, 0xac, 0x25, 0x44, 0xeb,
+	0x62, 0x22, 0xbf, 0xa9, 0x91, 0xe7, 0x3f, 0xaa, 0x24, 0x5b, 0x29, 0x22, 0x8b, 0x73, 0x8d, 0x99,
+	0x97, 0x0e, 0x93, 0xb7, 0x4b, 0xf6, 0x2f, 0xf2, 0xb5, 0x43, 0x2f, 0xd4, 0x25, 0x89, 0xfe, 0x53,
+	0x3a, 0x95, 0x72, 0x3e, 0xb5, 0xec, 0x54, 0x2a, 0x33, 0xe9, 0x99, 0x67, 0x27, 0x81, 0x31, 0x62,
+	0xb6, 0x08, 0x31, 0xf7, 0x8c, 0x73, 0xb9, 0xab, 0x9a, 0x51, 0xd4, 0x7a, 0x1b, 0x87, 0x05, 0x3f,
+	0x7e, 0x5d, 0xb5, 0x85, 0xa7, 0x40, 0x39, 0xe5, 0x72, 0xe8, 0x6a, 0x96, 0x72, 0x65, 0x30, 0xb2,
+	0x79, 0x76, 0x12, 0xd8, 0x44, 0xca, 0xf9, 0x74, 0x4f, 0x41, 0x79, 0x0a, 0x54, 0xd8, 0x05, 0xb2,
+	0xe1, 0xad, 0xca, 0x5d, 0x20, 0x37, 0x0a, 0xf6, 0xc9, 0xec, 0x02, 0xc2, 0x92, 0x4a, 0x76, 0x81,
+	0xfc, 0xf0, 0x54, 0xe5, 0x2e, 0x30, 0x31, 0x9a, 0xf5, 0xc9, 0xec, 0x02, 0x9c, 0x95, 0xc2, 0x2e,
+	0x70, 0xfd, 0xf7, 0xf5, 0xaf, 0x5f, 0xfb, 0x5d, 0xdd, 0xd8, 0x82, 0x23, 0xf7, 0xae, 0x6d, 0x6d,
+	0x5d, 0xa6, 0xfe, 0xf6, 0xf5, 0x6b, 0xf7, 0xef, 0x58, 0x1f, 0x81, 0x3a, 0xae, 0x5a, 0x67, 0xf9,
+	0x2b, 0x8d, 0xa5, 0xdd, 0x28, 0x1a, 0x86, 0x57, 0x5b, 0xad, 0x81, 0x13, 0x86, 0x1e, 0x8a, 0x9a,
+	0x7e, 0xb0, 0xd3, 0x32, 0x17, 0xbb, 0xbe, 0x17, 0x39, 0xdd, 0xe8, 0x13, 0x42, 0xed, 0xc5, 0x9f,
+	0xba, 0x52, 0x7a, 0xa6, 0xf9, 0xf4, 0x45, 0x4d, 0xbf, 0xb2, 0xe0, 0x0c, 0x87, 0x7d, 0xb7, 0x4b,
+	0xa2, 0x0e, 0x5b, 0x6f, 0x84, 0xbe, 0x77, 0x65, 0x59, 0xac, 0x19, 0x5f, 0xde, 0xf6, 0xfd, 0xcb,
+	0x03, 0x77, 0x80, 0xae, 0x66, 0x20, 0xaf, 0xe6, 0x40, 0xda, 0xa7, 0xa1, 0xf4, 0xc1, 0xa7, 0x9f,
+	0x35, 0x56, 0x61, 0xfe, 0xd3, 0xfe, 0xfa, 0x10, 0x05, 0x03, 0x37, 0x0c, 0x5d, 0xdf, 0x6b, 0x1a,
+	0x55, 0x28, 0xbf, 0xab, 0x6b, 0x33, 0xf6, 0x09, 0x0c, 0xf0, 0x41, 0x63, 0x09, 0xe0, 0xd3, 0x7e,
+	0xb4, 0xbe, 0xed, 0x8f, 0xbc, 0x5e, 0xfc, 0x31, 0x78, 0x0e, 0x4e, 0xa6, 0x46, 0xba, 0x7e, 0xd3,
+	0xef, 0x8e, 0xb0, 0xc6, 0x21, 0x98, 0xd4, 0xe3, 0xec, 0x54, 0x09, 0xf3, 0x9f, 0xfd, 0xbf, 0x01,
+	0x00, 0x6c, 0x07, 0x17, 0xfe, 0xaa, 0x77, 0x00, 0x00,
 }
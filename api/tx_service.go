@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/hex"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -13,9 +14,13 @@ import (
 	"massnet.org/mass-wallet/logging"
 	"massnet.org/mass-wallet/massutil"
 	"massnet.org/mass-wallet/masswallet"
+	"massnet.org/mass-wallet/masswallet/keystore/hdkeychain"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/utils"
 	"massnet.org/mass-wallet/txscript"
 	"massnet.org/mass-wallet/wire"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"golang.org/x/crypto/ripemd160"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/status"
@@ -367,6 +372,184 @@ func (s *APIServer) getStatus(txHash *wire.Hash) (code int32, err error) {
 	}
 }
 
+func (s *APIServer) GetMempoolInfo(ctx context.Context, in *empty.Empty) (*pb.GetMempoolInfoResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetMempoolInfo", logging.LogFormat{})
+
+	txPool := s.node.TxMemPool()
+	descs := txPool.TxDescs()
+	var bytes uint64
+	for _, desc := range descs {
+		bytes += uint64(desc.Tx.MsgTx().PlainSize())
+	}
+	minRelayFee, err := AmountToString(massutil.MinRelayTxFee().IntValue())
+	if err != nil {
+		return nil, status.New(ErrAPIInvalidAmount, ErrCode[ErrAPIInvalidAmount]).Err()
+	}
+	resp := &pb.GetMempoolInfoResponse{
+		Size:        uint32(len(descs)),
+		Bytes:       bytes,
+		MinRelayFee: minRelayFee,
+		Orphans:     uint32(len(txPool.OrphanTxs())),
+	}
+	logging.CPrint(logging.INFO, "api: GetMempoolInfo completed", logging.LogFormat{"response": resp})
+	return resp, nil
+}
+
+func (s *APIServer) GetRawMempool(ctx context.Context, in *pb.GetRawMempoolRequest) (*pb.GetRawMempoolResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetRawMempool", logging.LogFormat{"verbose": in.Verbose})
+
+	txPool := s.node.TxMemPool()
+	descs := txPool.TxDescs()
+	sort.Slice(descs, func(i, j int) bool {
+		return descs[i].Added.Before(descs[j].Added)
+	})
+	resp := &pb.GetRawMempoolResponse{
+		TxIds: make([]string, 0, len(descs)),
+	}
+	for _, desc := range descs {
+		resp.TxIds = append(resp.TxIds, desc.Tx.Hash().String())
+		if !in.Verbose {
+			continue
+		}
+		entry, err := mempoolEntry(txPool, desc)
+		if err != nil {
+			return nil, err
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+	logging.CPrint(logging.INFO, "api: GetRawMempool completed", logging.LogFormat{"size": len(descs)})
+	return resp, nil
+}
+
+func (s *APIServer) GetMempoolEntry(ctx context.Context, in *pb.GetMempoolEntryRequest) (*pb.GetMempoolEntryResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetMempoolEntry", logging.LogFormat{"txid": in.TxId})
+
+	err := checkTransactionIdLen(in.TxId)
+	if err != nil {
+		return nil, err
+	}
+	txHash, err := wire.NewHashFromStr(in.TxId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode the input string into hash", logging.LogFormat{"input string": in.TxId, "error": err})
+		return nil, status.New(ErrAPIInvalidTxId, ErrCode[ErrAPIInvalidTxId]).Err()
+	}
+
+	txPool := s.node.TxMemPool()
+	desc, err := txPool.FetchTxDesc(txHash)
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	ancestors, descendants, err := txPool.FetchTxRelatives(txHash)
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	entry, err := mempoolEntry(txPool, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetMempoolEntryResponse{
+		Entry:       entry,
+		Ancestors:   make([]string, 0, len(ancestors)),
+		Descendants: make([]string, 0, len(descendants)),
+	}
+	sumRelatives := func(relatives []*blockchain.TxDesc, txIds *[]string) (uint64, string, error) {
+		size := entry.Size
+		fees := desc.Fee
+		for _, rel := range relatives {
+			*txIds = append(*txIds, rel.Tx.Hash().String())
+			size += uint64(rel.Tx.MsgTx().PlainSize())
+			if fees, err = fees.Add(rel.Fee); err != nil {
+				return 0, "", err
+			}
+		}
+		feesStr, err := AmountToString(fees.IntValue())
+		return size, feesStr, err
+	}
+	resp.AncestorSize, resp.AncestorFees, err = sumRelatives(ancestors, &resp.Ancestors)
+	if err != nil {
+		return nil, status.New(ErrAPIInvalidAmount, ErrCode[ErrAPIInvalidAmount]).Err()
+	}
+	resp.DescendantSize, resp.DescendantFees, err = sumRelatives(descendants, &resp.Descendants)
+	if err != nil {
+		return nil, status.New(ErrAPIInvalidAmount, ErrCode[ErrAPIInvalidAmount]).Err()
+	}
+	logging.CPrint(logging.INFO, "api: GetMempoolEntry completed", logging.LogFormat{"txid": in.TxId})
+	return resp, nil
+}
+
+func (s *APIServer) EvictMempoolTransaction(ctx context.Context, in *pb.EvictMempoolTransactionRequest) (*pb.EvictMempoolTransactionResponse, error) {
+	logging.CPrint(logging.INFO, "api: EvictMempoolTransaction", logging.LogFormat{"txid": in.TxId})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkTransactionIdLen(in.TxId)
+	if err != nil {
+		return nil, err
+	}
+	txHash, err := wire.NewHashFromStr(in.TxId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode the input string into hash", logging.LogFormat{"input string": in.TxId, "error": err})
+		return nil, status.New(ErrAPIInvalidTxId, ErrCode[ErrAPIInvalidTxId]).Err()
+	}
+
+	evicted, err := mw.EvictTransaction(txHash)
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	resp := &pb.EvictMempoolTransactionResponse{
+		Evicted: make([]string, 0, len(evicted)),
+	}
+	for _, hash := range evicted {
+		resp.Evicted = append(resp.Evicted, hash.String())
+	}
+	logging.CPrint(logging.INFO, "api: EvictMempoolTransaction completed", logging.LogFormat{"response": resp})
+	return resp, nil
+}
+
+// mempoolEntry returns the entry of desc, depending on the transactions in
+// txPool.
+func mempoolEntry(txPool *blockchain.TxPool, desc *blockchain.TxDesc) (*pb.MempoolEntry, error) {
+	msgTx := desc.Tx.MsgTx()
+	size := int64(msgTx.PlainSize())
+	fee, err := AmountToString(desc.Fee.IntValue())
+	if err != nil {
+		return nil, status.New(ErrAPIInvalidAmount, ErrCode[ErrAPIInvalidAmount]).Err()
+	}
+	feeRate, err := AmountToString(desc.Fee.IntValue() * 1000 / size)
+	if err != nil {
+		return nil, status.New(ErrAPIInvalidAmount, ErrCode[ErrAPIInvalidAmount]).Err()
+	}
+	priority, _ := desc.StartingPriority()
+
+	depends := make([]string, 0)
+	seen := make(map[wire.Hash]struct{})
+	for _, txIn := range msgTx.TxIn {
+		prevHash := txIn.PreviousOutPoint.Hash
+		if _, ok := seen[prevHash]; ok {
+			continue
+		}
+		seen[prevHash] = struct{}{}
+		if txPool.IsTransactionInPool(&prevHash) {
+			depends = append(depends, prevHash.String())
+		}
+	}
+	return &pb.MempoolEntry{
+		TxId:             desc.Tx.Hash().String(),
+		Size:             uint64(size),
+		Fee:              fee,
+		FeeRate:          feeRate,
+		Time:             desc.Added.Unix(),
+		Height:           desc.Height,
+		StartingPriority: priority,
+		Depends:          depends,
+		Replaceable:      blockchain.SignalsReplacement(msgTx),
+	}, nil
+}
+
 func (s *APIServer) GetRawTransaction(ctx context.Context, in *pb.GetRawTransactionRequest) (*pb.GetRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetRawTransaction", logging.LogFormat{"txid": in.TxId})
 	err := checkTransactionIdLen(in.TxId)
@@ -470,10 +653,251 @@ func (s *APIServer) DecodeRawTransaction(ctx context.Context, in *pb.DecodeRawTr
 	return resp, nil
 }
 
+func (s *APIServer) CreatePsbt(ctx context.Context, in *pb.CreatePsbtRequest) (*pb.PsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: CreatePsbt", logging.LogFormat{})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serializedTx, err := decodeHexStr(in.Hex)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "decodeHexStr error", logging.LogFormat{
+			"err": err,
+		})
+		st := status.New(ErrAPIInvalidTxHex, ErrCode[ErrAPIInvalidTxHex])
+		return nil, st.Err()
+	}
+	var mtx wire.MsgTx
+	err = mtx.SetBytes(serializedTx, wire.Packet)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "deserialize tx error", logging.LogFormat{"err": err.Error()})
+		st := status.New(ErrAPIInvalidTxHex, ErrCode[ErrAPIInvalidTxHex])
+		return nil, st.Err()
+	}
+
+	packet, err := mw.CreatePsbt(&mtx)
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	resp, err := buildPsbtResponse(packet)
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "api: CreatePsbt completed", logging.LogFormat{})
+	return resp, nil
+}
+
+func (s *APIServer) DecodePsbt(ctx context.Context, in *pb.DecodePsbtRequest) (*pb.DecodePsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: DecodePsbt", logging.LogFormat{})
+
+	packet, err := decodePsbtStr(in.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	txResp, err := s.buildDecodeRawTxResponse(packet.UnsignedTx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "buildDecodeRawTxResponse error",
+			logging.LogFormat{
+				"txid": packet.UnsignedTx.TxHash(),
+				"err":  err,
+			})
+		st := status.New(ErrAPIRawTx, ErrCode[ErrAPIRawTx])
+		return nil, st.Err()
+	}
+
+	resp := &pb.DecodePsbtResponse{
+		Tx:       txResp,
+		Inputs:   make([]*pb.DecodePsbtResponse_Input, 0, len(packet.Inputs)),
+		Outputs:  make([]*pb.DecodePsbtResponse_Output, 0, len(packet.Outputs)),
+		Complete: psbtSignable(packet),
+	}
+	var totalIn int64
+	knownAmounts := true
+	for _, pIn := range packet.Inputs {
+		input := &pb.DecodePsbtResponse_Input{
+			WitnessScript: hex.EncodeToString(pIn.WitnessScript),
+			PartialSigs:   make([]*pb.DecodePsbtResponse_PartialSig, 0, len(pIn.PartialSigs)),
+			Derivations:   psbtDerivations(pIn.Derivations),
+			Finalized:     len(pIn.FinalWitness) != 0,
+		}
+		if pIn.WitnessUtxo != nil {
+			input.Amount, err = AmountToString(pIn.WitnessUtxo.Value)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "Failed to transfer amount to string", logging.LogFormat{"err": err})
+				st := status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt])
+				return nil, st.Err()
+			}
+			totalIn += pIn.WitnessUtxo.Value
+			if pks, err := utils.ParsePkScript(pIn.WitnessUtxo.PkScript, &cfg.ChainParams); err == nil {
+				input.Address = pks.StdEncodeAddress()
+			}
+		} else {
+			knownAmounts = false
+		}
+		if signed, required, err := pIn.Signatures(&cfg.ChainParams); err == nil {
+			input.Signatures = uint32(signed)
+			input.RequiredSignatures = uint32(required)
+		}
+		for _, ps := range pIn.PartialSigs {
+			input.PartialSigs = append(input.PartialSigs, &pb.DecodePsbtResponse_PartialSig{
+				PubKey:    hex.EncodeToString(ps.PubKey),
+				Signature: hex.EncodeToString(ps.Signature),
+			})
+		}
+		resp.Inputs = append(resp.Inputs, input)
+	}
+	for _, pOut := range packet.Outputs {
+		resp.Outputs = append(resp.Outputs, &pb.DecodePsbtResponse_Output{
+			WitnessScript: hex.EncodeToString(pOut.WitnessScript),
+			Derivations:   psbtDerivations(pOut.Derivations),
+		})
+	}
+	if knownAmounts {
+		var totalOut int64
+		for _, txOut := range packet.UnsignedTx.TxOut {
+			totalOut += txOut.Value
+		}
+		resp.Fee, err = AmountToString(totalIn - totalOut)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "Failed to transfer amount to string", logging.LogFormat{"err": err})
+			st := status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt])
+			return nil, st.Err()
+		}
+	}
+
+	logging.CPrint(logging.INFO, "api: DecodePsbt completed", logging.LogFormat{})
+	return resp, nil
+}
+
+func (s *APIServer) CombinePsbt(ctx context.Context, in *pb.CombinePsbtRequest) (*pb.PsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: CombinePsbt", logging.LogFormat{"count": len(in.Psbts)})
+
+	if len(in.Psbts) == 0 {
+		logging.CPrint(logging.ERROR, "no psbt to combine", logging.LogFormat{})
+		st := status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter])
+		return nil, st.Err()
+	}
+	packets := make([]*psbt.Packet, 0, len(in.Psbts))
+	for _, str := range in.Psbts {
+		packet, err := decodePsbtStr(str)
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, packet)
+	}
+	combined, err := psbt.Combine(packets...)
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	resp, err := buildPsbtResponse(combined)
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "api: CombinePsbt completed", logging.LogFormat{})
+	return resp, nil
+}
+
+func (s *APIServer) FinalizePsbt(ctx context.Context, in *pb.FinalizePsbtRequest) (*pb.FinalizePsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: FinalizePsbt", logging.LogFormat{})
+
+	packet, err := decodePsbtStr(in.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	err = psbt.Finalize(packet, &cfg.ChainParams)
+	if err != nil && err != psbt.ErrNotEnoughSignatures {
+		return nil, convertResponseError(err)
+	}
+	str, err := packet.B64Encode()
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	resp := &pb.FinalizePsbtResponse{
+		Psbt:     str,
+		Complete: packet.IsComplete(),
+	}
+	if resp.Complete {
+		tx, err := psbt.Extract(packet)
+		if err != nil {
+			return nil, convertResponseError(err)
+		}
+		resp.Hex, err = messageToHex(tx)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "messageToHex error", logging.LogFormat{"err": err})
+			st := status.New(ErrAPIRawTx, ErrCode[ErrAPIRawTx])
+			return nil, st.Err()
+		}
+	}
+	logging.CPrint(logging.INFO, "api: FinalizePsbt completed", logging.LogFormat{"complete": resp.Complete})
+	return resp, nil
+}
+
+func decodePsbtStr(str string) (*psbt.Packet, error) {
+	packet, err := psbt.B64Decode(str)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode psbt", logging.LogFormat{"err": err})
+		st := status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt])
+		return nil, st.Err()
+	}
+	return packet, nil
+}
+
+func buildPsbtResponse(packet *psbt.Packet) (*pb.PsbtResponse, error) {
+	str, err := packet.B64Encode()
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	return &pb.PsbtResponse{
+		Psbt:     str,
+		Complete: psbtSignable(packet),
+	}, nil
+}
+
+// psbtSignable returns whether every input of the packet is finalized or
+// holds enough signatures to be finalized.
+func psbtSignable(packet *psbt.Packet) bool {
+	for _, pIn := range packet.Inputs {
+		if len(pIn.FinalWitness) != 0 {
+			continue
+		}
+		signed, required, err := pIn.Signatures(&cfg.ChainParams)
+		if err != nil || signed < required {
+			return false
+		}
+	}
+	return true
+}
+
+func psbtDerivations(ds []*psbt.Derivation) []*pb.DecodePsbtResponse_Derivation {
+	result := make([]*pb.DecodePsbtResponse_Derivation, 0, len(ds))
+	for _, d := range ds {
+		path := "m"
+		for _, i := range d.Path {
+			if i >= hdkeychain.HardenedKeyStart {
+				path += "/" + strconv.FormatUint(uint64(i-hdkeychain.HardenedKeyStart), 10) + "'"
+			} else {
+				path += "/" + strconv.FormatUint(uint64(i), 10)
+			}
+		}
+		result = append(result, &pb.DecodePsbtResponse_Derivation{
+			PubKey: hex.EncodeToString(d.PubKey),
+			Path:   path,
+		})
+	}
+	return result
+}
+
 func (s *APIServer) CreateRawTransaction(ctx context.Context, in *pb.CreateRawTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateRawTransaction", logging.LogFormat{"params": in})
 
-	err := checkLocktime(in.LockTime)
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkLocktime(in.LockTime)
 	if err != nil {
 		return nil, err
 	}
@@ -534,7 +958,7 @@ func (s *APIServer) CreateRawTransaction(ctx context.Context, in *pb.CreateRawTr
 		subtractfeefrom[subfrom] = struct{}{}
 	}
 
-	mtxHex, fee, err := s.massWallet.CreateRawTransaction(inputs, amounts, in.LockTime, changeAddr, subtractfeefrom)
+	mtxHex, fee, err := mw.CreateRawTransaction(inputs, amounts, in.LockTime, changeAddr, subtractfeefrom)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "CreateRawTransaction failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -556,6 +980,11 @@ func (s *APIServer) CreateRawTransaction(ctx context.Context, in *pb.CreateRawTr
 func (s *APIServer) CreateStakingTransaction(ctx context.Context, in *pb.CreateStakingTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateStakingTransaction", logging.LogFormat{"params": in})
 
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	val, err := checkParseAmount(in.Amount)
 	if err != nil {
 		return nil, err
@@ -590,7 +1019,12 @@ func (s *APIServer) CreateStakingTransaction(ctx context.Context, in *pb.CreateS
 	}
 	outputs = append(outputs, output)
 
-	mtxHex, fee, err := s.massWallet.CreateStakingTransaction(in.FromAddress, outputs, uint64(0), valFee)
+	cc, err := parseCoinControl(in.IncludeUtxos, in.ExcludeUtxos, in.CoinSelection)
+	if err != nil {
+		return nil, err
+	}
+
+	mtxHex, fee, err := mw.CreateStakingTransaction(in.FromAddress, outputs, uint64(0), valFee, cc)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "AutoCreateStakingTransaction failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -609,11 +1043,69 @@ func (s *APIServer) CreateStakingTransaction(ctx context.Context, in *pb.CreateS
 	return &pb.CreateRawTransactionResponse{Hex: mtxHex}, nil
 }
 
+// Creates a transaction withdrawing expired staking outputs.
+func (s *APIServer) CreateStakingWithdrawTransaction(ctx context.Context, in *pb.CreateStakingWithdrawTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
+	logging.CPrint(logging.INFO, "api: CreateStakingWithdrawTransaction", logging.LogFormat{"params": in})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txIds := make([]*wire.Hash, 0, len(in.Txids))
+	for _, txId := range in.Txids {
+		if err = checkTransactionIdLen(txId); err != nil {
+			return nil, err
+		}
+		txHash, err := wire.NewHashFromStr(txId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to decode the input string into hash", logging.LogFormat{"input string": txId, "error": err})
+			return nil, status.New(ErrAPIInvalidTxId, ErrCode[ErrAPIInvalidTxId]).Err()
+		}
+		txIds = append(txIds, txHash)
+	}
+
+	if len(in.ToAddress) > 0 {
+		_, err = checkWitnessAddress(in.ToAddress, false, &cfg.ChainParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	valFee, err := checkParseAmount(in.Fee)
+	if err != nil {
+		return nil, status.New(ErrAPIUserTxFee, ErrCode[ErrAPIUserTxFee]).Err()
+	}
+
+	mtxHex, fee, err := mw.CreateStakingWithdrawTransaction(txIds, in.ToAddress, valFee)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "CreateStakingWithdrawTransaction failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	err = checkTxFeeLimit(s.config.Config, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: CreateStakingWithdrawTransaction completed", logging.LogFormat{})
+	return &pb.CreateRawTransactionResponse{Hex: mtxHex}, nil
+}
+
 // Creates a binding transaction with utxos that randomly selected.
 func (s *APIServer) CreateBindingTransaction(ctx context.Context, in *pb.CreateBindingTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateBindingTransaction", logging.LogFormat{"params": in})
 
-	err := checkNotEmpty(in.Outputs)
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkNotEmpty(in.Outputs)
 	if err != nil {
 		return nil, err
 	}
@@ -666,7 +1158,7 @@ func (s *APIServer) CreateBindingTransaction(ctx context.Context, in *pb.CreateB
 		outPut = append(outPut, tempBindingOutput)
 	}
 	//construct binding transaction
-	mtxHex, fee, err := s.massWallet.CreateBindingTransaction(in.FromAddress, txFee, outPut)
+	mtxHex, fee, err := mw.CreateBindingTransaction(in.FromAddress, txFee, outPut)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "CreateBindingTransaction failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -685,9 +1177,70 @@ func (s *APIServer) CreateBindingTransaction(ctx context.Context, in *pb.CreateB
 	return &pb.CreateRawTransactionResponse{Hex: mtxHex}, nil
 }
 
+// Creates a transaction withdrawing binding outputs, wholly or partially.
+func (s *APIServer) CreateBindingWithdrawTransaction(ctx context.Context, in *pb.CreateBindingWithdrawTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
+	logging.CPrint(logging.INFO, "api: CreateBindingWithdrawTransaction", logging.LogFormat{"params": in})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.HolderAddress) > 0 {
+		_, err = checkWitnessAddress(in.HolderAddress, false, &cfg.ChainParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(in.BindingAddress) > 0 {
+		_, err = checkPoCPubKeyAddress(in.BindingAddress, &cfg.ChainParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(in.ToAddress) > 0 {
+		_, err = checkWitnessAddress(in.ToAddress, false, &cfg.ChainParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	amount, err := checkParseAmount(in.Amount)
+	if err != nil {
+		return nil, err
+	}
+	txFee, err := checkParseAmount(in.Fee)
+	if err != nil {
+		return nil, status.New(ErrAPIUserTxFee, ErrCode[ErrAPIUserTxFee]).Err()
+	}
+
+	mtxHex, fee, err := mw.CreateBindingWithdrawTransaction(in.HolderAddress, in.BindingAddress, amount, in.ToAddress, txFee)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "CreateBindingWithdrawTransaction failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	err = checkTxFeeLimit(s.config.Config, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: CreateBindingWithdrawTransaction completed", logging.LogFormat{})
+	return &pb.CreateRawTransactionResponse{Hex: mtxHex}, nil
+}
+
 func (s *APIServer) AutoCreateTransaction(ctx context.Context, in *pb.AutoCreateTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: AutoCreateTransaction", logging.LogFormat{"params": in})
 
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := checkLocktime(in.LockTime); err != nil {
 		return nil, err
 	}
@@ -730,7 +1283,26 @@ func (s *APIServer) AutoCreateTransaction(ctx context.Context, in *pb.AutoCreate
 		}
 	}
 
-	mtxHex, fee, err := s.massWallet.AutoCreateRawTransaction(amounts, in.LockTime, txFee, fromAddr, changeAddr)
+	cc, err := parseCoinControl(in.IncludeUtxos, in.ExcludeUtxos, in.CoinSelection)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(in.FeeRate)) > 0 {
+		if !txFee.IsZero() {
+			logging.CPrint(logging.ERROR, "both fee and fee_rate specified", logging.LogFormat{})
+			return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+		}
+		feeRate, err := parseFeeRate(in.FeeRate, s.node.Blockchain().FeeEstimator())
+		if err != nil {
+			return nil, err
+		}
+		if cc == nil {
+			cc = &masswallet.CoinControl{}
+		}
+		cc.FeeRate = &feeRate
+	}
+
+	mtxHex, fee, err := mw.AutoCreateRawTransaction(amounts, in.LockTime, txFee, fromAddr, changeAddr, in.Replaceable, cc)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "AutoCreateRawTransaction failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -752,7 +1324,12 @@ func (s *APIServer) AutoCreateTransaction(ctx context.Context, in *pb.AutoCreate
 func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransactionFeeRequest) (*pb.GetTransactionFeeResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetTransactionFee", logging.LogFormat{"params": in})
 
-	err := checkLocktime(in.LockTime)
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkLocktime(in.LockTime)
 	if err != nil {
 		return nil, err
 	}
@@ -762,7 +1339,7 @@ func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransaction
 		return nil, err
 	}
 
-	if len(s.massWallet.CurrentWallet()) == 0 {
+	if len(mw.CurrentWallet()) == 0 {
 		return nil, convertResponseError(masswallet.ErrNoWalletInUse)
 	}
 
@@ -784,7 +1361,7 @@ func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransaction
 				}
 				gOutputs = append(gOutputs, tempBindingOutput)
 			}
-			_, txFee, err = s.massWallet.EstimateBindingTxFee(gOutputs, 0, txFee, "", "")
+			_, txFee, err = mw.EstimateBindingTxFee(gOutputs, 0, txFee, "", "")
 			if err != nil {
 				logging.CPrint(logging.ERROR, "EstimateBindingTxFee failed", logging.LogFormat{"err": err})
 				cvtErr := convertResponseError(err)
@@ -810,7 +1387,7 @@ func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransaction
 				outputs = append(outputs, output)
 			}
 
-			_, txFee, err = s.massWallet.EstimateStakingTxFee(outputs, uint64(in.LockTime), massutil.ZeroAmount(), "", "")
+			_, txFee, err = mw.EstimateStakingTxFee(outputs, uint64(in.LockTime), massutil.ZeroAmount(), "", "", nil)
 			if err != nil {
 				logging.CPrint(logging.ERROR, "EstimateStakingTxFee failed", logging.LogFormat{"err": err})
 				cvtErr := convertResponseError(err)
@@ -834,7 +1411,7 @@ func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransaction
 			inputs = append(inputs, input)
 		}
 
-		txFee, err = s.massWallet.EstimateManualTxFee(inputs, len(in.Amounts))
+		txFee, err = mw.EstimateManualTxFee(inputs, len(in.Amounts))
 		if err != nil {
 			logging.CPrint(logging.ERROR, "EstimateManualTxFee failed", logging.LogFormat{"err": err})
 			cvtErr := convertResponseError(err)
@@ -856,6 +1433,37 @@ func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransaction
 	return &pb.GetTransactionFeeResponse{Fee: fee}, nil
 }
 
+func (s *APIServer) EstimateFeeRate(ctx context.Context, in *pb.EstimateFeeRateRequest) (*pb.EstimateFeeRateResponse, error) {
+	logging.CPrint(logging.INFO, "api: EstimateFeeRate", logging.LogFormat{"target_blocks": in.TargetBlocks})
+
+	target := int(in.TargetBlocks)
+	if target == 0 {
+		target = blockchain.DefaultFeeEstimateTarget
+	}
+	if target > blockchain.MaxFeeEstimateTarget {
+		logging.CPrint(logging.ERROR, "fee estimate target out of range", logging.LogFormat{
+			"target_blocks": in.TargetBlocks,
+			"max":           blockchain.MaxFeeEstimateTarget,
+		})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+
+	rate, err := s.node.Blockchain().FeeEstimator().EstimateFeeRate(target)
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	rateStr, err := checkFormatAmount(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: EstimateFeeRate completed", logging.LogFormat{"fee_rate": rateStr})
+	return &pb.EstimateFeeRateResponse{
+		FeeRate:      rateStr,
+		TargetBlocks: uint32(target),
+	}, nil
+}
+
 func getEstimateBindingAddress() string {
 	var h [ripemd160.Size]byte
 	esAddr, _ := massutil.NewAddressPubKeyHash(h[:], &config.ChainParams)
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"google.golang.org/grpc/status"
+	pb "massnet.org/mass-wallet/api/proto"
 	"massnet.org/mass-wallet/blockchain"
 	"massnet.org/mass-wallet/config"
 	configpb "massnet.org/mass-wallet/config/pb"
@@ -18,7 +19,10 @@ import (
 	"massnet.org/mass-wallet/massutil/safetype"
 	"massnet.org/mass-wallet/masswallet"
 	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/txmgr"
 	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
 )
 
 const (
@@ -33,10 +37,32 @@ const (
 	LenMnemonicMin = 38
 )
 
+const defaultListTransactionsCount = 50
+
+const defaultSearchAddressTxCount = 50
+
+const maxBindingRequirementAddresses = 1000
+
+// formats of ExportTxHistory
+const (
+	exportFormatJSON = "json"
+	exportFormatCSV  = "csv"
+)
+
 var (
 	apiUnknownError = status.New(ErrAPIUnknownErr, ErrCode[ErrAPIUnknownErr]).Err()
 )
 
+var txCategoryNames = []struct {
+	category txmgr.TxCategory
+	name     string
+}{
+	{txmgr.TxCategorySent, "sent"},
+	{txmgr.TxCategoryReceived, "received"},
+	{txmgr.TxCategoryStaking, "staking"},
+	{txmgr.TxCategoryBinding, "binding"},
+}
+
 // AmountToString converts m(in Maxwell) to the string representation(float, in Mass)
 func AmountToString(m int64) (string, error) {
 	if m > massutil.MaxAmount().IntValue() {
@@ -207,6 +233,28 @@ func checkWitnessAddress(address string, expectLocktime bool, net *config.Params
 	return witAddr, nil
 }
 
+// checkIndexedAddress returns the witness or staking address, which are indexed
+// by the address index of the node.
+func checkIndexedAddress(address string, net *config.Params) (massutil.Address, error) {
+	err := checkAddressLen(address)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := massutil.DecodeAddress(address, net)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode address", logging.LogFormat{
+			"address": address,
+			"err":     err,
+		})
+		return nil, status.New(ErrAPIInvalidAddress, ErrCode[ErrAPIInvalidAddress]).Err()
+	}
+	witAddr, ok := addr.(*massutil.AddressWitnessScriptHash)
+	if !ok || witAddr.WitnessVersion() != 0 {
+		return nil, status.New(ErrAPIInvalidAddress, ErrCode[ErrAPIInvalidAddress]).Err()
+	}
+	return witAddr, nil
+}
+
 // PoC pub key address is P2PKH address
 func checkPoCPubKeyAddress(address string, net *config.Params) (massutil.Address, error) {
 	err := checkAddressLen(address)
@@ -330,11 +378,18 @@ func convertResponseError(err error) error {
 		return status.New(ErrAPIInvalidTxId, ErrCode[ErrAPIInvalidTxId]).Err()
 	case masswallet.ErrInvalidParameter,
 		txscript.ErrFrozenPeriod,
-		blockchain.ErrInvalidStakingTxValue:
+		blockchain.ErrInvalidStakingTxValue,
+		blockchain.ErrUnsupportedBitLength,
+		blockchain.ErrPlotCountOutOfRange:
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidParameter], logging.LogFormat{
 			"err": err,
 		})
 		return status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	case txmgr.ErrInvalidCursor:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidCursor], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidCursor, ErrCode[ErrAPIInvalidCursor]).Err()
 	case keystore.ErrInvalidPassphrase:
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidPassphrase], logging.LogFormat{
 			"err": err,
@@ -377,6 +432,11 @@ func convertResponseError(err error) error {
 			"err": err,
 		})
 		return status.New(ErrAPIWalletUnlocked, ErrCode[ErrAPIWalletUnlocked]).Err()
+	case keystore.ErrWalletLocked:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIWalletLocked], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIWalletLocked, ErrCode[ErrAPIWalletLocked]).Err()
 	case keystore.ErrCoinType:
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIMismatchedKeystoreJson], logging.LogFormat{
 			"err": err,
@@ -414,6 +474,54 @@ func convertResponseError(err error) error {
 			"err": err,
 		})
 		return status.New(ErrAPIInvalidBitSize, ErrCode[ErrAPIInvalidBitSize]).Err()
+	case keystore.ErrInvalidCosignerNumber,
+		keystore.ErrInvalidRequiredSigs:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidMultisigPolicy], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidMultisigPolicy, ErrCode[ErrAPIInvalidMultisigPolicy]).Err()
+	case keystore.ErrInvalidCosignerXpub,
+		keystore.ErrDuplicateCosigner:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidCosignerXpub], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidCosignerXpub, ErrCode[ErrAPIInvalidCosignerXpub]).Err()
+	case keystore.ErrInvalidAccountXpub:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidAccountXpub], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidAccountXpub, ErrCode[ErrAPIInvalidAccountXpub]).Err()
+	case keystore.ErrWatchOnly:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIWatchOnlyWallet], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIWatchOnlyWallet, ErrCode[ErrAPIWatchOnlyWallet]).Err()
+	case masswallet.ErrIncompleteSignature:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIIncompleteSignature], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIIncompleteSignature, ErrCode[ErrAPIIncompleteSignature]).Err()
+	case psbt.ErrInvalidSignature:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPISignRawTx], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPISignRawTx, ErrCode[ErrAPISignRawTx]).Err()
+	case psbt.ErrInvalidMagic,
+		psbt.ErrInvalidFormat,
+		psbt.ErrDuplicateKey,
+		psbt.ErrInputHasWitness,
+		psbt.ErrInputCountMismatch,
+		psbt.ErrOutputCountMismatch,
+		psbt.ErrMismatchedTx,
+		psbt.ErrConflictingScript,
+		psbt.ErrConflictingUtxo,
+		psbt.ErrMissingUtxo,
+		psbt.ErrMissingWitnessScript,
+		psbt.ErrUnsupportedScript:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidPsbt], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt]).Err()
 	case keystore.ErrGapLimit:
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIGapLimit], logging.LogFormat{
 			"err": err,
@@ -448,6 +556,67 @@ func convertResponseError(err error) error {
 			"err": err,
 		})
 		return status.New(ErrAPIDoubleSpend, ErrCode[ErrAPIDoubleSpend]).Err()
+	case masswallet.ErrUnspendableUtxo:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIUnspendable], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIUnspendable, ErrCode[ErrAPIUnspendable]).Err()
+	case masswallet.ErrTxNotUnmined:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPITxNotUnmined], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPITxNotUnmined, ErrCode[ErrAPITxNotUnmined]).Err()
+	case masswallet.ErrTxNotReplaceable:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPITxNotReplaceable], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPITxNotReplaceable, ErrCode[ErrAPITxNotReplaceable]).Err()
+	case masswallet.ErrBumpFeeTooLow,
+		blockchain.ErrReplacementFee:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIReplacementFee], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIReplacementFee, ErrCode[ErrAPIReplacementFee]).Err()
+	case masswallet.ErrNoChangeOutput:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPINoChangeOutput], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPINoChangeOutput, ErrCode[ErrAPINoChangeOutput]).Err()
+	case masswallet.ErrNothingToConsolidate:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPINothingToConsolidate], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPINothingToConsolidate, ErrCode[ErrAPINothingToConsolidate]).Err()
+	case blockchain.ErrFeeEstimateUnavailable:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIFeeEstimate], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIFeeEstimate, ErrCode[ErrAPIFeeEstimate]).Err()
+	case blockchain.ErrTxExsit:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPINotInMempool], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPINotInMempool, ErrCode[ErrAPINotInMempool]).Err()
+	case masswallet.ErrNothingToSweep:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPINothingToSweep], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPINothingToSweep, ErrCode[ErrAPINothingToSweep]).Err()
+	case masswallet.ErrNothingToWithdraw:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPINothingToWithdraw], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPINothingToWithdraw, ErrCode[ErrAPINothingToWithdraw]).Err()
+	case masswallet.ErrStakingNotExpired:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIStakingNotExpired], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIStakingNotExpired, ErrCode[ErrAPIStakingNotExpired]).Err()
+	case keystore.ErrInvalidWIF:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidWIF], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidWIF, ErrCode[ErrAPIInvalidWIF]).Err()
 	default:
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIUnknownErr], logging.LogFormat{
 			"err": err,
@@ -481,6 +650,74 @@ func checkWalletIdLen(walletId string) error {
 	return nil
 }
 
+// parseOutPoint parses an output referenced by the API.
+func parseOutPoint(in *pb.TransactionInput) (*wire.OutPoint, error) {
+	if err := checkTransactionIdLen(in.TxId); err != nil {
+		return nil, err
+	}
+	hash, err := wire.NewHashFromStr(in.TxId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode the input string into hash", logging.LogFormat{"input string": in.TxId, "error": err})
+		return nil, status.New(ErrAPIInvalidTxId, ErrCode[ErrAPIInvalidTxId]).Err()
+	}
+	return wire.NewOutPoint(hash, in.Vout), nil
+}
+
+// parseFeeRate parses a fee rate in MASS per KB. "auto" is estimated for
+// blockchain.DefaultFeeEstimateTarget blocks, or the min relay fee rate if
+// the estimator lacks data.
+func parseFeeRate(feeRate string, estimator *blockchain.FeeEstimator) (massutil.Amount, error) {
+	feeRate = strings.TrimSpace(feeRate)
+	if strings.ToLower(feeRate) != "auto" {
+		rate, err := checkParseAmount(feeRate)
+		if err != nil {
+			return massutil.ZeroAmount(), status.New(ErrAPIUserTxFee, ErrCode[ErrAPIUserTxFee]).Err()
+		}
+		return rate, nil
+	}
+	rate, err := estimator.EstimateFeeRate(blockchain.DefaultFeeEstimateTarget)
+	if err == blockchain.ErrFeeEstimateUnavailable {
+		logging.CPrint(logging.WARN, "fee rate not estimated, using the min relay fee rate", logging.LogFormat{})
+		return massutil.MinRelayTxFee(), nil
+	}
+	if err != nil {
+		return massutil.ZeroAmount(), convertResponseError(err)
+	}
+	return rate, nil
+}
+
+// parseCoinControl returns nil if neither utxos nor selection strategy is
+// specified.
+func parseCoinControl(include, exclude []*pb.TransactionInput, strategy string) (*masswallet.CoinControl, error) {
+	if len(include) == 0 && len(exclude) == 0 && len(strategy) == 0 {
+		return nil, nil
+	}
+	selector, err := masswallet.NewCoinSelector(strategy)
+	if err != nil {
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	cc := &masswallet.CoinControl{
+		Include:  make([]wire.OutPoint, 0, len(include)),
+		Exclude:  make([]wire.OutPoint, 0, len(exclude)),
+		Selector: selector,
+	}
+	for _, in := range include {
+		op, err := parseOutPoint(in)
+		if err != nil {
+			return nil, err
+		}
+		cc.Include = append(cc.Include, *op)
+	}
+	for _, in := range exclude {
+		op, err := parseOutPoint(in)
+		if err != nil {
+			return nil, err
+		}
+		cc.Exclude = append(cc.Exclude, *op)
+	}
+	return cc, nil
+}
+
 func checkTransactionIdLen(txId string) error {
 	if len(txId) != LenTxId {
 		logging.CPrint(logging.ERROR, "", logging.LogFormat{
@@ -526,3 +763,105 @@ func checkRemarksLen(remarks string) string {
 	}
 	return remarks
 }
+
+// hashOrEmpty returns the string of hash, or an empty string for zero hash.
+func hashOrEmpty(hash *wire.Hash) string {
+	if *hash == (wire.Hash{}) {
+		return ""
+	}
+	return hash.String()
+}
+
+func parseTxCategories(names []string) (txmgr.TxCategory, error) {
+	var category txmgr.TxCategory
+	for _, name := range names {
+		found := false
+		for _, c := range txCategoryNames {
+			if strings.ToLower(name) == c.name {
+				category |= c.category
+				found = true
+				break
+			}
+		}
+		if !found {
+			logging.CPrint(logging.ERROR, "invalid tx category", logging.LogFormat{"category": name})
+			return 0, status.New(ErrAPIInvalidTxCategory, ErrCode[ErrAPIInvalidTxCategory]).Err()
+		}
+	}
+	return category, nil
+}
+
+func txCategoryToStrings(category txmgr.TxCategory) []string {
+	names := make([]string, 0)
+	for _, c := range txCategoryNames {
+		if category&c.category != 0 {
+			names = append(names, c.name)
+		}
+	}
+	return names
+}
+
+// signedAmountToString formats m like AmountToString, with a leading "-" if m
+// is negative.
+func signedAmountToString(m int64) (string, error) {
+	if m < 0 {
+		s, err := AmountToString(-m)
+		return "-" + s, err
+	}
+	return AmountToString(m)
+}
+
+func txExportRecordToPb(rec *masswallet.TxExportRecord) (*pb.ExportTxHistoryResponse_Record, error) {
+	amount, err := signedAmountToString(rec.Amount)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := AmountToString(rec.Fee.IntValue())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportTxHistoryResponse_Record{
+		Timestamp:      rec.Time.Unix(),
+		TxId:           rec.TxHash.String(),
+		Category:       rec.Category,
+		Amount:         amount,
+		Fee:            fee,
+		Counterparties: rec.Counterparties,
+		BlockHeight:    rec.BlockHeight,
+	}, nil
+}
+
+func txHistoryToPb(h *txmgr.TxHistory, syncedHeight uint64) (*pb.ListTransactionsResponse_Transaction, error) {
+	received, err := AmountToString(h.Received.IntValue())
+	if err != nil {
+		return nil, err
+	}
+	sent, err := AmountToString(h.Sent.IntValue())
+	if err != nil {
+		return nil, err
+	}
+	net, err := signedAmountToString(h.NetAmount())
+	if err != nil {
+		return nil, err
+	}
+	fee, err := AmountToString(h.Fee.IntValue())
+	if err != nil {
+		return nil, err
+	}
+	var confs uint64
+	if syncedHeight >= h.Block.Height {
+		confs = syncedHeight - h.Block.Height + 1
+	}
+	return &pb.ListTransactionsResponse_Transaction{
+		TxId:          h.TxHash.String(),
+		BlockHeight:   h.Block.Height,
+		BlockHash:     h.Block.Hash.String(),
+		Timestamp:     h.Block.Timestamp.Unix(),
+		Confirmations: confs,
+		Categories:    txCategoryToStrings(h.Category),
+		Received:      received,
+		Sent:          sent,
+		NetAmount:     net,
+		Fee:           fee,
+	}, nil
+}
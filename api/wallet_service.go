@@ -1,11 +1,13 @@
 package api
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"golang.org/x/net/context"
@@ -16,7 +18,9 @@ import (
 	"massnet.org/mass-wallet/consensus"
 	"massnet.org/mass-wallet/logging"
 	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/masswallet"
 	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/txmgr"
 	"massnet.org/mass-wallet/masswallet/utils"
 	"massnet.org/mass-wallet/txscript"
 	"massnet.org/mass-wallet/wire"
@@ -87,6 +91,11 @@ func (s *APIServer) QuitClient(ctx context.Context, in *empty.Empty) (*pb.QuitCl
 func (s *APIServer) SignRawTransaction(ctx context.Context, in *pb.SignRawTransactionRequest) (*pb.SignRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: SignRawTransaction", logging.LogFormat{})
 
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(in.RawTx) == 0 {
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidTxHex], logging.LogFormat{
 			"err": in.RawTx})
@@ -109,10 +118,13 @@ func (s *APIServer) SignRawTransaction(ctx context.Context, in *pb.SignRawTransa
 		return nil, st.Err()
 	}
 
-	err = checkPassLen(in.Passphrase)
-	if err != nil {
-		s.massWallet.ClearUsedUTXOMark(&tx)
-		return nil, err
+	// empty passphrase is allowed when wallet is unlocked
+	if len(in.Passphrase) != 0 {
+		err = checkPassLen(in.Passphrase)
+		if err != nil {
+			mw.ClearUsedUTXOMark(&tx)
+			return nil, err
+		}
 	}
 
 	//check in.Flags
@@ -125,9 +137,9 @@ func (s *APIServer) SignRawTransaction(ctx context.Context, in *pb.SignRawTransa
 
 	logging.CPrint(logging.INFO, "get tx", logging.LogFormat{"tx input count": len(tx.TxIn), "tx output count": len(tx.TxOut)})
 
-	bufBytes, err := s.massWallet.SignRawTx([]byte(in.Passphrase), flag, &tx)
+	bufBytes, err := mw.SignRawTx([]byte(in.Passphrase), flag, &tx)
 	if err != nil {
-		s.massWallet.ClearUsedUTXOMark(&tx)
+		mw.ClearUsedUTXOMark(&tx)
 		return nil, convertResponseError(err)
 	}
 
@@ -139,6 +151,48 @@ func (s *APIServer) SignRawTransaction(ctx context.Context, in *pb.SignRawTransa
 	}, nil
 }
 
+func (s *APIServer) SignPsbt(ctx context.Context, in *pb.SignPsbtRequest) (*pb.PsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: SignPsbt", logging.LogFormat{})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := decodePsbtStr(in.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	// empty passphrase is allowed when wallet is unlocked
+	if len(in.Passphrase) != 0 {
+		err = checkPassLen(in.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	flag := in.Flags
+	if len(flag) == 0 {
+		flag = "ALL"
+	}
+
+	signed, err := mw.SignPsbt([]byte(in.Passphrase), flag, packet)
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	resp, err := buildPsbtResponse(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: SignPsbt completed", logging.LogFormat{
+		"signed":   signed,
+		"complete": resp.Complete,
+	})
+	return resp, nil
+}
+
 func decodeHexStr(hexStr string) ([]byte, error) {
 	if len(hexStr)%2 != 0 {
 		hexStr = "0" + hexStr
@@ -197,6 +251,76 @@ func (s *APIServer) SendRawTransaction(ctx context.Context, in *pb.SendRawTransa
 
 }
 
+func (s *APIServer) BumpFee(ctx context.Context, in *pb.BumpFeeRequest) (*pb.BumpFeeResponse, error) {
+	logging.CPrint(logging.INFO, "api: BumpFee", logging.LogFormat{"txid": in.TxId, "fee": in.Fee})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkTransactionIdLen(in.TxId)
+	if err != nil {
+		return nil, err
+	}
+	txHash, err := wire.NewHashFromStr(in.TxId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode the input string into hash", logging.LogFormat{"input string": in.TxId, "error": err})
+		return nil, status.New(ErrAPIInvalidTxId, ErrCode[ErrAPIInvalidTxId]).Err()
+	}
+	fee, err := checkParseAmount(in.Fee)
+	if err != nil {
+		return nil, status.New(ErrAPIUserTxFee, ErrCode[ErrAPIUserTxFee]).Err()
+	}
+	err = checkTxFeeLimit(s.config.Config, fee)
+	if err != nil {
+		return nil, err
+	}
+	// empty passphrase is allowed when wallet is unlocked
+	if len(in.Passphrase) != 0 {
+		err = checkPassLen(in.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	msgTx, replacedFee, err := mw.BumpFee([]byte(in.Passphrase), txHash, fee)
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+
+	tx := massutil.NewTx(msgTx)
+	_, err = s.node.Blockchain().ProcessTx(tx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ProcessTx failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIRejectTx, ErrCode[ErrAPIRejectTx]).Err()
+		}
+		return nil, cvtErr
+	}
+	mw.MarkUsedUTXO(msgTx)
+
+	replacedFeeStr, err := AmountToString(replacedFee.IntValue())
+	if err != nil {
+		return nil, err
+	}
+	feeStr, err := AmountToString(fee.IntValue())
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: BumpFee completed", logging.LogFormat{
+		"replaced": in.TxId,
+		"txHash":   tx.Hash().String(),
+	})
+	return &pb.BumpFeeResponse{
+		TxId:        tx.Hash().String(),
+		ReplacedFee: replacedFeeStr,
+		Fee:         feeStr,
+	}, nil
+}
+
 func (s *APIServer) GetBlockStakingReward(ctx context.Context, in *pb.GetBlockStakingRewardRequest) (*pb.GetBlockStakingRewardResponse, error) {
 
 	logging.CPrint(logging.INFO, "api: GetBlockStakingReward", logging.LogFormat{"height": in.Height})
@@ -345,12 +469,78 @@ func (s *APIServer) GetBlockStakingReward(ctx context.Context, in *pb.GetBlockSt
 	return reply, nil
 }
 
+func (s *APIServer) EstimateStakingReward(ctx context.Context, in *pb.EstimateStakingRewardRequest) (*pb.EstimateStakingRewardResponse, error) {
+	logging.CPrint(logging.INFO, "api: EstimateStakingReward", logging.LogFormat{"params": in})
+
+	val, err := checkParseAmount(in.Amount)
+	if err != nil {
+		return nil, err
+	}
+	estimate, err := s.node.Blockchain().EstimateStakingReward(val, uint64(in.FrozenPeriod))
+	if err != nil {
+		logging.CPrint(logging.ERROR, "EstimateStakingReward failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	reply := &pb.EstimateStakingRewardResponse{
+		StartHeight:   estimate.StartHeight,
+		EndHeight:     estimate.EndHeight,
+		BlockInterval: estimate.BlockInterval.Seconds(),
+		Projections:   make([]*pb.EstimateStakingRewardResponse_Projection, 0, len(estimate.Projections)),
+	}
+	for _, p := range estimate.Projections {
+		reward, err := checkFormatAmount(p.Reward)
+		if err != nil {
+			return nil, err
+		}
+		reply.Projections = append(reply.Projections, &pb.EstimateStakingRewardResponse_Projection{
+			Height:      p.Height,
+			Blocks:      p.Blocks,
+			Rank:        int32(p.Rank),
+			Weight:      p.Weight.Float64(),
+			TotalWeight: p.TotalWeight.Float64(),
+			Reward:      reward,
+		})
+	}
+	if len(reply.Projections) > 0 {
+		first := reply.Projections[0]
+		reply.Rank, reply.Weight, reply.BlockReward = first.Rank, first.Weight, first.Reward
+	}
+	annual, err := estimate.AnnualReward()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "annual reward error", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+	}
+	if reply.AnnualReward, err = checkFormatAmount(annual); err != nil {
+		return nil, err
+	}
+	reply.Apr = annual.ToMASS() / val.ToMASS() * 100
+	if reply.TotalReward, err = checkFormatAmount(estimate.TotalReward); err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: EstimateStakingReward completed", logging.LogFormat{
+		"rank":        reply.Rank,
+		"projections": len(reply.Projections),
+	})
+	return reply, nil
+}
+
 func (s *APIServer) TxHistory(ctx context.Context, in *pb.TxHistoryRequest) (*pb.TxHistoryResponse, error) {
 	logging.CPrint(logging.INFO, "api: TxHistory", logging.LogFormat{
 		"count":   in.Count,
 		"address": in.Address,
 	})
 
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(in.Address) > 0 {
 		err := checkAddressLen(in.Address)
 		if err != nil {
@@ -361,7 +551,7 @@ func (s *APIServer) TxHistory(ctx context.Context, in *pb.TxHistoryRequest) (*pb
 		return nil, status.New(ErrAPIInvalidTxHistoryCount, ErrCode[ErrAPIInvalidTxHistoryCount]).Err()
 	}
 
-	histories, err := s.massWallet.GetTxHistory(int(in.Count), in.Address)
+	histories, err := mw.GetTxHistory(int(in.Count), in.Address)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetTxHistory failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -381,8 +571,155 @@ func (s *APIServer) TxHistory(ctx context.Context, in *pb.TxHistoryRequest) (*pb
 	return reps, nil
 }
 
+func (s *APIServer) ListTransactions(ctx context.Context, in *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
+	logging.CPrint(logging.INFO, "api: ListTransactions", logging.LogFormat{
+		"cursor":     in.Cursor,
+		"count":      in.Count,
+		"min_height": in.MinHeight,
+		"max_height": in.MaxHeight,
+		"start_time": in.StartTime,
+		"end_time":   in.EndTime,
+		"categories": in.Categories,
+		"ascending":  in.Ascending,
+	})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Count > 1000 {
+		return nil, status.New(ErrAPIInvalidTxHistoryCount, ErrCode[ErrAPIInvalidTxHistoryCount]).Err()
+	}
+	if in.MaxHeight > 0 && in.MinHeight > in.MaxHeight {
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	if in.StartTime < 0 || in.EndTime < 0 || (in.EndTime > 0 && in.StartTime > in.EndTime) {
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	category, err := parseTxCategories(in.Categories)
+	if err != nil {
+		return nil, err
+	}
+	filter := &txmgr.TxHistoryFilter{
+		MinHeight: in.MinHeight,
+		MaxHeight: in.MaxHeight,
+		Category:  category,
+		Ascending: in.Ascending,
+		Limit:     int(in.Count),
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultListTransactionsCount
+	}
+	if in.StartTime > 0 {
+		filter.Since = time.Unix(in.StartTime, 0)
+	}
+	if in.EndTime > 0 {
+		filter.Until = time.Unix(in.EndTime, 0)
+	}
+	if len(in.Cursor) > 0 {
+		filter.Cursor, err = hex.DecodeString(in.Cursor)
+		if err != nil {
+			return nil, status.New(ErrAPIInvalidCursor, ErrCode[ErrAPIInvalidCursor]).Err()
+		}
+	}
+
+	histories, syncedHeight, cursor, err := mw.ListTransactions(filter)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ListTransactions failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	txs := make([]*pb.ListTransactionsResponse_Transaction, 0, len(histories))
+	for _, h := range histories {
+		tx, err := txHistoryToPb(h, syncedHeight)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "txHistoryToPb failed", logging.LogFormat{"err": err, "tx": h.TxHash.String()})
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		txs = append(txs, tx)
+	}
+	resp := &pb.ListTransactionsResponse{
+		Transactions: txs,
+		NextCursor:   hex.EncodeToString(cursor),
+	}
+
+	logging.CPrint(logging.INFO, "api: ListTransactions completed", logging.LogFormat{"num": len(txs)})
+	return resp, nil
+}
+
+func (s *APIServer) ExportTxHistory(ctx context.Context, in *pb.ExportTxHistoryRequest) (*pb.ExportTxHistoryResponse, error) {
+	logging.CPrint(logging.INFO, "api: ExportTxHistory", logging.LogFormat{
+		"start_time": in.StartTime,
+		"end_time":   in.EndTime,
+		"format":     in.Format,
+	})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	format := strings.ToLower(in.Format)
+	if format != "" && format != exportFormatJSON && format != exportFormatCSV {
+		return nil, status.New(ErrAPIInvalidExportFormat, ErrCode[ErrAPIInvalidExportFormat]).Err()
+	}
+	if in.StartTime < 0 || in.EndTime < 0 || (in.EndTime > 0 && in.StartTime > in.EndTime) {
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	var since, until time.Time
+	if in.StartTime > 0 {
+		since = time.Unix(in.StartTime, 0)
+	}
+	if in.EndTime > 0 {
+		until = time.Unix(in.EndTime, 0)
+	}
+
+	records, err := mw.ExportTxHistory(since, until)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ExportTxHistory failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	resp := &pb.ExportTxHistoryResponse{}
+	if format == exportFormatCSV {
+		var buf bytes.Buffer
+		if err = masswallet.WriteTxExportCSV(&buf, records); err != nil {
+			logging.CPrint(logging.ERROR, "WriteTxExportCSV failed", logging.LogFormat{"err": err})
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		resp.Csv = buf.String()
+	} else {
+		resp.Records = make([]*pb.ExportTxHistoryResponse_Record, 0, len(records))
+		for _, rec := range records {
+			r, err := txExportRecordToPb(rec)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "txExportRecordToPb failed", logging.LogFormat{"err": err, "tx": rec.TxHash.String()})
+				return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+			}
+			resp.Records = append(resp.Records, r)
+		}
+	}
+
+	logging.CPrint(logging.INFO, "api: ExportTxHistory completed", logging.LogFormat{"num": len(records)})
+	return resp, nil
+}
+
 func (s *APIServer) GetStakingHistory(ctx context.Context, in *pb.GetStakingHistoryRequest) (*pb.GetStakingHistoryResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetStakingHistory", logging.LogFormat{})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
 	newestHeight := s.node.Blockchain().BestBlockHeight()
 	rewards, err := s.node.Blockchain().GetUnexpiredStakingRank(newestHeight)
 	if err != nil {
@@ -396,7 +733,7 @@ func (s *APIServer) GetStakingHistory(ctx context.Context, in *pb.GetStakingHist
 	if in.Type == "all" {
 		excludeWithdrawn = false
 	}
-	stakingTxs, err := s.massWallet.GetStakingHistory(excludeWithdrawn)
+	stakingTxs, err := mw.GetStakingHistory(excludeWithdrawn)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "Failed to GetStakingHistory from walletDB", logging.LogFormat{
 			"err": err,
@@ -485,9 +822,117 @@ func (s *APIServer) GetStakingHistory(ctx context.Context, in *pb.GetStakingHist
 	return reply, nil
 }
 
+func (s *APIServer) GetStakingRewardHistory(ctx context.Context, in *pb.GetStakingRewardHistoryRequest) (*pb.GetStakingRewardHistoryResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetStakingRewardHistory", logging.LogFormat{
+		"start_time": in.StartTime,
+		"end_time":   in.EndTime,
+	})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.StartTime < 0 || in.EndTime < 0 || (in.EndTime > 0 && in.StartTime > in.EndTime) {
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	var from, until time.Time
+	if in.StartTime > 0 {
+		from = time.Unix(in.StartTime, 0)
+	}
+	if in.EndTime > 0 {
+		until = time.Unix(in.EndTime, 0)
+	}
+
+	days, err := mw.GetStakingRewardHistory(from, until)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "GetStakingRewardHistory failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	resp := &pb.GetStakingRewardHistoryResponse{
+		Days:   make([]*pb.GetStakingRewardHistoryResponse_Day, 0, len(days)),
+		Totals: make([]*pb.GetStakingRewardHistoryResponse_Total, 0),
+	}
+	type rewardTotal struct {
+		blocks uint64
+		reward massutil.Amount
+	}
+	totals := make(map[string]*rewardTotal)
+	addresses := make([]string, 0)
+	for _, day := range days {
+		reward, err := checkFormatAmount(day.Reward)
+		if err != nil {
+			return nil, err
+		}
+		resp.Days = append(resp.Days, &pb.GetStakingRewardHistoryResponse_Day{
+			Date:           day.Date.Format("2006-01-02"),
+			StakingAddress: day.StakingAddress,
+			Blocks:         day.Blocks,
+			FirstHeight:    day.FirstHeight,
+			LastHeight:     day.LastHeight,
+			Reward:         reward,
+		})
+
+		total, ok := totals[day.StakingAddress]
+		if !ok {
+			total = &rewardTotal{reward: massutil.ZeroAmount()}
+			totals[day.StakingAddress] = total
+			addresses = append(addresses, day.StakingAddress)
+		}
+		total.blocks += day.Blocks
+		if total.reward, err = total.reward.Add(day.Reward); err != nil {
+			logging.CPrint(logging.ERROR, "sum of staking rewards overflows", logging.LogFormat{"err": err})
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+	}
+	sort.Strings(addresses)
+	for _, addr := range addresses {
+		total := totals[addr]
+		reward, err := checkFormatAmount(total.reward)
+		if err != nil {
+			return nil, err
+		}
+		avg, err := total.reward.Value().DivInt(int64(total.blocks))
+		if err != nil {
+			logging.CPrint(logging.ERROR, "average staking reward error", logging.LogFormat{"err": err})
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		avgAmount, err := massutil.NewAmount(avg)
+		if err != nil {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		blockReward, err := checkFormatAmount(avgAmount)
+		if err != nil {
+			return nil, err
+		}
+		resp.Totals = append(resp.Totals, &pb.GetStakingRewardHistoryResponse_Total{
+			StakingAddress: addr,
+			Blocks:         total.blocks,
+			Reward:         reward,
+			BlockReward:    blockReward,
+		})
+	}
+
+	logging.CPrint(logging.INFO, "api: GetStakingRewardHistory completed", logging.LogFormat{
+		"days":      len(resp.Days),
+		"addresses": len(resp.Totals),
+	})
+	return resp, nil
+}
+
 func (s *APIServer) CreateAddress(ctx context.Context, in *pb.CreateAddressRequest) (*pb.CreateAddressResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateAddress", logging.LogFormat{"version": in.Version})
 
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	addressClass := uint16(in.Version)
 	if !massutil.IsValidAddressClass(addressClass) {
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidVersion], logging.LogFormat{
@@ -497,7 +942,7 @@ func (s *APIServer) CreateAddress(ctx context.Context, in *pb.CreateAddressReque
 		return nil, st.Err()
 	}
 
-	ads, err := s.massWallet.GetAddresses(addressClass)
+	ads, err := mw.GetAddresses(addressClass)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetAddresses failed", logging.LogFormat{"err": err})
 		return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
@@ -518,7 +963,7 @@ func (s *APIServer) CreateAddress(ctx context.Context, in *pb.CreateAddressReque
 		return nil, status.New(ErrAPIUnusedAddressLimit, ErrCode[ErrAPIUnusedAddressLimit]).Err()
 	}
 
-	address, err := s.massWallet.NewAddress(addressClass)
+	address, err := mw.NewAddress(addressClass)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "new address error", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -534,6 +979,11 @@ func (s *APIServer) CreateAddress(ctx context.Context, in *pb.CreateAddressReque
 
 func (s *APIServer) GetAddresses(ctx context.Context, in *pb.GetAddressesRequest) (*pb.GetAddressesResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetAddresses", logging.LogFormat{"version": in.Version})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
 	addressClass := uint16(in.Version)
 	if !massutil.IsValidAddressClass(addressClass) {
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidVersion], logging.LogFormat{
@@ -542,7 +992,7 @@ func (s *APIServer) GetAddresses(ctx context.Context, in *pb.GetAddressesRequest
 		return nil, status.New(ErrAPIInvalidVersion, ErrCode[ErrAPIInvalidVersion]).Err()
 	}
 
-	ads, err := s.massWallet.GetAddresses(addressClass)
+	ads, err := mw.GetAddresses(addressClass)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetAddresses failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -574,14 +1024,19 @@ func (s *APIServer) GetAddresses(ctx context.Context, in *pb.GetAddressesRequest
 
 func (s *APIServer) ValidateAddress(ctx context.Context, in *pb.ValidateAddressRequest) (*pb.ValidateAddressResponse, error) {
 	logging.CPrint(logging.INFO, "api: ValidateAddress", logging.LogFormat{"address": in.Address})
-	err := checkAddressLen(in.Address)
+
+	mw, err := s.wallet(ctx)
 	if err != nil {
 		return nil, err
 	}
-
-	witAddr, isMine, err := s.massWallet.IsAddressInCurrent(in.Address)
+	err = checkAddressLen(in.Address)
 	if err != nil {
-		logging.CPrint(logging.ERROR, "failed to check validate address", logging.LogFormat{
+		return nil, err
+	}
+
+	witAddr, isMine, err := mw.IsAddressInCurrent(in.Address)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to check validate address", logging.LogFormat{
 			"err":     err,
 			"address": in.Address,
 		})
@@ -612,6 +1067,11 @@ func (s *APIServer) ValidateAddress(ctx context.Context, in *pb.ValidateAddressR
 func (s *APIServer) GetWalletBalance(ctx context.Context, in *pb.GetWalletBalanceRequest) (*pb.GetWalletBalanceResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetWalletBalance", logging.LogFormat{"params": in})
 
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// uint32 is the set of all unsigned 32-bit integers.
 	// Range: 0 through 4294967295. (in.RequiredConfirmations int32)
 	// int32 is the set of all signed 32-bit integers.
@@ -623,7 +1083,7 @@ func (s *APIServer) GetWalletBalance(ctx context.Context, in *pb.GetWalletBalanc
 		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
 	}
 
-	bal, err := s.massWallet.WalletBalance(uint32(in.RequiredConfirmations), in.Detail)
+	bal, err := mw.WalletBalance(uint32(in.RequiredConfirmations), in.Detail)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "WalletBalance failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -664,6 +1124,11 @@ func (s *APIServer) GetWalletBalance(ctx context.Context, in *pb.GetWalletBalanc
 func (s *APIServer) GetAddressBalance(ctx context.Context, in *pb.GetAddressBalanceRequest) (*pb.GetAddressBalanceResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetAddressBalance", logging.LogFormat{"params": in})
 
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if in.RequiredConfirmations < 0 {
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidParameter], logging.LogFormat{
 			"confs": in.RequiredConfirmations,
@@ -678,7 +1143,7 @@ func (s *APIServer) GetAddressBalance(ctx context.Context, in *pb.GetAddressBala
 		}
 	}
 
-	bals, err := s.massWallet.AddressBalance(uint32(in.RequiredConfirmations), in.Addresses)
+	bals, err := mw.AddressBalance(uint32(in.RequiredConfirmations), in.Addresses)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "AddressBalance failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -770,10 +1235,15 @@ func (s *APIServer) Wallets(ctx context.Context, in *empty.Empty) (*pb.WalletsRe
 	}
 	for _, summary := range summaries {
 		ws := &pb.WalletsResponse_WalletSummary{
-			WalletId: summary.WalletID,
-			Type:     summary.Type,
-			Version:  uint32(summary.Version),
-			Remarks:  summary.Remarks,
+			WalletId:  summary.WalletID,
+			Type:      summary.Type,
+			Version:   uint32(summary.Version),
+			Remarks:   summary.Remarks,
+			WatchOnly: summary.WatchOnly,
+		}
+		if summary.Multisig != nil {
+			ws.RequiredSignatures = uint32(summary.Multisig.RequiredSigs)
+			ws.TotalSigners = uint32(len(summary.Multisig.CosignerXpubs) + 1)
 		}
 		switch {
 		case summary.Status.IsRemoved():
@@ -798,6 +1268,11 @@ func (s *APIServer) Wallets(ctx context.Context, in *empty.Empty) (*pb.WalletsRe
 func (s *APIServer) GetUtxo(ctx context.Context, in *pb.GetUtxoRequest) (*pb.GetUtxoResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetUtxo", logging.LogFormat{"addresses": in.Addresses})
 
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, addr := range in.Addresses {
 		err := checkAddressLen(addr)
 		if err != nil {
@@ -805,7 +1280,7 @@ func (s *APIServer) GetUtxo(ctx context.Context, in *pb.GetUtxoRequest) (*pb.Get
 		}
 	}
 
-	m, err := s.massWallet.GetUtxo(in.Addresses)
+	m, err := mw.GetUtxo(in.Addresses)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetUtxo failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -830,6 +1305,8 @@ func (s *APIServer) GetUtxo(ctx context.Context, in *pb.GetUtxoRequest) (*pb.Get
 				Maturity:       item.Maturity,
 				Confirmations:  item.Confirmations,
 				SpentByUnmined: item.SpentByUnmined,
+				Frozen:         item.Frozen,
+				Label:          item.Label,
 			})
 		}
 		list = append(list, &pb.AddressUTXO{
@@ -844,6 +1321,190 @@ func (s *APIServer) GetUtxo(ctx context.Context, in *pb.GetUtxoRequest) (*pb.Get
 	}, nil
 }
 
+func (s *APIServer) SetUtxoFrozen(ctx context.Context, in *pb.SetUtxoFrozenRequest) (*pb.SetUtxoFrozenResponse, error) {
+	logging.CPrint(logging.INFO, "api: SetUtxoFrozen", logging.LogFormat{"params": in})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkNotEmpty(in.Utxos)
+	if err != nil {
+		return nil, err
+	}
+	ops := make([]wire.OutPoint, 0, len(in.Utxos))
+	for _, utxo := range in.Utxos {
+		op, err := parseOutPoint(utxo)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, *op)
+	}
+
+	err = mw.SetUtxoFrozen(ops, in.Frozen)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "SetUtxoFrozen failed", logging.LogFormat{"err": err})
+		return nil, convertResponseError(err)
+	}
+
+	logging.CPrint(logging.INFO, "api: SetUtxoFrozen completed", logging.LogFormat{})
+	return &pb.SetUtxoFrozenResponse{Ok: true}, nil
+}
+
+func (s *APIServer) SetUtxoLabel(ctx context.Context, in *pb.SetUtxoLabelRequest) (*pb.SetUtxoLabelResponse, error) {
+	logging.CPrint(logging.INFO, "api: SetUtxoLabel", logging.LogFormat{"params": in})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := parseOutPoint(&pb.TransactionInput{TxId: in.TxId, Vout: in.Vout})
+	if err != nil {
+		return nil, err
+	}
+
+	err = mw.SetUtxoLabel(op, in.Label)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "SetUtxoLabel failed", logging.LogFormat{"err": err})
+		return nil, convertResponseError(err)
+	}
+
+	logging.CPrint(logging.INFO, "api: SetUtxoLabel completed", logging.LogFormat{})
+	return &pb.SetUtxoLabelResponse{Ok: true}, nil
+}
+
+func (s *APIServer) ConsolidateUtxos(ctx context.Context, in *pb.ConsolidateUtxosRequest) (*pb.ConsolidateUtxosResponse, error) {
+	logging.CPrint(logging.INFO, "api: ConsolidateUtxos", logging.LogFormat{"params": in})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, addr := range in.Addresses {
+		if _, err := checkWitnessAddress(addr, false, &cfg.ChainParams); err != nil {
+			return nil, err
+		}
+	}
+	toAddr := strings.TrimSpace(in.ToAddress)
+	if len(toAddr) > 0 {
+		if _, err := checkWitnessAddress(toAddr, false, &cfg.ChainParams); err != nil {
+			return nil, err
+		}
+	}
+	feeRate, err := checkParseAmount(in.FeeRate)
+	if err != nil {
+		return nil, status.New(ErrAPIUserTxFee, ErrCode[ErrAPIUserTxFee]).Err()
+	}
+
+	txs, err := mw.ConsolidateUtxos(in.Addresses, int(in.MaxInputs), feeRate, toAddr, in.DryRun)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ConsolidateUtxos failed", logging.LogFormat{"err": err})
+		return nil, convertResponseError(err)
+	}
+
+	resp := &pb.ConsolidateUtxosResponse{
+		Transactions: make([]*pb.ConsolidateUtxosResponse_Transaction, 0, len(txs)),
+	}
+	totalFee := massutil.ZeroAmount()
+	for _, tx := range txs {
+		if err = checkTxFeeLimit(s.config.Config, tx.Fee); err != nil {
+			if !in.DryRun {
+				for _, tx := range txs {
+					mw.ClearUsedUTXOMark(tx.MsgTx)
+				}
+			}
+			return nil, err
+		}
+		item := &pb.ConsolidateUtxosResponse_Transaction{
+			Inputs: uint32(len(tx.MsgTx.TxIn)),
+		}
+		if !in.DryRun {
+			if item.Hex, err = messageToHex(tx.MsgTx); err != nil {
+				return nil, err
+			}
+		}
+		if item.Amount, err = checkFormatAmount(tx.Amount); err != nil {
+			return nil, err
+		}
+		if item.Fee, err = checkFormatAmount(tx.Fee); err != nil {
+			return nil, err
+		}
+		if totalFee, err = totalFee.Add(tx.Fee); err != nil {
+			return nil, err
+		}
+		resp.Transactions = append(resp.Transactions, item)
+		resp.TotalInputs += item.Inputs
+	}
+	if resp.TotalFee, err = checkFormatAmount(totalFee); err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: ConsolidateUtxos completed", logging.LogFormat{
+		"transactions": len(txs),
+		"dry_run":      in.DryRun,
+	})
+	return resp, nil
+}
+
+func (s *APIServer) SweepPrivateKey(ctx context.Context, in *pb.SweepPrivateKeyRequest) (*pb.SweepPrivateKeyResponse, error) {
+	logging.CPrint(logging.INFO, "api: SweepPrivateKey", logging.LogFormat{"fee_rate": in.FeeRate})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wif := strings.TrimSpace(in.Wif)
+	if len(wif) == 0 {
+		return nil, status.New(ErrAPIInvalidWIF, ErrCode[ErrAPIInvalidWIF]).Err()
+	}
+	feeRate, err := checkParseAmount(in.FeeRate)
+	if err != nil {
+		return nil, status.New(ErrAPIUserTxFee, ErrCode[ErrAPIUserTxFee]).Err()
+	}
+
+	swept, err := mw.SweepPrivateKey(wif, feeRate)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "SweepPrivateKey failed", logging.LogFormat{"err": err})
+		return nil, convertResponseError(err)
+	}
+	if err = checkTxFeeLimit(s.config.Config, swept.Fee); err != nil {
+		return nil, err
+	}
+
+	tx := massutil.NewTx(swept.MsgTx)
+	_, err = s.node.Blockchain().ProcessTx(tx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ProcessTx failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIRejectTx, ErrCode[ErrAPIRejectTx]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	resp := &pb.SweepPrivateKeyResponse{
+		TxId:    tx.Hash().String(),
+		Address: swept.Address,
+		Inputs:  uint32(len(swept.MsgTx.TxIn)),
+	}
+	if resp.Amount, err = checkFormatAmount(swept.Amount); err != nil {
+		return nil, err
+	}
+	if resp.Fee, err = checkFormatAmount(swept.Fee); err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: SweepPrivateKey completed", logging.LogFormat{
+		"txHash":  resp.TxId,
+		"address": resp.Address,
+	})
+	return resp, nil
+}
+
 func (s *APIServer) ImportWallet(ctx context.Context, in *pb.ImportWalletRequest) (*pb.ImportWalletResponse, error) {
 	logging.CPrint(logging.INFO, "api: ImportWallet", logging.LogFormat{})
 	err := checkPassLen(in.Passphrase)
@@ -921,6 +1582,101 @@ func (s *APIServer) ImportMnemonic(ctx context.Context, in *pb.ImportMnemonicReq
 	}, nil
 }
 
+func (s *APIServer) ImportMultisigWallet(ctx context.Context, in *pb.ImportMultisigWalletRequest) (*pb.ImportWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: ImportMultisigWallet",
+		logging.LogFormat{
+			"remarks":             in.Remarks,
+			"required signatures": in.RequiredSignatures,
+			"cosigners":           len(in.CosignerXpubs),
+		})
+
+	err := checkMnemonicLen(in.Mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	remarks := checkRemarksLen(in.Remarks)
+
+	err = checkPassLen(in.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &keystore.WalletParams{
+		Mnemonic:          in.Mnemonic,
+		PrivatePassphrase: []byte(in.Passphrase),
+		Remarks:           remarks,
+		ExternalIndex:     in.ExternalIndex,
+		InternalIndex:     in.InternalIndex,
+		AddressGapLimit:   s.config.Advanced.AddressGapLimit,
+		Multisig: &keystore.MultisigParams{
+			RequiredSigs:  int(in.RequiredSignatures),
+			CosignerXpubs: in.CosignerXpubs,
+		},
+	}
+	ws, err := s.massWallet.ImportWalletWithMnemonic(params)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ImportWalletWithMnemonic failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: ImportMultisigWallet completed",
+		logging.LogFormat{
+			"wallet id": ws.WalletID,
+		})
+	return &pb.ImportWalletResponse{
+		Ok:       true,
+		WalletId: ws.WalletID,
+		Type:     ws.Type,
+		Version:  uint32(ws.Version),
+		Remarks:  ws.Remarks,
+	}, nil
+}
+
+func (s *APIServer) ImportWatchOnlyWallet(ctx context.Context, in *pb.ImportWatchOnlyWalletRequest) (*pb.ImportWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: ImportWatchOnlyWallet", logging.LogFormat{"remarks": in.Remarks})
+
+	if len(in.Xpub) == 0 {
+		logging.CPrint(logging.ERROR, "xpub is empty", logging.LogFormat{})
+		return nil, status.New(ErrAPIInvalidAccountXpub, ErrCode[ErrAPIInvalidAccountXpub]).Err()
+	}
+
+	remarks := checkRemarksLen(in.Remarks)
+
+	params := &keystore.WalletParams{
+		AccountXpub:     in.Xpub,
+		Remarks:         remarks,
+		ExternalIndex:   in.ExternalIndex,
+		InternalIndex:   in.InternalIndex,
+		AddressGapLimit: s.config.Advanced.AddressGapLimit,
+	}
+	ws, err := s.massWallet.ImportWatchOnlyWallet(params)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ImportWatchOnlyWallet failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: ImportWatchOnlyWallet completed",
+		logging.LogFormat{
+			"wallet id": ws.WalletID,
+		})
+	return &pb.ImportWalletResponse{
+		Ok:       true,
+		WalletId: ws.WalletID,
+		Type:     ws.Type,
+		Version:  uint32(ws.Version),
+		Remarks:  ws.Remarks,
+	}, nil
+}
+
 func (s *APIServer) CreateWallet(ctx context.Context, in *pb.CreateWalletRequest) (*pb.CreateWalletResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateWallet",
 		logging.LogFormat{
@@ -990,9 +1746,12 @@ func (s *APIServer) RemoveWallet(ctx context.Context, in *pb.RemoveWalletRequest
 		return nil, err
 	}
 
-	err = checkPassLen(in.Passphrase)
-	if err != nil {
-		return nil, err
+	// passphrase is not required by a watch-only wallet
+	if len(in.Passphrase) != 0 {
+		err = checkPassLen(in.Passphrase)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = s.massWallet.RemoveWallet(in.WalletId, in.Passphrase)
@@ -1011,6 +1770,62 @@ func (s *APIServer) RemoveWallet(ctx context.Context, in *pb.RemoveWalletRequest
 	}, nil
 }
 
+func (s *APIServer) SubscribeWalletEvents(in *pb.SubscribeWalletEventsRequest, stream pb.ApiService_SubscribeWalletEventsServer) error {
+	logging.CPrint(logging.INFO, "api: SubscribeWalletEvents", logging.LogFormat{"walletId": in.WalletId})
+
+	if len(in.WalletId) > 0 {
+		err := checkWalletIdLen(in.WalletId)
+		if err != nil {
+			return err
+		}
+	}
+
+	mw, err := s.wallet(stream.Context())
+	if err != nil {
+		return err
+	}
+	sub, err := mw.SubscribeWalletEvents(in.WalletId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "SubscribeWalletEvents failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return cvtErr
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			logging.CPrint(logging.INFO, "api: SubscribeWalletEvents completed", logging.LogFormat{"walletId": in.WalletId})
+			return nil
+		case ev, ok := <-sub.Events():
+			if !ok {
+				return status.New(ErrAPIEventsOverflow, ErrCode[ErrAPIEventsOverflow]).Err()
+			}
+			err = stream.Send(&pb.WalletEvent{
+				Type:         ev.Type.String(),
+				WalletId:     ev.WalletID,
+				TxId:         hashOrEmpty(&ev.TxHash),
+				BlockHash:    hashOrEmpty(&ev.BlockHash),
+				Height:       ev.Height,
+				SyncedHeight: ev.SyncedHeight,
+				BestHeight:   ev.BestHeight,
+			})
+			if err != nil {
+				logging.CPrint(logging.WARN, "failed to send wallet event", logging.LogFormat{"err": err})
+				return err
+			}
+			// the subscription is useless once the wallet is removed
+			if ev.Type == masswallet.EventRemoveFinished {
+				logging.CPrint(logging.INFO, "api: SubscribeWalletEvents completed", logging.LogFormat{"walletId": ev.WalletID})
+				return nil
+			}
+		}
+	}
+}
+
 /* func (s *APIServer) ChangePrivPassphrase(ctx context.Context, in *pb.ChangePrivPassphraseRequest) (*pb.ChangePrivPassphraseResponse, error) {
 	logging.CPrint(logging.INFO, "api: ChangePrivPassphrase")
 
@@ -1090,14 +1905,76 @@ func (s *APIServer) GetAddressBinding(ctx context.Context, in *pb.GetAddressBind
 	}, nil
 }
 
+func (s *APIServer) CheckBindingRequirement(ctx context.Context, in *pb.CheckBindingRequirementRequest) (*pb.CheckBindingRequirementResponse, error) {
+	logging.CPrint(logging.INFO, "api: CheckBindingRequirement", logging.LogFormat{
+		"addresses":  in.PocAddresses,
+		"bit_length": in.BitLength,
+		"plot_count": in.PlotCount,
+	})
+
+	if len(in.PocAddresses) == 0 || len(in.PocAddresses) > maxBindingRequirementAddresses {
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	plotCount := in.PlotCount
+	if plotCount == 0 {
+		plotCount = 1
+	}
+
+	resp := &pb.CheckBindingRequirementResponse{
+		BitLength:    in.BitLength,
+		PlotCount:    plotCount,
+		Requirements: make([]*pb.CheckBindingRequirementResponse_Requirement, 0, len(in.PocAddresses)),
+	}
+	for _, addr := range in.PocAddresses {
+		witnessAddr, err := checkPoCPubKeyAddress(addr, &cfg.ChainParams)
+		if err != nil {
+			return nil, err
+		}
+		req, err := s.node.Blockchain().CheckBindingRequirement(witnessAddr.ScriptAddress(), int(in.BitLength), plotCount)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "CheckBindingRequirement failed", logging.LogFormat{
+				"err":     err,
+				"address": addr,
+			})
+			cvtErr := convertResponseError(err)
+			if cvtErr == apiUnknownError {
+				return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+			}
+			return nil, cvtErr
+		}
+		r := &pb.CheckBindingRequirementResponse_Requirement{PocAddress: addr}
+		if r.Required, err = checkFormatAmount(req.Required); err != nil {
+			return nil, err
+		}
+		if r.Current, err = checkFormatAmount(req.Current); err != nil {
+			return nil, err
+		}
+		if r.Mature, err = checkFormatAmount(req.Mature); err != nil {
+			return nil, err
+		}
+		if r.Shortfall, err = checkFormatAmount(req.Shortfall); err != nil {
+			return nil, err
+		}
+		resp.Requirements = append(resp.Requirements, r)
+	}
+
+	logging.CPrint(logging.INFO, "api: CheckBindingRequirement completed", logging.LogFormat{})
+	return resp, nil
+}
+
 func (s *APIServer) GetBindingHistory(ctx context.Context, in *pb.GetBindingHistoryRequest) (*pb.GetBindingHistoryResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetBindingHistory", logging.LogFormat{})
 
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	excludeWithdrawn := true
 	if in.Type == "all" {
 		excludeWithdrawn = false
 	}
-	details, err := s.massWallet.GetBindingHistory(excludeWithdrawn)
+	details, err := mw.GetBindingHistory(excludeWithdrawn)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetBindingHistory failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -1221,3 +2098,84 @@ func (s *APIServer) GetWalletMnemonic(ctw context.Context, in *pb.GetWalletMnemo
 		Version:  uint32(version),
 	}, nil
 }
+
+func (s *APIServer) UnlockWallet(ctx context.Context, in *pb.UnlockWalletRequest) (*pb.UnlockWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: UnlockWallet", logging.LogFormat{"timeout": in.Timeout})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkPassLen(in.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Timeout == 0 {
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidTimeout], logging.LogFormat{"timeout": in.Timeout})
+		return nil, status.New(ErrAPIInvalidTimeout, ErrCode[ErrAPIInvalidTimeout]).Err()
+	}
+
+	err = mw.UnlockWallet(in.Passphrase, time.Duration(in.Timeout)*time.Second)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "UnlockWallet failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: UnlockWallet completed", logging.LogFormat{})
+	return &pb.UnlockWalletResponse{
+		Ok: true,
+	}, nil
+}
+
+func (s *APIServer) LockWallet(ctx context.Context, in *empty.Empty) (*pb.LockWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: LockWallet", logging.LogFormat{})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mw.LockWallet()
+
+	logging.CPrint(logging.INFO, "api: LockWallet completed", logging.LogFormat{})
+	return &pb.LockWalletResponse{
+		Ok: true,
+	}, nil
+}
+
+func (s *APIServer) GetWalletXpub(ctx context.Context, in *empty.Empty) (*pb.GetWalletXpubResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetWalletXpub", logging.LogFormat{})
+
+	mw, err := s.wallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	xpub, multisig, err := mw.AccountXpub()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "AccountXpub failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	resp := &pb.GetWalletXpubResponse{
+		WalletId: mw.CurrentWallet(),
+		Xpub:     xpub,
+	}
+	if multisig != nil {
+		resp.RequiredSignatures = uint32(multisig.RequiredSigs)
+		resp.CosignerXpubs = multisig.CosignerXpubs
+	}
+
+	logging.CPrint(logging.INFO, "api: GetWalletXpub completed", logging.LogFormat{})
+	return resp, nil
+}
@@ -0,0 +1,229 @@
+package blockchain
+
+import (
+	"bytes"
+	"sort"
+
+	"massnet.org/mass-wallet/database"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+// AddressTx is a transaction of main chain paying an address or spending its
+// outputs.
+type AddressTx struct {
+	Tx       *wire.MsgTx
+	Height   uint64
+	Received int64 // total value of outputs paying the address
+	Sent     int64 // total value of outputs of the address spent by inputs
+}
+
+// AddressUtxo is an unspent output of main chain paying an address.
+type AddressUtxo struct {
+	OutPoint   wire.OutPoint
+	Value      int64
+	Height     uint64
+	IsCoinbase bool
+}
+
+// addressTxLoc is the location of a transaction in the address index.
+type addressTxLoc struct {
+	height uint64
+	loc    *wire.TxLoc
+}
+
+// FetchAddressTxs returns the transactions of main chain related to addr
+// within the heights [start, stop], ordered by height and position in block,
+// or the reverse if not ascending. The first skip transactions are skipped and
+// no more than count are returned, or all if count is 0.
+//
+// It also returns the number of transactions of addr within the heights. The
+// address index maps the witness and the staking addresses of a redeem script
+// to the same script hash, so every transaction indexed within the heights is
+// read to tell those of addr from those of the other address.
+func (chain *Blockchain) FetchAddressTxs(addr massutil.Address, start, stop uint64, skip, count int,
+	ascending bool) ([]*AddressTx, int, error) {
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, 0, err
+	}
+	locs, err := chain.fetchAddressTxLocs(addr, start, stop)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !ascending {
+		for i, j := 0, len(locs)-1; i < j; i, j = i+1, j-1 {
+			locs[i], locs[j] = locs[j], locs[i]
+		}
+	}
+
+	txs := make([]*AddressTx, 0)
+	total := 0
+	prevTxs := make(map[wire.Hash]*wire.MsgTx)
+	for _, loc := range locs {
+		atx, err := chain.fetchAddressTx(pkScript, loc, prevTxs)
+		if err != nil {
+			return nil, 0, err
+		}
+		if atx == nil {
+			continue
+		}
+		total++
+		if total > skip && (count == 0 || len(txs) < count) {
+			txs = append(txs, atx)
+		}
+	}
+	return txs, total, nil
+}
+
+// FetchAddressUtxos returns the unspent outputs of main chain paying addr
+// within the heights [start, stop], ordered by height and position in block.
+// The outputs may be spent by transactions above stop, so all transactions
+// indexed for addr from start up to the best height are read.
+func (chain *Blockchain) FetchAddressUtxos(addr massutil.Address, start, stop uint64) ([]*AddressUtxo, error) {
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+	locs, err := chain.fetchAddressTxLocs(addr, start, chain.BestBlockHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	// Outputs of addr are spent by transactions indexed for addr as well,
+	// so the indexed transactions alone tell the unspent outputs.
+	utxos := make([]*AddressUtxo, 0)
+	spent := make(map[wire.OutPoint]struct{})
+	for _, loc := range locs {
+		mtx, err := chain.db.FetchTxByLoc(loc.height, loc.loc.TxStart, loc.loc.TxLen)
+		if err != nil {
+			return nil, err
+		}
+		isCoinbase := IsCoinBaseTx(mtx)
+		if !isCoinbase {
+			for _, txIn := range mtx.TxIn {
+				spent[txIn.PreviousOutPoint] = struct{}{}
+			}
+		}
+		if loc.height > stop {
+			continue
+		}
+		txHash := mtx.TxHash()
+		for i, txOut := range mtx.TxOut {
+			if bytes.Equal(txOut.PkScript, pkScript) {
+				utxos = append(utxos, &AddressUtxo{
+					OutPoint:   wire.OutPoint{Hash: txHash, Index: uint32(i)},
+					Value:      txOut.Value,
+					Height:     loc.height,
+					IsCoinbase: isCoinbase,
+				})
+			}
+		}
+	}
+
+	unspent := make([]*AddressUtxo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if _, ok := spent[utxo.OutPoint]; !ok {
+			unspent = append(unspent, utxo)
+		}
+	}
+	return unspent, nil
+}
+
+// fetchAddressTxLocs returns the locations of the transactions indexed for addr
+// within the heights [start, stop], ordered by height and position in block.
+// Only the best height is read under the chain lock, the address index is read
+// without it.
+func (chain *Blockchain) fetchAddressTxLocs(addr massutil.Address, start, stop uint64) ([]*addressTxLoc, error) {
+	chain.l.RLock()
+	best := chain.blockTree.bestBlockNode().Height
+	chain.l.RUnlock()
+
+	if stop > best {
+		stop = best
+	}
+	if start > stop {
+		return []*addressTxLoc{}, nil
+	}
+	related, err := chain.db.FetchScriptHashRelatedTx([][]byte{addr.ScriptAddress()}, start, stop+1)
+	if err != nil {
+		return nil, err
+	}
+	locs := make([]*addressTxLoc, 0)
+	for height, txLocs := range related {
+		for _, txLoc := range txLocs {
+			locs = append(locs, &addressTxLoc{height: height, loc: txLoc})
+		}
+	}
+	sort.Slice(locs, func(i, j int) bool {
+		if locs[i].height != locs[j].height {
+			return locs[i].height < locs[j].height
+		}
+		return locs[i].loc.TxStart < locs[j].loc.TxStart
+	})
+	return locs, nil
+}
+
+// fetchAddressTx reads the transaction at loc and returns it as a transaction
+// of the address of pkScript, or nil if it neither pays the address nor spends
+// its outputs. The spent outputs are told by the previous transactions, which
+// are cached in prevTxs.
+func (chain *Blockchain) fetchAddressTx(pkScript []byte, loc *addressTxLoc,
+	prevTxs map[wire.Hash]*wire.MsgTx) (*AddressTx, error) {
+	mtx, err := chain.db.FetchTxByLoc(loc.height, loc.loc.TxStart, loc.loc.TxLen)
+	if err != nil {
+		return nil, err
+	}
+	atx := &AddressTx{Tx: mtx, Height: loc.height}
+	matched := false
+	if !IsCoinBaseTx(mtx) {
+		for _, txIn := range mtx.TxIn {
+			op := &txIn.PreviousOutPoint
+			prevTx, ok := prevTxs[op.Hash]
+			if !ok {
+				prevTx, err = chain.fetchPrevTx(&op.Hash, loc.height)
+				if err != nil {
+					return nil, err
+				}
+				prevTxs[op.Hash] = prevTx
+			}
+			if int(op.Index) >= len(prevTx.TxOut) {
+				return nil, ErrBadTxInput
+			}
+			if prevOut := prevTx.TxOut[op.Index]; bytes.Equal(prevOut.PkScript, pkScript) {
+				matched = true
+				atx.Sent += prevOut.Value
+			}
+		}
+	}
+	for _, txOut := range mtx.TxOut {
+		if bytes.Equal(txOut.PkScript, pkScript) {
+			matched = true
+			atx.Received += txOut.Value
+		}
+	}
+	if !matched {
+		return nil, nil
+	}
+	return atx, nil
+}
+
+// fetchPrevTx returns the transaction of main chain with hash mined no higher
+// than height.
+func (chain *Blockchain) fetchPrevTx(hash *wire.Hash, height uint64) (*wire.MsgTx, error) {
+	replies, err := chain.db.FetchTxBySha(hash)
+	if err != nil {
+		return nil, err
+	}
+	var prev *database.TxReply
+	for _, reply := range replies {
+		if reply.Err == nil && reply.Height <= height && (prev == nil || reply.Height > prev.Height) {
+			prev = reply
+		}
+	}
+	if prev == nil {
+		return nil, database.ErrTxShaMissing
+	}
+	return prev.Tx, nil
+}
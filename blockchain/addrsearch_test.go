@@ -0,0 +1,157 @@
+package blockchain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+func TestFetchAddressTxs(t *testing.T) {
+	blks := loadBlks("./data/beforestaking.dat")
+
+	copy(config.ChainParams.GenesisHash[:], blks[0].Hash()[:])
+	copy(config.ChainParams.GenesisBlock.Header.Challenge[:], blks[0].MsgBlock().Header.Challenge[:])
+	copy(config.ChainParams.GenesisBlock.Header.ChainID[:], blks[0].MsgBlock().Header.ChainID[:])
+	config.ChainParams.GenesisBlock.Header.Timestamp = blks[0].MsgBlock().Header.Timestamp
+	config.ChainParams.GenesisBlock.Header.Target = blks[0].MsgBlock().Header.Target
+
+	bc, closeChain := newReorgTestChain(blks[0], "addrsearch")
+	defer closeChain()
+
+	for i := 1; i < 42; i++ {
+		isOrphan, err := bc.processBlock(blks[i], BFNone)
+		assert.Nil(t, err)
+		assert.False(t, isOrphan)
+	}
+
+	pkScript := blks[1].MsgBlock().Transactions[0].TxOut[0].PkScript
+	_, addrs, _, _, err := txscript.ExtractPkScriptAddrs(pkScript, &config.ChainParams)
+	if err != nil || len(addrs) != 1 {
+		t.Fatal("failed to extract address", err)
+	}
+
+	// scan the main chain for the expected transactions and utxos
+	var (
+		expectHeights []uint64
+		expectTxs     []wire.Hash
+		expectSent    []int64
+		unspent       = make(map[wire.OutPoint]int64)
+	)
+	for height := uint64(0); height <= bc.BestBlockHeight(); height++ {
+		blk, err := bc.GetBlockByHeight(height)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, mtx := range blk.MsgBlock().Transactions {
+			matched := false
+			sent := int64(0)
+			if !IsCoinBaseTx(mtx) {
+				for _, txIn := range mtx.TxIn {
+					if value, ok := unspent[txIn.PreviousOutPoint]; ok {
+						matched = true
+						sent += value
+						delete(unspent, txIn.PreviousOutPoint)
+					}
+				}
+			}
+			for i, txOut := range mtx.TxOut {
+				if bytes.Equal(txOut.PkScript, pkScript) {
+					matched = true
+					unspent[wire.OutPoint{Hash: mtx.TxHash(), Index: uint32(i)}] = txOut.Value
+				}
+			}
+			if matched {
+				expectHeights = append(expectHeights, height)
+				expectTxs = append(expectTxs, mtx.TxHash())
+				expectSent = append(expectSent, sent)
+			}
+		}
+	}
+	if len(expectTxs) == 0 {
+		t.Fatal("no transaction of the address")
+	}
+
+	txs, total, err := bc.FetchAddressTxs(addrs[0], 0, bc.BestBlockHeight(), 0, 0, true)
+	assert.Nil(t, err)
+	assert.Equal(t, len(expectTxs), total)
+	if assert.Equal(t, len(expectTxs), len(txs)) {
+		for i, atx := range txs {
+			assert.Equal(t, expectTxs[i], atx.Tx.TxHash())
+			assert.Equal(t, expectHeights[i], atx.Height)
+			assert.Equal(t, expectSent[i], atx.Sent)
+		}
+	}
+
+	// pages
+	if len(expectTxs) < 3 {
+		t.Fatal("too few transactions of the address")
+	}
+	txs, total, err = bc.FetchAddressTxs(addrs[0], 0, bc.BestBlockHeight(), 1, 2, true)
+	assert.Nil(t, err)
+	assert.Equal(t, len(expectTxs), total)
+	if assert.Equal(t, 2, len(txs)) {
+		assert.Equal(t, expectTxs[1], txs[0].Tx.TxHash())
+		assert.Equal(t, expectTxs[2], txs[1].Tx.TxHash())
+	}
+	txs, _, err = bc.FetchAddressTxs(addrs[0], 0, bc.BestBlockHeight(), 0, 1, false)
+	assert.Nil(t, err)
+	if assert.Equal(t, 1, len(txs)) {
+		assert.Equal(t, expectTxs[len(expectTxs)-1], txs[0].Tx.TxHash())
+	}
+
+	// page to the end
+	for _, ascending := range []bool{true, false} {
+		var paged []wire.Hash
+		for skip := 0; ; skip += 2 {
+			txs, total, err = bc.FetchAddressTxs(addrs[0], 0, bc.BestBlockHeight(), skip, 2, ascending)
+			assert.Nil(t, err)
+			assert.Equal(t, len(expectTxs), total)
+			if len(txs) == 0 {
+				break
+			}
+			for _, atx := range txs {
+				paged = append(paged, atx.Tx.TxHash())
+			}
+		}
+		if assert.Equal(t, len(expectTxs), len(paged)) {
+			for i := range paged {
+				if ascending {
+					assert.Equal(t, expectTxs[i], paged[i])
+				} else {
+					assert.Equal(t, expectTxs[len(expectTxs)-1-i], paged[i])
+				}
+			}
+		}
+	}
+
+	// height range
+	txs, _, err = bc.FetchAddressTxs(addrs[0], expectHeights[0]+1, expectHeights[0]+1, 0, 0, true)
+	assert.Nil(t, err)
+	for _, atx := range txs {
+		assert.Equal(t, expectHeights[0]+1, atx.Height)
+	}
+
+	utxos, err := bc.FetchAddressUtxos(addrs[0], 0, bc.BestBlockHeight())
+	assert.Nil(t, err)
+	assert.Equal(t, len(unspent), len(utxos))
+	for _, utxo := range utxos {
+		value, ok := unspent[utxo.OutPoint]
+		assert.True(t, ok)
+		assert.Equal(t, value, utxo.Value)
+	}
+
+	// utxos within the heights
+	mid := bc.BestBlockHeight() / 2
+	lower, err := bc.FetchAddressUtxos(addrs[0], 0, mid)
+	assert.Nil(t, err)
+	upper, err := bc.FetchAddressUtxos(addrs[0], mid+1, bc.BestBlockHeight())
+	assert.Nil(t, err)
+	assert.Equal(t, utxos, append(lower, upper...))
+	for _, utxo := range lower {
+		assert.True(t, utxo.Height <= mid)
+	}
+}
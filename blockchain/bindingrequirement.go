@@ -0,0 +1,87 @@
+package blockchain
+
+import (
+	"math"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/consensus"
+	"massnet.org/mass-wallet/database"
+	"massnet.org/mass-wallet/massutil"
+)
+
+// BindingRequirement is the binding of a PoC public key against the binding
+// required to mine plots of a bit length.
+type BindingRequirement struct {
+	BitLength int
+	PlotCount uint64
+	Required  massutil.Amount // required of each plot times the plot count
+	Current   massutil.Amount // all bound, including immature outputs
+	Mature    massutil.Amount // counted by coinbase of the next block
+	Shortfall massutil.Amount // Required less Mature, zero if met
+}
+
+// CheckBindingRequirement checks the binding of the PoC public key of
+// scriptHash against the binding required to mine plotCount plots of
+// bitLength in the next block.
+func (chain *Blockchain) CheckBindingRequirement(scriptHash []byte, bitLength int, plotCount uint64) (*BindingRequirement, error) {
+	if _, ok := bindingRequiredAmount[bitLength]; !ok {
+		return nil, ErrUnsupportedBitLength
+	}
+	bindings, err := chain.db.FetchScriptHashRelatedBindingTx(scriptHash, &config.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+	return checkBindingRequirement(bindings, chain.BestBlockHeight()+1, bitLength, plotCount)
+}
+
+// checkBindingRequirement checks bindings against the binding required to
+// mine plotCount plots of bitLength in the block at height. Like coinbase,
+// only mature outputs count, and no more than MaxBindingNum of them. The
+// binding required must be a valid amount, or ErrPlotCountOutOfRange returns.
+func checkBindingRequirement(bindings []*database.BindingTxReply, height uint64, bitLength int,
+	plotCount uint64) (*BindingRequirement, error) {
+	perPlot, ok := bindingRequiredAmount[bitLength]
+	if !ok {
+		return nil, ErrUnsupportedBitLength
+	}
+	if plotCount > math.MaxInt64 {
+		return nil, ErrPlotCountOutOfRange
+	}
+	required, err := mulAmount(perPlot, int64(plotCount))
+	if err != nil {
+		return nil, ErrPlotCountOutOfRange
+	}
+	req := &BindingRequirement{
+		BitLength: bitLength,
+		PlotCount: plotCount,
+		Required:  required,
+		Current:   massutil.ZeroAmount(),
+		Mature:    massutil.ZeroAmount(),
+		Shortfall: massutil.ZeroAmount(),
+	}
+
+	matureNum := 0
+	for _, binding := range bindings {
+		if req.Current, err = req.Current.AddInt(binding.Value); err != nil {
+			return nil, err
+		}
+		maturity := consensus.TransactionMaturity
+		if binding.IsCoinbase {
+			maturity = consensus.CoinbaseMaturity
+		}
+		if height < binding.Height+maturity || matureNum >= MaxBindingNum {
+			continue
+		}
+		if req.Mature, err = req.Mature.AddInt(binding.Value); err != nil {
+			return nil, err
+		}
+		matureNum++
+	}
+
+	if req.Mature.Cmp(req.Required) < 0 {
+		if req.Shortfall, err = req.Required.Sub(req.Mature); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
@@ -0,0 +1,70 @@
+package blockchain
+
+import (
+	"math"
+	"testing"
+
+	"massnet.org/mass-wallet/consensus"
+	"massnet.org/mass-wallet/database"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/wire"
+)
+
+func TestCheckBindingRequirement(t *testing.T) {
+	height := consensus.CoinbaseMaturity + 100
+	newBinding := func(mass float64, bindingHeight uint64, isCoinbase bool) *database.BindingTxReply {
+		amt, err := massutil.NewAmountFromMass(mass)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash := wire.DoubleHashH([]byte{byte(bindingHeight)})
+		return &database.BindingTxReply{Height: bindingHeight, TxSha: &hash, IsCoinbase: isCoinbase, Value: amt.IntValue()}
+	}
+	mass := func(amt massutil.Amount) float64 { return amt.ToMASS() }
+
+	bindings := []*database.BindingTxReply{
+		newBinding(2, 1, false),
+		newBinding(1, height, false),                             // immature
+		newBinding(4, height-consensus.CoinbaseMaturity+1, true), // immature coinbase
+	}
+	req, err := checkBindingRequirement(bindings, height, 32, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mass(req.Required) != 4.096 || mass(req.Current) != 7 || mass(req.Mature) != 2 || mass(req.Shortfall) != 2.096 {
+		t.Fatalf("unexpected requirement %v, %v, %v, %v", req.Required, req.Current, req.Mature, req.Shortfall)
+	}
+
+	// met requirement
+	req, err = checkBindingRequirement(bindings, height+consensus.CoinbaseMaturity, 32, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mass(req.Mature) != 7 || !req.Shortfall.IsZero() {
+		t.Fatalf("unexpected requirement %v, %v", req.Mature, req.Shortfall)
+	}
+
+	// no more than MaxBindingNum outputs count
+	bindings = bindings[:0]
+	for i := 0; i < MaxBindingNum+2; i++ {
+		bindings = append(bindings, newBinding(0.1, uint64(i+1), false))
+	}
+	req, err = checkBindingRequirement(bindings, height, 30, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mass(req.Current) != 1.2 || mass(req.Mature) != 1 || mass(req.Shortfall) != 0.44 {
+		t.Fatalf("unexpected requirement %v, %v, %v", req.Current, req.Mature, req.Shortfall)
+	}
+
+	if _, err = checkBindingRequirement(nil, height, 31, 1); err != ErrUnsupportedBitLength {
+		t.Fatalf("expected ErrUnsupportedBitLength, got %v", err)
+	}
+
+	// plot counts requiring more than the max amount
+	for _, plotCount := range []uint64{1 << 40, math.MaxInt64, math.MaxInt64 + 1, math.MaxUint64} {
+		if _, err = checkBindingRequirement(nil, height, 32, plotCount); err != ErrPlotCountOutOfRange {
+			t.Fatalf("%d: expected ErrPlotCountOutOfRange, got %v", plotCount, err)
+		}
+	}
+}
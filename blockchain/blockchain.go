@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -54,6 +55,7 @@ type Blockchain struct {
 	proposalPool   *ProposalPool         // pool of proposals
 	addrIndexer    *AddrIndexer          // address indexer
 	dmd            *DoubleMiningDetector // double mining detector
+	feeEstimator   *FeeEstimator         // estimator of fee rates
 	processBlockCh chan *processBlockMsg
 	listeners      map[Listener]struct{}
 
@@ -81,6 +83,8 @@ func NewBlockchain(db database.Db, dbPath string, server Server) (*Blockchain, e
 	}
 
 	chain.txPool = NewTxPool(chain, chain.sigCache, chain.hashCache)
+	chain.feeEstimator = NewFeeEstimator(dbPath)
+	chain.listeners[chain.feeEstimator] = struct{}{}
 
 	if punishments, err := chain.RetrievePunishment(); err == nil {
 		chain.proposalPool = NewProposalPool(punishments)
@@ -356,6 +360,43 @@ func (chain *Blockchain) FetchMinedBlocks(pubKey *pocec.PublicKey) ([]uint64, er
 	return chain.db.FetchMinedBlocks(pubKey)
 }
 
+// ChainTip is the tip of a chain in the block tree.
+type ChainTip struct {
+	Hash      wire.Hash
+	Height    uint64
+	BranchLen uint64 // number of blocks of the chain not in main chain
+	Active    bool   // whether it is the tip of main chain
+}
+
+// ChainTips returns the tips of main chain and of the side chains known by
+// the block tree, highest first.
+func (chain *Blockchain) ChainTips() []*ChainTip {
+	chain.l.RLock()
+	defer chain.l.RUnlock()
+
+	best := chain.blockTree.bestBlockNode()
+	leaves := chain.blockTree.leafNodes()
+	tips := make([]*ChainTip, 0, len(leaves))
+	for _, node := range leaves {
+		tip := &ChainTip{
+			Hash:   *node.Hash,
+			Height: node.Height,
+			Active: node == best,
+		}
+		for n := node; n != nil && !n.InMainChain; n = n.Parent {
+			tip.BranchLen++
+		}
+		tips = append(tips, tip)
+	}
+	sort.Slice(tips, func(i, j int) bool {
+		if tips[i].Height != tips[j].Height {
+			return tips[i].Height > tips[j].Height
+		}
+		return tips[i].Active
+	})
+	return tips
+}
+
 func (chain *Blockchain) GetTransaction(hash *wire.Hash) (*wire.MsgTx, error) {
 	txList, err := chain.db.FetchTxBySha(hash)
 	if err != nil {
@@ -384,6 +425,10 @@ func (chain *Blockchain) GetTxPool() *TxPool {
 	return chain.txPool
 }
 
+func (chain *Blockchain) FeeEstimator() *FeeEstimator {
+	return chain.feeEstimator
+}
+
 func (chain *Blockchain) BlockWaiter(height uint64) (<-chan *BlockNode, error) {
 	chain.l.RLock()
 	defer chain.l.RUnlock()
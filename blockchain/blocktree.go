@@ -178,6 +178,20 @@ func (tree *BlockTree) orphanExists(hash *wire.Hash) bool {
 	return tree.orphanBlockPool.isOrphanInPool(hash)
 }
 
+// leafNodes returns the nodes without children in block tree
+func (tree *BlockTree) leafNodes() []*BlockNode {
+	tree.RLock()
+	defer tree.RUnlock()
+
+	leaves := make([]*BlockNode, 0)
+	for hash, node := range tree.index {
+		if _, exists := tree.children[hash]; !exists {
+			leaves = append(leaves, node)
+		}
+	}
+	return leaves
+}
+
 // attachBlockNode attaches a leaf node
 func (tree *BlockTree) attachBlockNode(node *BlockNode) error {
 	tree.Lock()
@@ -583,3 +583,31 @@ func SumBlkFileSize(t *testing.T, blks ...*massutil.Block) (sum uint64) {
 	}
 	return sum
 }
+
+func TestChainTips(t *testing.T) {
+	// height: 0(main)... -> 30(main) -> 31(main) -> 31(fork) -> 32(fork) -> 33(fork) -> 32(main) -> 33(main) -> ...49(main)
+	// i:      0      ...    30          31          32          33          34          35          36          ...52
+	blks := loadBlks("./data/beforestaking.dat")
+
+	copy(config.ChainParams.GenesisHash[:], blks[0].Hash()[:])
+	copy(config.ChainParams.GenesisBlock.Header.Challenge[:], blks[0].MsgBlock().Header.Challenge[:])
+	copy(config.ChainParams.GenesisBlock.Header.ChainID[:], blks[0].MsgBlock().Header.ChainID[:])
+	config.ChainParams.GenesisBlock.Header.Timestamp = blks[0].MsgBlock().Header.Timestamp
+	config.ChainParams.GenesisBlock.Header.Target = blks[0].MsgBlock().Header.Target
+
+	bc, closeChain := newReorgTestChain(blks[0], "tips")
+	defer closeChain()
+
+	for i := 1; i < 42; i++ {
+		isOrphan, err := bc.processBlock(blks[i], BFNone)
+		assert.Nil(t, err)
+		assert.False(t, isOrphan)
+	}
+
+	tips := bc.ChainTips()
+	if !assert.Equal(t, 2, len(tips)) {
+		t.FailNow()
+	}
+	assert.Equal(t, ChainTip{Hash: *bc.BestBlockHash(), Height: 38, BranchLen: 0, Active: true}, *tips[0])
+	assert.Equal(t, ChainTip{Hash: *blks[34].Hash(), Height: 33, BranchLen: 3, Active: false}, *tips[1])
+}
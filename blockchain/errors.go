@@ -17,6 +17,11 @@ var (
 	errDetachParentBlockNode     = errors.New("can not detach parent block from blockTree")
 	errRootNodeAlreadyExists     = errors.New("can not set root node multiple times")
 
+	// FeeEstimator
+	ErrFeeEstimateTarget      = errors.New("fee estimate target out of range")
+	ErrFeeEstimateUnavailable = errors.New("insufficient data to estimate fee rate")
+	ErrFeeEstimatesVersion    = errors.New("unexpected version of saved fee estimates")
+
 	// DoubleMiningDetector
 	errInvalidDmdTree = errors.New("invalid double-mining-detector tree")
 
@@ -79,6 +84,8 @@ var (
 	ErrBindingPubKey         = errors.New("binding pubkey does not match miner pubkey")
 	ErrBindingInputMissing   = errors.New("input of binding missing")
 	ErrDuplicateStaking      = errors.New("duplicate staking")
+	ErrUnsupportedBitLength  = errors.New("no binding required for the bit length")
+	ErrPlotCountOutOfRange   = errors.New("binding required for the plot count out of range")
 
 	// TxIn
 	ErrFindReferenceInput = errors.New("unable find reference transaction ")
@@ -104,6 +111,11 @@ var (
 	ErrInsufficientPriority = errors.New("transaction`s Priority is under the required amount")
 	ErrDust                 = errors.New("transaction output payment is dust")
 
+	// Replacement
+	ErrReplacementFee            = errors.New("replacement transaction`s fees is under the required amount")
+	ErrTooManyReplacements       = errors.New("replacement transaction evicts too many transactions")
+	ErrReplacementSpendsConflict = errors.New("replacement transaction spends outputs of replaced transactions")
+
 	// Size
 	ErrNonStandardTxSize = errors.New("transaction size is larger than max allowed size")
 	ErrWitnessSize       = errors.New("transaction input witness size is large than max allowed size")
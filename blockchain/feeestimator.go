@@ -0,0 +1,308 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/wire"
+)
+
+const (
+	// MaxFeeEstimateTarget is the max number of blocks fee rates are
+	// estimated for.
+	MaxFeeEstimateTarget = 48
+
+	// DefaultFeeEstimateTarget is the number of blocks the transactions
+	// created with an estimated fee rate are expected to be mined within.
+	DefaultFeeEstimateTarget = 6
+
+	feeEstimatesFileName = "fee_estimates.json"
+	feeEstimatesVersion  = 1
+
+	// the fee rate buckets grow exponentially from the min relay fee rate,
+	// the highest one collects any higher fee rate
+	feeBucketSpacing = 1.1
+	feeBucketCount   = 128
+
+	// the weight of past observations decays by each connected block, so
+	// that they are halved in about 350 blocks
+	feeDecay = 0.998
+
+	// the ratio of transactions mined within the target for a fee rate to be
+	// estimated sufficient, and the min decayed number of transactions the
+	// ratio is computed over
+	feeSuccessThreshold = 0.85
+	feeSufficientTxs    = 2.0
+
+	// the estimates are saved every feeSaveInterval connected blocks
+	feeSaveInterval = 10
+)
+
+// feeBucket collects the decayed statistics of transactions of a fee rate
+// range. Confirmed[i] is the number of transactions mined within i+1 blocks.
+type feeBucket struct {
+	Confirmed [MaxFeeEstimateTarget]float64 `json:"confirmed"`
+	Total     float64                       `json:"total"`
+	RateSum   float64                       `json:"rate_sum"`
+}
+
+// observedTx is a transaction accepted to the memory pool and not mined yet.
+type observedTx struct {
+	Bucket int     `json:"bucket"`
+	Rate   float64 `json:"rate"`
+	Height uint64  `json:"height"`
+}
+
+// feeEstimates is the persisted state of FeeEstimator.
+type feeEstimates struct {
+	Version  int                    `json:"version"`
+	Height   uint64                 `json:"height"`
+	Buckets  []*feeBucket           `json:"buckets"`
+	Observed map[string]*observedTx `json:"observed"` // by transaction hash
+}
+
+// FeeEstimator estimates the fee rate of transactions to be mined within a
+// number of blocks, by how long transactions accepted to the memory pool
+// took to be mined at each fee rate.
+type FeeEstimator struct {
+	sync.Mutex
+	path       string
+	bounds     [feeBucketCount]float64 // lower bound of each bucket, in Maxwell/kB
+	est        *feeEstimates
+	sinceSaved int
+}
+
+// NewFeeEstimator returns a FeeEstimator restored from the estimates saved in
+// dir, if any.
+func NewFeeEstimator(dir string) *FeeEstimator {
+	fe := &FeeEstimator{
+		path: filepath.Join(dir, feeEstimatesFileName),
+	}
+	bound := float64(massutil.MinRelayTxFee().IntValue())
+	for i := range fe.bounds {
+		fe.bounds[i] = bound
+		bound *= feeBucketSpacing
+	}
+	if err := fe.restore(); err != nil {
+		logging.CPrint(logging.WARN, "failed to restore fee estimates", logging.LogFormat{
+			"path": fe.path,
+			"err":  err,
+		})
+		fe.est = newFeeEstimates()
+	}
+	return fe
+}
+
+func newFeeEstimates() *feeEstimates {
+	est := &feeEstimates{
+		Version:  feeEstimatesVersion,
+		Buckets:  make([]*feeBucket, feeBucketCount),
+		Observed: make(map[string]*observedTx),
+	}
+	for i := range est.Buckets {
+		est.Buckets[i] = &feeBucket{}
+	}
+	return est
+}
+
+func (fe *FeeEstimator) bucketIndex(rate float64) int {
+	for i := len(fe.bounds) - 1; i > 0; i-- {
+		if rate >= fe.bounds[i] {
+			return i
+		}
+	}
+	return 0
+}
+
+// ObserveTransaction records a transaction accepted to the memory pool at
+// height, paying fee for size bytes.
+func (fe *FeeEstimator) ObserveTransaction(txHash *wire.Hash, fee massutil.Amount, size int64, height uint64) {
+	if size <= 0 {
+		return
+	}
+	fe.Lock()
+	defer fe.Unlock()
+
+	if _, ok := fe.est.Observed[txHash.String()]; ok {
+		return
+	}
+	rate := float64(fee.IntValue()) * 1000 / float64(size)
+	fe.est.Observed[txHash.String()] = &observedTx{
+		Bucket: fe.bucketIndex(rate),
+		Rate:   rate,
+		Height: height,
+	}
+}
+
+// OnBlockConnected records how long the observed transactions of block took
+// to be mined. Observed transactions not mined within MaxFeeEstimateTarget
+// blocks are recorded as failing every target.
+func (fe *FeeEstimator) OnBlockConnected(block *wire.MsgBlock) error {
+	fe.Lock()
+	defer fe.Unlock()
+
+	height := block.Header.Height
+	if height <= fe.est.Height {
+		// reorganized, the transactions were recorded by the detached blocks
+		return nil
+	}
+	fe.est.Height = height
+
+	for _, b := range fe.est.Buckets {
+		for i := range b.Confirmed {
+			b.Confirmed[i] *= feeDecay
+		}
+		b.Total *= feeDecay
+		b.RateSum *= feeDecay
+	}
+	for _, tx := range block.Transactions {
+		txHash := tx.TxHash().String()
+		otx, ok := fe.est.Observed[txHash]
+		if !ok {
+			continue
+		}
+		delete(fe.est.Observed, txHash)
+		if otx.Height >= height {
+			continue
+		}
+		fe.record(otx, int(height-otx.Height))
+	}
+	for txHash, otx := range fe.est.Observed {
+		if otx.Height+MaxFeeEstimateTarget < height {
+			delete(fe.est.Observed, txHash)
+			fe.record(otx, MaxFeeEstimateTarget+1)
+		}
+	}
+
+	fe.sinceSaved++
+	if fe.sinceSaved >= feeSaveInterval {
+		if err := fe.save(); err != nil {
+			logging.CPrint(logging.WARN, "failed to save fee estimates", logging.LogFormat{
+				"path": fe.path,
+				"err":  err,
+			})
+		}
+	}
+	return nil
+}
+
+// OnTransactionReceived implements Listener, the transactions are observed
+// by TxPool along with their fees.
+func (fe *FeeEstimator) OnTransactionReceived(tx *wire.MsgTx) error {
+	return nil
+}
+
+func (fe *FeeEstimator) record(otx *observedTx, blocks int) {
+	b := fe.est.Buckets[otx.Bucket]
+	for i := blocks - 1; i < MaxFeeEstimateTarget; i++ {
+		b.Confirmed[i]++
+	}
+	b.Total++
+	b.RateSum += otx.Rate
+}
+
+// EstimateFeeRate returns the lowest fee rate, in MASS per kB, at which most
+// transactions were mined within targetBlocks blocks. Higher fee rates are
+// checked first, the lowest range of buckets passing the threshold gives the
+// estimate.
+func (fe *FeeEstimator) EstimateFeeRate(targetBlocks int) (massutil.Amount, error) {
+	if targetBlocks < 1 || targetBlocks > MaxFeeEstimateTarget {
+		return massutil.ZeroAmount(), ErrFeeEstimateTarget
+	}
+	fe.Lock()
+	defer fe.Unlock()
+
+	var (
+		confirmed, total, rateSum float64
+		passed                    bool
+		passedRate                float64
+	)
+	for i := len(fe.est.Buckets) - 1; i >= 0; i-- {
+		b := fe.est.Buckets[i]
+		confirmed += b.Confirmed[targetBlocks-1]
+		total += b.Total
+		rateSum += b.RateSum
+		if total < feeSufficientTxs {
+			continue
+		}
+		if confirmed/total < feeSuccessThreshold {
+			break
+		}
+		passed, passedRate = true, rateSum/total
+		confirmed, total, rateSum = 0, 0, 0
+	}
+	if !passed {
+		return massutil.ZeroAmount(), ErrFeeEstimateUnavailable
+	}
+
+	rate, err := massutil.NewAmountFromInt(int64(math.Ceil(passedRate)))
+	if err != nil {
+		return massutil.ZeroAmount(), err
+	}
+	if rate.Cmp(massutil.MinRelayTxFee()) < 0 {
+		rate = massutil.MinRelayTxFee()
+	}
+	return rate, nil
+}
+
+// Save writes the estimates to disk.
+func (fe *FeeEstimator) Save() error {
+	fe.Lock()
+	defer fe.Unlock()
+	return fe.save()
+}
+
+func (fe *FeeEstimator) save() error {
+	data, err := json.Marshal(fe.est)
+	if err != nil {
+		return err
+	}
+	tmp := fe.path + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, fe.path); err != nil {
+		return err
+	}
+	fe.sinceSaved = 0
+	return nil
+}
+
+func (fe *FeeEstimator) restore() error {
+	data, err := ioutil.ReadFile(fe.path)
+	if os.IsNotExist(err) {
+		fe.est = newFeeEstimates()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	est := &feeEstimates{}
+	if err = json.Unmarshal(data, est); err != nil {
+		return err
+	}
+	if est.Version != feeEstimatesVersion || len(est.Buckets) != feeBucketCount {
+		return ErrFeeEstimatesVersion
+	}
+	for _, b := range est.Buckets {
+		if b == nil {
+			return ErrFeeEstimatesVersion
+		}
+	}
+	if est.Observed == nil {
+		est.Observed = make(map[string]*observedTx)
+	}
+	for _, otx := range est.Observed {
+		if otx == nil || otx.Bucket < 0 || otx.Bucket >= feeBucketCount {
+			return ErrFeeEstimatesVersion
+		}
+	}
+	fe.est = est
+	return nil
+}
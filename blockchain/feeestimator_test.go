@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/wire"
+)
+
+func newFeeTestTx(seq uint64) *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: uint32(seq)}, nil))
+	tx.AddTxOut(wire.NewTxOut(1, []byte{0x00}))
+	tx.LockTime = seq
+	return tx
+}
+
+func TestFeeEstimator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "feeestimator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fe := NewFeeEstimator(dir)
+	_, err = fe.EstimateFeeRate(0)
+	assert.Equal(t, ErrFeeEstimateTarget, err)
+	_, err = fe.EstimateFeeRate(MaxFeeEstimateTarget + 1)
+	assert.Equal(t, ErrFeeEstimateTarget, err)
+	_, err = fe.EstimateFeeRate(DefaultFeeEstimateTarget)
+	assert.Equal(t, ErrFeeEstimateUnavailable, err)
+
+	minRate := massutil.MinRelayTxFee().IntValue()
+	highFee, _ := massutil.NewAmountFromInt(minRate * 100)
+	lowFee, _ := massutil.NewAmountFromInt(minRate * 2)
+
+	// transactions paying high fees are mined in the next block, the others
+	// 10 blocks later
+	var (
+		seq     uint64
+		pending = make(map[uint64][]*wire.MsgTx)
+	)
+	for height := uint64(1); height <= 100; height++ {
+		block := &wire.MsgBlock{Header: wire.BlockHeader{Height: height}}
+		block.Transactions = append(block.Transactions, pending[height]...)
+		delete(pending, height)
+		assert.Nil(t, fe.OnBlockConnected(block))
+
+		for i := 0; i < 2; i++ {
+			seq++
+			tx := newFeeTestTx(seq)
+			txHash := tx.TxHash()
+			fe.ObserveTransaction(&txHash, highFee, 1000, height)
+			pending[height+1] = append(pending[height+1], tx)
+
+			seq++
+			tx = newFeeTestTx(seq)
+			txHash = tx.TxHash()
+			fe.ObserveTransaction(&txHash, lowFee, 1000, height)
+			pending[height+10] = append(pending[height+10], tx)
+		}
+	}
+
+	rate, err := fe.EstimateFeeRate(1)
+	assert.Nil(t, err)
+	assert.Equal(t, highFee, rate)
+	rate, err = fe.EstimateFeeRate(DefaultFeeEstimateTarget)
+	assert.Nil(t, err)
+	assert.Equal(t, highFee, rate)
+	rate, err = fe.EstimateFeeRate(10)
+	assert.Nil(t, err)
+	assert.Equal(t, lowFee, rate)
+
+	// restored from disk
+	assert.Nil(t, fe.Save())
+	restored := NewFeeEstimator(dir)
+	rate, err = restored.EstimateFeeRate(10)
+	assert.Nil(t, err)
+	assert.Equal(t, lowFee, rate)
+	assert.Equal(t, len(fe.est.Observed), len(restored.est.Observed))
+
+	// blocks of a reorganization are not recorded twice
+	assert.Nil(t, restored.OnBlockConnected(&wire.MsgBlock{Header: wire.BlockHeader{Height: 50}}))
+	assert.Equal(t, uint64(100), restored.est.Height)
+}
@@ -34,8 +34,58 @@ const (
 	// adds a few extra bytes to provide a little buffer.
 	// 15*74 + (15*34 + 3) + 27 = 1650
 	maxStandardWitnessSize = 1650
+
+	// MaxReplaceableSequence is the highest sequence number of an input that
+	// signals the transaction may be replaced in the memory pool by one
+	// spending the same outputs with a higher fee.  The relative lock time
+	// disabled flag is still set, so no sequence lock is imposed.
+	MaxReplaceableSequence = wire.MaxTxInSequenceNum - 2
+
+	// maxReplacementEvictions is the maximum number of transactions, including
+	// descendants, that a replacement transaction may evict from the pool.
+	maxReplacementEvictions = 100
 )
 
+// SignalsReplacement returns whether or not any input of tx opts in to be
+// replaced by a transaction paying a higher fee.
+func SignalsReplacement(tx *wire.MsgTx) bool {
+	for _, txIn := range tx.TxIn {
+		if txIn.Sequence <= MaxReplaceableSequence {
+			return true
+		}
+	}
+	return false
+}
+
+// checkReplacementFee checks whether or not a replacement transaction pays at
+// least the fees of the transactions it evicts plus the relay fee of its own
+// size, and a higher fee rate than each of them.
+func checkReplacementFee(txFee massutil.Amount, serializedSize int64, evicted map[wire.Hash]*TxDesc) error {
+	relayFee, err := CalcMinRequiredTxRelayFee(serializedSize, massutil.MinRelayTxFee())
+	if err != nil {
+		return err
+	}
+	minFee := relayFee
+	feeRate := float64(txFee.IntValue()) / float64(serializedSize)
+	for _, desc := range evicted {
+		if minFee, err = minFee.Add(desc.Fee); err != nil {
+			return err
+		}
+		size := desc.Tx.MsgTx().PlainSize()
+		if feeRate <= float64(desc.Fee.IntValue())/float64(size) {
+			logging.CPrint(logging.ERROR, "replacement transaction`s fee rate is not higher than the replaced",
+				logging.LogFormat{"replaced": desc.Tx.Hash(), "feeRate": feeRate})
+			return ErrReplacementFee
+		}
+	}
+	if txFee.Cmp(minFee) < 0 {
+		logging.CPrint(logging.ERROR, "replacement transaction`s fees is under the required amount",
+			logging.LogFormat{"txFee": txFee, "requiredFee": minFee, "evicted": len(evicted)})
+		return ErrReplacementFee
+	}
+	return nil
+}
+
 // CalcMinRequiredTxRelayFee returns the minimum transaction fee required for a
 // transaction with the passed serialized size to be accepted into the memory
 // pool and relayed.
@@ -0,0 +1,233 @@
+package blockchain
+
+import (
+	"crypto/sha256"
+	"sort"
+	"time"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/consensus"
+	"massnet.org/mass-wallet/database"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/massutil/safetype"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+const (
+	// blockIntervalWindow is the number of recent blocks averaged for the
+	// block interval, about one day.
+	blockIntervalWindow = 1920
+
+	// defaultBlockInterval is the block interval assumed before the chain
+	// is long enough to measure it.
+	defaultBlockInterval = 45 * time.Second
+)
+
+// estimatedStakingKey is the script hash standing for the new staking in the
+// projected reward lists.
+var estimatedStakingKey = sha256.Sum256([]byte("estimated staking"))
+
+// StakingRewardProjection is the projected reward of a staking for each block
+// from Height on, until the next projection.
+type StakingRewardProjection struct {
+	Height      uint64
+	Blocks      uint64 // number of blocks the projection holds for
+	Rank        int    // in the reward list, -1 if not rewarded
+	Weight      *safetype.Uint128
+	TotalWeight *safetype.Uint128 // of the reward list, including the staking
+	Reward      massutil.Amount   // per block
+}
+
+// StakingRewardEstimate is the projected reward of a new staking over its
+// frozen period, assuming that no other staking is made, and that miners have
+// valid bindings so that stakings get their share of the block subsidy.
+type StakingRewardEstimate struct {
+	StartHeight   uint64 // first rewarded block
+	EndHeight     uint64 // last rewarded block
+	BlockInterval time.Duration
+	Projections   []*StakingRewardProjection
+	TotalReward   massutil.Amount // over the frozen period
+}
+
+// AnnualReward returns the reward of a year at the rate of the first
+// projection.
+func (e *StakingRewardEstimate) AnnualReward() (massutil.Amount, error) {
+	if len(e.Projections) == 0 || e.BlockInterval <= 0 {
+		return massutil.ZeroAmount(), nil
+	}
+	blocks := int64(365 * 24 * time.Hour / e.BlockInterval)
+	return mulAmount(e.Projections[0].Reward, blocks)
+}
+
+// EstimateStakingReward projects the reward of staking value for frozenPeriod
+// in the next block against the current unexpired stakings. A new projection
+// starts whenever any other staking expires or the subsidy halves.
+func (chain *Blockchain) EstimateStakingReward(value massutil.Amount, frozenPeriod uint64) (*StakingRewardEstimate, error) {
+	if !wire.IsValidStakingValue(value.IntValue()) {
+		return nil, ErrInvalidStakingTxValue
+	}
+	if !wire.IsValidFrozenPeriod(frozenPeriod) {
+		return nil, txscript.ErrFrozenPeriod
+	}
+
+	best := chain.blockTree.bestBlockNode()
+	ranks, err := chain.db.FetchUnexpiredStakingRank(best.Height, false)
+	if err != nil {
+		return nil, err
+	}
+	estimate, err := projectStakingReward(ranks, best.Height+1, value, frozenPeriod, &config.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+	estimate.BlockInterval = averageBlockInterval(best)
+	return estimate, nil
+}
+
+// projectStakingReward projects the reward of staking value for frozenPeriod
+// in the block at height, against the stakings of ranks.
+func projectStakingReward(ranks []database.Rank, height uint64, value massutil.Amount, frozenPeriod uint64,
+	chainParams *config.Params) (*StakingRewardEstimate, error) {
+	estimate := &StakingRewardEstimate{
+		StartHeight: height + consensus.StakingTxRewardStart,
+		EndHeight:   height + frozenPeriod,
+		TotalReward: massutil.ZeroAmount(),
+	}
+	staking := database.StakingTxInfo{
+		Value:        uint64(value.IntValue()),
+		FrozenPeriod: frozenPeriod,
+		BlkHeight:    height,
+	}
+
+	// the reward list changes right after other stakings expire
+	boundarySet := map[uint64]struct{}{estimate.StartHeight: {}}
+	for _, rank := range ranks {
+		for _, stk := range rank.StakingTx {
+			h := stk.BlkHeight + stk.FrozenPeriod + 1
+			if h > estimate.StartHeight && h <= estimate.EndHeight {
+				boundarySet[h] = struct{}{}
+			}
+		}
+	}
+	for h := nextHalvingHeight(estimate.StartHeight, chainParams); h != 0 && h <= estimate.EndHeight; h = nextHalvingHeight(h, chainParams) {
+		boundarySet[h] = struct{}{}
+	}
+	boundaries := make([]uint64, 0, len(boundarySet))
+	for h := range boundarySet {
+		boundaries = append(boundaries, h)
+	}
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i] < boundaries[j] })
+
+	for i, h := range boundaries {
+		next := estimate.EndHeight + 1
+		if i+1 < len(boundaries) {
+			next = boundaries[i+1]
+		}
+		active := map[[sha256.Size]byte][]database.StakingTxInfo{
+			estimatedStakingKey: {staking},
+		}
+		for _, rank := range ranks {
+			for _, stk := range rank.StakingTx {
+				if stk.BlkHeight+stk.FrozenPeriod >= h {
+					active[rank.ScriptHash] = append(active[rank.ScriptHash], stk)
+				}
+			}
+		}
+		projection, err := projectBlockStakingReward(active, h, chainParams)
+		if err != nil {
+			return nil, err
+		}
+		projection.Blocks = next - h
+		estimate.Projections = append(estimate.Projections, projection)
+
+		segment, err := mulAmount(projection.Reward, int64(projection.Blocks))
+		if err != nil {
+			return nil, err
+		}
+		if estimate.TotalReward, err = estimate.TotalReward.Add(segment); err != nil {
+			return nil, err
+		}
+	}
+	return estimate, nil
+}
+
+// projectBlockStakingReward returns the reward of the estimated staking in
+// the block at height, rewarding the active stakings the way coinbase does.
+func projectBlockStakingReward(active map[[sha256.Size]byte][]database.StakingTxInfo, height uint64,
+	chainParams *config.Params) (*StakingRewardProjection, error) {
+	pairs, err := database.SortMap(active, height, true)
+	if err != nil {
+		return nil, err
+	}
+	projection := &StakingRewardProjection{
+		Height:      height,
+		Rank:        -1,
+		Weight:      safetype.NewUint128(),
+		TotalWeight: safetype.NewUint128(),
+		Reward:      massutil.ZeroAmount(),
+	}
+	for i, pair := range pairs {
+		weight := pair.Weight
+		if height < consensus.Massip1Activation { // by value
+			if weight, err = safetype.NewUint128FromInt(pair.Value); err != nil {
+				return nil, err
+			}
+		}
+		if projection.TotalWeight, err = projection.TotalWeight.Add(weight); err != nil {
+			return nil, err
+		}
+		if pair.Key == estimatedStakingKey {
+			projection.Rank = i
+			projection.Weight = weight
+		}
+	}
+	if projection.Rank < 0 {
+		return projection, nil
+	}
+
+	subsidy := halvedSubsidy(height, chainParams)
+	if subsidy.IsZero() {
+		return projection, nil
+	}
+	_, superNode, err := calBlockSubsidy(subsidy, true, true)
+	if err != nil {
+		return nil, err
+	}
+	projection.Reward, err = calcNodeReward(superNode, projection.TotalWeight, projection.Weight)
+	if err != nil {
+		return nil, err
+	}
+	return projection, nil
+}
+
+func mulAmount(a massutil.Amount, n int64) (massutil.Amount, error) {
+	u, err := a.Value().MulInt(n)
+	if err != nil {
+		return massutil.ZeroAmount(), err
+	}
+	return massutil.NewAmount(u)
+}
+
+// nextHalvingHeight returns the first height after height at which the
+// subsidy halves, or 0 if it never does.
+func nextHalvingHeight(height uint64, chainParams *config.Params) uint64 {
+	if chainParams.SubsidyHalvingInterval == 0 || height == 0 {
+		return 0
+	}
+	// the subsidy halves when (height-1)/interval+1 reaches the next power of 2
+	t := uint64(1) << (calcRshNum(height) + 1)
+	return (t-1)*consensus.SubsidyHalvingInterval + 1
+}
+
+// averageBlockInterval returns the average interval of recent blocks up to
+// node.
+func averageBlockInterval(node *BlockNode) time.Duration {
+	if node.Height < blockIntervalWindow {
+		return defaultBlockInterval
+	}
+	ancestor := node.Ancestor(node.Height - blockIntervalWindow)
+	if ancestor == nil || !node.Timestamp.After(ancestor.Timestamp) {
+		return defaultBlockInterval
+	}
+	return node.Timestamp.Sub(ancestor.Timestamp) / blockIntervalWindow
+}
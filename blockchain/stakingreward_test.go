@@ -0,0 +1,95 @@
+package blockchain
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/consensus"
+	"massnet.org/mass-wallet/database"
+	"massnet.org/mass-wallet/massutil"
+)
+
+func TestNextHalvingHeight(t *testing.T) {
+	interval := consensus.SubsidyHalvingInterval
+	tests := []struct {
+		height uint64
+		next   uint64
+	}{
+		{1, interval + 1},
+		{interval, interval + 1},
+		{interval + 1, 3*interval + 1},
+		{3*interval + 1, 7*interval + 1},
+	}
+	for _, test := range tests {
+		next := nextHalvingHeight(test.height, &config.ChainParams)
+		if next != test.next {
+			t.Fatalf("height %d: expected %d, got %d", test.height, test.next, next)
+		}
+		if calcRshNum(next) != calcRshNum(test.height)+1 || calcRshNum(next-1) != calcRshNum(test.height) {
+			t.Fatalf("height %d: subsidy does not halve at %d", test.height, next)
+		}
+	}
+}
+
+func TestProjectStakingReward(t *testing.T) {
+	height := consensus.Massip1Activation + 1000
+	ranks := []database.Rank{
+		{
+			ScriptHash: sha256.Sum256([]byte("long")),
+			StakingTx: []database.StakingTxInfo{
+				{Value: 5000 * consensus.MaxwellPerMass, FrozenPeriod: consensus.MaxValidPeriod, BlkHeight: height - 50},
+			},
+		},
+		{
+			ScriptHash: sha256.Sum256([]byte("short")),
+			StakingTx: []database.StakingTxInfo{
+				{Value: 10000 * consensus.MaxwellPerMass, FrozenPeriod: 3000, BlkHeight: height - 100},
+			},
+		},
+	}
+	value, err := massutil.NewAmountFromUint(4096 * consensus.MaxwellPerMass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frozenPeriod := uint64(5000)
+	estimate, err := projectStakingReward(ranks, height, value, frozenPeriod, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if estimate.StartHeight != height+consensus.StakingTxRewardStart || estimate.EndHeight != height+frozenPeriod {
+		t.Fatalf("unexpected range [%d, %d]", estimate.StartHeight, estimate.EndHeight)
+	}
+	// the short staking expires in the middle
+	if len(estimate.Projections) != 2 {
+		t.Fatalf("expected 2 projections, got %d", len(estimate.Projections))
+	}
+	first, second := estimate.Projections[0], estimate.Projections[1]
+	if second.Height != height-100+3000+1 {
+		t.Fatalf("unexpected height of second projection %d", second.Height)
+	}
+	if first.Rank != 2 || second.Rank != 1 {
+		t.Fatalf("unexpected ranks %d, %d", first.Rank, second.Rank)
+	}
+	if first.Blocks+second.Blocks != estimate.EndHeight-estimate.StartHeight+1 {
+		t.Fatalf("projections cover %d blocks", first.Blocks+second.Blocks)
+	}
+	if first.Reward.IsZero() || first.Reward.Cmp(second.Reward) >= 0 {
+		t.Fatalf("expected reward to rise after expiry, got %s, %s", first.Reward, second.Reward)
+	}
+
+	total := massutil.ZeroAmount()
+	for _, p := range estimate.Projections {
+		segment, err := mulAmount(p.Reward, int64(p.Blocks))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total, err = total.Add(segment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if total.Cmp(estimate.TotalReward) != 0 {
+		t.Fatalf("expected total reward %s, got %s", total, estimate.TotalReward)
+	}
+}
@@ -280,13 +280,34 @@ func (tp *TxPool) removeDoubleSpends(tx *massutil.Tx) {
 // This function MUST be called with the mempool lock held (for writes).
 func (tp *TxPool) addTransaction(tx *massutil.Tx, height uint64, startingPriority float64,
 	totalInputValue, fee massutil.Amount) error {
-	// Add the transaction to the pool and mark the referenced outpoints
-	// as spent by the pool.
+	indexAddrs, err := tp.prepareAddTransaction(tx)
+	if err != nil {
+		return err
+	}
+	tp.insertTransaction(tx, height, startingPriority, totalInputValue, fee, indexAddrs)
+	return nil
+}
 
+// prepareAddTransaction runs the checks of adding tx to the pool which may
+// fail, and returns the addresses of tx to index.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) prepareAddTransaction(tx *massutil.Tx) ([]string, error) {
 	if tp.pool == nil {
-		return ErrTxPoolNil
+		return nil, ErrTxPoolNil
 	}
+	if !config.AddrIndex {
+		return nil, nil
+	}
+	return tp.txIndexAddresses(tx)
+}
 
+// insertTransaction adds tx to the pool, marks the referenced outpoints as
+// spent by the pool and indexes tx under indexAddrs.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (tp *TxPool) insertTransaction(tx *massutil.Tx, height uint64, startingPriority float64,
+	totalInputValue, fee massutil.Amount, indexAddrs []string) {
 	tp.pool[*tx.Hash()] = &TxDesc{
 		Tx:               tx,
 		Added:            time.Now(),
@@ -303,11 +324,9 @@ func (tp *TxPool) addTransaction(tx *massutil.Tx, height uint64, startingPriorit
 
 	tp.NewTxCh <- tx
 
-	if config.AddrIndex {
-		err := tp.addTransactionToAddrIndex(tx)
-		return err
+	for _, ea := range indexAddrs {
+		tp.indexAddressToTx(ea, tx)
 	}
-	return nil
 }
 
 // addTransactionToAddrIndex adds all addresses related to the transaction to
@@ -316,29 +335,43 @@ func (tp *TxPool) addTransaction(tx *massutil.Tx, height uint64, startingPriorit
 //
 // This function MUST be called with the mempool lock held (for writes).
 func (tp *TxPool) addTransactionToAddrIndex(tx *massutil.Tx) error {
+	indexAddrs, err := tp.txIndexAddresses(tx)
+	if err != nil {
+		return err
+	}
+	for _, ea := range indexAddrs {
+		tp.indexAddressToTx(ea, tx)
+	}
+	return nil
+}
+
+// txIndexAddresses returns the encoded addresses of the outputs referenced
+// and created by tx.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) txIndexAddresses(tx *massutil.Tx) ([]string, error) {
 	previousOutScripts, err := tp.fetchReferencedOutputScripts(tx)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "Unable to obtain referenced output scripts for the passed tx ",
 			logging.LogFormat{
 				"addrindex": err,
 			})
-		return err
+		return nil, err
 	}
-	// Index addresses of all referenced previous output tx's.
-	for _, pkScript := range previousOutScripts {
-		if err := tp.indexScriptAddressToTx(pkScript, tx); err != nil {
-			return err
-		}
+	pkScripts := previousOutScripts
+	for _, txOut := range tx.MsgTx().TxOut {
+		pkScripts = append(pkScripts, txOut.PkScript)
 	}
 
-	// Index addresses of all created outputs.
-	for _, txOut := range tx.MsgTx().TxOut {
-		if err := tp.indexScriptAddressToTx(txOut.PkScript, tx); err != nil {
-			return err
+	ret := make([]string, 0, len(pkScripts))
+	for _, pkScript := range pkScripts {
+		addresses, err := scriptIndexAddresses(pkScript)
+		if err != nil {
+			return nil, err
 		}
+		ret = append(ret, addresses...)
 	}
-
-	return nil
+	return ret, nil
 }
 
 // fetchReferencedOutputScripts looks up and returns all the scriptPubKeys
@@ -367,6 +400,30 @@ func (tp *TxPool) fetchReferencedOutputScripts(tx *massutil.Tx) ([][]byte, error
 //
 // This function MUST be called with the mempool lock held (for writes).
 func (tp *TxPool) indexScriptAddressToTx(pkScript []byte, tx *massutil.Tx) error {
+	addresses, err := scriptIndexAddresses(pkScript)
+	if err != nil {
+		return err
+	}
+	for _, ea := range addresses {
+		tp.indexAddressToTx(ea, tx)
+	}
+	return nil
+}
+
+// indexAddressToTx indexes the encoded address ea to tx.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (tp *TxPool) indexAddressToTx(ea string, tx *massutil.Tx) {
+	m, exists := tp.addrindex[ea]
+	if !exists {
+		m = make(map[wire.Hash]struct{})
+		tp.addrindex[ea] = m
+	}
+	m[*tx.Hash()] = struct{}{}
+}
+
+// scriptIndexAddresses returns the encoded addresses of pkScript.
+func scriptIndexAddresses(pkScript []byte) ([]string, error) {
 	_, addresses, _, _, err := txscript.ExtractPkScriptAddrs(pkScript,
 		&config.ChainParams)
 	if err != nil {
@@ -374,20 +431,13 @@ func (tp *TxPool) indexScriptAddressToTx(pkScript []byte, tx *massutil.Tx) error
 			logging.LogFormat{
 				"addrindex": err,
 			})
-		return err
+		return nil, err
 	}
-
+	ret := make([]string, 0, len(addresses))
 	for _, addr := range addresses {
-		ea := addr.EncodeAddress()
-		m, exists := tp.addrindex[ea]
-		if !exists {
-			m = make(map[wire.Hash]struct{})
-			tp.addrindex[ea] = m
-		}
-		m[*tx.Hash()] = struct{}{}
+		ret = append(ret, addr.EncodeAddress())
 	}
-
-	return nil
+	return ret, nil
 }
 
 // TODO: should lazily update txD.totalInputValue, but that costs a lot.
@@ -434,6 +484,64 @@ func (tp *TxPool) checkPoolDoubleSpend(tx *massutil.Tx) error {
 	return nil
 }
 
+// checkPoolReplacement checks whether or not the passed transaction may
+// replace the transactions in the pool spending the same outputs.  It returns
+// the transactions to be evicted, which are the conflicts and all of their
+// descendants, or ErrDoubleSpend if any conflict does not signal replacement.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) checkPoolReplacement(tx *massutil.Tx) (map[wire.Hash]*TxDesc, error) {
+	evicted := make(map[wire.Hash]*TxDesc)
+	queue := make([]*massutil.Tx, 0)
+	for _, txIn := range tx.MsgTx().TxIn {
+		txR, exists := tp.outpoints[txIn.PreviousOutPoint]
+		if !exists {
+			continue
+		}
+		if !SignalsReplacement(txR.MsgTx()) {
+			logging.CPrint(logging.ERROR, "output already spent by transaction in the memory pool",
+				logging.LogFormat{
+					"output":      txIn.PreviousOutPoint,
+					"transcation": txR.Hash(),
+				})
+			return nil, ErrDoubleSpend
+		}
+		if _, ok := evicted[*txR.Hash()]; !ok {
+			evicted[*txR.Hash()] = tp.pool[*txR.Hash()]
+			queue = append(queue, txR)
+		}
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for i := range cur.MsgTx().TxOut {
+			txR, exists := tp.outpoints[*wire.NewOutPoint(cur.Hash(), uint32(i))]
+			if !exists {
+				continue
+			}
+			if _, ok := evicted[*txR.Hash()]; !ok {
+				evicted[*txR.Hash()] = tp.pool[*txR.Hash()]
+				queue = append(queue, txR)
+			}
+		}
+		if len(evicted) > maxReplacementEvictions {
+			logging.CPrint(logging.ERROR, "replacement transaction evicts too many transactions",
+				logging.LogFormat{"txHash": tx.Hash(), "max": maxReplacementEvictions})
+			return nil, ErrTooManyReplacements
+		}
+	}
+
+	for _, txIn := range tx.MsgTx().TxIn {
+		if _, ok := evicted[txIn.PreviousOutPoint.Hash]; ok {
+			logging.CPrint(logging.ERROR, "replacement transaction spends outputs of replaced transactions",
+				logging.LogFormat{"txHash": tx.Hash(), "output": txIn.PreviousOutPoint})
+			return nil, ErrReplacementSpendsConflict
+		}
+	}
+	return evicted, nil
+}
+
 func (tp *TxPool) CheckPoolOutPointSpend(op *wire.OutPoint) bool {
 	tp.RLock()
 	defer tp.RUnlock()
@@ -490,6 +598,116 @@ func (tp *TxPool) FetchTransaction(txHash *wire.Hash) (*massutil.Tx, error) {
 	return nil, ErrTxExsit
 }
 
+// FetchTxDesc returns the descriptor of the requested transaction in the main
+// pool.  The descriptor is to be treated as read only.
+//
+// This function is safe for concurrent access.
+func (tp *TxPool) FetchTxDesc(txHash *wire.Hash) (*TxDesc, error) {
+	tp.RLock()
+	defer tp.RUnlock()
+
+	if txDesc, exists := tp.pool[*txHash]; exists {
+		return txDesc, nil
+	}
+	return nil, ErrTxExsit
+}
+
+// FetchTxRelatives returns the descriptors of the transactions in the main
+// pool which the requested transaction spends outputs of, and of those
+// spending its outputs, recursively.
+//
+// This function is safe for concurrent access.
+func (tp *TxPool) FetchTxRelatives(txHash *wire.Hash) (ancestors, descendants []*TxDesc, err error) {
+	tp.RLock()
+	defer tp.RUnlock()
+
+	txDesc, exists := tp.pool[*txHash]
+	if !exists {
+		return nil, nil, ErrTxExsit
+	}
+	return tp.txAncestors(txDesc), tp.txDescendants(txDesc), nil
+}
+
+// txAncestors returns the descriptors of the transactions in the main pool
+// which txDesc depends on, recursively.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) txAncestors(txDesc *TxDesc) []*TxDesc {
+	ancestors := make([]*TxDesc, 0)
+	seen := map[wire.Hash]struct{}{*txDesc.Tx.Hash(): {}}
+	queue := []*TxDesc{txDesc}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, txIn := range cur.Tx.MsgTx().TxIn {
+			prevHash := txIn.PreviousOutPoint.Hash
+			if _, ok := seen[prevHash]; ok {
+				continue
+			}
+			if prev, exists := tp.pool[prevHash]; exists {
+				seen[prevHash] = struct{}{}
+				ancestors = append(ancestors, prev)
+				queue = append(queue, prev)
+			}
+		}
+	}
+	return ancestors
+}
+
+// txDescendants returns the descriptors of the transactions in the main pool
+// which depend on txDesc, recursively.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (tp *TxPool) txDescendants(txDesc *TxDesc) []*TxDesc {
+	descendants := make([]*TxDesc, 0)
+	seen := map[wire.Hash]struct{}{*txDesc.Tx.Hash(): {}}
+	queue := []*massutil.Tx{txDesc.Tx}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for i := range cur.MsgTx().TxOut {
+			txR, exists := tp.outpoints[*wire.NewOutPoint(cur.Hash(), uint32(i))]
+			if !exists {
+				continue
+			}
+			if _, ok := seen[*txR.Hash()]; ok {
+				continue
+			}
+			seen[*txR.Hash()] = struct{}{}
+			if desc, exists := tp.pool[*txR.Hash()]; exists {
+				descendants = append(descendants, desc)
+			}
+			queue = append(queue, txR)
+		}
+	}
+	return descendants
+}
+
+// EvictTransaction removes the requested transaction from the main pool, along
+// with the transactions depending on it.  It returns the hashes of the removed
+// transactions, the requested one first.
+//
+// This function is safe for concurrent access.
+func (tp *TxPool) EvictTransaction(txHash *wire.Hash) ([]*wire.Hash, error) {
+	tp.Lock()
+	defer tp.Unlock()
+
+	txDesc, exists := tp.pool[*txHash]
+	if !exists {
+		return nil, ErrTxExsit
+	}
+	descendants := tp.txDescendants(txDesc)
+	evicted := make([]*wire.Hash, 0, len(descendants)+1)
+	evicted = append(evicted, txDesc.Tx.Hash())
+	for _, desc := range descendants {
+		evicted = append(evicted, desc.Tx.Hash())
+	}
+	tp.removeTransaction(txDesc.Tx, true)
+	logging.CPrint(logging.INFO, "transaction evicted from the memory pool",
+		logging.LogFormat{"txHash": txHash, "evicted": len(evicted)})
+	return evicted, nil
+}
+
 // FilterTransactionsByAddress returns all transactions currently in the
 // mempool that either create an output to the passed address or spend a
 // previously created ouput to the address.
@@ -598,7 +816,10 @@ func (tp *TxPool) maybeAcceptTransaction(tx *massutil.Tx, isNew, rateLimit bool)
 	// at this point.  There is a more in-depth check that happens later
 	// after fetching the referenced transaction inputs from the main chain
 	// which examines the actual spend data and prevents double spends.
-	err = tp.checkPoolDoubleSpend(tx)
+	//
+	// Transactions in the pool that signal replacement may be replaced by
+	// this one, the fees are checked once they are known below.
+	evicted, err := tp.checkPoolReplacement(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -683,6 +904,13 @@ func (tp *TxPool) maybeAcceptTransaction(tx *massutil.Tx, isNew, rateLimit bool)
 		return nil, err
 	}
 
+	// A replacement pays for the transactions it evicts and its own relay.
+	if len(evicted) > 0 {
+		if err = checkReplacementFee(txFee, serializedSize, evicted); err != nil {
+			return nil, err
+		}
+	}
+
 	if txFee.Cmp(requiredFee) < 0 {
 		if serializedSize >= (defaultBlockPrioritySize - 1000) {
 			logging.CPrint(logging.ERROR, "transaction`s fees is under the required amount",
@@ -749,10 +977,20 @@ func (tp *TxPool) maybeAcceptTransaction(tx *massutil.Tx, isNew, rateLimit bool)
 	if err != nil {
 		return nil, err
 	}
-	err = tp.addTransaction(tx, curHeight, startingPriority, totalInputValue, txFee)
+	// the replaced transactions are only removed once nothing can fail
+	indexAddrs, err := tp.prepareAddTransaction(tx)
 	if err != nil {
 		return nil, err
 	}
+	for hash, desc := range evicted {
+		logging.CPrint(logging.INFO, "transaction replaced in the memory pool",
+			logging.LogFormat{"replaced": hash, "by": txHash})
+		tp.removeTransaction(desc.Tx, false)
+	}
+	tp.insertTransaction(tx, curHeight, startingPriority, totalInputValue, txFee, indexAddrs)
+	if tp.chain.feeEstimator != nil {
+		tp.chain.feeEstimator.ObserveTransaction(txHash, txFee, serializedSize, curHeight)
+	}
 
 	err = tp.chain.notifyTransactionReceived(tx)
 	if err != nil {
@@ -884,3 +884,110 @@ func TestTxPool_MaybeAcceptTransaction_LockTime(t *testing.T) {
 	_, err = txP.maybeAcceptTransaction(tx, true, true)
 	assert.Equal(t, ErrImmatureSpend, err)
 }
+
+func newReplaceableTx(sequence uint64, value int64, prevOuts ...wire.OutPoint) *massutil.Tx {
+	msgTx := wire.NewMsgTx()
+	for i := range prevOuts {
+		txIn := wire.NewTxIn(&prevOuts[i], nil)
+		txIn.Sequence = sequence
+		msgTx.AddTxIn(txIn)
+	}
+	msgTx.AddTxOut(wire.NewTxOut(value, testTx.TxOut[0].PkScript))
+	return massutil.NewTx(msgTx)
+}
+
+func TestTxPool_checkPoolReplacement(t *testing.T) {
+	txP, close, err := newTxPool(25)
+	assert.Nil(t, err)
+	defer close()
+
+	op1 := wire.OutPoint{Hash: wire.DoubleHashH([]byte("op1"))}
+	op2 := wire.OutPoint{Hash: wire.DoubleHashH([]byte("op2"))}
+	parent := newReplaceableTx(MaxReplaceableSequence, 100, op1)
+	child := newReplaceableTx(MaxReplaceableSequence, 90, wire.OutPoint{Hash: *parent.Hash()})
+	final := newReplaceableTx(wire.MaxTxInSequenceNum-1, 100, op2)
+	for _, tx := range []*massutil.Tx{parent, child, final} {
+		txP.addTransaction(tx, 25, 1, massutil.ZeroAmount(), massutil.ZeroAmount())
+	}
+
+	// conflict and its descendants are evicted
+	evicted, err := txP.checkPoolReplacement(newReplaceableTx(wire.MaxTxInSequenceNum, 80, op1))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(evicted))
+	assert.NotNil(t, evicted[*parent.Hash()])
+	assert.NotNil(t, evicted[*child.Hash()])
+
+	// no conflict
+	evicted, err = txP.checkPoolReplacement(newReplaceableTx(wire.MaxTxInSequenceNum, 80, wire.OutPoint{Index: 1}))
+	assert.Nil(t, err)
+	assert.Zero(t, len(evicted))
+
+	// conflict not signaling replacement
+	_, err = txP.checkPoolReplacement(newReplaceableTx(MaxReplaceableSequence, 80, op2))
+	assert.Equal(t, ErrDoubleSpend, err)
+
+	// replacement depending on the replaced
+	_, err = txP.checkPoolReplacement(newReplaceableTx(MaxReplaceableSequence, 80, op1, wire.OutPoint{Hash: *parent.Hash()}))
+	assert.Equal(t, ErrReplacementSpendsConflict, err)
+}
+
+func TestCheckReplacementFee(t *testing.T) {
+	replaced := newReplaceableTx(MaxReplaceableSequence, 100, wire.OutPoint{})
+	size := int64(replaced.MsgTx().PlainSize())
+	relayFee, err := CalcMinRequiredTxRelayFee(size, massutil.MinRelayTxFee())
+	assert.Nil(t, err)
+	evicted := map[wire.Hash]*TxDesc{
+		*replaced.Hash(): {Tx: replaced, Fee: relayFee},
+	}
+
+	tests := []struct {
+		fee    int64
+		expect error
+	}{
+		{relayFee.IntValue(), ErrReplacementFee},
+		{2*relayFee.IntValue() - 1, ErrReplacementFee},
+		{2 * relayFee.IntValue(), nil},
+	}
+	for i, test := range tests {
+		fee, err := massutil.NewAmountFromInt(test.fee)
+		assert.Nil(t, err)
+		if err = checkReplacementFee(fee, size, evicted); err != test.expect {
+			t.Errorf("%d: expected %v, got %v", i, test.expect, err)
+		}
+	}
+	assert.True(t, SignalsReplacement(replaced.MsgTx()))
+	assert.False(t, SignalsReplacement(newReplaceableTx(wire.MaxTxInSequenceNum-1, 100, wire.OutPoint{}).MsgTx()))
+}
+
+func TestTxPool_EvictTransaction(t *testing.T) {
+	txP, close, err := newTxPool(25)
+	assert.Nil(t, err)
+	defer close()
+
+	parent := newReplaceableTx(wire.MaxTxInSequenceNum, 100, wire.OutPoint{Hash: wire.DoubleHashH([]byte("op1"))})
+	child := newReplaceableTx(wire.MaxTxInSequenceNum, 90, wire.OutPoint{Hash: *parent.Hash()})
+	grandchild := newReplaceableTx(wire.MaxTxInSequenceNum, 80, wire.OutPoint{Hash: *child.Hash()})
+	other := newReplaceableTx(wire.MaxTxInSequenceNum, 100, wire.OutPoint{Hash: wire.DoubleHashH([]byte("op2"))})
+	for _, tx := range []*massutil.Tx{parent, child, grandchild, other} {
+		txP.addTransaction(tx, 25, 1, massutil.ZeroAmount(), massutil.ZeroAmount())
+	}
+
+	ancestors, descendants, err := txP.FetchTxRelatives(child.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ancestors))
+	assert.Equal(t, parent.Hash(), ancestors[0].Tx.Hash())
+	assert.Equal(t, 1, len(descendants))
+	assert.Equal(t, grandchild.Hash(), descendants[0].Tx.Hash())
+
+	_, err = txP.FetchTxDesc(&wire.Hash{})
+	assert.Equal(t, ErrTxExsit, err)
+	_, err = txP.EvictTransaction(&wire.Hash{})
+	assert.Equal(t, ErrTxExsit, err)
+
+	evicted, err := txP.EvictTransaction(child.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, []*wire.Hash{child.Hash(), grandchild.Hash()}, evicted)
+	assert.Equal(t, 2, txP.Count())
+	assert.True(t, txP.IsTransactionInPool(parent.Hash()))
+	assert.False(t, txP.CheckPoolOutPointSpend(&wire.OutPoint{Hash: *parent.Hash()}))
+}
@@ -447,14 +447,7 @@ func calBlockSubsidy(subsidy *safetype.Uint128, hasValidBinding, hasSuperNode bo
 func CalcBlockSubsidy(height uint64, chainParams *config.Params, totalBinding massutil.Amount, numRank, bitLength int) (
 	miner, superNode massutil.Amount, err error) {
 
-	subsidy := baseSubsidy
-	if chainParams.SubsidyHalvingInterval != 0 {
-		subsidy = baseSubsidy.Rsh(calcRshNum(height))
-		if subsidy.Lt(minHalvedSubsidy) {
-			subsidy = safetype.NewUint128()
-		}
-	}
-
+	subsidy := halvedSubsidy(height, chainParams)
 	if subsidy.IsZero() {
 		return massutil.ZeroAmount(), massutil.ZeroAmount(), nil
 	}
@@ -476,6 +469,19 @@ func CalcBlockSubsidy(height uint64, chainParams *config.Params, totalBinding ma
 	return calBlockSubsidy(subsidy, hasValidBinding, hasSuperNode)
 }
 
+// halvedSubsidy returns the total subsidy of the block at height, before it is
+// split between the miner and stakings.
+func halvedSubsidy(height uint64, chainParams *config.Params) *safetype.Uint128 {
+	subsidy := baseSubsidy
+	if chainParams.SubsidyHalvingInterval != 0 {
+		subsidy = baseSubsidy.Rsh(calcRshNum(height))
+		if subsidy.Lt(minHalvedSubsidy) {
+			subsidy = safetype.NewUint128()
+		}
+	}
+	return subsidy
+}
+
 func calcRshNum(height uint64) uint {
 	t := (height-1)/consensus.SubsidyHalvingInterval + 1
 	i := uint(0)
@@ -25,11 +25,20 @@ func Execute() {
 
 func init() {
 	cobra.OnInitialize(initConfig)
+	rootCmd.PersistentFlags().StringVar(&client.walletId, "wallet", "", "wallet id serving the command instead of the wallet in use")
 
 	// cmd_others
 	rootCmd.AddCommand(createCertCmd)
 	rootCmd.AddCommand(getClientStatusCmd)
 	rootCmd.AddCommand(getBestBlockCmd)
+	rootCmd.AddCommand(getBlockCmd)
+	rootCmd.AddCommand(getBlockHeaderCmd)
+	rootCmd.AddCommand(getBlockHashCmd)
+	rootCmd.AddCommand(getChainTipsCmd)
+	rootCmd.AddCommand(getMinedBlocksCmd)
+	rootCmd.AddCommand(searchAddressTransactionsCmd)
+	rootCmd.AddCommand(getAddressUtxosCmd)
+	rootCmd.AddCommand(getAddressReceivedCmd)
 	rootCmd.AddCommand(stopCmd)
 
 	// cmd_wallet
@@ -38,12 +47,22 @@ func init() {
 	rootCmd.AddCommand(useWalletCmd)
 	rootCmd.AddCommand(importWalletCmd)
 	rootCmd.AddCommand(importMnemonicCmd)
+	rootCmd.AddCommand(importMultisigCmd)
+	rootCmd.AddCommand(importWatchOnlyCmd)
 	rootCmd.AddCommand(exportWalletCmd)
 	rootCmd.AddCommand(removeWalletCmd)
 	rootCmd.AddCommand(getWalletMnemonicCmd)
+	rootCmd.AddCommand(walletPassphraseCmd)
+	rootCmd.AddCommand(walletLockCmd)
+	rootCmd.AddCommand(getWalletXpubCmd)
 	rootCmd.AddCommand(getWalletBalanceCmd)
 	rootCmd.AddCommand(getAddressBalanceCmd)
 	rootCmd.AddCommand(listUtxoCmd)
+	rootCmd.AddCommand(freezeUtxoCmd)
+	rootCmd.AddCommand(unfreezeUtxoCmd)
+	rootCmd.AddCommand(setUtxoLabelCmd)
+	rootCmd.AddCommand(consolidateUtxoCmd)
+	rootCmd.AddCommand(sweepPrivateKeyCmd)
 	rootCmd.AddCommand(createAddressCmd)
 	rootCmd.AddCommand(listAddressesCmd)
 	rootCmd.AddCommand(validateAddressCmd)
@@ -52,18 +71,36 @@ func init() {
 	rootCmd.AddCommand(createRawTransactionCmd)
 	rootCmd.AddCommand(autoCreateRawTransactionCmd)
 	rootCmd.AddCommand(signRawTransactionCmd)
+	rootCmd.AddCommand(createPsbtCmd)
+	rootCmd.AddCommand(decodePsbtCmd)
+	rootCmd.AddCommand(signPsbtCmd)
+	rootCmd.AddCommand(combinePsbtCmd)
+	rootCmd.AddCommand(finalizePsbtCmd)
 	rootCmd.AddCommand(getTransactionFeeCmd)
+	rootCmd.AddCommand(estimateFeeRateCmd)
 	rootCmd.AddCommand(sendRawTransactionCmd)
+	rootCmd.AddCommand(bumpFeeCmd)
 	rootCmd.AddCommand(getRawTransactionCmd)
 	rootCmd.AddCommand(decodeRawTransactionCmd)
 	rootCmd.AddCommand(getTxStatusCmd)
+	rootCmd.AddCommand(getMempoolInfoCmd)
+	rootCmd.AddCommand(getRawMempoolCmd)
+	rootCmd.AddCommand(getMempoolEntryCmd)
+	rootCmd.AddCommand(evictMempoolTransactionCmd)
 	rootCmd.AddCommand(listTrasactionsCmd)
+	rootCmd.AddCommand(queryTransactionsCmd)
+	rootCmd.AddCommand(exportTransactionsCmd)
 
 	rootCmd.AddCommand(createStakingTransactionCmd)
+	rootCmd.AddCommand(withdrawStakingCmd)
 	rootCmd.AddCommand(getStakingHistoryCmd)
 	rootCmd.AddCommand(getBlockStakingReward)
+	rootCmd.AddCommand(estimateStakingRewardCmd)
+	rootCmd.AddCommand(getStakingRewardHistoryCmd)
 
 	rootCmd.AddCommand(createBindingTransactionCmd)
+	rootCmd.AddCommand(unbindCmd)
 	rootCmd.AddCommand(getBindingHistoryCmd)
 	rootCmd.AddCommand(getAddressBindingCmd)
+	rootCmd.AddCommand(checkBindingRequirementCmd)
 }
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -71,6 +73,56 @@ var createBindingTransactionCmd = &cobra.Command{
 	},
 }
 
+var unbindCmd = &cobra.Command{
+	Use:   "unbind [holder=?] [poc=?] [amount=?] [to=?] [fee=?]",
+	Short: "Creates a transaction withdrawing bindings of current wallet.",
+	Long: "Creates a transaction spending binding UTXOs of current wallet to a standard address.\n" +
+		"With amount, the smallest bindings are spent until the amount is covered, and the excess\n" +
+		"is bound again to the same holder and PoC address. The transaction is unsigned, sign it\n" +
+		"by signrawtransaction.\n" +
+		"\nArguments:\n" +
+		"  [holder]     optional, holder address of the bindings, any address of current wallet by default\n" +
+		"  [poc]        optional, PoC address of the bindings, any PoC address by default\n" +
+		"  [amount]     optional, MASS to unbind, all matched bindings by default\n" +
+		"  [to]         optional, address receiving the unbound MASS, the holder address by default\n" +
+		"  [fee]        optional, MASS paid to miner, the min relay fee by default\n",
+	Example: `  unbind poc=146hGPwfYRDde6tJ6trbyhkSoPwt69AqyZ amount=1 fee=0.0001`,
+	Args:    cobra.RangeArgs(0, 5),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.CreateBindingWithdrawTransactionRequest{}
+		for _, arg := range args {
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "holder":
+				req.HolderAddress = value
+			case "poc":
+				req.BindingAddress = value
+			case "amount":
+				req.Amount = value
+			case "to":
+				req.ToAddress = value
+			case "fee":
+				req.Fee = value
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+		logging.VPrint(logging.INFO, "unbind called", logging.LogFormat{
+			"holder": req.HolderAddress,
+			"poc":    req.BindingAddress,
+			"amount": req.Amount,
+			"to":     req.ToAddress,
+			"fee":    req.Fee,
+		})
+
+		resp := &pb.CreateRawTransactionResponse{}
+		return ClientCall("/v1/transactions/binding/withdraw", POST, req, resp)
+	},
+}
+
 var getBindingHistoryCmd = &cobra.Command{
 	Use:   "listbindingtransactions [all]",
 	Short: "Returns binding transaction of current wallet.",
@@ -108,3 +160,69 @@ var getAddressBindingCmd = &cobra.Command{
 		return ClientCall("/v1/addresses/binding", POST, req, resp)
 	},
 }
+
+var checkBindingRequirementCmd = &cobra.Command{
+	Use:   "checkbindingrequirement <file> <bit_length> [plot_count=?]",
+	Short: "Checks whether PoC addresses have enough binding for their plots.",
+	Long: "Checks the binding of each PoC address listed in file against the binding required to mine\n" +
+		"plots of bit_length, and returns the required, current, mature and missing binding MASS.\n" +
+		"Only mature binding outputs count for mining, no more than 10 of them.\n" +
+		"\nArguments:\n" +
+		"  <file>           file of PoC addresses, one per line, empty lines and lines starting with '#' ignored,\n" +
+		"                   1000 addresses at most\n" +
+		"  <bit_length>     bit length of the plots\n" +
+		"  [plot_count]     optional, number of plots of each address, 1 by default\n",
+	Example: `  checkbindingrequirement miners.txt 32 plot_count=2`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.RangeArgs(2, 3)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bitLength, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return ErrInvalidArgument
+		}
+		req := &pb.CheckBindingRequirementRequest{BitLength: uint32(bitLength)}
+		if len(args) > 2 {
+			key, value, err := parseCommandVar(args[2])
+			if err != nil {
+				return err
+			}
+			if key != "plot_count" {
+				return errorUnknownCommandParam(key)
+			}
+			if req.PlotCount, err = strconv.ParseUint(value, 10, 64); err != nil {
+				return ErrInvalidArgument
+			}
+		}
+
+		data, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			logging.VPrint(logging.ERROR, "failed to read file", logging.LogFormat{"err": err, "file": args[0]})
+			return err
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if len(line) == 0 || strings.HasPrefix(line, "#") {
+				continue
+			}
+			req.PocAddresses = append(req.PocAddresses, line)
+		}
+		if len(req.PocAddresses) == 0 {
+			logging.VPrint(logging.ERROR, "no address in file", logging.LogFormat{"file": args[0]})
+			return ErrInvalidArgument
+		}
+
+		logging.VPrint(logging.INFO, "checkbindingrequirement called", logging.LogFormat{
+			"file":       args[0],
+			"addresses":  len(req.PocAddresses),
+			"bit_length": req.BitLength,
+			"plot_count": req.PlotCount,
+		})
+		resp := &pb.CheckBindingRequirementResponse{}
+		return ClientCall("/v1/addresses/binding/requirement", POST, req, resp)
+	},
+}
@@ -2,8 +2,11 @@ package cmd
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	jww "github.com/spf13/jwalterweatherman"
@@ -67,6 +70,261 @@ var getBestBlockCmd = &cobra.Command{
 	},
 }
 
+// blockPath returns the api path of the block specified by a hash or a height.
+func blockPath(block string) string {
+	if _, err := strconv.ParseUint(block, 10, 64); err == nil {
+		return "/v1/blocks/height/" + block
+	}
+	return "/v1/blocks/hash/" + block
+}
+
+var getBlockCmd = &cobra.Command{
+	Use:   "getblock <hash|height> [verbose]",
+	Short: "Returns a block of the chain.",
+	Long: "Returns the header and transaction ids of a block, and the hex-encoded block.\n" +
+		"\nArguments:\n" +
+		"  <hash|height>   hash of the block, or height of the block in main chain\n" +
+		"  [verbose]       optional, returns the decoded transactions instead of the hex-encoded block\n",
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getblock called", logging.LogFormat{"args": args})
+
+		resp := &pb.GetBlockResponse{}
+		if len(args) < 2 || strings.ToLower(args[1]) != "verbose" {
+			return ClientCall(blockPath(args[0]), GET, nil, resp)
+		}
+		return ClientCall(blockPath(args[0])+"?verbose=true", GET, nil, resp)
+	},
+}
+
+var getBlockHeaderCmd = &cobra.Command{
+	Use:   "getblockheader <hash|height>",
+	Short: "Returns the header of a block.",
+	Long: "Returns the header of a block.\n" +
+		"\nArguments:\n" +
+		"  <hash|height>   hash of the block, or height of the block in main chain\n",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getblockheader called", logging.LogFormat{"block": args[0]})
+
+		resp := &pb.BlockHeader{}
+		return ClientCall(blockPath(args[0])+"/header", GET, nil, resp)
+	},
+}
+
+var getBlockHashCmd = &cobra.Command{
+	Use:   "getblockhash <height>",
+	Short: "Returns the hash of the block at height in main chain.",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		_, err := strconv.ParseUint(args[0], 10, 64)
+		return err
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getblockhash called", logging.LogFormat{"height": args[0]})
+
+		resp := &pb.GetBlockHashResponse{}
+		return ClientCall(fmt.Sprintf("/v1/blocks/height/%s/hash", args[0]), GET, nil, resp)
+	},
+}
+
+var getChainTipsCmd = &cobra.Command{
+	Use:   "getchaintips",
+	Short: "Returns the tips of main chain and of the known side chains.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getchaintips called", EmptyLogFormat)
+
+		resp := &pb.GetChainTipsResponse{}
+		return ClientCall("/v1/blocks/tips", GET, nil, resp)
+	},
+}
+
+var getMinedBlocksCmd = &cobra.Command{
+	Use:   "getminedblocks <poc_pub_key>",
+	Short: "Returns the heights of blocks mined by a poc public key.",
+	Long: "Returns the heights of blocks mined by a poc public key.\n" +
+		"\nArguments:\n" +
+		"  <poc_pub_key>   hex-encoded poc public key\n",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getminedblocks called", logging.LogFormat{"pubkey": args[0]})
+
+		resp := &pb.GetMinedBlocksResponse{}
+		return ClientCall("/v1/blocks/mined/"+args[0], GET, nil, resp)
+	},
+}
+
+// parseHeightRange parses the height range args of the address index commands
+// into query parameters.
+func parseHeightRange(key, value string, query url.Values) (bool, error) {
+	switch key {
+	case "minheight":
+		query.Set("min_height", value)
+	case "maxheight":
+		query.Set("max_height", value)
+	default:
+		return false, nil
+	}
+	_, err := strconv.ParseUint(value, 10, 64)
+	return true, err
+}
+
+var searchAddressTransactionsCmd = &cobra.Command{
+	Use:   "searchaddresstransactions <address> [minheight=?] [maxheight=?] [skip=?] [count=?] [order=?]",
+	Short: "Returns transactions of any address by the address index of the node.",
+	Long: "Returns transactions of any witness or staking address by the address index of the node,\n" +
+		"with the amounts paid to and spent from the address.\n" +
+		"\nArguments:\n" +
+		"  <address>     witness or staking address\n" +
+		"  [minheight]   optional, lowest block height, inclusive.\n" +
+		"  [maxheight]   optional, highest block height, inclusive.\n" +
+		"  [skip]        optional, number of transactions to skip.\n" +
+		"  [count]       optional, max number of transactions, if not provided(or 0) a default value will be used.\n" +
+		"  [order]       optional, 'asc' for oldest first or 'desc' for newest first, default desc.\n",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.RangeArgs(1, 6)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query := url.Values{}
+		for _, arg := range args[1:] {
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			if ok, err := parseHeightRange(key, value, query); ok {
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			switch key {
+			case "skip", "count":
+				if _, err = strconv.ParseUint(value, 10, 32); err != nil {
+					return err
+				}
+				query.Set(key, value)
+			case "order":
+				switch value {
+				case "asc":
+					query.Set("ascending", "true")
+				case "desc":
+				default:
+					return ErrInvalidArgument
+				}
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+		logging.VPrint(logging.INFO, "searchaddresstransactions called", logging.LogFormat{
+			"address": args[0],
+			"query":   query.Encode(),
+		})
+
+		resp := &pb.SearchAddressTransactionsResponse{}
+		return ClientCall("/v1/addresses/"+args[0]+"/transactions?"+query.Encode(), GET, nil, resp)
+	},
+}
+
+var getAddressUtxosCmd = &cobra.Command{
+	Use:   "getaddressutxos <address> [minheight=?] [maxheight=?] [skip=?] [count=?]",
+	Short: "Returns unspent outputs of any address by the address index of the node.",
+	Long: "Returns unspent outputs of any witness or staking address by the address index of the node,\n" +
+		"oldest first.\n" +
+		"\nArguments:\n" +
+		"  <address>     witness or staking address\n" +
+		"  [minheight]   optional, lowest block height, inclusive.\n" +
+		"  [maxheight]   optional, highest block height, inclusive.\n" +
+		"  [skip]        optional, number of utxos to skip.\n" +
+		"  [count]       optional, max number of utxos, if not provided(or 0) a default value will be used.\n",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.RangeArgs(1, 5)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query := url.Values{}
+		for _, arg := range args[1:] {
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			if ok, err := parseHeightRange(key, value, query); ok {
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			switch key {
+			case "skip", "count":
+				if _, err = strconv.ParseUint(value, 10, 32); err != nil {
+					return err
+				}
+				query.Set(key, value)
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+		logging.VPrint(logging.INFO, "getaddressutxos called", logging.LogFormat{
+			"address": args[0],
+			"query":   query.Encode(),
+		})
+
+		resp := &pb.GetAddressUtxosResponse{}
+		return ClientCall("/v1/addresses/"+args[0]+"/utxos?"+query.Encode(), GET, nil, resp)
+	},
+}
+
+var getAddressReceivedCmd = &cobra.Command{
+	Use:   "getaddressreceived <address> [minheight=?] [maxheight=?]",
+	Short: "Returns the amount received by any address by the address index of the node.",
+	Long: "Returns the amounts paid to and spent from any witness or staking address within the heights,\n" +
+		"by the address index of the node.\n" +
+		"\nArguments:\n" +
+		"  <address>     witness or staking address\n" +
+		"  [minheight]   optional, lowest block height, inclusive.\n" +
+		"  [maxheight]   optional, highest block height, inclusive.\n",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.RangeArgs(1, 3)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query := url.Values{}
+		for _, arg := range args[1:] {
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			ok, err := parseHeightRange(key, value, query)
+			if !ok {
+				return errorUnknownCommandParam(key)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		logging.VPrint(logging.INFO, "getaddressreceived called", logging.LogFormat{
+			"address": args[0],
+			"query":   query.Encode(),
+		})
+
+		resp := &pb.GetAddressReceivedResponse{}
+		return ClientCall("/v1/addresses/"+args[0]+"/received?"+query.Encode(), GET, nil, resp)
+	},
+}
+
 var stopCmd = &cobra.Command{
 	Use:   "stop",
 	Short: "Stop wallet node.",
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	pb "massnet.org/mass-wallet/api/proto"
@@ -11,11 +12,12 @@ import (
 )
 
 var (
-	frozenPeriod uint32
+	frozenPeriod  uint32
+	coinSelection string
 )
 
 var createStakingTransactionCmd = &cobra.Command{
-	Use:   "createstakingtransaction <staking_address> <frozen_period> <value> [fee=?] [from=?]",
+	Use:   "createstakingtransaction <staking_address> <frozen_period> <value> [fee=?] [from=?] [selection=?]",
 	Short: "Creates a staking transaction.",
 	Long: "Creates a staking transaction.\n" +
 		"\nArguments:\n" +
@@ -24,7 +26,8 @@ var createStakingTransactionCmd = &cobra.Command{
 		"  <value>              amount of staked MASS, a real with max 8 decimal places\n" +
 		"  [fee]                optional, MASS paid to miner, a real with max 8 decimal places\n" +
 		"  [from]               optional, the address of current wallet from which all inputs selected. \n" +
-		"                       if not provided inputs may be selected from any address of current wallet\n",
+		"                       if not provided inputs may be selected from any address of current wallet\n" +
+		"  [selection]          optional, strategy of selecting inputs, one of 'default', 'bnb', 'oldest' and 'consolidate'\n",
 	Example: `  createstakingtransaction ms1qp0czrc8errz8gdmpjgxd59kwvydf3g3ch72d6qm2kqwzlgm232pksqw0eky 1000 95.5 fee=0.05`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if err := cobra.RangeArgs(3, 5)(cmd, args); err != nil {
@@ -46,6 +49,8 @@ var createStakingTransactionCmd = &cobra.Command{
 				fee = value
 			case "from":
 				from = value
+			case "selection":
+				coinSelection = value
 			default:
 				return errorUnknownCommandParam(key)
 			}
@@ -59,6 +64,7 @@ var createStakingTransactionCmd = &cobra.Command{
 			"frozen_period":   frozenPeriod,
 			"amount":          args[2],
 			"fee":             fee,
+			"selection":       coinSelection,
 		})
 
 		req := &pb.CreateStakingTransactionRequest{
@@ -67,12 +73,57 @@ var createStakingTransactionCmd = &cobra.Command{
 			FrozenPeriod:   frozenPeriod,
 			Amount:         args[2],
 			Fee:            fee,
+			CoinSelection:  coinSelection,
 		}
 		resp := &pb.CreateRawTransactionResponse{}
 		return ClientCall("/v1/transactions/staking", POST, req, resp)
 	},
 }
 
+var withdrawStakingCmd = &cobra.Command{
+	Use:   "withdrawstaking [txid ...] [to=?] [fee=?]",
+	Short: "Creates a transaction withdrawing expired staking outputs.",
+	Long: "Creates a transaction spending expired staking outputs of current wallet to a standard address,\n" +
+		"with the sequence each staking output requires. The transaction is unsigned, sign it by\n" +
+		"signrawtransaction.\n" +
+		"\nArguments:\n" +
+		"  [txid]               optional, staking transactions to withdraw, all expired stakings by default\n" +
+		"  [to]                 optional, address receiving the withdrawn amount,\n" +
+		"                       the withdraw address of the first staking output by default\n" +
+		"  [fee]                optional, MASS paid to miner, the min relay fee by default\n",
+	Example: `  withdrawstaking 5f6e4a6e1a7ac1c1a8e1d9d6bd8cd5e5ef5e1e35b2d44e29d3a0d4c2a8b3e1c5 fee=0.001`,
+	Args:    cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.CreateStakingWithdrawTransactionRequest{}
+		for _, arg := range args {
+			if !strings.Contains(arg, "=") {
+				req.Txids = append(req.Txids, arg)
+				continue
+			}
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "to":
+				req.ToAddress = value
+			case "fee":
+				req.Fee = value
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+		logging.VPrint(logging.INFO, "withdrawstaking called", logging.LogFormat{
+			"txids": req.Txids,
+			"to":    req.ToAddress,
+			"fee":   req.Fee,
+		})
+
+		resp := &pb.CreateRawTransactionResponse{}
+		return ClientCall("/v1/transactions/staking/withdraw", POST, req, resp)
+	},
+}
+
 var getStakingHistoryCmd = &cobra.Command{
 	Use:   "liststakingtransactions [all]",
 	Short: "Returns staking transactions of current wallet.",
@@ -90,6 +141,87 @@ var getStakingHistoryCmd = &cobra.Command{
 	},
 }
 
+var estimateStakingRewardCmd = &cobra.Command{
+	Use:   "estimatestakingreward <value> <frozen_period>",
+	Short: "Estimates the reward of a new staking.",
+	Long: "Estimates the rank, weight and reward of staking value MASS for frozen_period blocks from the next block,\n" +
+		"against current unexpired stakings, assuming no new stakings. The reward is projected again whenever\n" +
+		"other stakings expire or the block subsidy halves.\n" +
+		"\nArguments:\n" +
+		"  <value>              amount of staked MASS, a real with max 8 decimal places\n" +
+		"  <frozen_period>      number of blocks the staking would be locked\n",
+	Example: `  estimatestakingreward 10000 65000`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(2)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		lh, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return err
+		}
+		frozenPeriod = uint32(lh)
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "estimatestakingreward called", logging.LogFormat{
+			"amount":        args[0],
+			"frozen_period": frozenPeriod,
+		})
+		req := &pb.EstimateStakingRewardRequest{
+			Amount:       args[0],
+			FrozenPeriod: frozenPeriod,
+		}
+		resp := &pb.EstimateStakingRewardResponse{}
+		return ClientCall("/v1/staking/estimate", POST, req, resp)
+	},
+}
+
+var getStakingRewardHistoryCmd = &cobra.Command{
+	Use:   "getstakingrewardhistory [from=?] [to=?]",
+	Short: "Returns the staking rewards received by current wallet per day.",
+	Long: "Returns the staking rewards paid to the staking addresses of current wallet by coinbase, summed up\n" +
+		"per day (UTC) and staking address, with the total and average block reward of each address.\n" +
+		"\nArguments:\n" +
+		"  [from]       optional, earliest date as 'yyyy-mm-dd' (UTC) or unix seconds, inclusive.\n" +
+		"  [to]         optional, latest date as 'yyyy-mm-dd' (UTC) or unix seconds, inclusive.\n",
+	Example: `  getstakingrewardhistory from=2020-05-01 to=2020-05-31`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.MaximumNArgs(2)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.GetStakingRewardHistoryRequest{}
+		for _, arg := range args {
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "from":
+				if req.StartTime, err = parseTimeInLocation(value, false, time.UTC); err != nil {
+					return err
+				}
+			case "to":
+				if req.EndTime, err = parseTimeInLocation(value, true, time.UTC); err != nil {
+					return err
+				}
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+		logging.VPrint(logging.INFO, "getstakingrewardhistory called", logging.LogFormat{
+			"from": req.StartTime,
+			"to":   req.EndTime,
+		})
+		resp := &pb.GetStakingRewardHistoryResponse{}
+		return ClientCall("/v1/staking/rewards/history", POST, req, resp)
+	},
+}
+
 var getBlockStakingReward = &cobra.Command{
 	Use:   "getblockstakingreward [height]",
 	Short: "Returns staking reward list at target height.",
@@ -3,12 +3,15 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
+	"time"
 
 	pb "massnet.org/mass-wallet/api/proto"
 	"massnet.org/mass-wallet/logging"
 
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +23,7 @@ var (
 	inputs          []*pb.TransactionInput
 	outputs         map[string]string
 	signFlags       = "ALL"
+	signPassphrase  string
 	estimateBinding bool
 	historyCount    uint32
 )
@@ -70,9 +74,18 @@ var autoCreateRawTransactionCmd = &cobra.Command{
 		"\n<json_data>:\n" +
 		"  - amounts		required\n" +
 		"  - fee			optional, floating fee with max 8 decimal places\n" +
+		"  - fee_rate		optional, fee in MASS per KB, or 'auto' to estimate it from recent blocks, exclusive with fee\n" +
 		"  - lock_time		optional\n" +
 		"  - change_address	optional, the first sender address will be used by default.\n" +
-		"  - from_address	optional, specific sender, if not provided, the inputs may be selected from any address of current wallet\n",
+		"  - from_address	optional, specific sender, if not provided, the inputs may be selected from any address of current wallet\n" +
+		"  - replaceable	optional, true to allow replacing the transaction by 'bumpfee' before it is mined\n" +
+		"  - include_utxos	optional, list of {tx_id, vout} always spent by the transaction, even if frozen\n" +
+		"  - exclude_utxos	optional, list of {tx_id, vout} never spent by the transaction\n" +
+		"  - coin_selection	optional, strategy of selecting inputs:\n" +
+		"      default:     spends the largest utxos not exceeding the amount first\n" +
+		"      bnb:         searches utxos matching the amount so that no change is needed, the remaining dust is paid as fee\n" +
+		"      oldest:      spends the oldest utxos first\n" +
+		"      consolidate: spends as many small utxos as possible besides, for merging utxos while fees are low\n",
 	Example: `	autocreaterawtransaction '{"amounts":{"ms1qqwmyrmca0zfcpyhjv7tdek2mvsrtr6yzrm8g227r4ryadn42hs0hst2gvut": "1.01"},` +
 		`"change_address":"ms1qq8mg72nwy02g0zpej0247rwtccycy3zrjmv8na5vl3yp6dgttd7ds0pa2df",` +
 		`"fee":"0.005"}'`,
@@ -91,12 +104,12 @@ var autoCreateRawTransactionCmd = &cobra.Command{
 }
 
 var signRawTransactionCmd = &cobra.Command{
-	Use:   "signrawtransaction <hexstring> <passphrase> [mode=?]",
+	Use:   "signrawtransaction <hexstring> [passphrase] [mode=?]",
 	Short: "Adds signatures to a raw transaction and returns the resulting raw transaction.",
 	Long: "Adds signatures to a raw transaction and returns the resulting raw transaction.\n" +
 		"\nArguments:\n" +
 		"  <hexstring>   signed, serialized, hex-encoded transaction\n" +
-		"  <passphrase>  \n" +
+		"  [passphrase]  Optional if wallet is unlocked by walletpassphrase\n" +
 		"  [mode]        Optional, allowed modes(normally ALL) are:\n" +
 		"            ALL:                 sign for all inputs and outputs (default)\n" +
 		"            NONE:                sign for all inputs\n" +
@@ -104,41 +117,139 @@ var signRawTransactionCmd = &cobra.Command{
 		"            ALL|ANYONECANPAY:    sign for one specified input and all outputs\n" +
 		"            NONE|ANYONECANPAY:   sign for one specified input\n" +
 		"            SINGLE|ANYONECANPAY: sign for one specified input and corresponding output",
-	Args: func(cmd *cobra.Command, args []string) error {
-		if err := cobra.RangeArgs(2, 3)(cmd, args); err != nil {
-			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
-			return err
+	Args: parseSignArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "signrawtransaction called", logging.LogFormat{"hex": args[0], "mode": signFlags})
+
+		req := &pb.SignRawTransactionRequest{
+			RawTx:      args[0],
+			Passphrase: signPassphrase,
+			Flags:      signFlags,
 		}
-		for i := 2; i < len(args); i++ {
-			key, value, err := parseCommandVar(args[i])
+		resp := &pb.SignRawTransactionResponse{}
+		return ClientCall("/v1/transactions/sign", POST, req, resp)
+	},
+}
+
+func parseSignArgs(cmd *cobra.Command, args []string) error {
+	if err := cobra.RangeArgs(1, 3)(cmd, args); err != nil {
+		logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+		return err
+	}
+	for i := 1; i < len(args); i++ {
+		key, value, err := parseCommandVar(args[i])
+		if err != nil || key != "mode" {
+			if i == 1 {
+				signPassphrase = args[i]
+				continue
+			}
 			if err != nil {
 				return err
 			}
-			switch key {
-			case "mode":
-				upper := strings.ToUpper(value)
-				switch upper {
-				case "ALL", "NONE", "SINGLE", "ALL|ANYONECANPAY", "NONE|ANYONECANPAY", "SINGLE|ANYONECANPAY":
-					signFlags = upper
-				default:
-					return fmt.Errorf("invalid mode: %s", value)
-				}
-			default:
-				return errorUnknownCommandParam(key)
-			}
+			return errorUnknownCommandParam(key)
 		}
-		return nil
+		upper := strings.ToUpper(value)
+		switch upper {
+		case "ALL", "NONE", "SINGLE", "ALL|ANYONECANPAY", "NONE|ANYONECANPAY", "SINGLE|ANYONECANPAY":
+			signFlags = upper
+		default:
+			return fmt.Errorf("invalid mode: %s", value)
+		}
+	}
+	return nil
+}
+
+var createPsbtCmd = &cobra.Command{
+	Use:   "createpsbt <hexstring>",
+	Short: "Wraps an unsigned raw transaction into a partially signed transaction (psbt).",
+	Long: "Wraps an unsigned raw transaction into a partially signed transaction (psbt).\n" +
+		"The amounts, witness scripts and derivation paths of inputs of current wallet are filled in,\n" +
+		"so that cosigners are able to sign the psbt with signpsbt.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "createpsbt called", logging.LogFormat{})
+		resp := &pb.PsbtResponse{}
+		return ClientCall("/v1/transactions/psbt/create", POST, &pb.CreatePsbtRequest{Hex: args[0]}, resp)
 	},
+}
+
+var decodePsbtCmd = &cobra.Command{
+	Use:   "decodepsbt <psbt>",
+	Short: "Decodes base64-encoded psbt.",
+	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		logging.VPrint(logging.INFO, "signrawtransaction called", logging.LogFormat{"hex": args[0], "mode": signFlags})
+		logging.VPrint(logging.INFO, "decodepsbt called", logging.LogFormat{})
+		resp := &pb.DecodePsbtResponse{}
+		return ClientCall("/v1/transactions/psbt/decode", POST, &pb.DecodePsbtRequest{Psbt: args[0]}, resp)
+	},
+}
 
-		req := &pb.SignRawTransactionRequest{
-			RawTx:      args[0],
-			Passphrase: args[1],
+var signPsbtCmd = &cobra.Command{
+	Use:   "signpsbt <psbt> [passphrase] [mode=?]",
+	Short: "Adds signatures of current wallet to a psbt and returns the resulting psbt.",
+	Long: "Adds signatures of current wallet to a psbt and returns the resulting psbt.\n" +
+		"\nArguments:\n" +
+		"  <psbt>        base64-encoded psbt\n" +
+		"  [passphrase]  Optional if wallet is unlocked by walletpassphrase\n" +
+		"  [mode]        Optional, same as signrawtransaction",
+	Args: parseSignArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "signpsbt called", logging.LogFormat{"mode": signFlags})
+
+		req := &pb.SignPsbtRequest{
+			Psbt:       args[0],
+			Passphrase: signPassphrase,
 			Flags:      signFlags,
 		}
-		resp := &pb.SignRawTransactionResponse{}
-		return ClientCall("/v1/transactions/sign", POST, req, resp)
+		resp := &pb.PsbtResponse{}
+		return ClientCall("/v1/transactions/psbt/sign", POST, req, resp)
+	},
+}
+
+var combinePsbtCmd = &cobra.Command{
+	Use:   "combinepsbt <psbt> <psbt>...",
+	Short: "Combines signatures of several psbts of the same transaction into one psbt.",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "combinepsbt called", logging.LogFormat{"count": len(args)})
+		resp := &pb.PsbtResponse{}
+		return ClientCall("/v1/transactions/psbt/combine", POST, &pb.CombinePsbtRequest{Psbts: args}, resp)
+	},
+}
+
+var finalizePsbtCmd = &cobra.Command{
+	Use:   "finalizepsbt <psbt>",
+	Short: "Finalizes inputs of a psbt holding enough signatures.",
+	Long: "Finalizes inputs of a psbt holding enough signatures.\n" +
+		"If all inputs are finalized, the signed raw transaction is returned, which can be sent by sendrawtransaction.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "finalizepsbt called", logging.LogFormat{})
+		resp := &pb.FinalizePsbtResponse{}
+		return ClientCall("/v1/transactions/psbt/finalize", POST, &pb.FinalizePsbtRequest{Psbt: args[0]}, resp)
+	},
+}
+
+var estimateFeeRateCmd = &cobra.Command{
+	Use:   "estimatefeerate [target_blocks]",
+	Short: "Estimates the fee rate for a transaction to be mined within target blocks.",
+	Long: "Estimates the fee rate, in MASS per KB, for a transaction to be mined within target blocks,\n" +
+		"by how long the transactions of recent blocks took to be mined.\n" +
+		"\nArguments:\n" +
+		"  [target_blocks]   optional, 1 to 48, default 6\n",
+	Args: cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		logging.VPrint(logging.INFO, "estimatefeerate called", logging.LogFormat{"args": args})
+
+		target := uint64(0)
+		if len(args) > 0 {
+			target, err = strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+		}
+		resp := &pb.EstimateFeeRateResponse{}
+		return ClientCall(fmt.Sprintf("/v1/transactions/feerate/%d", target), GET, nil, resp)
 	},
 }
 
@@ -234,6 +345,38 @@ var sendRawTransactionCmd = &cobra.Command{
 	},
 }
 
+var bumpFeeCmd = &cobra.Command{
+	Use:   "bumpfee <txid> <fee> [passphrase]",
+	Short: "Replaces an unmined transaction of current wallet with one paying a higher fee.",
+	Long: "Replaces an unmined transaction of current wallet with one paying a higher fee.\n" +
+		"The transaction must have been created as replaceable. The replacement spends the same\n" +
+		"inputs and pays the same outputs, the increased fee is deducted from the change output.\n" +
+		"\nArguments:\n" +
+		"  <txid>        id of the unmined transaction\n" +
+		"  <fee>         new fee, higher than the fee of the transaction\n" +
+		"  [passphrase]  Optional if wallet is unlocked by walletpassphrase\n",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.RangeArgs(2, 3)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "bumpfee called", logging.LogFormat{"txid": args[0], "fee": args[1]})
+
+		req := &pb.BumpFeeRequest{
+			TxId: args[0],
+			Fee:  args[1],
+		}
+		if len(args) > 2 {
+			req.Passphrase = args[2]
+		}
+		resp := &pb.BumpFeeResponse{}
+		return ClientCall("/v1/transactions/bumpfee", POST, req, resp)
+	},
+}
+
 var getRawTransactionCmd = &cobra.Command{
 	Use:   "getrawtransaction <txid>",
 	Short: "Returns raw transaction representation for given transaction id.",
@@ -270,6 +413,77 @@ var getTxStatusCmd = &cobra.Command{
 	},
 }
 
+var getMempoolInfoCmd = &cobra.Command{
+	Use:   "getmempoolinfo",
+	Short: "Returns the state of the memory pool.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getmempoolinfo called", EmptyLogFormat)
+
+		resp := &pb.GetMempoolInfoResponse{}
+		return ClientCall("/v1/mempool", GET, nil, resp)
+	},
+}
+
+var getRawMempoolCmd = &cobra.Command{
+	Use:   "getrawmempool [verbose]",
+	Short: "Returns the transactions in the memory pool.",
+	Long: "Returns the ids of transactions in the memory pool, oldest first.\n" +
+		"\nArguments:\n" +
+		"  [verbose]   optional, also returns the fee, size, starting priority and height added of each transaction\n",
+	Args: cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getrawmempool called", logging.LogFormat{"args": args})
+
+		resp := &pb.GetRawMempoolResponse{}
+		if len(args) == 0 || strings.ToLower(args[0]) != "verbose" {
+			return ClientCall("/v1/mempool/transactions", GET, nil, resp)
+		}
+		return ClientCall("/v1/mempool/transactions?verbose=true", GET, nil, resp)
+	},
+}
+
+var getMempoolEntryCmd = &cobra.Command{
+	Use:   "getmempoolentry <txid>",
+	Short: "Returns a transaction in the memory pool, with its ancestors and descendants.",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getmempoolentry called", logging.LogFormat{"txid": args[0]})
+
+		resp := &pb.GetMempoolEntryResponse{}
+		return ClientCall(fmt.Sprintf("/v1/mempool/transactions/%s", args[0]), GET, nil, resp)
+	},
+}
+
+var evictMempoolTransactionCmd = &cobra.Command{
+	Use:   "evictmempooltransaction <txid>",
+	Short: "Removes an unmined transaction of the wallets from the memory pool.",
+	Long: "Removes an unmined transaction of the wallets from the memory pool and from the unmined\n" +
+		"transactions of the wallets, along with the transactions spending its outputs, so that its\n" +
+		"inputs may be spent again. The transaction may still be mined if relayed to other nodes.\n" +
+		"\nArguments:\n" +
+		"  <txid>      id of the unmined transaction\n",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "evictmempooltransaction called", logging.LogFormat{"txid": args[0]})
+
+		resp := &pb.EvictMempoolTransactionResponse{}
+		return ClientCall("/v1/mempool/evict", POST, &pb.EvictMempoolTransactionRequest{TxId: args[0]}, resp)
+	},
+}
+
 var listTrasactionsCmd = &cobra.Command{
 	Use:   "listtransactions [count=?] [address=?]",
 	Short: "Returns up to N most recent transactions for current wallet.",
@@ -316,3 +530,185 @@ var listTrasactionsCmd = &cobra.Command{
 		return ClientCall("/v1/transactions/history", POST, req, resp)
 	},
 }
+
+var queryTransactionsCmd = &cobra.Command{
+	Use:   "querytransactions [cursor=?] [count=?] [minheight=?] [maxheight=?] [starttime=?] [endtime=?] [category=?] [order=?]",
+	Short: "Returns a page of transactions for current wallet, with net amounts and fees.",
+	Long: "Returns a page of transactions for current wallet, with net amounts and fees.\n" +
+		"\nArguments:\n" +
+		"  [cursor]      optional, next_cursor returned by the previous page, the first page if not provided.\n" +
+		"  [count]       optional, max number of transactions in a page, if not provided(or 0) a default value will be used.\n" +
+		"  [minheight]   optional, lowest block height, inclusive.\n" +
+		"  [maxheight]   optional, highest block height, inclusive.\n" +
+		"  [starttime]   optional, earliest block time in unix seconds, inclusive.\n" +
+		"  [endtime]     optional, latest block time in unix seconds, inclusive.\n" +
+		"  [category]    optional, comma-separated list of sent, received, staking and binding.\n" +
+		"  [order]       optional, 'asc' for oldest first or 'desc' for newest first, default desc.\n",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.RangeArgs(0, 8)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.ListTransactionsRequest{}
+		for _, arg := range args {
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "cursor":
+				req.Cursor = value
+			case "count":
+				c, err := strconv.ParseUint(value, 10, 32)
+				if err != nil {
+					return err
+				}
+				req.Count = uint32(c)
+			case "minheight":
+				if req.MinHeight, err = strconv.ParseUint(value, 10, 64); err != nil {
+					return err
+				}
+			case "maxheight":
+				if req.MaxHeight, err = strconv.ParseUint(value, 10, 64); err != nil {
+					return err
+				}
+			case "starttime":
+				if req.StartTime, err = strconv.ParseInt(value, 10, 64); err != nil {
+					return err
+				}
+			case "endtime":
+				if req.EndTime, err = strconv.ParseInt(value, 10, 64); err != nil {
+					return err
+				}
+			case "category":
+				req.Categories = strings.Split(value, ",")
+			case "order":
+				switch value {
+				case "asc":
+					req.Ascending = true
+				case "desc":
+					req.Ascending = false
+				default:
+					return ErrInvalidArgument
+				}
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+		logging.VPrint(logging.INFO, "querytransactions called", logging.LogFormat{
+			"cursor":    req.Cursor,
+			"count":     req.Count,
+			"minHeight": req.MinHeight,
+			"maxHeight": req.MaxHeight,
+		})
+
+		resp := &pb.ListTransactionsResponse{}
+		return ClientCall("/v1/transactions/list", POST, req, resp)
+	},
+}
+
+var exportTransactionsCmd = &cobra.Command{
+	Use:   "exporttransactions <file> [from=?] [to=?] [format=?]",
+	Short: "Writes every transaction of current wallet to a file for accounting.",
+	Long: "Writes every transaction of current wallet to a file for accounting, with date, txid,\n" +
+		"category, amount, fee, counterparty addresses and block height.\n" +
+		"\nArguments:\n" +
+		"  <file>       file to write to\n" +
+		"  [from]       optional, earliest date as 'yyyy-mm-dd' or unix seconds, inclusive.\n" +
+		"  [to]         optional, latest date as 'yyyy-mm-dd' or unix seconds, inclusive.\n" +
+		"  [format]     optional, 'csv' or 'json', json if the file name ends with '.json', otherwise csv.\n",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.RangeArgs(1, 4)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.ExportTxHistoryRequest{Format: "csv"}
+		if strings.HasSuffix(strings.ToLower(args[0]), ".json") {
+			req.Format = "json"
+		}
+		for _, arg := range args[1:] {
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "from":
+				if req.StartTime, err = parseExportTime(value, false); err != nil {
+					return err
+				}
+			case "to":
+				if req.EndTime, err = parseExportTime(value, true); err != nil {
+					return err
+				}
+			case "format":
+				if value != "csv" && value != "json" {
+					return ErrInvalidArgument
+				}
+				req.Format = value
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+		logging.VPrint(logging.INFO, "exporttransactions called", logging.LogFormat{
+			"file":   args[0],
+			"from":   req.StartTime,
+			"to":     req.EndTime,
+			"format": req.Format,
+		})
+
+		resp := &pb.ExportTxHistoryResponse{}
+		if err := ClientFetch("/v1/transactions/export", POST, req, resp); err != nil {
+			return err
+		}
+		data := []byte(resp.Csv)
+		if req.Format == "json" {
+			m := jsonpb.Marshaler{EmitDefaults: true, Indent: "  "}
+			str, err := m.MarshalToString(resp)
+			if err != nil {
+				return err
+			}
+			data = []byte(str)
+		}
+		if err := ioutil.WriteFile(args[0], data, 0600); err != nil {
+			logging.VPrint(logging.ERROR, "failed to write file", logging.LogFormat{"err": err, "file": args[0]})
+			return err
+		}
+		fmt.Printf("exported %d transactions to %s\n", len(resp.Records)+countCSVRecords(resp.Csv), args[0])
+		return nil
+	},
+}
+
+// parseExportTime parses a date as 'yyyy-mm-dd' in local time, or unix
+// seconds. The last second of the date is returned if endOfDay is true.
+func parseExportTime(value string, endOfDay bool) (int64, error) {
+	return parseTimeInLocation(value, endOfDay, time.Local)
+}
+
+// parseTimeInLocation is parseExportTime with dates in loc.
+func parseTimeInLocation(value string, endOfDay bool, loc *time.Location) (int64, error) {
+	if sec, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return sec, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", value, loc)
+	if err != nil {
+		return 0, ErrInvalidArgument
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Second)
+	}
+	return t.Unix(), nil
+}
+
+// countCSVRecords returns the number of lines in data, excluding the header.
+func countCSVRecords(data string) int {
+	if n := strings.Count(data, "\n"); n > 0 {
+		return n - 1
+	}
+	return 0
+}
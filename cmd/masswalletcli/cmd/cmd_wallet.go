@@ -149,6 +149,166 @@ var listUtxoCmd = &cobra.Command{
 	},
 }
 
+var freezeUtxoCmd = &cobra.Command{
+	Use:   "freezeutxo <txid> <vout>",
+	Short: "Freezes an UTXO of current wallet, so that it won't be selected as input automatically.",
+	Long: "Freezes an UTXO of current wallet, so that it won't be selected as input automatically.\n" +
+		"A frozen UTXO is still spent if it is specified in 'include_utxos' of autocreaterawtransaction.\n" +
+		"\nArguments:\n" +
+		"  <txid>  hash of the transaction creating the UTXO\n" +
+		"  <vout>  index of the UTXO in the transaction",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setUtxoFrozen(args, true)
+	},
+}
+
+var unfreezeUtxoCmd = &cobra.Command{
+	Use:   "unfreezeutxo <txid> <vout>",
+	Short: "Unfreezes an UTXO of current wallet.",
+	Long: "Unfreezes an UTXO of current wallet.\n" +
+		"\nArguments:\n" +
+		"  <txid>  hash of the transaction creating the UTXO\n" +
+		"  <vout>  index of the UTXO in the transaction",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setUtxoFrozen(args, false)
+	},
+}
+
+func setUtxoFrozen(args []string, frozen bool) error {
+	logging.VPrint(logging.INFO, "setutxofrozen called", logging.LogFormat{"txid": args[0], "vout": args[1], "frozen": frozen})
+
+	vout, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return err
+	}
+	req := &pb.SetUtxoFrozenRequest{
+		Utxos:  []*pb.TransactionInput{{TxId: args[0], Vout: uint32(vout)}},
+		Frozen: frozen,
+	}
+	resp := &pb.SetUtxoFrozenResponse{}
+	return ClientCall("/v1/utxos/freeze", POST, req, resp)
+}
+
+var setUtxoLabelCmd = &cobra.Command{
+	Use:   "setutxolabel <txid> <vout> [label]",
+	Short: "Labels an UTXO of current wallet.",
+	Long: "Labels an UTXO of current wallet, the label is listed by listutxo.\n" +
+		"\nArguments:\n" +
+		"  <txid>   hash of the transaction creating the UTXO\n" +
+		"  <vout>   index of the UTXO in the transaction\n" +
+		"  [label]  optional, at most 256 characters, the existing label is removed if not provided",
+	Example: `  setutxolabel 8e5b4d6c8ab7c3a49d2ba6a2a7e1e4c1bf1b3bb37ddfa2b1c9e8b4b6a9f2c7d1 0 'cold storage'`,
+	Args:    cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "setutxolabel called", logging.LogFormat{"txid": args[0], "vout": args[1]})
+
+		vout, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return err
+		}
+		req := &pb.SetUtxoLabelRequest{
+			TxId: args[0],
+			Vout: uint32(vout),
+		}
+		if len(args) > 2 {
+			req.Label = args[2]
+		}
+		resp := &pb.SetUtxoLabelResponse{}
+		return ClientCall("/v1/utxos/label", POST, req, resp)
+	},
+}
+
+var consolidateUtxoCmd = &cobra.Command{
+	Use:   "consolidateutxo [address ...] [to=?] [max_inputs=?] [fee_rate=?] [dry_run=?]",
+	Short: "Creates transactions merging small UTXOs of current wallet into one address.",
+	Long: "Creates transactions merging small UTXOs of current wallet into one address, smallest first,\n" +
+		"as many as needed within the standard transaction size. Frozen UTXOs and UTXOs not paying\n" +
+		"the fee are left alone. The transactions are unsigned, sign them by signrawtransaction.\n" +
+		"\nArguments:\n" +
+		"  [address]     optional, addresses to consolidate, all addresses of current wallet by default\n" +
+		"  [to]          optional, address receiving the merged amount, the first address by default,\n" +
+		"                or the address of current wallet with the lowest index if no address is given\n" +
+		"  [max_inputs]  optional, max number of inputs per transaction\n" +
+		"  [fee_rate]    optional, fee in MASS per KB, the min relay fee by default\n" +
+		"  [dry_run]     optional, 'true' to report the number of transactions and fees only",
+	Example: `  consolidateutxo ms1qqf8870v59cdaanj3cxgfq97d3xpz94g9gqqsvz0wnj7lmlp9ehr2sxdj0um max_inputs=300 dry_run=true`,
+	Args:    cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.ConsolidateUtxosRequest{}
+		for _, arg := range args {
+			if !strings.Contains(arg, "=") {
+				req.Addresses = append(req.Addresses, arg)
+				continue
+			}
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "to":
+				req.ToAddress = value
+			case "max_inputs":
+				n, err := strconv.ParseUint(value, 10, 32)
+				if err != nil {
+					return err
+				}
+				req.MaxInputs = uint32(n)
+			case "fee_rate":
+				req.FeeRate = value
+			case "dry_run":
+				if req.DryRun, err = strconv.ParseBool(value); err != nil {
+					return err
+				}
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+		logging.VPrint(logging.INFO, "consolidateutxo called", logging.LogFormat{
+			"addresses":  req.Addresses,
+			"to":         req.ToAddress,
+			"max_inputs": req.MaxInputs,
+			"fee_rate":   req.FeeRate,
+			"dry_run":    req.DryRun,
+		})
+
+		resp := &pb.ConsolidateUtxosResponse{}
+		return ClientCall("/v1/utxos/consolidate", POST, req, resp)
+	},
+}
+
+var sweepPrivateKeyCmd = &cobra.Command{
+	Use:   "sweepprivatekey <wif> [fee_rate=?]",
+	Short: "Moves the funds of a private key into an unused address of current wallet.",
+	Long: "Creates, signs and sends a transaction spending all UTXOs of the address of a private key\n" +
+		"to the unused address of current wallet with the lowest index, or a new address if all are\n" +
+		"used. The address is derived the same way as addresses of wallets with single signature.\n" +
+		"The private key is not imported. Fails if the UTXOs do not fit in one standard transaction.\n" +
+		"\nArguments:\n" +
+		"  <wif>         private key in wallet import format\n" +
+		"  [fee_rate]    optional, fee in MASS per KB, the min relay fee by default",
+	Example: `  sweepprivatekey KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617 fee_rate=0.001`,
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.SweepPrivateKeyRequest{Wif: args[0]}
+		if len(args) > 1 {
+			key, value, err := parseCommandVar(args[1])
+			if err != nil {
+				return err
+			}
+			if key != "fee_rate" {
+				return errorUnknownCommandParam(key)
+			}
+			req.FeeRate = value
+		}
+		logging.VPrint(logging.INFO, "sweepprivatekey called", logging.LogFormat{"fee_rate": req.FeeRate})
+
+		resp := &pb.SweepPrivateKeyResponse{}
+		return ClientCall("/v1/wallets/sweep", POST, req, resp)
+	},
+}
+
 var listWalletsCmd = &cobra.Command{
 	Use:   "listwallets",
 	Short: "Returns all wallets imported into this server.",
@@ -227,15 +387,21 @@ var useWalletCmd = &cobra.Command{
 }
 
 var removeWalletCmd = &cobra.Command{
-	Use:   "removewallet <wallet_id> <passphrase>",
+	Use:   "removewallet <wallet_id> [passphrase]",
 	Short: "Removes specified wallet from server.",
-	Args:  cobra.ExactArgs(2),
+	Long: "Removes specified wallet from server.\n" +
+		"\nArguments:\n" +
+		"  <wallet_id>	wallet id\n" +
+		"  [passphrase]	wallet passphrase, not required by a watch-only wallet\n",
+	Args: cobra.RangeArgs(1, 2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		logging.VPrint(logging.INFO, "removewallet called", logging.LogFormat{"walletid": args[0]})
 
 		req := &pb.RemoveWalletRequest{
-			WalletId:   args[0],
-			Passphrase: args[1],
+			WalletId: args[0],
+		}
+		if len(args) > 1 {
+			req.Passphrase = args[1]
 		}
 		resp := &pb.RemoveWalletResponse{}
 		return ClientCall("/v1/wallets/remove", POST, req, resp)
@@ -327,6 +493,112 @@ var importMnemonicCmd = &cobra.Command{
 	},
 }
 
+var importMultisigCmd = &cobra.Command{
+	Use:   "importmultisig <mnemonic> <passphrase> <nrequired> <xpubs> [initial=?] [remarks=?]",
+	Short: "Imports an m-of-n multisig wallet.",
+	Long: "Imports an m-of-n multisig wallet, whose own key is derived from the mnemonic.\n" +
+		"All cosigners get the same wallet and addresses with their own mnemonic.\n" +
+		"\nArguments:\n" +
+		"  <mnemonic>	mnemonic phrase\n" +
+		"  <passphrase>	wallet passphrase\n" +
+		"  <nrequired>	number of signatures required to spend\n" +
+		"  <xpubs>	account xpubs of the other cosigners, separated by comma\n" +
+		"  [initial]	number of initial addresses, default 0\n",
+	Example: `  importmultisig 'tomorrow entry oval ...' 123456 2 xpub6Bx...,xpub6Ck... initial=10 remarks='treasury'`,
+	Args:    cobra.MinimumNArgs(4),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		nRequired, err := strconv.ParseUint(args[2], 10, 32)
+		if err != nil {
+			return err
+		}
+		xpubs := strings.Split(args[3], ",")
+
+		initial := 0
+		remarks := ""
+		for i := 4; i < len(args); i++ {
+			key, value, err := parseCommandVar(args[i])
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "initial":
+				initial, err = strconv.Atoi(value)
+				if err != nil {
+					return err
+				}
+			case "remarks":
+				remarks = value
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+
+		logging.VPrint(logging.INFO, "importmultisig called", logging.LogFormat{
+			"nrequired": nRequired,
+			"cosigners": len(xpubs),
+			"initial":   initial,
+			"remarks":   remarks,
+		})
+
+		req := &pb.ImportMultisigWalletRequest{
+			Mnemonic:           args[0],
+			Passphrase:         args[1],
+			ExternalIndex:      uint32(initial),
+			Remarks:            remarks,
+			RequiredSignatures: uint32(nRequired),
+			CosignerXpubs:      xpubs,
+		}
+		resp := &pb.ImportWalletResponse{}
+		return ClientCall("/v1/wallets/import/multisig", POST, req, resp)
+	},
+}
+
+var importWatchOnlyCmd = &cobra.Command{
+	Use:   "importwatchonly <xpub> [initial=?] [remarks=?]",
+	Short: "Imports a watch-only wallet from an account xpub.",
+	Long: "Imports a watch-only wallet from an account xpub, which can be obtained by getwalletxpub.\n" +
+		"The wallet tracks balances and transactions, but it cannot sign.\n" +
+		"\nArguments:\n" +
+		"  <xpub>	account extended public key\n" +
+		"  [initial]	number of initial addresses, default 0\n",
+	Example: `  importwatchonly xpub6Bx... initial=10 remarks='invoicing'`,
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initial := 0
+		remarks := ""
+		for i := 1; i < len(args); i++ {
+			key, value, err := parseCommandVar(args[i])
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "initial":
+				initial, err = strconv.Atoi(value)
+				if err != nil {
+					return err
+				}
+			case "remarks":
+				remarks = value
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+
+		logging.VPrint(logging.INFO, "importwatchonly called", logging.LogFormat{
+			"initial": initial,
+			"remarks": remarks,
+		})
+
+		req := &pb.ImportWatchOnlyWalletRequest{
+			Xpub:          args[0],
+			ExternalIndex: uint32(initial),
+			Remarks:       remarks,
+		}
+		resp := &pb.ImportWalletResponse{}
+		return ClientCall("/v1/wallets/import/watchonly", POST, req, resp)
+	},
+}
+
 var getWalletMnemonicCmd = &cobra.Command{
 	Use:   "getwalletmnemonic <wallet_id> <passphrase>",
 	Short: "Returns mnemonic of the specified wallet.",
@@ -344,3 +616,55 @@ var getWalletMnemonicCmd = &cobra.Command{
 		return ClientCall("/v1/wallets/mnemonic", POST, req, resp)
 	},
 }
+
+var walletPassphraseCmd = &cobra.Command{
+	Use:   "walletpassphrase <passphrase> <timeout>",
+	Short: "Unlocks current wallet for <timeout> seconds.",
+	Long: "Stores the private keys of current wallet in memory for <timeout> seconds,\n" +
+		"transactions can be signed without passphrase meanwhile.\n" +
+		"\nArguments:\n" +
+		"  <passphrase>  wallet passphrase\n" +
+		"  <timeout>     time to keep the wallet unlocked, in seconds\n",
+	Example: `  walletpassphrase 123456 60`,
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		timeout, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return err
+		}
+		logging.VPrint(logging.INFO, "walletpassphrase called", logging.LogFormat{"timeout": timeout})
+
+		req := &pb.UnlockWalletRequest{
+			Passphrase: args[0],
+			Timeout:    uint32(timeout),
+		}
+		resp := &pb.UnlockWalletResponse{}
+		return ClientCall("/v1/wallets/current/unlock", POST, req, resp)
+	},
+}
+
+var getWalletXpubCmd = &cobra.Command{
+	Use:   "getwalletxpub",
+	Short: "Returns account xpub of current wallet.",
+	Long: "Returns account xpub of current wallet, which is shared with the cosigners\n" +
+		"to set up a multisig wallet. Multisig policy is also returned for a multisig wallet.\n",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getwalletxpub called", EmptyLogFormat)
+
+		resp := &pb.GetWalletXpubResponse{}
+		return ClientCall("/v1/wallets/current/xpub", GET, nil, resp)
+	},
+}
+
+var walletLockCmd = &cobra.Command{
+	Use:   "walletlock",
+	Short: "Removes the private keys of current wallet from memory.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "walletlock called", EmptyLogFormat)
+
+		resp := &pb.LockWalletResponse{}
+		return ClientCall("/v1/wallets/current/lock", POST, nil, resp)
+	},
+}
@@ -8,4 +8,5 @@ const (
 
 var (
 	ErrInvalidArgument = errors.New("invalid argument")
+	ErrRequestFailed   = errors.New("request failed")
 )
@@ -49,8 +49,9 @@ const (
 
 // Client is the top level for http/rpc server
 type Client struct {
-	url    *url.URL
-	client *http.Client
+	url      *url.URL
+	client   *http.Client
+	walletId string // wallet serving requests, the wallet in use if empty
 }
 
 var client = &Client{}
@@ -89,6 +90,9 @@ func (c *Client) call(ctx context.Context, u *url.URL, method Method, bodyReader
 	if err != nil {
 		return nil, err
 	}
+	if c.walletId != "" {
+		req.Header.Set("Wallet-Id", c.walletId)
+	}
 
 	resp, err := c.client.Do(req.WithContext(ctx))
 	if err != nil && ctx.Err() != nil { // check if it timed out
@@ -100,10 +104,13 @@ func (c *Client) call(ctx context.Context, u *url.URL, method Method, bodyReader
 	return resp, nil
 }
 
-// CallRaw calls a remote node, specified by the path.
+// CallRaw calls a remote node, specified by the path, which may end with a query.
 // It returns the raw response body
 func (c *Client) CallRaw(ctx context.Context, path string, method Method, request interface{}) (*http.Response, error) {
-	c.url.Path = path
+	c.url.Path, c.url.RawQuery = path, ""
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		c.url.Path, c.url.RawQuery = path[:i], path[i+1:]
+	}
 
 	var bodyReader io.Reader
 	if request != nil {
@@ -141,6 +148,28 @@ func (c *Client) Call(ctx context.Context, path string, method Method, request,
 	return err
 }
 
+// Fetch calls a remote node like Call, without printing the response.
+func (c *Client) Fetch(ctx context.Context, path string, method Method, request, response interface{}) error {
+	resp, err := c.CallRaw(ctx, path, method, request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var buf [1000]byte
+		n, _ := resp.Body.Read(buf[:])
+		fmt.Fprintf(os.Stderr, "%v\n", string(buf[:n]))
+		logging.VPrint(logging.ERROR, "fail on request with code", logging.LogFormat{
+			"code":    resp.StatusCode,
+			"content": string(buf[:n]),
+		})
+		return ErrRequestFailed
+	}
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	return u.Unmarshal(resp.Body, response.(proto.Message))
+}
+
 // ClientCall selects a client type and execute calling
 func ClientCall(path string, method Method, request, response interface{}) error {
 	initClient()
@@ -151,6 +180,16 @@ func ClientCall(path string, method Method, request, response interface{}) error
 	return nil
 }
 
+// ClientFetch is like ClientCall, without printing the response.
+func ClientFetch(path string, method Method, request, response interface{}) error {
+	initClient()
+	if err := client.Fetch(context.Background(), path, method, request, response); err != nil {
+		logging.VPrint(logging.ERROR, "fail on client call", logging.LogFormat{"err": err})
+		return err
+	}
+	return nil
+}
+
 func printJSON(data interface{}) {
 	m := jsonpb.Marshaler{EmitDefaults: true, Indent: "  "}
 
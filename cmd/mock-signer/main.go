@@ -0,0 +1,66 @@
+// mock-signer is the reference external signer of masswallet. It signs with
+// the keys derived from the seed of a mnemonic, served over stdin/stdout, or
+// over a local socket if -listen is given.
+//
+// It keeps the seed in plain memory, so it is only for local tests of the
+// signing flow and must not be used with the mnemonic of real funds.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/signer"
+)
+
+func main() {
+	mnemonic := flag.String("mnemonic", "", "mnemonic of the wallet")
+	passphrase := flag.String("passphrase", "", "private passphrase the wallet was created with")
+	listen := flag.String("listen", "", `serve on "unix:<path>" or "tcp:<host:port>" instead of stdin/stdout`)
+	flag.Parse()
+
+	if err := run(*mnemonic, *passphrase, *listen); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(mnemonic, passphrase, listen string) error {
+	seed, err := keystore.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return err
+	}
+	s, err := signer.NewMockSigner(seed, &config.ChainParams)
+	if err != nil {
+		return err
+	}
+	if listen == "" {
+		return signer.Serve(os.Stdin, os.Stdout, s)
+	}
+
+	network, addr, err := signer.ParseAddress(listen)
+	if err != nil {
+		return err
+	}
+	l, err := net.Listen(network, addr)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			if err := signer.Serve(conn, conn, s); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		}()
+	}
+}
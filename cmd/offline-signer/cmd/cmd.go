@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/wire"
+)
+
+var listWalletsCmd = &cobra.Command{
+	Use:   "listwallets",
+	Short: "Lists the ids of wallets in wallet.db.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		signer, closeDb, err := loadSigner()
+		if err != nil {
+			return err
+		}
+		defer closeDb()
+
+		for _, id := range signer.Wallets() {
+			fmt.Println(id)
+		}
+		return nil
+	},
+}
+
+var signCmd = &cobra.Command{
+	Use:   "sign <wallet_id> <psbt_file> <output_file> [mode=?]",
+	Short: "Signs a psbt with the keys of a wallet and writes the result to a file.",
+	Long: "Signs a psbt with the keys of a wallet and writes the result to a file.\n" +
+		"Every input of the psbt must carry the output it spends, as the psbt created by\n" +
+		"'masswallet-cli createpsbt' does. The passphrase of the wallet is read from terminal.\n" +
+		"If the psbt is fully signed, the hex-encoded signed transaction is written, which can be\n" +
+		"broadcast with 'masswallet-cli sendrawtransaction'. Otherwise the base64-encoded psbt is\n" +
+		"written, which awaits the signatures of cosigners.\n" +
+		"\nArguments:\n" +
+		"  <wallet_id>     id of the wallet to sign with\n" +
+		"  <psbt_file>     file of base64-encoded psbt\n" +
+		"  <output_file>   file to write the result to\n" +
+		"  [mode]          Optional, same as 'masswallet-cli signrawtransaction', default ALL\n",
+	Args: cobra.RangeArgs(3, 4),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mode := "ALL"
+		if len(args) > 3 {
+			var err error
+			if mode, err = parseMode(args[3]); err != nil {
+				return err
+			}
+		}
+		b, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			return err
+		}
+		p, err := psbt.B64Decode(strings.TrimSpace(string(b)))
+		if err != nil {
+			logging.CPrint(logging.ERROR, "invalid psbt", logging.LogFormat{"err": err, "path": args[1]})
+			return err
+		}
+
+		signer, closeDb, err := loadSigner()
+		if err != nil {
+			return err
+		}
+		defer closeDb()
+
+		passphrase, err := readPassphrase()
+		if err != nil {
+			return err
+		}
+		signed, err := signer.SignPsbt(args[0], passphrase, mode, p)
+		if err != nil {
+			return err
+		}
+
+		var result string
+		err = psbt.Finalize(p, &config.ChainParams)
+		switch err {
+		case nil:
+			tx, err := psbt.Extract(p)
+			if err != nil {
+				return err
+			}
+			buf, err := tx.Bytes(wire.Packet)
+			if err != nil {
+				return err
+			}
+			result = hex.EncodeToString(buf)
+		case psbt.ErrNotEnoughSignatures:
+			if result, err = p.B64Encode(); err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+		if err = ioutil.WriteFile(args[2], []byte(result), 0600); err != nil {
+			return err
+		}
+		logging.CPrint(logging.INFO, "sign completed", logging.LogFormat{
+			"signatures": signed,
+			"complete":   p.IsComplete(),
+			"output":     args[2],
+		})
+		return nil
+	},
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/logging"
+)
+
+var configFile string
+
+func init() {
+	logging.Init(".", "offline-signer", "info", 1, false)
+	rootCmd.PersistentFlags().StringVarP(&configFile, "configfile", "C", config.DefaultConfigFilename, "path to configuration file of masswallet")
+	rootCmd.AddCommand(listWalletsCmd)
+	rootCmd.AddCommand(signCmd)
+}
+
+var rootCmd = &cobra.Command{
+	Use:   filepath.Base(os.Args[0]),
+	Short: "Offline Signer for MASS Wallet",
+	Long: "Signs transactions on an air-gapped machine.\n" +
+		"Only the keystores in wallet.db are opened, neither chain data nor network is required.",
+}
+
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		logging.VPrint(logging.FATAL, "Command failed", logging.LogFormat{"err": err})
+	}
+}
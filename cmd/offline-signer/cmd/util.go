@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/ssh/terminal"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/masswallet"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	_ "massnet.org/mass-wallet/masswallet/db/ldb"
+	_ "massnet.org/mass-wallet/masswallet/db/rdb"
+)
+
+const walletDbName = "wallet.db"
+
+var (
+	ErrWalletDbNotFound = errors.New("wallet database not found")
+	ErrInvalidMode      = errors.New("invalid mode")
+)
+
+// loadSigner opens the wallet database configured by configFile, falling
+// back to the default options of masswallet if the file does not exist.
+func loadSigner() (*masswallet.OfflineSigner, func(), error) {
+	cfg := config.NewDefaultConfig()
+	b, err := ioutil.ReadFile(configFile)
+	if err == nil {
+		if err = json.Unmarshal(b, cfg); err != nil {
+			logging.CPrint(logging.ERROR, "invalid config file", logging.LogFormat{"err": err, "path": configFile})
+			return nil, nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+
+	dbPath := filepath.Join(filepath.Clean(os.ExpandEnv(cfg.Data.DbDir)), walletDbName)
+	if _, err = os.Stat(dbPath); err != nil {
+		logging.CPrint(logging.ERROR, "wallet database not found", logging.LogFormat{"err": err, "path": dbPath})
+		return nil, nil, ErrWalletDbNotFound
+	}
+	db, err := mwdb.OpenDB(cfg.Data.DbType, dbPath)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "OpenDB failed", logging.LogFormat{"err": err, "path": dbPath})
+		return nil, nil, err
+	}
+	signer, err := masswallet.NewOfflineSigner(db, &config.ChainParams, cfg.Data.WalletPubPass)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	return signer, func() { db.Close() }, nil
+}
+
+// parseMode returns the sighash flag of a "mode=?" argument.
+func parseMode(arg string) (string, error) {
+	kv := strings.SplitN(arg, "=", 2)
+	if len(kv) != 2 || kv[0] != "mode" {
+		return "", fmt.Errorf("unknown param: %s", arg)
+	}
+	upper := strings.ToUpper(kv[1])
+	switch upper {
+	case "ALL", "NONE", "SINGLE", "ALL|ANYONECANPAY", "NONE|ANYONECANPAY", "SINGLE|ANYONECANPAY":
+		return upper, nil
+	default:
+		return "", ErrInvalidMode
+	}
+}
+
+func readPassphrase() ([]byte, error) {
+	fmt.Print("Enter wallet passphrase:")
+	pass, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	return pass, err
+}
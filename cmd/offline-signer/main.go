@@ -0,0 +1,9 @@
+package main
+
+import (
+	"massnet.org/mass-wallet/cmd/offline-signer/cmd"
+)
+
+func main() {
+	cmd.Execute()
+}
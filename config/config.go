@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -41,6 +42,12 @@ const (
 	DefaultAddressGapLimit         = 20
 	DefaultMaxUnusedStakingAddress = 8
 	DefaultMaxTxFee                = "1.0" // MASS
+
+	DefaultWebhookConfirmations = 6
+	DefaultWebhookMaxRetries    = 10
+	DefaultWebhookTimeout       = 10 // seconds
+
+	DefaultSignerTimeout = 60 // seconds, long enough to confirm on devices
 )
 
 var (
@@ -198,6 +205,41 @@ func CheckConfig(cfg *Config) *Config {
 		cfg.Advanced.MaxTxFee = DefaultMaxTxFee
 	}
 
+	// Checks for WebhookConfig
+	if cfg.Webhook == nil {
+		cfg.Webhook = &configpb.WebhookConfig{}
+	}
+	for _, u := range cfg.Webhook.Urls {
+		if parsed, err := url.Parse(u); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			err := errors.New(fmt.Sprintf("invalid webhook url %s", u))
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(0)
+		}
+	}
+	if cfg.Webhook.Confirmations == 0 {
+		cfg.Webhook.Confirmations = DefaultWebhookConfirmations
+	}
+	if cfg.Webhook.MaxRetries == 0 {
+		cfg.Webhook.MaxRetries = DefaultWebhookMaxRetries
+	}
+	if cfg.Webhook.Timeout == 0 {
+		cfg.Webhook.Timeout = DefaultWebhookTimeout
+	}
+
+	// Checks for SignerConfig
+	if cfg.Signer == nil {
+		cfg.Signer = &configpb.SignerConfig{}
+	}
+	if addr := cfg.Signer.Address; addr != "" &&
+		!((strings.HasPrefix(addr, "unix:") || strings.HasPrefix(addr, "tcp:")) && strings.IndexByte(addr, ':') < len(addr)-1) {
+		err := errors.New(fmt.Sprintf("invalid signer address %s", addr))
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(0)
+	}
+	if cfg.Signer.Timeout == 0 {
+		cfg.Signer.Timeout = DefaultSignerTimeout
+	}
+
 	return cfg
 }
 
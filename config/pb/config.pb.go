@@ -16,6 +16,8 @@ It has these top-level messages:
 	AppConfig
 	DataConfig
 	AdvancedConfig
+	WebhookConfig
+	SignerConfig
 */
 package configpb
 
@@ -40,6 +42,9 @@ type Config struct {
 	Log      *LogConfig      `protobuf:"bytes,3,opt,name=log" json:"log"`
 	Data     *DataConfig     `protobuf:"bytes,4,opt,name=data" json:"data"`
 	Advanced *AdvancedConfig `protobuf:"bytes,5,opt,name=advanced" json:"advanced"`
+	// Do not attempt to set them if you dont kown how they work.
+	Webhook *WebhookConfig `protobuf:"bytes,6,opt,name=webhook" json:"webhook"`
+	Signer  *SignerConfig  `protobuf:"bytes,7,opt,name=signer" json:"signer"`
 }
 
 func (m *Config) Reset()                    { *m = Config{} }
@@ -82,6 +87,20 @@ func (m *Config) GetAdvanced() *AdvancedConfig {
 	return nil
 }
 
+func (m *Config) GetWebhook() *WebhookConfig {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+func (m *Config) GetSigner() *SignerConfig {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
 type P2PConfig struct {
 	Seeds            string   `protobuf:"bytes,1,opt,name=seeds,proto3" json:"seeds"`
 	AddPeer          []string `protobuf:"bytes,2,rep,name=add_peer,json=addPeer" json:"add_peer"`
@@ -354,6 +373,94 @@ func (m *AdvancedConfig) GetMaxTxFee() string {
 	return ""
 }
 
+type WebhookConfig struct {
+	Urls          []string `protobuf:"bytes,1,rep,name=urls" json:"urls"`
+	Secret        string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret"`
+	Confirmations uint32   `protobuf:"varint,3,opt,name=confirmations,proto3" json:"confirmations"`
+	MaxRetries    uint32   `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries"`
+	Timeout       uint32   `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout"`
+}
+
+func (m *WebhookConfig) Reset()                    { *m = WebhookConfig{} }
+func (m *WebhookConfig) String() string            { return proto.CompactTextString(m) }
+func (*WebhookConfig) ProtoMessage()               {}
+func (*WebhookConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{8} }
+
+func (m *WebhookConfig) GetUrls() []string {
+	if m != nil {
+		return m.Urls
+	}
+	return nil
+}
+
+func (m *WebhookConfig) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *WebhookConfig) GetConfirmations() uint32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *WebhookConfig) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *WebhookConfig) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type SignerConfig struct {
+	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	Command string   `protobuf:"bytes,2,opt,name=command,proto3" json:"command"`
+	Args    []string `protobuf:"bytes,3,rep,name=args" json:"args"`
+	Timeout uint32   `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout"`
+}
+
+func (m *SignerConfig) Reset()                    { *m = SignerConfig{} }
+func (m *SignerConfig) String() string            { return proto.CompactTextString(m) }
+func (*SignerConfig) ProtoMessage()               {}
+func (*SignerConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{9} }
+
+func (m *SignerConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignerConfig) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *SignerConfig) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *SignerConfig) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "configpb.Config")
 	proto.RegisterType((*P2PConfig)(nil), "configpb.P2PConfig")
@@ -363,54 +470,65 @@ func init() {
 	proto.RegisterType((*AppConfig)(nil), "configpb.AppConfig")
 	proto.RegisterType((*DataConfig)(nil), "configpb.DataConfig")
 	proto.RegisterType((*AdvancedConfig)(nil), "configpb.AdvancedConfig")
+	proto.RegisterType((*WebhookConfig)(nil), "configpb.WebhookConfig")
+	proto.RegisterType((*SignerConfig)(nil), "configpb.SignerConfig")
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xdb, 0x4e, 0xdc, 0x3a,
-	0x14, 0xd5, 0x30, 0xd7, 0x6c, 0x18, 0x38, 0xf8, 0x80, 0xc8, 0xb9, 0xe9, 0x70, 0xa2, 0x43, 0x85,
-	0x5a, 0x09, 0x09, 0xda, 0xb7, 0x3e, 0x4d, 0x41, 0x54, 0x55, 0x69, 0x15, 0xa5, 0xc3, 0x63, 0x65,
-	0x39, 0xb1, 0x27, 0x13, 0x8d, 0x27, 0xb6, 0x6c, 0x07, 0x66, 0xbe, 0xa4, 0x3f, 0xd6, 0x9f, 0xe8,
-	0x53, 0x7f, 0xa1, 0xf2, 0x25, 0x03, 0x54, 0x7d, 0x8b, 0xd7, 0x5a, 0xde, 0xde, 0x6b, 0x7b, 0x39,
-	0xb0, 0x53, 0x88, 0x7a, 0x56, 0x95, 0x67, 0x52, 0x09, 0x23, 0xd0, 0xc8, 0xaf, 0x64, 0x9e, 0x7c,
-	0xeb, 0xc0, 0xe0, 0xd2, 0x2d, 0xd0, 0x09, 0x74, 0x89, 0x94, 0x71, 0xe7, 0xb8, 0x73, 0xba, 0x7d,
-	0xf1, 0xfb, 0x59, 0x2b, 0x39, 0x9b, 0x48, 0xe9, 0x15, 0x99, 0xe5, 0xd1, 0x39, 0x0c, 0x6b, 0x66,
-	0xee, 0x85, 0x5a, 0xc4, 0x5b, 0x4e, 0x7a, 0xf4, 0x20, 0xfd, 0xe8, 0x89, 0x20, 0x6f, 0x75, 0xb6,
-	0x32, 0x17, 0x65, 0xdc, 0xfd, 0xb9, 0xf2, 0x8d, 0x28, 0xdb, 0xca, 0x5c, 0x94, 0xe8, 0x14, 0x7a,
-	0x94, 0x18, 0x12, 0xf7, 0x9c, 0xee, 0xe0, 0x41, 0x77, 0x45, 0x0c, 0x09, 0x42, 0xa7, 0x40, 0xaf,
-	0x60, 0x44, 0xe8, 0x1d, 0xa9, 0x0b, 0x46, 0xe3, 0xbe, 0x53, 0xc7, 0x8f, 0xfa, 0x0d, 0x4c, 0xd8,
-	0xb1, 0x51, 0x26, 0xdf, 0x3b, 0x10, 0xa5, 0x17, 0x69, 0xb0, 0x7b, 0x00, 0x7d, 0xcd, 0x18, 0xd5,
-	0xce, 0x70, 0x94, 0xf9, 0x05, 0xfa, 0xc3, 0x56, 0xa6, 0x58, 0x32, 0xa6, 0xe2, 0xad, 0xe3, 0xee,
-	0x69, 0x94, 0x0d, 0x09, 0xa5, 0x29, 0x63, 0x0a, 0xfd, 0x05, 0x91, 0x5e, 0x54, 0x12, 0x37, 0xb2,
-	0x96, 0xce, 0xcb, 0x28, 0x1b, 0x59, 0xe0, 0x56, 0xd6, 0x12, 0xbd, 0x80, 0xfd, 0x39, 0xa9, 0xa9,
-	0x9e, 0x93, 0x05, 0xc3, 0xa6, 0x5a, 0x32, 0xd1, 0x18, 0x67, 0x64, 0x9c, 0xfd, 0xb6, 0x21, 0xa6,
-	0x1e, 0x47, 0xff, 0xc1, 0x0e, 0xad, 0x08, 0xdf, 0xe8, 0xfa, 0x4e, 0xb7, 0x6d, 0xb1, 0x56, 0xf2,
-	0x0f, 0xc0, 0x1d, 0x69, 0xb8, 0xc1, 0x4b, 0x41, 0x59, 0x3c, 0x70, 0xa7, 0x45, 0x0e, 0xf9, 0x20,
-	0x28, 0x43, 0x27, 0xb0, 0xcb, 0x2b, 0x6d, 0x58, 0x8d, 0x09, 0xa5, 0x8a, 0x69, 0x1d, 0x0f, 0x9d,
-	0x8b, 0xb1, 0x47, 0x27, 0x1e, 0x4c, 0xbe, 0x76, 0x20, 0x9a, 0xa4, 0xef, 0x82, 0x63, 0x04, 0xbd,
-	0xb9, 0xd0, 0x26, 0x18, 0x76, 0xdf, 0xd6, 0x54, 0xa9, 0x64, 0x81, 0xa5, 0x50, 0xc6, 0xdd, 0x67,
-	0x94, 0x8d, 0x2c, 0x90, 0x0a, 0xe5, 0xc8, 0xb9, 0x31, 0xd2, 0x93, 0x5d, 0x4f, 0x5a, 0xc0, 0x91,
-	0xff, 0xc3, 0xae, 0x23, 0x0b, 0xa1, 0xb4, 0xeb, 0x22, 0xee, 0xb9, 0x79, 0xed, 0x58, 0xf4, 0x52,
-	0x28, 0x6d, 0x9b, 0x40, 0xff, 0xc2, 0x36, 0xad, 0x34, 0xc9, 0x39, 0xc3, 0x86, 0x6b, 0xe7, 0x74,
-	0x94, 0x41, 0x80, 0xa6, 0xdc, 0x0d, 0xdc, 0x9e, 0x5f, 0x30, 0x65, 0x9c, 0xcd, 0x28, 0x1b, 0x2a,
-	0x59, 0x5c, 0x32, 0x65, 0xd0, 0x11, 0xd8, 0x4f, 0xbc, 0x60, 0xeb, 0xe0, 0x6e, 0xa0, 0x64, 0xf1,
-	0x9e, 0xad, 0x93, 0xcf, 0x30, 0x7e, 0x92, 0x34, 0x1b, 0x30, 0x79, 0xf1, 0x8b, 0xe8, 0x6e, 0x6e,
-	0x3b, 0xb3, 0xbc, 0x4f, 0x78, 0x15, 0x62, 0xfb, 0x38, 0xe1, 0xed, 0x88, 0x6c, 0xc2, 0xab, 0x64,
-	0x02, 0xd1, 0x26, 0x99, 0xb6, 0x09, 0x2e, 0x4a, 0x4c, 0x2b, 0x15, 0xe6, 0x36, 0xe0, 0xa2, 0xbc,
-	0xaa, 0x5c, 0x1c, 0x2c, 0xc1, 0xd9, 0x1d, 0xe3, 0xed, 0xe4, 0xb8, 0x28, 0x6f, 0xec, 0x3a, 0x59,
-	0x43, 0xb4, 0x79, 0x36, 0x28, 0x86, 0xa1, 0x54, 0x62, 0x56, 0x71, 0x16, 0x4a, 0xb4, 0x4b, 0x3b,
-	0x9d, 0x42, 0x36, 0xb8, 0x65, 0x7d, 0x15, 0x28, 0x64, 0x93, 0x06, 0xc1, 0x39, 0x1c, 0xd6, 0xc2,
-	0xa5, 0x11, 0xe7, 0x5c, 0x88, 0x25, 0x9e, 0x55, 0xdc, 0x30, 0xa5, 0x43, 0xfe, 0x50, 0x2d, 0x6c,
-	0x34, 0xdf, 0x58, 0xea, 0xda, 0x33, 0x09, 0x05, 0x78, 0x78, 0x2f, 0xb6, 0x7d, 0x9a, 0x63, 0xb3,
-	0x96, 0xed, 0xd9, 0x03, 0x9a, 0x4f, 0xd7, 0x92, 0xa1, 0x43, 0x18, 0xd0, 0xdc, 0xd9, 0xf2, 0xa7,
-	0xf6, 0x69, 0x6e, 0x5d, 0x3d, 0x83, 0xbd, 0x7b, 0xc2, 0x39, 0x33, 0x58, 0x36, 0x39, 0x96, 0x44,
-	0xeb, 0x70, 0xf1, 0x63, 0x0f, 0xa7, 0x4d, 0x9e, 0x12, 0xad, 0x93, 0x2f, 0x1d, 0xd8, 0x7d, 0xfa,
-	0xd0, 0xd0, 0x73, 0xd8, 0x0f, 0x61, 0xc4, 0x25, 0x91, 0x98, 0x57, 0xcb, 0xca, 0x67, 0x6d, 0x9c,
-	0xed, 0x05, 0xe2, 0x2d, 0x91, 0x37, 0x16, 0x46, 0xaf, 0xe1, 0xcf, 0x25, 0x59, 0xe1, 0xa6, 0x6e,
-	0x34, 0xa3, 0x58, 0x1b, 0xb2, 0xa8, 0xea, 0x72, 0x93, 0xe5, 0x2d, 0xb7, 0xe9, 0x68, 0x49, 0x56,
-	0xb7, 0x4e, 0xf0, 0xc9, 0xf3, 0x21, 0xd5, 0xe8, 0x6f, 0x00, 0xbb, 0xd9, 0xac, 0xf0, 0x8c, 0xb1,
-	0x36, 0x97, 0x4b, 0xb2, 0x9a, 0xae, 0xae, 0x19, 0xcb, 0x07, 0xee, 0x17, 0xf7, 0xf2, 0x47, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xfc, 0x04, 0x15, 0x60, 0xf2, 0x04, 0x00, 0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0xdd, 0x6e, 0x1c, 0x35,
+	0x14, 0xd6, 0x26, 0x9b, 0xdd, 0x9d, 0x93, 0x6c, 0x4a, 0x4d, 0xdb, 0x0c, 0x7f, 0xa2, 0x8c, 0x5a,
+	0x14, 0x81, 0x14, 0xa9, 0x81, 0x3b, 0xae, 0x42, 0xaa, 0x22, 0x44, 0x40, 0xab, 0x69, 0x2a, 0xae,
+	0x90, 0xe5, 0x19, 0x3b, 0xb3, 0xd6, 0x7a, 0xc6, 0x96, 0xed, 0x49, 0x76, 0x9f, 0x84, 0x1b, 0x1e,
+	0x89, 0x4b, 0xde, 0x83, 0x57, 0x40, 0xc7, 0xf6, 0x6c, 0xb2, 0x11, 0x77, 0x3e, 0xdf, 0xf7, 0xd9,
+	0x3e, 0xe7, 0xf8, 0x3b, 0x33, 0x70, 0x54, 0xeb, 0xee, 0x46, 0x36, 0x67, 0xc6, 0x6a, 0xaf, 0xc9,
+	0x2c, 0x46, 0xa6, 0x2a, 0xfe, 0xde, 0x83, 0xc9, 0x65, 0x08, 0xc8, 0x6b, 0xd8, 0x67, 0xc6, 0xe4,
+	0xa3, 0x97, 0xa3, 0xd3, 0xc3, 0xf3, 0x8f, 0xcf, 0x06, 0xc9, 0xd9, 0x85, 0x31, 0x51, 0x51, 0x22,
+	0x4f, 0xde, 0xc0, 0xb4, 0x13, 0xfe, 0x4e, 0xdb, 0x55, 0xbe, 0x17, 0xa4, 0x27, 0xf7, 0xd2, 0xdf,
+	0x22, 0x91, 0xe4, 0x83, 0x0e, 0x4f, 0x56, 0xba, 0xc9, 0xf7, 0x1f, 0x9f, 0x7c, 0xa5, 0x9b, 0xe1,
+	0x64, 0xa5, 0x1b, 0x72, 0x0a, 0x63, 0xce, 0x3c, 0xcb, 0xc7, 0x41, 0xf7, 0xec, 0x5e, 0xf7, 0x96,
+	0x79, 0x96, 0x84, 0x41, 0x41, 0xbe, 0x87, 0x19, 0xe3, 0xb7, 0xac, 0xab, 0x05, 0xcf, 0x0f, 0x82,
+	0x3a, 0x7f, 0x90, 0x6f, 0x62, 0xd2, 0x8e, 0xad, 0x12, 0x33, 0xbf, 0x13, 0xd5, 0x52, 0xeb, 0x55,
+	0x3e, 0x79, 0x9c, 0xf9, 0xef, 0x91, 0x18, 0x32, 0x4f, 0x3a, 0x72, 0x06, 0x13, 0x27, 0x9b, 0x4e,
+	0xd8, 0x7c, 0x1a, 0x76, 0xbc, 0xb8, 0xdf, 0xf1, 0x3e, 0xe0, 0x69, 0x43, 0x52, 0x15, 0xff, 0x8e,
+	0x20, 0x5b, 0x9c, 0x2f, 0x52, 0x47, 0x9f, 0xc1, 0x81, 0x13, 0x82, 0xbb, 0xd0, 0xd3, 0xac, 0x8c,
+	0x01, 0xf9, 0x04, 0x93, 0xe7, 0xd4, 0x08, 0x61, 0xf3, 0xbd, 0x97, 0xfb, 0xa7, 0x59, 0x39, 0x65,
+	0x9c, 0x2f, 0x84, 0xb0, 0xe4, 0x33, 0xc8, 0xdc, 0x4a, 0x1a, 0xda, 0x9b, 0xce, 0x84, 0x76, 0xcd,
+	0xca, 0x19, 0x02, 0x1f, 0x4c, 0x67, 0xc8, 0xb7, 0xf0, 0x74, 0xc9, 0x3a, 0xee, 0x96, 0x6c, 0x25,
+	0xa8, 0x97, 0xad, 0xd0, 0xbd, 0x0f, 0xbd, 0x9a, 0x97, 0x1f, 0x6d, 0x89, 0xeb, 0x88, 0x93, 0xaf,
+	0xe0, 0x88, 0x4b, 0xa6, 0xb6, 0xba, 0x83, 0xa0, 0x3b, 0x44, 0x6c, 0x90, 0x7c, 0x01, 0x70, 0xcb,
+	0x7a, 0xe5, 0x69, 0xab, 0xb9, 0x08, 0x1d, 0x99, 0x95, 0x59, 0x40, 0x7e, 0xd5, 0x5c, 0x90, 0xd7,
+	0x70, 0xac, 0xa4, 0xf3, 0xa2, 0xa3, 0x8c, 0x73, 0x2b, 0x9c, 0x0b, 0x2d, 0xc8, 0xca, 0x79, 0x44,
+	0x2f, 0x22, 0x58, 0xfc, 0x33, 0x82, 0xec, 0x62, 0xf1, 0x73, 0xaa, 0x98, 0xc0, 0x78, 0xa9, 0x9d,
+	0x4f, 0x05, 0x87, 0x35, 0x16, 0xd5, 0x58, 0x53, 0x53, 0xa3, 0xad, 0x0f, 0x96, 0xc9, 0xca, 0x19,
+	0x02, 0x0b, 0x6d, 0x03, 0xb9, 0xf4, 0xde, 0x44, 0x72, 0x3f, 0x92, 0x08, 0x04, 0xf2, 0x15, 0x1c,
+	0x07, 0xb2, 0xd6, 0xd6, 0x85, 0x2c, 0xf2, 0x71, 0xe8, 0xd7, 0x11, 0xa2, 0x97, 0xda, 0x3a, 0x4c,
+	0x82, 0x7c, 0x09, 0x87, 0x5c, 0x3a, 0x56, 0x29, 0x41, 0xbd, 0x72, 0xa1, 0xd2, 0x59, 0x09, 0x09,
+	0xba, 0x56, 0xa1, 0xe1, 0x78, 0x7f, 0x2d, 0xac, 0x0f, 0x65, 0x66, 0xe5, 0xd4, 0x9a, 0xfa, 0x52,
+	0x58, 0x4f, 0x4e, 0x00, 0x97, 0x74, 0x25, 0x36, 0xa9, 0xba, 0x89, 0x35, 0xf5, 0x2f, 0x62, 0x53,
+	0xfc, 0x01, 0xf3, 0x1d, 0x33, 0xa3, 0x87, 0xcd, 0xf9, 0xff, 0x4c, 0xc7, 0xf6, 0xb5, 0x4b, 0xe4,
+	0xe3, 0x10, 0xc9, 0x7c, 0xef, 0xb1, 0x6c, 0xdb, 0x22, 0x1c, 0x22, 0x59, 0x5c, 0x40, 0xb6, 0x35,
+	0x3f, 0x26, 0xa1, 0x74, 0x43, 0xb9, 0xb4, 0xa9, 0x6f, 0x13, 0xa5, 0x9b, 0xb7, 0x32, 0xd8, 0x01,
+	0x09, 0x25, 0x6e, 0x85, 0x1a, 0x3a, 0xa7, 0x74, 0x73, 0x85, 0x71, 0xb1, 0x81, 0x6c, 0x3b, 0x99,
+	0x24, 0x87, 0xa9, 0xb1, 0xfa, 0x46, 0x2a, 0x91, 0x8e, 0x18, 0x42, 0xec, 0x4e, 0x6d, 0x7a, 0x3a,
+	0xb0, 0xf1, 0x14, 0xa8, 0x4d, 0xbf, 0x48, 0x82, 0x37, 0xf0, 0xbc, 0xd3, 0xc1, 0x8d, 0xb4, 0x52,
+	0x5a, 0xb7, 0xf4, 0x46, 0x2a, 0x2f, 0xac, 0x4b, 0xfe, 0x23, 0x9d, 0x46, 0x6b, 0xfe, 0x88, 0xd4,
+	0xbb, 0xc8, 0x14, 0x1c, 0xe0, 0x7e, 0x24, 0x31, 0x7d, 0x5e, 0x51, 0xbf, 0x31, 0xc3, 0xdd, 0x13,
+	0x5e, 0x5d, 0x6f, 0x8c, 0x20, 0xcf, 0x61, 0xc2, 0xab, 0x50, 0x56, 0xbc, 0xf5, 0x80, 0x57, 0x58,
+	0xd5, 0xd7, 0xf0, 0xe4, 0x8e, 0x29, 0x25, 0x3c, 0x35, 0x7d, 0x45, 0x0d, 0x73, 0x2e, 0x3d, 0xfc,
+	0x3c, 0xc2, 0x8b, 0xbe, 0x5a, 0x30, 0xe7, 0x8a, 0x3f, 0x47, 0x70, 0xbc, 0x3b, 0xcb, 0xe4, 0x1b,
+	0x78, 0x9a, 0xcc, 0x48, 0x1b, 0x66, 0xa8, 0x92, 0xad, 0x8c, 0x5e, 0x9b, 0x97, 0x4f, 0x12, 0xf1,
+	0x13, 0x33, 0x57, 0x08, 0x93, 0x1f, 0xe0, 0xd3, 0x96, 0xad, 0x69, 0xdf, 0xf5, 0x4e, 0x70, 0xea,
+	0x3c, 0x5b, 0xc9, 0xae, 0xd9, 0x7a, 0x79, 0x2f, 0x6c, 0x3a, 0x69, 0xd9, 0xfa, 0x43, 0x10, 0xbc,
+	0x8f, 0x7c, 0x72, 0x35, 0xf9, 0x1c, 0x00, 0x37, 0xfb, 0x35, 0xbd, 0x11, 0x62, 0xf0, 0x65, 0xcb,
+	0xd6, 0xd7, 0xeb, 0x77, 0x42, 0x14, 0x7f, 0x8d, 0x60, 0xbe, 0xf3, 0xc1, 0x40, 0xdf, 0xf7, 0x56,
+	0xe1, 0xa0, 0xa3, 0x3f, 0xc3, 0x9a, 0xbc, 0x80, 0x89, 0x13, 0xb5, 0x15, 0x83, 0xe9, 0x53, 0x44,
+	0x5e, 0xc1, 0x3c, 0xd8, 0xc2, 0xb6, 0xcc, 0x4b, 0xdd, 0xc5, 0xea, 0xe7, 0xe5, 0x2e, 0x88, 0xef,
+	0x86, 0x19, 0x58, 0xe1, 0xad, 0x14, 0x2e, 0xcd, 0x39, 0x26, 0x55, 0x46, 0x04, 0x9f, 0x7c, 0x77,
+	0xb8, 0x87, 0xb0, 0x30, 0x70, 0xf4, 0xf0, 0xe3, 0x84, 0xca, 0xa1, 0xec, 0x64, 0x8e, 0x14, 0x22,
+	0x53, 0xeb, 0xb6, 0x65, 0x1d, 0x4f, 0x39, 0x0e, 0x21, 0x16, 0xc4, 0x6c, 0x83, 0xb9, 0x85, 0x82,
+	0x70, 0xfd, 0xf0, 0xc6, 0xf1, 0xce, 0x8d, 0xd5, 0x24, 0xfc, 0x56, 0xbe, 0xfb, 0x6f, 0x00, 0x2a,
+	0x4f, 0xdd, 0xb6, 0x66, 0x06, 0x00, 0x00,
 }
@@ -258,5 +258,15 @@ func NewDefaultConfig() *configpb.Config {
 			MaxUnusedStakingAddress: DefaultMaxUnusedStakingAddress,
 			MaxTxFee:                DefaultMaxTxFee,
 		},
+		Webhook: &configpb.WebhookConfig{
+			Urls:          make([]string, 0),
+			Confirmations: DefaultWebhookConfirmations,
+			MaxRetries:    DefaultWebhookMaxRetries,
+			Timeout:       DefaultWebhookTimeout,
+		},
+		Signer: &configpb.SignerConfig{
+			Args:    make([]string, 0),
+			Timeout: DefaultSignerTimeout,
+		},
 	}
 }
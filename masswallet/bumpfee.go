@@ -0,0 +1,155 @@
+package masswallet
+
+import (
+	"massnet.org/mass-wallet/blockchain"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+// signalReplacement makes every input of msgTx signal replaceability, unless
+// it already does.
+func signalReplacement(msgTx *wire.MsgTx) {
+	for _, txIn := range msgTx.TxIn {
+		if txIn.Sequence > blockchain.MaxReplaceableSequence {
+			txIn.Sequence = blockchain.MaxReplaceableSequence
+		}
+	}
+}
+
+// BumpFee creates a transaction replacing the unmined transaction txHash of
+// current wallet. It spends the same inputs and pays the same outputs, but
+// for the change output which is lowered to pay newFee. The replacement is
+// signed with password, and returned along with the fee of the replaced
+// transaction.
+func (w *WalletManager) BumpFee(password []byte, txHash *wire.Hash, newFee massutil.Amount) (*wire.MsgTx, massutil.Amount, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	ks := w.ksmgr.CurrentKeystore()
+	if ks == nil {
+		return nil, massutil.ZeroAmount(), ErrNoWalletInUse
+	}
+
+	msgTx, err := w.existsUnminedTx(txHash)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "unmined transaction not found", logging.LogFormat{
+			"err": err,
+			"tx":  txHash.String(),
+		})
+		if err == txmgr.ErrNotFound {
+			return nil, massutil.ZeroAmount(), ErrTxNotUnmined
+		}
+		return nil, massutil.ZeroAmount(), err
+	}
+	if !blockchain.SignalsReplacement(msgTx) {
+		return nil, massutil.ZeroAmount(), ErrTxNotReplaceable
+	}
+
+	// The inputs are spent by the replaced transaction, so the spent outputs
+	// are looked up without checking the spending status.
+	prevTxOuts := make([]*wire.TxOut, len(msgTx.TxIn))
+	totalIn := massutil.ZeroAmount()
+	for i, txIn := range msgTx.TxIn {
+		txIn.Witness = nil
+		prevTx, err := w.existsMsgTx(&txIn.PreviousOutPoint)
+		if err == txmgr.ErrNotFound {
+			prevTx, err = w.existsUnminedTx(&txIn.PreviousOutPoint.Hash)
+		}
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to check previous transaction", logging.LogFormat{
+				"err": err,
+				"tx":  txHash.String(),
+			})
+			return nil, massutil.ZeroAmount(), ErrUTXONotExists
+		}
+		if txIn.PreviousOutPoint.Index >= uint32(len(prevTx.TxOut)) {
+			return nil, massutil.ZeroAmount(), ErrInvalidIndex
+		}
+		prevTxOut := prevTx.TxOut[txIn.PreviousOutPoint.Index]
+		if totalIn, err = totalIn.AddInt(prevTxOut.Value); err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+		prevTxOuts[i] = prevTxOut
+	}
+
+	totalOut := massutil.ZeroAmount()
+	change := -1
+	for i, txOut := range msgTx.TxOut {
+		if totalOut, err = totalOut.AddInt(txOut.Value); err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+		mAddr, err := managedAddressOf(txOut.PkScript, ks, w.chainParams)
+		if err == nil && mAddr.IsChangeAddr() && change < 0 {
+			change = i
+		}
+	}
+	oldFee, err := totalIn.Sub(totalOut)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	if newFee.Cmp(oldFee) <= 0 {
+		logging.CPrint(logging.ERROR, "new fee not higher than the replaced", logging.LogFormat{
+			"oldFee": oldFee.String(),
+			"newFee": newFee.String(),
+		})
+		return nil, massutil.ZeroAmount(), ErrBumpFeeTooLow
+	}
+	if change < 0 {
+		return nil, massutil.ZeroAmount(), ErrNoChangeOutput
+	}
+
+	delta, err := newFee.Sub(oldFee)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	changeOut := msgTx.TxOut[change]
+	changeValue, err := massutil.NewAmountFromInt(changeOut.Value)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	if changeValue.Cmp(delta) < 0 {
+		return nil, massutil.ZeroAmount(), ErrInsufficientFunds
+	}
+	changeValue, _ = changeValue.Sub(delta)
+	changeOut.Value = changeValue.IntValue()
+	isDust, err := blockchain.IsDust(changeOut, massutil.MinRelayTxFee())
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	if isDust {
+		return nil, massutil.ZeroAmount(), ErrDustChange
+	}
+
+	p, err := psbt.New(msgTx)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	for i, pIn := range p.Inputs {
+		pIn.WitnessUtxo = prevTxOuts[i]
+	}
+	if err = updatePsbtScripts(p, ks, w.chainParams); err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	_, err = w.signWitnessTx(password, p, txscript.SigHashAll)
+	if err == nil {
+		err = psbt.Finalize(p, w.chainParams)
+	}
+	if err != nil {
+		logging.CPrint(logging.ERROR, "Failed to sign the replacement", logging.LogFormat{
+			"err": err,
+			"tx":  txHash.String(),
+		})
+		if err == psbt.ErrNotEnoughSignatures {
+			return nil, massutil.ZeroAmount(), ErrIncompleteSignature
+		}
+		return nil, massutil.ZeroAmount(), err
+	}
+	for i, pIn := range p.Inputs {
+		msgTx.TxIn[i].Witness = pIn.FinalWitness
+	}
+	return msgTx, oldFee, nil
+}
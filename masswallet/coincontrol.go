@@ -0,0 +1,133 @@
+package masswallet
+
+import (
+	"unicode/utf8"
+
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/wire"
+)
+
+// MaxUtxoLabelLen is the max length of utxo labels in characters.
+const MaxUtxoLabelLen = 256
+
+// CoinControl constrains the automatic selection of inputs. Outputs in
+// Include are always spent, even if frozen, outputs in Exclude never are.
+// The rest is selected by Selector, or the default one if nil. FeeRate is
+// the fee paid in MASS per KB, the min relay fee rate if nil or lower.
+type CoinControl struct {
+	Include  []wire.OutPoint
+	Exclude  []wire.OutPoint
+	Selector CoinSelector
+	FeeRate  *massutil.Amount
+}
+
+func (cc *CoinControl) selector() CoinSelector {
+	if cc == nil || cc.Selector == nil {
+		return defaultSelector{}
+	}
+	return cc.Selector
+}
+
+func (cc *CoinControl) feeRate() massutil.Amount {
+	if cc == nil || cc.FeeRate == nil || cc.FeeRate.Cmp(massutil.MinRelayTxFee()) < 0 {
+		return massutil.MinRelayTxFee()
+	}
+	return *cc.FeeRate
+}
+
+func (cc *CoinControl) avoidsChange() bool {
+	ca, ok := cc.selector().(changeAvoider)
+	return ok && ca.avoidsChange()
+}
+
+// settleChange splits the excess of the selected inputs over the outputs and
+// fee into the change output and the dust paid to miners. It reports false if
+// the change is dust and the selector doesn't avoid change, so the inputs need
+// to cover the dust threshold more.
+func (cc *CoinControl) settleChange(excess massutil.Amount) (change, dust massutil.Amount, ok bool) {
+	if excess.IsZero() || excess.Cmp(massutil.MinRelayTxFee()) >= 0 {
+		return excess, massutil.ZeroAmount(), true
+	}
+	if cc.avoidsChange() {
+		return massutil.ZeroAmount(), excess, true
+	}
+	return massutil.ZeroAmount(), massutil.ZeroAmount(), false
+}
+
+func (cc *CoinControl) check() error {
+	if cc == nil {
+		return nil
+	}
+	include := make(map[wire.OutPoint]struct{}, len(cc.Include))
+	for _, op := range cc.Include {
+		include[op] = struct{}{}
+	}
+	for _, op := range cc.Exclude {
+		if _, ok := include[op]; ok {
+			logging.CPrint(logging.ERROR, "outpoint both included and excluded", logging.LogFormat{
+				"tx":   op.Hash.String(),
+				"vout": op.Index,
+			})
+			return ErrInvalidParameter
+		}
+	}
+	return nil
+}
+
+// SetUtxoFrozen freezes or unfreezes outputs of current wallet. Frozen outputs
+// are skipped by automatic selection of inputs.
+func (w *WalletManager) SetUtxoFrozen(ops []wire.OutPoint, frozen bool) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.updateUtxoControls(ops, func(ctl *txmgr.UtxoControl) {
+		ctl.Frozen = frozen
+	})
+}
+
+// SetUtxoLabel labels an output of current wallet. An empty label removes the
+// existing one.
+func (w *WalletManager) SetUtxoLabel(op *wire.OutPoint, label string) error {
+	if utf8.RuneCountInString(label) > MaxUtxoLabelLen {
+		return ErrInvalidParameter
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.updateUtxoControls([]wire.OutPoint{*op}, func(ctl *txmgr.UtxoControl) {
+		ctl.Label = label
+	})
+}
+
+func (w *WalletManager) updateUtxoControls(ops []wire.OutPoint, update func(ctl *txmgr.UtxoControl)) error {
+	am := w.ksmgr.CurrentKeystore()
+	if am == nil {
+		return ErrNoWalletInUse
+	}
+	return mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		for i := range ops {
+			op := &ops[i]
+			if _, err := w.txStore.ExistsUtxo(tx, am.Name(), op); err != nil {
+				logging.CPrint(logging.ERROR, "output not found in wallet", logging.LogFormat{
+					"err":      err,
+					"tx":       op.Hash.String(),
+					"vout":     op.Index,
+					"walletId": am.Name(),
+				})
+				return ErrUTXONotExists
+			}
+			ctl, err := w.utxoStore.FetchUtxoControl(tx, am.Name(), op)
+			if err != nil {
+				return err
+			}
+			update(ctl)
+			if err = w.utxoStore.PutUtxoControl(tx, am.Name(), op, ctl); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,36 @@
+package masswallet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass-wallet/massutil"
+)
+
+func TestCoinControl_feeRate(t *testing.T) {
+	minRate := massutil.MinRelayTxFee()
+	lowRate, err := massutil.NewAmountFromInt(minRate.IntValue() - 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	highRate, err := massutil.NewAmountFromInt(minRate.IntValue() * 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		cc     *CoinControl
+		expect massutil.Amount
+	}{
+		{"nil coin control", nil, minRate},
+		{"no fee rate", &CoinControl{}, minRate},
+		{"fee rate below min relay fee", &CoinControl{FeeRate: &lowRate}, minRate},
+		{"fee rate", &CoinControl{FeeRate: &highRate}, highRate},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect.IntValue(), test.cc.feeRate().IntValue())
+		})
+	}
+}
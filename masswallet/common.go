@@ -22,8 +22,12 @@ import (
 
 // for current wallet
 func (w *WalletManager) existsMsgTx(out *wire.OutPoint) (mtx *wire.MsgTx, err error) {
+	am := w.ksmgr.CurrentKeystore()
+	if am == nil {
+		return nil, ErrNoWalletInUse
+	}
 	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
-		mtx, err = w.txStore.ExistsTx(tx, out)
+		mtx, err = w.txStore.ExistsTx(tx, am.Name(), out)
 		return err
 	})
 	return
@@ -39,8 +43,12 @@ func (w *WalletManager) existsUnminedTx(hash *wire.Hash) (mtx *wire.MsgTx, err e
 
 // for current wallet
 func (w *WalletManager) existsOutPoint(out *wire.OutPoint) (utxoFlags *txmgr.UtxoFlags, err error) {
+	am := w.ksmgr.CurrentKeystore()
+	if am == nil {
+		return nil, ErrNoWalletInUse
+	}
 	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
-		utxoFlags, err = w.txStore.ExistsUtxo(tx, out)
+		utxoFlags, err = w.txStore.ExistsUtxo(tx, am.Name(), out)
 		return err
 	})
 	return
@@ -76,7 +84,11 @@ func (w *WalletManager) addTxIn(msgTx *wire.MsgTx, LockTime uint64, inputUtxos [
 }
 
 func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockTime uint64,
-	addrs []string, userTxFee massutil.Amount, changeAddr string) (fee massutil.Amount, err error) {
+	addrs []string, userTxFee massutil.Amount, changeAddr string, cc *CoinControl) (fee massutil.Amount, err error) {
+
+	if err = cc.check(); err != nil {
+		return massutil.ZeroAmount(), err
+	}
 
 	targetTxFee := massutil.MinRelayTxFee()
 	if !userTxFee.IsZero() {
@@ -97,6 +109,7 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 	for {
 		var (
 			adj       = massutil.ZeroAmount()
+			dust      = massutil.ZeroAmount()
 			changeOut *wire.TxOut
 			txOutLen  int
 			utxos     []*txmgr.Credit
@@ -119,7 +132,7 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 				overfull  bool
 			)
 
-			utxos, firstAddr, found, overfull, err = w.findEligibleUtxos(wantAdj, addrs)
+			utxos, firstAddr, found, overfull, err = w.findEligibleUtxos(wantAdj, addrs, cc)
 			if err != nil {
 				return outAmounts, err
 			}
@@ -139,12 +152,14 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 
 			txOutLen = len(msgTx.TxOut)
 			// construct change output
-			changeAmount, _ := found.Sub(want)
+			excess, _ := found.Sub(want)
+			changeAmount, paidDust, ok := cc.settleChange(excess)
+			if !ok {
+				adj = massutil.MinRelayTxFee()
+				continue
+			}
+			dust = paidDust
 			if !changeAmount.IsZero() {
-				if changeAmount.Cmp(massutil.MinRelayTxFee()) < 0 {
-					adj = massutil.MinRelayTxFee()
-					continue
-				}
 				if len(changeAddr) > 0 {
 					changeOut, err = amountToTxOut(changeAddr, changeAmount)
 				} else {
@@ -164,11 +179,15 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 			logging.CPrint(logging.ERROR, "estimate signedSize failed", logging.LogFormat{"err": err})
 			return outAmounts, ErrInvalidParameter
 		}
-		requiredFee, err := blockchain.CalcMinRequiredTxRelayFee(signedTxSize, massutil.MinRelayTxFee())
+		requiredFee, err := blockchain.CalcMinRequiredTxRelayFee(signedTxSize, cc.feeRate())
+		if err != nil {
+			return outAmounts, err
+		}
+		paidFee, err := targetTxFee.Add(dust)
 		if err != nil {
 			return outAmounts, err
 		}
-		if targetTxFee.Cmp(requiredFee) >= 0 {
+		if paidFee.Cmp(requiredFee) >= 0 {
 			err = w.addTxIn(msgTx, LockTime, utxos)
 			if err != nil {
 				return outAmounts, err
@@ -176,7 +195,7 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 			if changeOut != nil {
 				msgTx.AddTxOut(changeOut)
 			}
-			return targetTxFee, nil
+			return paidFee, nil
 		}
 
 		msgTx.TxIn = make([]*wire.TxIn, 0)
@@ -0,0 +1,226 @@
+package masswallet
+
+import (
+	"sort"
+
+	"massnet.org/mass-wallet/blockchain"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/wire"
+)
+
+// ConsolidationTx is a transaction merging outputs of the wallet.
+type ConsolidationTx struct {
+	MsgTx  *wire.MsgTx
+	Amount massutil.Amount // paid to the target address
+	Fee    massutil.Amount
+}
+
+// maxConsolidationInputs is the default max number of inputs per
+// consolidation transaction, see topKSelector.
+func maxConsolidationInputs() int {
+	return blockchain.GetMaxStandardTxSize() / 154
+}
+
+// ConsolidateUtxos creates unsigned transactions merging the spendable outputs
+// of addresses into the target address, smallest first, as many as needed with
+// at most maxInputs inputs and within the standard size each. Frozen outputs
+// are left alone, so are outputs not paying the fee at feeRate, in MASS per KB.
+//
+// All the witness v0 receiving addresses of current wallet are consolidated if
+// addresses is empty, by index, and the first one of addresses is the target
+// if empty, so the target defaults to the one with the lowest index. Unless
+// dryRun, the spent outputs are reserved for the returned transactions.
+func (w *WalletManager) ConsolidateUtxos(addresses []string, maxInputs int, feeRate massutil.Amount,
+	target string, dryRun bool) ([]*ConsolidationTx, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	ks := w.ksmgr.CurrentKeystore()
+	if ks == nil {
+		return nil, ErrNoWalletInUse
+	}
+	if len(addresses) == 0 {
+		mas, err := w.receivingAddresses(ks)
+		if err != nil {
+			return nil, err
+		}
+		addresses = make([]string, 0, len(mas))
+		for _, ma := range mas {
+			addresses = append(addresses, ma.String())
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, ErrNoAddressInWallet
+	}
+	if len(target) == 0 {
+		target = addresses[0]
+	}
+	if err := w.checkConsolidationTarget(target); err != nil {
+		return nil, err
+	}
+	if maxInputs <= 0 || maxInputs > maxConsolidationInputs() {
+		maxInputs = maxConsolidationInputs()
+	}
+	if feeRate.IsZero() {
+		feeRate = massutil.MinRelayTxFee()
+	}
+
+	credits, err := w.consolidatableCredits(addresses)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(credits, func(i, j int) bool {
+		return credits[i].Amount.Cmp(credits[j].Amount) < 0
+	})
+
+	ret := make([]*ConsolidationTx, 0)
+	for len(credits) >= 2 {
+		n := maxInputs
+		if n > len(credits) {
+			n = len(credits)
+		}
+		ctx, size, err := w.newConsolidationTx(credits[:n], feeRate, target)
+		for err == nil && size > int64(blockchain.GetMaxStandardTxSize()) && n > 2 {
+			// shrink proportionally, multisig inputs are larger than others
+			shrunk := int(int64(n) * int64(blockchain.GetMaxStandardTxSize()) / size)
+			if shrunk >= n {
+				shrunk = n - 1
+			}
+			n = shrunk
+			ctx, size, err = w.newConsolidationTx(credits[:n], feeRate, target)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if n < 2 {
+			break
+		}
+		if ctx == nil {
+			// the smallest outputs are not worth the fee
+			credits = credits[n:]
+			continue
+		}
+		ret = append(ret, ctx)
+		credits = credits[n:]
+	}
+	if len(ret) == 0 {
+		return nil, ErrNothingToConsolidate
+	}
+
+	if !dryRun {
+		for _, ctx := range ret {
+			w.MarkUsedUTXO(ctx.MsgTx)
+		}
+	}
+	return ret, nil
+}
+
+func (w *WalletManager) checkConsolidationTarget(target string) error {
+	ks := w.ksmgr.CurrentKeystore()
+	if _, err := ks.Address(target); err != nil {
+		logging.CPrint(logging.ERROR, "target address not found in wallet", logging.LogFormat{
+			"target": target,
+			"err":    err,
+		})
+		return err
+	}
+	addr, err := massutil.DecodeAddress(target, w.chainParams)
+	if err != nil {
+		return err
+	}
+	if massutil.IsWitnessStakingAddress(addr) {
+		return ErrInvalidParameter
+	}
+	return nil
+}
+
+// consolidatableCredits returns the spendable outputs of addresses, excluding
+// frozen ones.
+func (w *WalletManager) consolidatableCredits(addresses []string) ([]*txmgr.Credit, error) {
+	ks := w.ksmgr.CurrentKeystore()
+	scriptSet := make(map[string]struct{})
+	for _, addr := range addresses {
+		ma, err := ks.Address(addr)
+		if err != nil {
+			return nil, err
+		}
+		scriptSet[string(ma.ScriptAddress())] = struct{}{}
+	}
+
+	credits := make([]*txmgr.Credit, 0)
+	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		syncedTo, err := w.syncStore.SyncedTo(tx)
+		if err != nil {
+			return err
+		}
+		controls, err := w.utxoStore.FetchUtxoControls(tx, ks.Name())
+		if err != nil {
+			return err
+		}
+		_, err = w.utxoStore.ScriptAddressUnspents(tx, ks.Name(), scriptSet, syncedTo.Height,
+			func(item *txmgr.Credit) (stopIter, selected bool) {
+				if !w.isSpendableCredit(item) {
+					return
+				}
+				if ctl, ok := controls[item.OutPoint]; ok && ctl.Frozen {
+					return
+				}
+				credits = append(credits, item)
+				return
+			})
+		return err
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to load utxos", logging.LogFormat{
+			"err":      err,
+			"walletId": ks.Name(),
+		})
+		return nil, err
+	}
+	return credits, nil
+}
+
+// newConsolidationTx returns the transaction spending credits to target, along
+// with its estimated signed size. The transaction is nil if credits do not pay
+// the fee and a non-dust output.
+func (w *WalletManager) newConsolidationTx(credits []*txmgr.Credit, feeRate massutil.Amount,
+	target string) (*ConsolidationTx, int64, error) {
+	size, err := w.estimateSignedSize(credits, 1)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "estimate signedSize failed", logging.LogFormat{"err": err})
+		return nil, 0, err
+	}
+	fee, err := blockchain.CalcMinRequiredTxRelayFee(size, feeRate)
+	if err != nil {
+		return nil, 0, err
+	}
+	sum := massutil.ZeroAmount()
+	for _, item := range credits {
+		if sum, err = sum.Add(item.Amount); err != nil {
+			return nil, 0, err
+		}
+	}
+	amount, err := sum.Sub(fee)
+	if err != nil || amount.Cmp(massutil.MinRelayTxFee()) < 0 {
+		return nil, size, nil
+	}
+
+	msgTx := wire.NewMsgTx()
+	if err = w.addTxIn(msgTx, 0, credits); err != nil {
+		return nil, 0, err
+	}
+	txOut, err := amountToTxOut(target, amount)
+	if err != nil {
+		return nil, 0, err
+	}
+	msgTx.AddTxOut(txOut)
+	msgTx.Version = wire.TxVersion
+	return &ConsolidationTx{
+		MsgTx:  msgTx,
+		Amount: amount,
+		Fee:    fee,
+	}, size, nil
+}
@@ -22,8 +22,19 @@ var (
 	ErrDustChange            = errors.New("Change is dust")
 	ErrDustAmount            = errors.New("Amount is dust")
 	ErrNotEnoughInputs       = errors.New("Not enough inputs")
+	ErrUnspendableUtxo       = errors.New("Output is not spendable")
+	ErrNothingToConsolidate  = errors.New("No utxo to consolidate")
+	ErrNothingToSweep        = errors.New("No utxo to sweep")
+	ErrNothingToWithdraw     = errors.New("No utxo to withdraw")
+	ErrStakingNotExpired     = errors.New("Staking output is not expired")
 
-	ErrSignWitnessTx = errors.New("Failed to sign witness tx")
+	ErrSignWitnessTx       = errors.New("Failed to sign witness tx")
+	ErrIncompleteSignature = errors.New("Transaction requires signatures of cosigners")
+
+	ErrTxNotUnmined     = errors.New("Transaction not found in unmined transactions")
+	ErrTxNotReplaceable = errors.New("Transaction does not signal replaceability")
+	ErrBumpFeeTooLow    = errors.New("New fee must be higher than the fee of replaced transaction")
+	ErrNoChangeOutput   = errors.New("Transaction has no change output to pay the fee")
 
 	ErrNoWalletInUse     = errors.New("no wallet in use")
 	ErrIllegalReorgBlock = errors.New("illegal reorg block")
@@ -0,0 +1,186 @@
+package masswallet
+
+import (
+	"sync"
+
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/wire"
+)
+
+// WalletEventType ...
+type WalletEventType uint8
+
+const (
+	// EventMempoolTx is sent when a transaction of the wallet enters mempool.
+	EventMempoolTx WalletEventType = iota
+	// EventTxConfirmed is sent when a transaction of the wallet is mined.
+	EventTxConfirmed
+	// EventBlockConnected is sent to every subscriber when the wallet
+	// synchronizes a block.
+	EventBlockConnected
+	// EventBlockDisconnected is sent to every subscriber when a block is
+	// rolled back by reorganization. Transactions of the block become
+	// unconfirmed until they are mined again.
+	EventBlockDisconnected
+	// EventImportProgress is sent when an importing wallet synchronizes a
+	// batch of blocks.
+	EventImportProgress
+	// EventImportFinished is sent when an importing wallet becomes ready.
+	EventImportFinished
+	// EventRemoveProgress is sent when the balances and addresses of a
+	// removing wallet are deleted.
+	EventRemoveProgress
+	// EventRemoveFinished is sent when the keystore of a removing wallet
+	// is deleted.
+	EventRemoveFinished
+)
+
+var walletEventTypeStrings = map[WalletEventType]string{
+	EventMempoolTx:         "mempool_tx",
+	EventTxConfirmed:       "tx_confirmed",
+	EventBlockConnected:    "block_connected",
+	EventBlockDisconnected: "block_disconnected",
+	EventImportProgress:    "import_progress",
+	EventImportFinished:    "import_finished",
+	EventRemoveProgress:    "remove_progress",
+	EventRemoveFinished:    "remove_finished",
+}
+
+func (t WalletEventType) String() string {
+	if s, ok := walletEventTypeStrings[t]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// WalletEvent is a notification pushed to subscribers. WalletID is empty for
+// block events, which concern every wallet.
+type WalletEvent struct {
+	Type      WalletEventType
+	WalletID  string
+	TxHash    wire.Hash
+	BlockHash wire.Hash
+	Height    uint64
+	// import progress only
+	SyncedHeight uint64
+	BestHeight   uint64
+}
+
+const eventBufferSize = 256
+
+// EventSubscription receives the events of one wallet, or of all wallets if
+// its wallet id is empty.
+type EventSubscription struct {
+	id       uint64
+	walletID string
+	c        chan *WalletEvent
+	hub      *eventHub
+}
+
+// Events returns the channel of events. It is closed once the subscription
+// is closed, or the subscriber falls eventBufferSize events behind.
+func (s *EventSubscription) Events() <-chan *WalletEvent {
+	return s.c
+}
+
+// Close stops the delivery of events.
+func (s *EventSubscription) Close() {
+	s.hub.unsubscribe(s.id)
+}
+
+// eventHub fans out wallet events to subscribers without ever blocking the
+// publisher.
+type eventHub struct {
+	mu     sync.Mutex
+	nextID uint64
+	subs   map[uint64]*EventSubscription
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{subs: make(map[uint64]*EventSubscription)}
+}
+
+func (hub *eventHub) subscribe(walletID string) *EventSubscription {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	hub.nextID++
+	sub := &EventSubscription{
+		id:       hub.nextID,
+		walletID: walletID,
+		c:        make(chan *WalletEvent, eventBufferSize),
+		hub:      hub,
+	}
+	hub.subs[sub.id] = sub
+	return sub
+}
+
+func (hub *eventHub) unsubscribe(id uint64) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if sub, ok := hub.subs[id]; ok {
+		delete(hub.subs, id)
+		close(sub.c)
+	}
+}
+
+func (hub *eventHub) publish(events ...*WalletEvent) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	for _, ev := range events {
+		for id, sub := range hub.subs {
+			if len(ev.WalletID) != 0 && len(sub.walletID) != 0 && ev.WalletID != sub.walletID {
+				continue
+			}
+			select {
+			case sub.c <- ev:
+			default:
+				logging.CPrint(logging.WARN, "event subscriber too slow, unsubscribed", logging.LogFormat{
+					"walletId": sub.walletID,
+				})
+				delete(hub.subs, id)
+				close(sub.c)
+			}
+		}
+	}
+}
+
+// txEvents returns an event of typ for every wallet relevant to rec.
+func txEvents(typ WalletEventType, rec *txmgr.TxRecord, blockMeta *txmgr.BlockMeta) []*WalletEvent {
+	wallets := make(map[string]struct{})
+	for _, meta := range rec.RelevantTxIn {
+		wallets[meta.WalletId] = struct{}{}
+	}
+	for _, meta := range rec.RelevantTxOut {
+		wallets[meta.WalletId] = struct{}{}
+	}
+	events := make([]*WalletEvent, 0, len(wallets))
+	for walletID := range wallets {
+		ev := &WalletEvent{
+			Type:     typ,
+			WalletID: walletID,
+			TxHash:   rec.Hash,
+		}
+		if blockMeta != nil {
+			ev.BlockHash = blockMeta.Hash
+			ev.Height = blockMeta.Height
+		}
+		events = append(events, ev)
+	}
+	return events
+}
+
+// SubscribeWalletEvents subscribes to the events of walletId, or of the
+// current wallet if walletId is empty.
+func (w *WalletManager) SubscribeWalletEvents(walletId string) (*EventSubscription, error) {
+	if len(walletId) == 0 {
+		am := w.ksmgr.CurrentKeystore()
+		if am == nil {
+			return nil, ErrNoWalletInUse
+		}
+		walletId = am.Name()
+	} else if _, err := w.ksmgr.GetAddrManagerByAccountID(walletId); err != nil {
+		return nil, err
+	}
+	return w.ntfnsHandler.events.subscribe(walletId), nil
+}
@@ -0,0 +1,61 @@
+package masswallet
+
+import (
+	"testing"
+
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/wire"
+)
+
+func TestEventHub(t *testing.T) {
+	hub := newEventHub()
+	subA := hub.subscribe("walletA")
+	subB := hub.subscribe("walletB")
+
+	rec := &txmgr.TxRecord{
+		Hash:          wire.DoubleHashH([]byte("tx")),
+		RelevantTxIn:  []*txmgr.RelevantMeta{{WalletId: "walletA"}},
+		RelevantTxOut: []*txmgr.RelevantMeta{{WalletId: "walletA"}, {WalletId: "walletC"}},
+	}
+	events := txEvents(EventMempoolTx, rec, nil)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	hub.publish(events...)
+	hub.publish(&WalletEvent{Type: EventBlockConnected, Height: 10})
+
+	ev := <-subA.Events()
+	if ev.Type != EventMempoolTx || ev.WalletID != "walletA" || ev.TxHash != rec.Hash {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+	if ev = <-subA.Events(); ev.Type != EventBlockConnected || ev.Height != 10 {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+	if ev = <-subB.Events(); ev.Type != EventBlockConnected {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+	if len(subA.Events()) != 0 || len(subB.Events()) != 0 {
+		t.Fatal("unexpected pending events")
+	}
+
+	// a slow subscriber is dropped instead of blocking the publisher
+	for i := 0; i <= eventBufferSize; i++ {
+		hub.publish(&WalletEvent{Type: EventImportProgress, WalletID: "walletB"})
+	}
+	n := 0
+	for range subB.Events() {
+		n++
+	}
+	if n != eventBufferSize {
+		t.Fatalf("expected %d events, got %d", eventBufferSize, n)
+	}
+	if len(subA.Events()) != 0 {
+		t.Fatal("unexpected events of other wallet")
+	}
+
+	subA.Close()
+	if _, ok := <-subA.Events(); ok {
+		t.Fatal("subscription not closed")
+	}
+	subB.Close()
+}
@@ -0,0 +1,64 @@
+package masswallet
+
+import (
+	"massnet.org/mass-wallet/blockchain"
+	"massnet.org/mass-wallet/logging"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/wire"
+)
+
+// EvictTransaction removes the unmined transaction txHash of the wallets from
+// the memory pool of the node and from the unmined transactions, along with
+// the transactions spending its outputs, so that its inputs may be spent
+// again. It returns the hashes of removed transactions.
+//
+// The transaction may still be mined if relayed to other nodes.
+func (w *WalletManager) EvictTransaction(txHash *wire.Hash) ([]*wire.Hash, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.ksmgr.CurrentKeystore() == nil {
+		return nil, ErrNoWalletInUse
+	}
+	if _, err := w.existsUnminedTx(txHash); err != nil {
+		logging.CPrint(logging.ERROR, "unmined transaction not found", logging.LogFormat{
+			"err": err,
+			"tx":  txHash.String(),
+		})
+		if err == txmgr.ErrNotFound {
+			return nil, ErrTxNotUnmined
+		}
+		return nil, err
+	}
+
+	evicted, err := w.server.TxMemPool().EvictTransaction(txHash)
+	if err != nil && err != blockchain.ErrTxExsit {
+		return nil, err
+	}
+
+	var removed []wire.Hash
+	err = mwdb.Update(w.db, func(tx mwdb.DBTransaction) (err error) {
+		removed, err = w.txStore.RemoveUnminedTx(tx, txHash)
+		return err
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to remove unmined transaction", logging.LogFormat{
+			"err": err,
+			"tx":  txHash.String(),
+		})
+		return nil, err
+	}
+
+	seen := make(map[wire.Hash]struct{}, len(evicted))
+	for _, hash := range evicted {
+		seen[*hash] = struct{}{}
+	}
+	for i := range removed {
+		if _, ok := seen[removed[i]]; !ok {
+			evicted = append(evicted, &removed[i])
+		}
+	}
+	w.ntfnsHandler.RemoveMempoolTx(evicted)
+	return evicted, nil
+}
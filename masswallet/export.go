@@ -0,0 +1,274 @@
+package masswallet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"massnet.org/mass-wallet/blockchain"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/masswallet/utils"
+	"massnet.org/mass-wallet/wire"
+)
+
+// categories of exported transactions
+const (
+	ExportSend            = "send"
+	ExportReceive         = "receive"
+	ExportStakingDeposit  = "staking_deposit"
+	ExportStakingWithdraw = "staking_withdraw"
+	ExportBinding         = "binding"
+	ExportBindingWithdraw = "binding_withdraw"
+)
+
+// counterparty of coinbase transactions
+const exportCoinbase = "COINBASE"
+
+// TxExportRecord is a mined transaction of the wallet, as reported to
+// accounting.
+//
+// Amount excludes the fee. It is negative for ExportSend, the value deposited
+// or withdrawn for staking and binding transactions, and positive otherwise.
+// Counterparties are the external recipients of ExportSend, the senders of
+// ExportReceive and the poc addresses of ExportBinding.
+type TxExportRecord struct {
+	Time           time.Time
+	TxHash         wire.Hash
+	Category       string
+	Amount         int64
+	Fee            massutil.Amount
+	Counterparties []string
+	BlockHeight    uint64
+}
+
+var txExportCSVHeader = []string{"date", "txid", "category", "amount", "fee", "counterparties", "block_height"}
+
+// ExportTxHistory returns every mined transaction of current wallet with block
+// time between since and until, oldest first. A zero since or until leaves
+// the range open.
+func (w *WalletManager) ExportTxHistory(since, until time.Time) ([]*TxExportRecord, error) {
+	am := w.ksmgr.CurrentKeystore()
+	if am == nil {
+		return nil, ErrNoWalletInUse
+	}
+
+	var (
+		histories []*txmgr.TxHistory
+		msgTxs    []*wire.MsgTx
+		staking   []*txmgr.StakingHistoryDetail
+		binding   []*txmgr.BindingHistoryDetail
+	)
+	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) (err error) {
+		histories, _, err = w.txStore.ListTxHistory(tx, am.Name(), &txmgr.TxHistoryFilter{
+			Since:     since,
+			Until:     until,
+			Ascending: true,
+		})
+		if err != nil {
+			return err
+		}
+		msgTxs = make([]*wire.MsgTx, len(histories))
+		for i, h := range histories {
+			if msgTxs[i], err = w.txStore.FetchMinedTx(tx, &h.TxHash, &h.Block); err != nil {
+				return err
+			}
+		}
+		if staking, err = w.utxoStore.GetStakingHistoryDetail(tx, am, false); err != nil {
+			return err
+		}
+		binding, err = w.utxoStore.GetBindingHistoryDetail(tx, am, false)
+		return err
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to load tx history", logging.LogFormat{
+			"err":      err,
+			"walletId": am.Name(),
+		})
+		return nil, err
+	}
+
+	stakingOuts := make(map[wire.OutPoint]massutil.Amount, len(staking))
+	for _, det := range staking {
+		stakingOuts[wire.OutPoint{Hash: det.TxHash, Index: det.Index}] = det.Utxo.Amount
+	}
+	bindingOuts := make(map[wire.OutPoint]*txmgr.BindingUtxo, len(binding))
+	for _, det := range binding {
+		bindingOuts[wire.OutPoint{Hash: det.TxHash, Index: det.Index}] = &det.Utxo
+	}
+
+	records := make([]*TxExportRecord, 0, len(histories))
+	for i, h := range histories {
+		rec, err := w.newTxExportRecord(am.Name(), h, msgTxs[i], stakingOuts, bindingOuts)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to export tx", logging.LogFormat{
+				"err":      err,
+				"tx":       h.TxHash.String(),
+				"walletId": am.Name(),
+			})
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+func (w *WalletManager) newTxExportRecord(walletId string, h *txmgr.TxHistory, msgTx *wire.MsgTx,
+	stakingOuts map[wire.OutPoint]massutil.Amount, bindingOuts map[wire.OutPoint]*txmgr.BindingUtxo) (*TxExportRecord, error) {
+	rec := &TxExportRecord{
+		Time:           h.Block.Timestamp,
+		TxHash:         h.TxHash,
+		Fee:            h.Fee,
+		Counterparties: make([]string, 0),
+		BlockHeight:    h.Block.Height,
+	}
+
+	var stakingDeposit, stakingWithdraw, bindingDeposit, bindingWithdraw int64
+	pocAddresses := make([]string, 0)
+	for i := range msgTx.TxOut {
+		op := wire.OutPoint{Hash: h.TxHash, Index: uint32(i)}
+		if amt, ok := stakingOuts[op]; ok {
+			stakingDeposit += amt.IntValue()
+		}
+		if utxo, ok := bindingOuts[op]; ok {
+			bindingDeposit += utxo.Amount.IntValue()
+			pocAddresses = append(pocAddresses, utxo.BindingAddress)
+		}
+	}
+	for _, txIn := range msgTx.TxIn {
+		if amt, ok := stakingOuts[txIn.PreviousOutPoint]; ok {
+			stakingWithdraw += amt.IntValue()
+		}
+		if utxo, ok := bindingOuts[txIn.PreviousOutPoint]; ok {
+			bindingWithdraw += utxo.Amount.IntValue()
+		}
+	}
+
+	switch {
+	case stakingDeposit > 0:
+		rec.Category, rec.Amount = ExportStakingDeposit, stakingDeposit
+	case stakingWithdraw > 0:
+		rec.Category, rec.Amount = ExportStakingWithdraw, stakingWithdraw
+	case bindingDeposit > 0:
+		rec.Category, rec.Amount = ExportBinding, bindingDeposit
+		rec.Counterparties = pocAddresses
+	case bindingWithdraw > 0:
+		rec.Category, rec.Amount = ExportBindingWithdraw, bindingWithdraw
+	case h.Sent.IsZero():
+		rec.Category, rec.Amount = ExportReceive, h.NetAmount()
+		senders, err := w.txSenders(msgTx, h.Block.Height)
+		if err != nil {
+			return nil, err
+		}
+		rec.Counterparties = senders
+	default:
+		rec.Category, rec.Amount = ExportSend, h.NetAmount()+h.Fee.IntValue()
+		recipients, err := w.txExternalRecipients(walletId, msgTx)
+		if err != nil {
+			return nil, err
+		}
+		rec.Counterparties = recipients
+	}
+	return rec, nil
+}
+
+// txSenders returns the addresses of the outputs spent by msgTx.
+func (w *WalletManager) txSenders(msgTx *wire.MsgTx, height uint64) ([]string, error) {
+	if blockchain.IsCoinBaseTx(msgTx) {
+		return []string{exportCoinbase}, nil
+	}
+	ret := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, txIn := range msgTx.TxIn {
+		prevTx, err := w.chainFetcher.FetchLastTxUntilHeight(&txIn.PreviousOutPoint.Hash, height)
+		if err != nil {
+			return nil, err
+		}
+		if prevTx == nil || int(txIn.PreviousOutPoint.Index) >= len(prevTx.TxOut) {
+			return nil, fmt.Errorf("transaction %s not found", txIn.PreviousOutPoint.Hash.String())
+		}
+		ps, err := utils.ParsePkScript(prevTx.TxOut[txIn.PreviousOutPoint.Index].PkScript, w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		addr := ps.StdEncodeAddress()
+		if _, ok := seen[addr]; !ok {
+			seen[addr] = struct{}{}
+			ret = append(ret, addr)
+		}
+	}
+	return ret, nil
+}
+
+// txExternalRecipients returns the addresses of the outputs of msgTx not paid
+// to walletId.
+func (w *WalletManager) txExternalRecipients(walletId string, msgTx *wire.MsgTx) ([]string, error) {
+	ret := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, txOut := range msgTx.TxOut {
+		ps, err := utils.ParsePkScript(txOut.PkScript, w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		ma, err := w.ksmgr.GetManagedAddressByScriptHash(ps.StdScriptAddress())
+		if err == nil && ma.Account() == walletId {
+			continue
+		}
+		if err != nil && err != keystore.ErrScriptHashNotFound {
+			return nil, err
+		}
+		addr := ps.StdEncodeAddress()
+		if _, ok := seen[addr]; !ok {
+			seen[addr] = struct{}{}
+			ret = append(ret, addr)
+		}
+	}
+	return ret, nil
+}
+
+// WriteTxExportCSV writes records as CSV with a header line. Dates are in
+// RFC3339 UTC, amounts in MASS and counterparties separated by ';'.
+func WriteTxExportCSV(wr io.Writer, records []*TxExportRecord) error {
+	cw := csv.NewWriter(wr)
+	if err := cw.Write(txExportCSVHeader); err != nil {
+		return err
+	}
+	for _, rec := range records {
+		amount, err := signedAmountToString(rec.Amount)
+		if err != nil {
+			return err
+		}
+		fee, err := AmountToString(rec.Fee.IntValue())
+		if err != nil {
+			return err
+		}
+		err = cw.Write([]string{
+			rec.Time.UTC().Format(time.RFC3339),
+			rec.TxHash.String(),
+			rec.Category,
+			amount,
+			fee,
+			strings.Join(rec.Counterparties, ";"),
+			strconv.FormatUint(rec.BlockHeight, 10),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func signedAmountToString(m int64) (string, error) {
+	if m < 0 {
+		s, err := AmountToString(-m)
+		return "-" + s, err
+	}
+	return AmountToString(m)
+}
@@ -0,0 +1,257 @@
+package masswallet
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/consensus"
+	"massnet.org/mass-wallet/database"
+	"massnet.org/mass-wallet/massutil"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/masswallet/utils"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+func TestWalletManager_newTxExportRecord(t *testing.T) {
+	chainDb, close, err := newTestChainDB(2)
+	if err != nil {
+		t.Fatal("newTestChainDB error:", err)
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testExportRecord")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{chainDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	walletId, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = w.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+
+	payTo := func(addr massutil.Address) []byte {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pkScript
+	}
+	walletAddr := func() massutil.Address {
+		encoded, err := w.NewAddress(0)
+		if err != nil {
+			t.Fatal("new addr error", err.Error())
+		}
+		addr, err := massutil.DecodeAddress(encoded, w.chainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr
+	}
+	recvAddr, changeAddr := walletAddr(), walletAddr()
+	external, err := massutil.NewAddressWitnessScriptHash(wire.DoubleHashB([]byte("external")), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stakingAddr, err := massutil.NewAddressStakingScriptHash(recvAddr.ScriptAddress(), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stakingScript, err := txscript.PayToStakingAddrScript(stakingAddr, consensus.MinFrozenPeriod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindingScript, err := txscript.PayToBindingScriptHashScript(recvAddr.ScriptAddress(), make([]byte, 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindingPs, err := utils.ParsePkScript(bindingScript, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first output of the coinbase of block 1 pays an external sender
+	funding := blks200[1].MsgBlock().Transactions[0]
+	senderPs, err := utils.ParsePkScript(funding.TxOut[0].PkScript, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	coinbase := wire.NewMsgTx()
+	coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: math.MaxUint32}, nil))
+	coinbase.AddTxOut(wire.NewTxOut(10e8, payTo(recvAddr)))
+
+	receive := wire.NewMsgTx()
+	receive.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: funding.TxHash(), Index: 0}, nil))
+	receive.AddTxOut(wire.NewTxOut(5e8, payTo(recvAddr)))
+
+	send := wire.NewMsgTx()
+	send.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: coinbase.TxHash(), Index: 0}, nil))
+	send.AddTxOut(wire.NewTxOut(3e8, payTo(external)))
+	send.AddTxOut(wire.NewTxOut(6.9e8, payTo(changeAddr)))
+
+	staking := wire.NewMsgTx()
+	staking.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: receive.TxHash(), Index: 0}, nil))
+	staking.AddTxOut(wire.NewTxOut(4e8, stakingScript))
+	staking.AddTxOut(wire.NewTxOut(0.9e8, payTo(changeAddr)))
+
+	binding := wire.NewMsgTx()
+	binding.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: send.TxHash(), Index: 1}, nil))
+	binding.AddTxOut(wire.NewTxOut(2e8, bindingScript))
+	binding.AddTxOut(wire.NewTxOut(4.8e8, payTo(changeAddr)))
+
+	msgTxs := []*wire.MsgTx{coinbase, receive, send, staking, binding}
+	amount := func(i int64) massutil.Amount {
+		amt, err := massutil.NewAmountFromInt(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return amt
+	}
+	stakingOuts := map[wire.OutPoint]massutil.Amount{
+		{Hash: staking.TxHash(), Index: 0}: amount(4e8),
+	}
+	bindingOuts := map[wire.OutPoint]*txmgr.BindingUtxo{
+		{Hash: binding.TxHash(), Index: 0}: {
+			Amount:         amount(2e8),
+			HolderAddress:  bindingPs.StdEncodeAddress(),
+			BindingAddress: bindingPs.SecondEncodeAddress(),
+		},
+	}
+
+	// mine one transaction per block, as the wallet would relate them
+	prevScripts := make(map[wire.OutPoint][]byte)
+	allBalances := map[string]massutil.Amount{walletId: massutil.ZeroAmount()}
+	for i, msgTx := range msgTxs {
+		rec, err := txmgr.NewTxRecordFromMsgTx(msgTx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec.TxLoc = &wire.TxLoc{}
+		for j, txIn := range msgTx.TxIn {
+			pkScript, ok := prevScripts[txIn.PreviousOutPoint]
+			if !ok {
+				continue
+			}
+			ps, err := utils.ParsePkScript(pkScript, w.chainParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rec.RelevantTxIn = append(rec.RelevantTxIn, &txmgr.RelevantMeta{Index: j, PkScript: ps, WalletId: walletId})
+		}
+		for j, txOut := range msgTx.TxOut {
+			ps, err := utils.ParsePkScript(txOut.PkScript, w.chainParams)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = w.ksmgr.GetManagedAddressByScriptHash(ps.StdScriptAddress()); err != nil {
+				continue
+			}
+			prevScripts[wire.OutPoint{Hash: rec.Hash, Index: uint32(j)}] = txOut.PkScript
+			rec.HasBindingOut = rec.HasBindingOut || ps.IsBinding()
+			rec.RelevantTxOut = append(rec.RelevantTxOut, &txmgr.RelevantMeta{Index: j, PkScript: ps, WalletId: walletId})
+		}
+		height := uint64(100 + i)
+		block := &txmgr.BlockMeta{
+			Height:    height,
+			Hash:      wire.DoubleHashH([]byte{byte(height)}),
+			Timestamp: time.Unix(int64(1582099523+height), 0),
+			Loc:       &database.BlockLoc{},
+		}
+		err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+			return w.txStore.AddRelevantTx(tx, allBalances, rec, block)
+		})
+		if err != nil {
+			t.Fatal("add relevantTx error", err.Error())
+		}
+	}
+
+	var histories []*txmgr.TxHistory
+	err = mwdb.View(walletDb, func(tx mwdb.ReadTransaction) (err error) {
+		histories, _, err = w.txStore.ListTxHistory(tx, walletId, &txmgr.TxHistoryFilter{Ascending: true})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(histories) != len(msgTxs) {
+		t.Fatalf("expected %d histories, got %d", len(msgTxs), len(histories))
+	}
+
+	tests := []struct {
+		name           string
+		category       string
+		amount         int64
+		fee            int64
+		counterparties []string
+	}{
+		{"coinbase", ExportReceive, 10e8, 0, []string{exportCoinbase}},
+		{"receive", ExportReceive, 5e8, 0, []string{senderPs.StdEncodeAddress()}},
+		{"send", ExportSend, -3e8, 0.1e8, []string{external.EncodeAddress()}},
+		{"staking", ExportStakingDeposit, 4e8, 0.1e8, []string{}},
+		{"binding", ExportBinding, 2e8, 0.1e8, []string{bindingPs.SecondEncodeAddress()}},
+	}
+	for i, test := range tests {
+		h := histories[i]
+		if !assert.Equal(t, msgTxs[i].TxHash(), h.TxHash, test.name) {
+			continue
+		}
+		rec, err := w.newTxExportRecord(walletId, h, msgTxs[i], stakingOuts, bindingOuts)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		assert.Equal(t, test.category, rec.Category, test.name)
+		assert.Equal(t, test.amount, rec.Amount, test.name)
+		assert.Equal(t, test.fee, rec.Fee.IntValue(), test.name)
+		assert.Equal(t, test.counterparties, rec.Counterparties, test.name)
+		assert.Equal(t, uint64(100+i), rec.BlockHeight, test.name)
+	}
+}
+
+func TestWriteTxExportCSV(t *testing.T) {
+	fee, err := massutil.NewAmountFromInt(10000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := []*TxExportRecord{
+		{
+			Time:           time.Unix(1582099523, 0),
+			TxHash:         wire.DoubleHashH([]byte("receive")),
+			Category:       ExportReceive,
+			Amount:         150000000,
+			Fee:            massutil.ZeroAmount(),
+			Counterparties: []string{exportCoinbase},
+			BlockHeight:    100,
+		},
+		{
+			Time:           time.Unix(1582099623, 0),
+			TxHash:         wire.DoubleHashH([]byte("send")),
+			Category:       ExportSend,
+			Amount:         -100000001,
+			Fee:            fee,
+			Counterparties: []string{"a", "b"},
+			BlockHeight:    101,
+		},
+	}
+	var buf bytes.Buffer
+	if err = WriteTxExportCSV(&buf, records); err != nil {
+		t.Fatal(err)
+	}
+	expect := "date,txid,category,amount,fee,counterparties,block_height\n" +
+		"2020-02-19T08:05:23Z," + records[0].TxHash.String() + ",receive,1.5,0,COINBASE,100\n" +
+		"2020-02-19T08:07:03Z," + records[1].TxHash.String() + ",send,-1.00000001,0.0001,a;b,101\n"
+	if buf.String() != expect {
+		t.Fatalf("unexpected csv:\n%s", buf.String())
+	}
+}
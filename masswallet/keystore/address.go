@@ -16,12 +16,18 @@ type ManagedAddress struct {
 	address        string
 	stakingAddress string
 	keystoreName   string
+
+	// multisig only, sorted public keys of the witness script
+	multisigPubKeys []*btcec.PublicKey
+	nRequired       int
 }
 
 func newManagedAddressWithoutPrivKey(keystoreName string, derivationPath DerivationPath,
-	pubKey *btcec.PublicKey, nRequired int, addressClass uint16, net *config.Params) (*ManagedAddress, error) {
+	pubKey *btcec.PublicKey, cosignerKeys []*btcec.PublicKey, nRequired int, addressClass uint16, net *config.Params) (*ManagedAddress, error) {
 	var pubkeys []*btcec.PublicKey
 	pubkeys = append(pubkeys, pubKey)
+	pubkeys = append(pubkeys, cosignerKeys...)
+	sortPubKeys(pubkeys)
 	_, witAddress, err := newWitnessScriptAddressForBtcec(pubkeys, nRequired, addressClass, net)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "newWitnessScriptAddressForBtcec error",
@@ -30,15 +36,16 @@ func newManagedAddressWithoutPrivKey(keystoreName string, derivationPath Derivat
 			})
 		return nil, err
 	}
+	var mAddr *ManagedAddress
 	switch addressClass {
 	case massutil.AddressClassWitnessV0:
-		return &ManagedAddress{
+		mAddr = &ManagedAddress{
 			address:        witAddress.EncodeAddress(),
 			derivationPath: derivationPath,
 			pubKey:         pubKey,
 			keystoreName:   keystoreName,
 			scriptHash:     witAddress.ScriptAddress(),
-		}, nil
+		}
 	case massutil.AddressClassWitnessStaking:
 		witV0Addr, err := massutil.NewAddressWitnessScriptHash(witAddress.ScriptAddress(), net)
 		if err != nil {
@@ -48,23 +55,28 @@ func newManagedAddressWithoutPrivKey(keystoreName string, derivationPath Derivat
 				})
 			return nil, err
 		}
-		return &ManagedAddress{
+		mAddr = &ManagedAddress{
 			address:        witV0Addr.EncodeAddress(),
 			stakingAddress: witAddress.EncodeAddress(),
 			derivationPath: derivationPath,
 			pubKey:         pubKey,
 			keystoreName:   keystoreName,
 			scriptHash:     witV0Addr.ScriptAddress(),
-		}, nil
+		}
 	default:
 		return nil, ErrAddressVersion
 	}
-
+	if len(cosignerKeys) > 0 {
+		mAddr.multisigPubKeys = pubkeys
+		mAddr.nRequired = nRequired
+	}
+	return mAddr, nil
 }
 
-func newManagedAddress(keystoreName string, derivationPath DerivationPath, privKey *btcec.PrivateKey, nRquired int, addressClass uint16, net *config.Params) (*ManagedAddress, error) {
+func newManagedAddress(keystoreName string, derivationPath DerivationPath, privKey *btcec.PrivateKey,
+	cosignerKeys []*btcec.PublicKey, nRquired int, addressClass uint16, net *config.Params) (*ManagedAddress, error) {
 	ecPubKey := (*btcec.PublicKey)(&privKey.PublicKey)
-	managedAddress, err := newManagedAddressWithoutPrivKey(keystoreName, derivationPath, ecPubKey, nRquired, addressClass, net)
+	managedAddress, err := newManagedAddressWithoutPrivKey(keystoreName, derivationPath, ecPubKey, cosignerKeys, nRquired, addressClass, net)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "create address failed", logging.LogFormat{"error": err})
 		return nil, err
@@ -73,8 +85,21 @@ func newManagedAddress(keystoreName string, derivationPath DerivationPath, privK
 	return managedAddress, nil
 }
 
+// newManagedAddressFromExtKey creates the managed address of extKey. For a
+// multisig keystore, the keys of the cosigners at the same derivation path
+// are added to the witness script and nRequired is replaced by the policy.
 func newManagedAddressFromExtKey(keystoreName string, derivationPath DerivationPath,
-	extKey *hdkeychain.ExtendedKey, nRequired int, addressClass uint16, net *config.Params) (*ManagedAddress, error) {
+	extKey *hdkeychain.ExtendedKey, multisig *multisigInfo, nRequired int, addressClass uint16, net *config.Params) (*ManagedAddress, error) {
+	var cosignerKeys []*btcec.PublicKey
+	if multisig != nil {
+		var err error
+		cosignerKeys, err = multisig.cosignerPubKeys(derivationPath.Branch, derivationPath.Index)
+		if err != nil {
+			return nil, err
+		}
+		nRequired = multisig.nRequired
+	}
+
 	// create a new managed address based on the public or private key
 	// depending on whether the generated key is private.
 	var managedAddr *ManagedAddress
@@ -87,7 +112,7 @@ func newManagedAddressFromExtKey(keystoreName string, derivationPath DerivationP
 		// Ensure the temp private key big integer is cleared after
 		// use.
 		managedAddr, err = newManagedAddress(
-			keystoreName, derivationPath, privKey, nRequired, addressClass, net,
+			keystoreName, derivationPath, privKey, cosignerKeys, nRequired, addressClass, net,
 		)
 		if err != nil {
 			return nil, err
@@ -99,7 +124,7 @@ func newManagedAddressFromExtKey(keystoreName string, derivationPath DerivationP
 		}
 
 		managedAddr, err = newManagedAddressWithoutPrivKey(
-			keystoreName, derivationPath, pubKey, nRequired, addressClass, net,
+			keystoreName, derivationPath, pubKey, cosignerKeys, nRequired, addressClass, net,
 		)
 		if err != nil {
 			return nil, err
@@ -134,11 +159,28 @@ func (mAddr *ManagedAddress) PubKey() *btcec.PublicKey {
 	return mAddr.pubKey
 }
 
+func (mAddr *ManagedAddress) DerivationPath() DerivationPath {
+	return mAddr.derivationPath
+}
+
 func (mAddr *ManagedAddress) PrivKey() *btcec.PrivateKey {
 	return mAddr.privKey
 }
 
+// IsMultisig returns whether the address is an m-of-n multisig address of a
+// multisig keystore.
+func (mAddr *ManagedAddress) IsMultisig() bool {
+	return len(mAddr.multisigPubKeys) > 0
+}
+
 func (mAddr *ManagedAddress) RedeemScript(chainParams *config.Params) ([]byte, error) {
+	if mAddr.IsMultisig() {
+		script, _, err := NewNonPersistentWitSAddrForBtcec(mAddr.multisigPubKeys, mAddr.nRequired, massutil.AddressClassWitnessV0, chainParams)
+		if err != nil {
+			return nil, err
+		}
+		return script, nil
+	}
 	var pubkeys []*btcec.PublicKey
 	pubkeys = append(pubkeys, mAddr.PubKey())
 	script, _, err := NewNonPersistentWitSAddrForBtcec(pubkeys, nRequiredDefault, massutil.AddressClassWitnessV0, chainParams)
@@ -37,8 +37,15 @@ type AddrManager struct {
 
 	hdScope KeyScope
 
+	// multisig is nil unless this is a multisig keystore
+	multisig *multisigInfo
+
 	storage db.BucketMeta
 
+	// watchOnly is set if the keystore is imported from an account xpub,
+	// and holds no private key material
+	watchOnly bool
+
 	unlocked bool
 
 	// masterKeyPub is the secret key used to secure the cryptoKeyPub key
@@ -77,9 +84,15 @@ type AddrManager struct {
 }
 
 type Keystore struct {
-	Remarks string     `json:"remarks"`
-	Crypto  cryptoJSON `json:"crypto"`
-	HDpath  hdPath     `json:"hdPath"`
+	Remarks  string        `json:"remarks"`
+	Crypto   cryptoJSON    `json:"crypto"`
+	HDpath   hdPath        `json:"hdPath"`
+	Multisig *multisigJSON `json:"multisig,omitempty"`
+}
+
+type multisigJSON struct {
+	RequiredSigs  int      `json:"requiredSigs"`
+	CosignerXpubs []string `json:"cosignerXpubs"`
 }
 
 type hdPath struct {
@@ -150,6 +163,9 @@ func getKeystoreFromJson(keysJson []byte) (*Keystore, error) {
 
 // NOTE: this func will leave the masterKeyPriv derived
 func (a *AddrManager) checkPassword(passphrase []byte) error {
+	if a.watchOnly {
+		return ErrWatchOnly
+	}
 	if a.unlocked {
 		saltedPassphrase := append(a.privPassphraseSalt[:],
 			passphrase...)
@@ -271,6 +287,13 @@ func (a *AddrManager) exportKeystore(dbTransaction db.ReadTransaction, passphras
 			})
 		return nil, err
 	}
+	if a.multisig != nil {
+		params := a.multisig.params()
+		keystore.Multisig = &multisigJSON{
+			RequiredSigs:  params.RequiredSigs,
+			CosignerXpubs: params.CosignerXpubs,
+		}
+	}
 
 	return keystore, nil
 }
@@ -325,6 +348,10 @@ func (a *AddrManager) updatePrivKeys(pass []byte) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
+	if a.watchOnly {
+		return ErrWatchOnly
+	}
+
 	cryptoKeyPrivDec, err := a.masterKeyPriv.Decrypt(a.cryptoKeyPrivEncrypted)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to decrypt",
@@ -522,7 +549,7 @@ func (a *AddrManager) nextAddresses(dbTransaction db.DBTransaction, checkfunc fu
 		// create a new managed address based on the private key.
 		// Also, zero the next key after creating the managed address
 		// from it.
-		managedAddr, err := newManagedAddressFromExtKey(a.keystoreName, derivationPath, nextKey, nRequired, addressClass, net)
+		managedAddr, err := newManagedAddressFromExtKey(a.keystoreName, derivationPath, nextKey, a.multisig, nRequired, addressClass, net)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "new managedAddress failed",
 				logging.LogFormat{
@@ -600,6 +627,9 @@ func (a *AddrManager) getPrivKeyBtcec(addr string, password []byte) (*btcec.Priv
 	}
 	internal := mAddr.derivationPath.Branch == InternalBranch
 
+	if a.watchOnly {
+		return nil, ErrWatchOnly
+	}
 	if mAddr.privKey != nil {
 		return mAddr.privKey, nil
 	}
@@ -674,10 +704,12 @@ func (a *AddrManager) signBtcec(hash []byte, addr string, password []byte) (sign
 		return nil, ErrInvalidDataHash
 	}
 
-	// check private passphrase
-	err = a.checkPassword(password)
-	if err != nil {
-		return nil, err
+	// check private passphrase, empty passphrase is only allowed when unlocked
+	if len(password) != 0 || !a.unlocked {
+		err = a.checkPassword(password)
+		if err != nil {
+			return nil, err
+		}
 	}
 	// update cache, mark unlocked
 	if !a.unlocked {
@@ -709,6 +741,37 @@ func (a *AddrManager) signBtcec(hash []byte, addr string, password []byte) (sign
 	return signed, nil
 }
 
+// unlock derives private keys of all managed addresses and keeps them in
+// memory until clearPrivKeys is called.
+func (a *AddrManager) unlock(password []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	err := a.checkPassword(password)
+	if err != nil {
+		return err
+	}
+	if !a.unlocked {
+		saltPassphrase := append(a.privPassphraseSalt[:], password...)
+		a.hashedPrivPassphrase = sha512.Sum512(saltPassphrase)
+		zero.Bytes(saltPassphrase)
+		a.unlocked = true
+	}
+
+	for addr := range a.addrs {
+		_, err = a.getPrivKeyBtcec(addr, password)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "get privKey failed",
+				logging.LogFormat{
+					"address": addr,
+					"err":     err,
+				})
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *AddrManager) changeRemark(dbTransaction db.DBTransaction, newRemark string) error {
 	amBucket := dbTransaction.FetchBucket(a.storage)
 	if amBucket == nil {
@@ -780,6 +843,9 @@ func (a *AddrManager) getMnemonic(dbTransaction db.ReadTransaction, privpass []b
 func (a *AddrManager) changePrivPassphrase(dbTransaction db.ReadTransaction, oldPrivPass, newPrivPass []byte, scryptConfig *ScryptOptions) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
+	if a.watchOnly {
+		return ErrWatchOnly
+	}
 	if a.unlocked {
 		return ErrBadTimingForChangingPass
 	}
@@ -876,6 +942,27 @@ func (a *AddrManager) KeyScope() KeyScope {
 	return a.hdScope
 }
 
+// AccountXpub returns the extended public key of the account, which is what
+// cosigners need to set up a multisig keystore together.
+func (a *AddrManager) AccountXpub() string {
+	return a.acctInfo.acctKeyPub.String()
+}
+
+// IsWatchOnly returns whether the keystore is imported from an account xpub
+// and therefore cannot sign.
+func (a *AddrManager) IsWatchOnly() bool {
+	return a.watchOnly
+}
+
+// Multisig returns the multisig policy of the keystore, or nil if it is a
+// single-key keystore.
+func (a *AddrManager) Multisig() *MultisigParams {
+	if a.multisig == nil {
+		return nil
+	}
+	return a.multisig.params()
+}
+
 func (a *AddrManager) CountAddresses() (external int, internal int) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -909,6 +996,21 @@ func (a *AddrManager) ManagedAddresses() []*ManagedAddress {
 	return mas
 }
 
+// addressOfPubKey returns the address whose own key is pubKey. Single-key
+// addresses are looked up by their encoded address, multisig ones by key.
+func (a *AddrManager) addressOfPubKey(pubKey *btcec.PublicKey, encoded string) (string, bool) {
+	if a.multisig == nil {
+		_, ok := a.addrs[encoded]
+		return encoded, ok
+	}
+	for addr, mAddr := range a.addrs {
+		if mAddr.pubKey.IsEqual(pubKey) {
+			return addr, true
+		}
+	}
+	return "", false
+}
+
 func (a *AddrManager) Address(addr string) (*ManagedAddress, error) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
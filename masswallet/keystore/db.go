@@ -44,6 +44,8 @@ var (
 	internalBranchPubKeyName = []byte("inbPubKey")
 	externalChildNumName     = []byte("exChildNum")
 	internalChildNumName     = []byte("inChildNum")
+	// multisig
+	multisigName = []byte("multisig")
 )
 
 // accountType represents a type of address stored in the database.
@@ -593,3 +595,66 @@ func fetchEncryptedPubKey(b db.Bucket) ([]*pubkeyAndPath, error) {
 	}
 	return pks, nil
 }
+
+// putMultisigInfo stores the number of required signatures and the encrypted
+// account xpubs of the cosigners.
+func putMultisigInfo(b db.Bucket, nRequired uint32, encryptedXpubs [][]byte) error {
+	// The serialized multisig format is:
+	//   <nrequired><count>[<xpublen><encxpub>...]
+	//
+	// 4 bytes required signatures + 4 bytes count + for each cosigner
+	// 4 bytes encrypted xpub len + encrypted xpub
+	size := 8
+	for _, xpub := range encryptedXpubs {
+		size += 4 + len(xpub)
+	}
+	buf := make([]byte, size)
+	binary.LittleEndian.PutUint32(buf[0:4], nRequired)
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(encryptedXpubs)))
+	offset := 8
+	for _, xpub := range encryptedXpubs {
+		binary.LittleEndian.PutUint32(buf[offset:offset+4], uint32(len(xpub)))
+		offset += 4
+		copy(buf[offset:offset+len(xpub)], xpub)
+		offset += len(xpub)
+	}
+	err := b.Put(multisigName, buf)
+	if err != nil {
+		return fmt.Errorf("failed to store multisig info: %v", err)
+	}
+	return nil
+}
+
+// fetchMultisigInfo returns nil encrypted xpubs if the account is not a
+// multisig one.
+func fetchMultisigInfo(b db.Bucket) (uint32, [][]byte, error) {
+	val, err := b.Get(multisigName)
+	if err != nil {
+		return 0, nil, err
+	}
+	if val == nil {
+		return 0, nil, nil
+	}
+	if len(val) < 8 {
+		return 0, nil, errors.New("malformed serialized multisig info")
+	}
+	nRequired := binary.LittleEndian.Uint32(val[0:4])
+	count := binary.LittleEndian.Uint32(val[4:8])
+	encryptedXpubs := make([][]byte, 0, count)
+	offset := uint32(8)
+	for i := uint32(0); i < count; i++ {
+		if uint32(len(val)) < offset+4 {
+			return 0, nil, errors.New("malformed serialized multisig info")
+		}
+		xpubLen := binary.LittleEndian.Uint32(val[offset : offset+4])
+		offset += 4
+		if uint32(len(val)) < offset+xpubLen {
+			return 0, nil, errors.New("malformed serialized multisig info")
+		}
+		xpub := make([]byte, xpubLen)
+		copy(xpub, val[offset:offset+xpubLen])
+		offset += xpubLen
+		encryptedXpubs = append(encryptedXpubs, xpub)
+	}
+	return nRequired, encryptedXpubs, nil
+}
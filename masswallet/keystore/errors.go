@@ -19,6 +19,7 @@ var (
 	ErrExceedAllowedNumberPerAccount = errors.New("exceed the maximum allowed number of addresses per account")
 
 	ErrBadTimingForChangingPass = errors.New("not allowed to change private passphrase when unlocked")
+	ErrWalletLocked             = errors.New("wallet is locked, passphrase required")
 	ErrChangePassNotAllowed     = errors.New("not allowed to change private passphrase")
 	ErrAddressNotFound          = errors.New("address not found")
 	ErrAccountNotFound          = errors.New("account not found")
@@ -44,4 +45,13 @@ var (
 
 	ErrUnexpectedPubKeyToSign = errors.New("unexpected pubkey to sign")
 	ErrBuildWitnessScript     = errors.New("failed to build witness script/address")
+
+	ErrInvalidCosignerNumber = errors.New("invalid number of cosigners")
+	ErrInvalidRequiredSigs   = errors.New("invalid number of required signatures")
+	ErrInvalidCosignerXpub   = errors.New("invalid cosigner extended public key")
+	ErrDuplicateCosigner     = errors.New("duplicate cosigner extended public key")
+
+	ErrInvalidAccountXpub = errors.New("invalid account extended public key")
+	ErrWatchOnly          = errors.New("watch-only wallet has no private keys")
+	ErrInvalidWIF         = errors.New("invalid WIF private key")
 )
@@ -179,6 +179,13 @@ func (k *ExtendedKey) Depth() uint8 {
 	return k.depth
 }
 
+// ChildIndex returns the index at which the child extended key was derived.
+//
+// Extended keys with depth 0 (the master key) will have a child index of 0.
+func (k *ExtendedKey) ChildIndex() uint32 {
+	return k.childNum
+}
+
 // ParentFingerprint returns a fingerprint of the parent extended key from which
 // this one was derived.
 func (k *ExtendedKey) ParentFingerprint() uint32 {
@@ -2,6 +2,7 @@ package keystore
 
 import (
 	"sync"
+	"time"
 
 	"crypto/rand"
 	"fmt"
@@ -72,7 +73,15 @@ const (
 
 type AddrUse uint8
 
+// KeystoreManager serves the current keystore, or the keystore it is scoped
+// to by ForKeystore. Views returned by ForKeystore share the keystores with
+// the manager they are derived from.
 type KeystoreManager struct {
+	*keystores
+	scope string
+}
+
+type keystores struct {
 	mu               sync.Mutex
 	managedKeystores map[string]*AddrManager
 	params           *config.Params
@@ -80,12 +89,20 @@ type KeystoreManager struct {
 	accountIDMeta    db.BucketMeta
 	pubPassphrase    []byte
 	currentKeystore  *currentKeystore
+	unlockSessions   map[string]*unlockSession // by account name
 }
 
 type currentKeystore struct {
 	accountName string
 }
 
+// unlockSession records a keystore unlocked by Unlock, whose private keys are
+// kept in memory until the timer fires.
+type unlockSession struct {
+	accountName string
+	timer       *time.Timer
+}
+
 // ScryptOptions is used to hold the scrypt parameters needed when deriving new
 // passphrase keys.
 type ScryptOptions struct {
@@ -171,16 +188,11 @@ func generateSeed(bitSize int, privpass []byte) ([]byte, string, []byte, error)
 // This partitions key derivation for a particular purpose+coin tuple, allowing
 // multiple address derivation schems to be maintained concurrently.
 func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
-	cryptoKeyPub, cryptoKeyPriv EncryptorDecryptor, hdpath *hdPath, checkfunc func([]byte) (bool, error),
+	cryptoKeyPub, cryptoKeyPriv EncryptorDecryptor, hdpath *hdPath, multisig *multisigInfo, checkfunc func([]byte) (bool, error),
 	net *config.Params, addressGapLimit uint32) (db.BucketMeta, error) {
 
 	scope := Net2KeyScope[net.HDCoinType]
 
-	accountIDBucket, err := db.GetOrCreateBucket(km, accountIDBucket)
-	if err != nil {
-		return nil, err
-	}
-
 	// Derive the cointype key according to the passed scope.
 	coinTypeKeyPriv, err := deriveCoinTypeKey(root, scope)
 	if err != nil {
@@ -203,8 +215,25 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 	}
 	defer acctKeyPriv.Zero()
 
+	return createAccountKeyScope(km, acctKeyPriv, cryptoKeyPub, cryptoKeyPriv, hdpath, multisig, checkfunc, net, addressGapLimit)
+}
+
+// createAccountKeyScope creates the account bucket of acctKey, which is the
+// extended key at m/purpose'/<coin type>'/<account>'. A public acctKey
+// creates a watch-only account, in which case cryptoKeyPriv is not used.
+func createAccountKeyScope(km db.Bucket, acctKey *hdkeychain.ExtendedKey,
+	cryptoKeyPub, cryptoKeyPriv EncryptorDecryptor, hdpath *hdPath, multisig *multisigInfo, checkfunc func([]byte) (bool, error),
+	net *config.Params, addressGapLimit uint32) (db.BucketMeta, error) {
+
+	scope := Net2KeyScope[net.HDCoinType]
+
+	accountIDBucket, err := db.GetOrCreateBucket(km, accountIDBucket)
+	if err != nil {
+		return nil, err
+	}
+
 	// The address manager needs the first address for the account.
-	acctKeyPub, err := acctKeyPriv.Neuter()
+	acctKeyPub, err := acctKey.Neuter()
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert private account key to public account key: %v", err)
 	}
@@ -220,6 +249,17 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 		return nil, err
 	}
 
+	// multisig keystore is identified by all the keys and the policy
+	if multisig != nil {
+		if err = multisig.checkOwnKey(acctKeyPub); err != nil {
+			return nil, err
+		}
+		accountID, err = multisig.accountID(acctKeyPub)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// check for repeated seed
 	value, _ := accountIDBucket.Get([]byte(accountID))
 	if value != nil {
@@ -228,7 +268,7 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 
 	// Ensure the branch keys can be derived for the provided seed according
 	// to our BIP0044-like derivation.
-	if err := checkBranchKeys(acctKeyPriv); err != nil {
+	if err := checkBranchKeys(acctKey); err != nil {
 		// The seed is unusable if the any of the children in the
 		// required hierarchy can't be derived due to invalid child.
 		if err == hdkeychain.ErrInvalidChild {
@@ -255,9 +295,12 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 	if err != nil {
 		return nil, fmt.Errorf("failed to encrypt public account key: %v", err)
 	}
-	acctPrivEnc, err := cryptoKeyPriv.Encrypt([]byte(acctKeyPriv.String()))
-	if err != nil {
-		return nil, fmt.Errorf("failed to encrypt private account key: %v", err)
+	var acctPrivEnc []byte
+	if acctKey.IsPrivate() {
+		acctPrivEnc, err = cryptoKeyPriv.Encrypt([]byte(acctKey.String()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt private account key: %v", err)
+		}
 	}
 
 	err = putCoinType(accountBucket, scope.Coin)
@@ -270,8 +313,23 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 		return nil, err
 	}
 
+	if multisig != nil {
+		cosignerXpubsEnc := make([][]byte, 0, len(multisig.cosignerXpubs))
+		for _, xpub := range multisig.cosignerXpubs {
+			xpubEnc, err := cryptoKeyPub.Encrypt([]byte(xpub.String()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt cosigner account key: %v", err)
+			}
+			cosignerXpubsEnc = append(cosignerXpubsEnc, xpubEnc)
+		}
+		err = putMultisigInfo(accountBucket, uint32(multisig.nRequired), cosignerXpubsEnc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// new external branch and save the pubkey
-	internalBranchPrivKey, err := acctKeyPriv.Child(InternalBranch)
+	internalBranchKey, err := acctKey.Child(InternalBranch)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "new childKey failed",
 			logging.LogFormat{
@@ -279,8 +337,8 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 			})
 		return nil, err
 	}
-	defer internalBranchPrivKey.Zero()
-	internalBranchPubKey, err := internalBranchPrivKey.Neuter()
+	defer internalBranchKey.Zero()
+	internalBranchPubKey, err := internalBranchKey.Neuter()
 	if err != nil {
 		logging.CPrint(logging.ERROR, "exKey->exPubKey failed",
 			logging.LogFormat{
@@ -289,7 +347,7 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 		return nil, err
 	}
 
-	externalBranchPrivKey, err := acctKeyPriv.Child(ExternalBranch)
+	externalBranchKey, err := acctKey.Child(ExternalBranch)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "new childKey failed",
 			logging.LogFormat{
@@ -297,8 +355,8 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 			})
 		return nil, err
 	}
-	defer externalBranchPrivKey.Zero()
-	externalBranchPubKey, err := externalBranchPrivKey.Neuter()
+	defer externalBranchKey.Zero()
+	externalBranchPubKey, err := externalBranchKey.Neuter()
 	if err != nil {
 		logging.CPrint(logging.ERROR, "exKey->exPubKey failed",
 			logging.LogFormat{
@@ -375,7 +433,7 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 			// create a new managed address based on the private key.
 			// Also, zero the next key after creating the managed address
 			// from it.
-			managedAddr, err := newManagedAddressFromExtKey(accountID, derivationPath, nextKey, nRequiredDefault, massutil.AddressClassWitnessV0, net)
+			managedAddr, err := newManagedAddressFromExtKey(accountID, derivationPath, nextKey, multisig, nRequiredDefault, massutil.AddressClassWitnessV0, net)
 			if err != nil {
 				logging.CPrint(logging.ERROR, "new managedAddress failed",
 					logging.LogFormat{
@@ -465,7 +523,7 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 			// create a new managed address based on the private key.
 			// Also, zero the next key after creating the managed address
 			// from it.
-			managedAddr, err := newManagedAddressFromExtKey(accountID, derivationPath, nextKey, nRequiredDefault, massutil.AddressClassWitnessV0, net)
+			managedAddr, err := newManagedAddressFromExtKey(accountID, derivationPath, nextKey, multisig, nRequiredDefault, massutil.AddressClassWitnessV0, net)
 			if err != nil {
 				logging.CPrint(logging.ERROR, "new managedAddress failed",
 					logging.LogFormat{
@@ -608,8 +666,16 @@ func initAcctBucket(dbTransaction db.DBTransaction, kmBucketMeta db.BucketMeta,
 	}
 	zero.Bytes(entropy)
 
+	var multisig *multisigInfo
+	if walletParams.Multisig != nil {
+		multisig, err = newMultisigInfo(walletParams.Multisig, net)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	acctBucketMeta, err := createManagerKeyScope(kmBucket, rootKey,
-		cryptoKeyPub, cryptoKeyPriv, hdPath, checkfunc, net, walletParams.AddressGapLimit)
+		cryptoKeyPub, cryptoKeyPriv, hdPath, multisig, checkfunc, net, walletParams.AddressGapLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -651,6 +717,77 @@ func initAcctBucket(dbTransaction db.DBTransaction, kmBucketMeta db.BucketMeta,
 	return acctBucketMeta, nil
 }
 
+// initWatchOnlyAcctBucket is the watch-only counterpart of initAcctBucket. Only
+// the master public key and the crypto public key are generated and stored, so
+// the account bucket never holds any private key material.
+func initWatchOnlyAcctBucket(dbTransaction db.DBTransaction, kmBucketMeta db.BucketMeta, net *config.Params, walletParams *WalletParams,
+	scryptConfig *ScryptOptions, hdPath *hdPath, pubPassphrase []byte, acctKeyPub *hdkeychain.ExtendedKey, checkfunc func([]byte) (bool, error)) (db.BucketMeta, error) {
+	kmBucket := dbTransaction.FetchBucket(kmBucketMeta)
+	if kmBucket == nil {
+		return nil, ErrBucketNotFound
+	}
+
+	if scryptConfig == nil {
+		scryptConfig = &DefaultScryptOptions
+	}
+
+	masterKeyPub, err := secretKeyGen(&pubPassphrase, scryptConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate master public key: %v", err)
+	}
+	cryptoKeyPub, err := newCryptoKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate crypto public key: %v", err)
+	}
+	cryptoKeyPubEnc, err := masterKeyPub.Encrypt(cryptoKeyPub.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt crypto public key: %v", err)
+	}
+
+	var multisig *multisigInfo
+	if walletParams.Multisig != nil {
+		multisig, err = newMultisigInfo(walletParams.Multisig, net)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	acctBucketMeta, err := createAccountKeyScope(kmBucket, acctKeyPub,
+		cryptoKeyPub, nil, hdPath, multisig, checkfunc, net, walletParams.AddressGapLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	acctBucket := dbTransaction.FetchBucket(acctBucketMeta)
+	if acctBucket == nil {
+		return nil, ErrUnexpecteDBError
+	}
+
+	err = putVersion(acctBucket, walletParams.Version.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(walletParams.Remarks) > 0 {
+		err = putRemark(acctBucket, []byte(walletParams.Remarks))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = putMasterKeyParams(acctBucket, masterKeyPub.Marshal(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = putCryptoKeys(acctBucket, cryptoKeyPubEnc, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return acctBucketMeta, nil
+}
+
 // create creates a new address manager in the given namespace.  The seed must
 // conform to the standards described in hdkeychain.NewMaster and will be used
 // to create the master root node from which all hierarchical deterministic
@@ -751,11 +888,16 @@ func loadAddrManager(amBucket db.Bucket, pubPassphrase []byte, net *config.Param
 		return nil, err
 	}
 
-	var masterKeyPriv snacl.SecretKey
-	err = masterKeyPriv.Unmarshal(masterKeyPrivParams)
-	if err != nil {
-		str := "failed to unmarshal master private key"
-		return nil, errors.New(str)
+	// the private params are absent for a watch-only keystore
+	watchOnly := masterKeyPrivParams == nil
+	var masterKeyPriv *snacl.SecretKey
+	if !watchOnly {
+		masterKeyPriv = &snacl.SecretKey{}
+		err = masterKeyPriv.Unmarshal(masterKeyPrivParams)
+		if err != nil {
+			str := "failed to unmarshal master private key"
+			return nil, errors.New(str)
+		}
 	}
 
 	// Derive the master public key using the serialized params and provided
@@ -859,6 +1001,32 @@ func loadAddrManager(amBucket db.Bucket, pubPassphrase []byte, net *config.Param
 	// get child number
 	internalChildNum, externalChildNum, err := fetchChildNum(amBucket)
 
+	// fetch the multisig policy, if any
+	var multisig *multisigInfo
+	nRequired, cosignerXpubsEnc, err := fetchMultisigInfo(amBucket)
+	if err != nil {
+		return nil, err
+	}
+	if len(cosignerXpubsEnc) > 0 {
+		params := &MultisigParams{
+			RequiredSigs:  int(nRequired),
+			CosignerXpubs: make([]string, 0, len(cosignerXpubsEnc)),
+		}
+		for _, xpubEnc := range cosignerXpubsEnc {
+			xpub, err := cryptoKeyPub.Decrypt(xpubEnc)
+			if err != nil {
+				str := fmt.Sprintf("failed to decrypt cosigner account key for account %d",
+					account)
+				return nil, errors.New(str)
+			}
+			params.CosignerXpubs = append(params.CosignerXpubs, string(xpub))
+		}
+		multisig, err = newMultisigInfo(params, net)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	branchInfo := &branchInfo{
 		internalBranchPub: internalBranchPub,
 		externalBranchPub: externalBranchPub,
@@ -888,7 +1056,16 @@ func loadAddrManager(amBucket db.Bucket, pubPassphrase []byte, net *config.Param
 			if err != nil {
 				return nil, err
 			}
-			managedAddress, err := newManagedAddressWithoutPrivKey(amBucketMeta.Name(), path, pubkey, nRequiredDefault, massutil.AddressClassWitnessStaking, net)
+			var cosignerKeys []*btcec.PublicKey
+			nRequired := nRequiredDefault
+			if multisig != nil {
+				cosignerKeys, err = multisig.cosignerPubKeys(pkp.branch, pkp.index)
+				if err != nil {
+					return nil, err
+				}
+				nRequired = multisig.nRequired
+			}
+			managedAddress, err := newManagedAddressWithoutPrivKey(amBucketMeta.Name(), path, pubkey, cosignerKeys, nRequired, massutil.AddressClassWitnessStaking, net)
 			if err != nil {
 				return nil, err
 			}
@@ -907,10 +1084,12 @@ func loadAddrManager(amBucket db.Bucket, pubPassphrase []byte, net *config.Param
 		acctInfo:                  acctInfo,
 		branchInfo:                branchInfo,
 		hdScope:                   keyScope,
+		multisig:                  multisig,
 		storage:                   amBucketMeta,
+		watchOnly:                 watchOnly,
 		unlocked:                  false,
 		masterKeyPub:              &masterKeyPub,
-		masterKeyPriv:             &masterKeyPriv,
+		masterKeyPriv:             masterKeyPriv,
 		cryptoKeyPub:              cryptoKeyPub,
 		cryptoKeyPrivEncrypted:    cryptoKeyPrivEnc,
 		cryptoKeyPriv:             &cryptoKey{},
@@ -1067,8 +1246,19 @@ func (km *KeystoreManager) allocAddrMgrNamespace(dbTransaction db.DBTransaction,
 		return nil, ErrBucketNotFound
 	}
 
+	var multisig *multisigInfo
+	if kStore.Multisig != nil {
+		multisig, err = newMultisigInfo(&MultisigParams{
+			RequiredSigs:  kStore.Multisig.RequiredSigs,
+			CosignerXpubs: kStore.Multisig.CosignerXpubs,
+		}, net)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	acctBucketMeta, err := createManagerKeyScope(kmBucket, rootKey,
-		cryptoKeyPub, cryptoKeyPriv, &kStore.HDpath, checkfunc, net, addressGapLimit)
+		cryptoKeyPub, cryptoKeyPriv, &kStore.HDpath, multisig, checkfunc, net, addressGapLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -1204,6 +1394,63 @@ func (km *KeystoreManager) ImportKeystoreWithMnemonic(dbTransaction db.DBTransac
 	return addrManager, nil
 }
 
+// ImportWatchOnlyKeystore imports the account extended public key given by
+// walletParams.AccountXpub. The keystore derives and watches addresses as any
+// other keystore does, but it cannot sign.
+func (km *KeystoreManager) ImportWatchOnlyKeystore(dbTransaction db.DBTransaction,
+	checkfunc func([]byte) (bool, error), walletParams *WalletParams) (*AddrManager, error) {
+
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	acctKeyPub, err := hdkeychain.NewKeyFromString(walletParams.AccountXpub)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to parse account xpub",
+			logging.LogFormat{
+				"err": err,
+			})
+		return nil, ErrInvalidAccountXpub
+	}
+	if acctKeyPub.IsPrivate() || !acctKeyPub.IsForNet(km.params) || acctKeyPub.Depth() != 3 {
+		logging.CPrint(logging.ERROR, "xpub is not a public account key of the connected network",
+			logging.LogFormat{
+				"depth": acctKeyPub.Depth(),
+			})
+		return nil, ErrInvalidAccountXpub
+	}
+	if acctKeyPub.ChildIndex() != uint32(WalletUsage)+hdkeychain.HardenedKeyStart {
+		return nil, ErrAccountType
+	}
+
+	hdpath := &hdPath{
+		Account:          uint32(WalletUsage),
+		InternalChildNum: walletParams.InternalIndex,
+		ExternalChildNum: walletParams.ExternalIndex,
+	}
+	if hdpath.ExternalChildNum == 0 {
+		hdpath.ExternalChildNum = 1
+	}
+
+	acctBucketMeta, err := initWatchOnlyAcctBucket(dbTransaction, km.ksMgrMeta, km.params, walletParams, &DefaultScryptOptions, hdpath,
+		km.pubPassphrase, acctKeyPub, checkfunc)
+	if err != nil {
+		return nil, err
+	}
+
+	acctBucket := dbTransaction.FetchBucket(acctBucketMeta)
+	if acctBucket == nil {
+		return nil, ErrUnexpecteDBError
+	}
+
+	addrManager, err := loadAddrManager(acctBucket, km.pubPassphrase, km.params)
+	if err != nil {
+		return nil, err
+	}
+
+	km.managedKeystores[addrManager.keystoreName] = addrManager
+	return addrManager, nil
+}
+
 func (km *KeystoreManager) ExportKeystore(dbTransaction db.ReadTransaction, accountID string, privPassphrase []byte) ([]byte, error) {
 	km.mu.Lock()
 	defer km.mu.Unlock()
@@ -1258,6 +1505,7 @@ func (km *KeystoreManager) DeleteKeystore(dbTransaction db.DBTransaction, accoun
 		if err != nil {
 			return false, err
 		}
+		km.stopUnlockSessionOf(accountID)
 		addrManager.clearPrivKeys()
 		delete(km.managedKeystores, accountID)
 		if km.currentKeystore != nil && km.currentKeystore.accountName == accountID {
@@ -1313,6 +1561,7 @@ func (km *KeystoreManager) updateManagedKeystore(dbTransaction db.ReadTransactio
 		km.managedKeystores[addrManager.keystoreName] = addrManager
 	}
 	if amBucket == nil && ok {
+		km.stopUnlockSessionOf(accountID)
 		addrManager.clearPrivKeys()
 		delete(km.managedKeystores, accountID)
 	}
@@ -1331,6 +1580,7 @@ func (km *KeystoreManager) RemoveCachedKeystore(accountID string) {
 	defer km.mu.Unlock()
 	addrManager, ok := km.managedKeystores[accountID]
 	if ok {
+		km.stopUnlockSessionOf(accountID)
 		addrManager.clearPrivKeys()
 		delete(km.managedKeystores, accountID)
 	}
@@ -1340,11 +1590,10 @@ func (km *KeystoreManager) NextAddresses(dbTransaction db.DBTransaction, checkfu
 	km.mu.Lock()
 	defer km.mu.Unlock()
 
-	if km.currentKeystore == nil {
+	addrManager := km.current()
+	if addrManager == nil {
 		return nil, ErrCurrentKeystoreNotFound
 	}
-	accountID := km.currentKeystore.accountName
-	addrManager := km.managedKeystores[accountID]
 	managedAddresses, err := addrManager.nextAddresses(dbTransaction, checkfunc, internal, numAddresses, addressGapLimit, km.params, nRequiredDefault, addressClass)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "new address failed",
@@ -1378,24 +1627,21 @@ func (km *KeystoreManager) SignHash(pubKey *btcec.PublicKey, hash []byte, passwo
 	km.mu.Lock()
 	defer km.mu.Unlock()
 
-	pks := make([]*btcec.PublicKey, 0)
-	pks = append(pks, pubKey)
-	_, addr, err := newWitnessScriptAddressForBtcec(pks, nRequiredDefault, massutil.AddressClassWitnessV0, km.params)
+	addrManager, addr, err := km.getAddrManagerByPubKey(pubKey)
 	if err != nil {
-		logging.CPrint(logging.ERROR, "newWitnessScriptAddressForBtcec error",
-			logging.LogFormat{
-				"err": err,
-			})
 		return nil, err
 	}
 
-	addrManager, err := km.getAddrManager(addr.EncodeAddress())
-	if err != nil {
-		return nil, err
+	if addrManager.watchOnly {
+		return nil, ErrWatchOnly
+	}
+
+	if _, unlocked := km.unlockSessions[addrManager.keystoreName]; len(password) == 0 && !unlocked {
+		return nil, ErrWalletLocked
 	}
 
 	var sig *btcec.Signature
-	sig, err = addrManager.signBtcec(hash, addr.EncodeAddress(), password)
+	sig, err = addrManager.signBtcec(hash, addr, password)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "sign failed",
 			logging.LogFormat{
@@ -1427,12 +1673,8 @@ func (km *KeystoreManager) ChangePrivPassphrase(dbTransaction db.DBTransaction,
 		scryptConfig = &DefaultScryptOptions
 	}
 
-	if km.currentKeystore == nil {
-		return ErrCurrentKeystoreNotFound
-	}
-
-	addrManager, found := km.managedKeystores[km.currentKeystore.accountName]
-	if !found {
+	addrManager := km.current()
+	if addrManager == nil {
 		return ErrCurrentKeystoreNotFound
 	}
 
@@ -1552,15 +1794,109 @@ func (km *KeystoreManager) ChangeRemark(dbTransaction db.DBTransaction, accountI
 	}
 }
 
+// ClearPrivKey zeroes private keys of all managed keystores and ends the
+// unlock sessions.
 func (km *KeystoreManager) ClearPrivKey() {
 	km.mu.Lock()
 	defer km.mu.Unlock()
 
+	for accountID := range km.unlockSessions {
+		km.stopUnlockSessionOf(accountID)
+	}
 	for _, addrManager := range km.managedKeystores {
 		addrManager.clearPrivKeys()
 	}
 }
 
+// Lock zeroes private keys of current keystore and ends its unlock session.
+func (km *KeystoreManager) Lock() error {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	addrManager := km.current()
+	if addrManager == nil {
+		return ErrCurrentKeystoreNotFound
+	}
+	km.stopUnlockSessionOf(addrManager.keystoreName)
+	addrManager.clearPrivKeys()
+	return nil
+}
+
+// ReleasePrivKey zeroes private keys decrypted for a single signing call,
+// while keys of the unlocked keystores are kept until their sessions expire.
+func (km *KeystoreManager) ReleasePrivKey() {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	for accountID, addrManager := range km.managedKeystores {
+		if _, ok := km.unlockSessions[accountID]; ok {
+			continue
+		}
+		addrManager.clearPrivKeys()
+	}
+}
+
+// Unlock decrypts private keys of current keystore and keeps them in memory,
+// so that signing is allowed with an empty passphrase until timeout expires.
+// Unlocking again resets the timeout. Other keystores stay unlocked.
+func (km *KeystoreManager) Unlock(privPassphrase []byte, timeout time.Duration) error {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	addrManager := km.current()
+	if addrManager == nil {
+		return ErrCurrentKeystoreNotFound
+	}
+	accountID := addrManager.keystoreName
+	km.stopUnlockSessionOf(accountID)
+
+	err := addrManager.unlock(privPassphrase)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to unlock keystore",
+			logging.LogFormat{
+				"accountID": accountID,
+				"err":       err,
+			})
+		addrManager.clearPrivKeys()
+		return err
+	}
+
+	session := &unlockSession{accountName: accountID}
+	session.timer = time.AfterFunc(timeout, func() {
+		km.mu.Lock()
+		defer km.mu.Unlock()
+		if km.unlockSessions[accountID] == session {
+			delete(km.unlockSessions, accountID)
+			addrManager.clearPrivKeys()
+		}
+	})
+	if km.unlockSessions == nil {
+		km.unlockSessions = make(map[string]*unlockSession)
+	}
+	km.unlockSessions[accountID] = session
+	return nil
+}
+
+// IsUnlocked returns true if current keystore is unlocked by Unlock.
+func (km *KeystoreManager) IsUnlocked() bool {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	addrManager := km.current()
+	if addrManager == nil {
+		return false
+	}
+	_, ok := km.unlockSessions[addrManager.keystoreName]
+	return ok
+}
+
+func (km *KeystoreManager) stopUnlockSessionOf(accountID string) {
+	if session, ok := km.unlockSessions[accountID]; ok {
+		session.timer.Stop()
+		delete(km.unlockSessions, accountID)
+	}
+}
+
 func (km *KeystoreManager) ListKeystoreNames() []string {
 	km.mu.Lock()
 	defer km.mu.Unlock()
@@ -1581,6 +1917,27 @@ func (km *KeystoreManager) ChainParams() *config.Params {
 func (km *KeystoreManager) CurrentKeystore() *AddrManager {
 	km.mu.Lock()
 	defer km.mu.Unlock()
+	return km.current()
+}
+
+// ForKeystore returns a view of km serving the keystore name as current one,
+// without changing the current keystore of km.
+func (km *KeystoreManager) ForKeystore(name string) (*KeystoreManager, error) {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	if _, found := km.managedKeystores[name]; !found {
+		return nil, ErrAccountNotFound
+	}
+	return &KeystoreManager{keystores: km.keystores, scope: name}, nil
+}
+
+// current returns the keystore km is scoped to, or the current keystore if
+// not scoped, nil if not found.
+func (km *KeystoreManager) current() *AddrManager {
+	if len(km.scope) != 0 {
+		return km.managedKeystores[km.scope]
+	}
 	if km.currentKeystore == nil {
 		return nil
 	}
@@ -1620,6 +1977,37 @@ func (km *KeystoreManager) getAddrManager(addr string) (*AddrManager, error) {
 	return nil, ErrAccountNotFound
 }
 
+// getAddrManagerByPubKey returns the AddrManager holding the private key of
+// pubKey, together with the address the key belongs to. The current keystore
+// is preferred, since a key may be shared by a single-key keystore and the
+// multisig keystores built upon the same seed.
+func (km *KeystoreManager) getAddrManagerByPubKey(pubKey *btcec.PublicKey) (*AddrManager, string, error) {
+	pks := make([]*btcec.PublicKey, 0)
+	pks = append(pks, pubKey)
+	_, addr, err := newWitnessScriptAddressForBtcec(pks, nRequiredDefault, massutil.AddressClassWitnessV0, km.params)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "newWitnessScriptAddressForBtcec error",
+			logging.LogFormat{
+				"err": err,
+			})
+		return nil, "", err
+	}
+	encoded := addr.EncodeAddress()
+
+	if addrManager := km.current(); addrManager != nil {
+		if found, ok := addrManager.addressOfPubKey(pubKey, encoded); ok {
+			return addrManager, found, nil
+		}
+	}
+	for _, addrManager := range km.managedKeystores {
+		if found, ok := addrManager.addressOfPubKey(pubKey, encoded); ok {
+			return addrManager, found, nil
+		}
+	}
+
+	return nil, "", ErrAccountNotFound
+}
+
 func (km *KeystoreManager) GetAddrManager(addr string) (*AddrManager, error) {
 	km.mu.Lock()
 	defer km.mu.Unlock()
@@ -1700,7 +2088,8 @@ func (km *KeystoreManager) GetManagedAddressByScriptHashInCurrent(scriptHash []b
 	km.mu.Lock()
 	defer km.mu.Unlock()
 
-	if km.currentKeystore == nil {
+	addrManager := km.current()
+	if addrManager == nil {
 		return nil, ErrCurrentKeystoreNotFound
 	}
 
@@ -1711,7 +2100,7 @@ func (km *KeystoreManager) GetManagedAddressByScriptHashInCurrent(scriptHash []b
 	}
 	encoded := scriptHashStruct.EncodeAddress()
 
-	mAddr, ok := km.managedKeystores[km.currentKeystore.accountName].addrs[encoded]
+	mAddr, ok := addrManager.addrs[encoded]
 	if ok {
 		return mAddr, nil
 	}
@@ -1754,10 +2143,13 @@ func NewKeystoreManager(rootBucket db.Bucket, pubPassphrase []byte, net *config.
 		managed[accountID] = addrManager
 	}
 	return &KeystoreManager{
-		managedKeystores: managed,
-		params:           net,
-		ksMgrMeta:        kmBucketMeta,
-		accountIDMeta:    accountIDBucketMeta,
-		pubPassphrase:    pubPassphrase,
+		keystores: &keystores{
+			managedKeystores: managed,
+			params:           net,
+			ksMgrMeta:        kmBucketMeta,
+			accountIDMeta:    accountIDBucketMeta,
+			pubPassphrase:    pubPassphrase,
+			unlockSessions:   make(map[string]*unlockSession),
+		},
 	}, nil
 }
@@ -733,6 +733,377 @@ func TestKeystoreManager_ImportKeystoreWithMnemonic(t *testing.T) {
 	return
 }
 
+func TestKeystoreManager_ImportMultisigKeystore(t *testing.T) {
+	ldb, tearDown, err := GetDb("Tst_Manager")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown()
+
+	newParams := func(mnemonic string, multisig *MultisigParams) *WalletParams {
+		return &WalletParams{
+			Version:           KeystoreVersion0,
+			Mnemonic:          mnemonic,
+			PrivatePassphrase: privPassphrase,
+			AddressGapLimit:   addressGapLimit,
+			ExternalIndex:     3,
+			Multisig:          multisig,
+		}
+	}
+
+	km := &KeystoreManager{}
+	var xpubs []string
+	var multisigID string
+	var pk *btcec.PublicKey
+	addresses := make(map[string]*ManagedAddress)
+	err = mwdb.Update(ldb, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km, err = NewKeystoreManager(bucket, pubPassphrase, &config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+		for _, mnemonic := range []string{mnemonic1, mnemonic2, mnemonic3} {
+			am, err := km.ImportKeystoreWithMnemonic(tx, alwaysFalseCheck, newParams(mnemonic, nil))
+			if err != nil {
+				return fmt.Errorf("failed to import keystore, %v", err)
+			}
+			if am.Multisig() != nil {
+				return fmt.Errorf("unexpected multisig keystore")
+			}
+			xpubs = append(xpubs, am.AccountXpub())
+		}
+
+		// invalid policies
+		tests := []struct {
+			multisig *MultisigParams
+			err      error
+		}{
+			{&MultisigParams{RequiredSigs: 4, CosignerXpubs: xpubs[1:]}, ErrInvalidRequiredSigs},
+			{&MultisigParams{RequiredSigs: 0, CosignerXpubs: xpubs[1:]}, ErrInvalidRequiredSigs},
+			{&MultisigParams{RequiredSigs: 1, CosignerXpubs: nil}, ErrInvalidCosignerNumber},
+			{&MultisigParams{RequiredSigs: 2, CosignerXpubs: []string{xpubs[1], xpubs[1]}}, ErrDuplicateCosigner},
+			{&MultisigParams{RequiredSigs: 2, CosignerXpubs: xpubs}, ErrDuplicateCosigner},
+			{&MultisigParams{RequiredSigs: 2, CosignerXpubs: []string{"xpub"}}, ErrInvalidCosignerXpub},
+		}
+		for i, test := range tests {
+			_, err = km.ImportKeystoreWithMnemonic(tx, alwaysFalseCheck, newParams(mnemonic1, test.multisig))
+			if err != test.err {
+				return fmt.Errorf("%d: expected error %v, got %v", i, test.err, err)
+			}
+		}
+
+		am, err := km.ImportKeystoreWithMnemonic(tx, alwaysFalseCheck, newParams(mnemonic1,
+			&MultisigParams{RequiredSigs: 2, CosignerXpubs: []string{xpubs[1], xpubs[2]}}))
+		if err != nil {
+			return fmt.Errorf("failed to import multisig keystore, %v", err)
+		}
+		multisigID = am.Name()
+		if am.AccountXpub() != xpubs[0] {
+			return fmt.Errorf("unexpected account xpub %s", am.AccountXpub())
+		}
+		if policy := am.Multisig(); policy == nil || policy.RequiredSigs != 2 || len(policy.CosignerXpubs) != 2 {
+			return fmt.Errorf("unexpected multisig policy %v", policy)
+		}
+		for _, ma := range am.ManagedAddresses() {
+			if !ma.IsMultisig() {
+				return fmt.Errorf("address %s is not multisig", ma.String())
+			}
+			script, err := ma.RedeemScript(&config.ChainParams)
+			if err != nil {
+				return err
+			}
+			scriptHash := sha256.Sum256(script)
+			if hex.EncodeToString(scriptHash[:]) != hex.EncodeToString(ma.ScriptAddress()) {
+				return fmt.Errorf("redeem script mismatched with address %s", ma.String())
+			}
+			addresses[ma.String()] = ma
+		}
+		if len(addresses) != 3 {
+			return fmt.Errorf("unexpected number of addresses, %d", len(addresses))
+		}
+
+		// the same multisig keystore built from another cosigner
+		_, err = km.ImportKeystoreWithMnemonic(tx, alwaysFalseCheck, newParams(mnemonic2,
+			&MultisigParams{RequiredSigs: 2, CosignerXpubs: []string{xpubs[2], xpubs[0]}}))
+		if err != ErrDuplicateSeed {
+			return fmt.Errorf("failed to catch error, %v", err)
+		}
+
+		err = km.UseKeystoreForWallet(multisigID)
+		if err != nil {
+			return fmt.Errorf("failed to use keystore, %v", err)
+		}
+		mas, err := km.NextAddresses(tx, alwaysTrueCheck, false, 1, addressGapLimit, massutil.AddressClassWitnessV0)
+		if err != nil {
+			return fmt.Errorf("failed to new address, %v", err)
+		}
+		if !mas[0].IsMultisig() {
+			return fmt.Errorf("new address %s is not multisig", mas[0].String())
+		}
+		addresses[mas[0].String()] = mas[0]
+		pk = mas[0].PubKey()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// sign with the own key of a multisig address
+	hash := sha256.Sum256([]byte("test multisig"))
+	sign, err := km.SignHash(pk, hash[:], privPassphrase)
+	if err != nil {
+		t.Fatalf("failed to sign hash, %v", err)
+	}
+	if !sign.Verify(hash[:], pk) {
+		t.Fatal("failed to verify signature")
+	}
+	km.ClearPrivKey()
+
+	// reload
+	err = mwdb.View(ldb, func(tx mwdb.ReadTransaction) error {
+		bucket := tx.FetchBucket(km.ksMgrMeta)
+		if bucket == nil {
+			return fmt.Errorf("failed to get bucket")
+		}
+		am, err := loadAddrManager(bucket.Bucket(multisigID), pubPassphrase, &config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to load multisig keystore, %v", err)
+		}
+		if len(am.addrs) != len(addresses) {
+			return fmt.Errorf("unexpected number of addresses, %d", len(am.addrs))
+		}
+		for addr, ma := range am.addrs {
+			expected, ok := addresses[addr]
+			if !ok || !ma.IsMultisig() || ma.nRequired != expected.nRequired {
+				return fmt.Errorf("mismatched address %s", addr)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// another cosigner derives the same keystore
+	ldb1, tearDown1, err := GetDb("Tst_Manager1")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown1()
+	err = mwdb.Update(ldb1, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km1, err := NewKeystoreManager(bucket, pubPassphrase, &config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+		am, err := km1.ImportKeystoreWithMnemonic(tx, alwaysFalseCheck, newParams(mnemonic3,
+			&MultisigParams{RequiredSigs: 2, CosignerXpubs: []string{xpubs[0], xpubs[1]}}))
+		if err != nil {
+			return fmt.Errorf("failed to import multisig keystore, %v", err)
+		}
+		if am.Name() != multisigID {
+			return fmt.Errorf("mismatched account id, %s, %s", am.Name(), multisigID)
+		}
+		for _, ma := range am.ManagedAddresses() {
+			if _, ok := addresses[ma.String()]; !ok {
+				return fmt.Errorf("mismatched address %s", ma.String())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKeystoreManager_ImportWatchOnlyKeystore(t *testing.T) {
+	ldb, tearDown, err := GetDb("Tst_Manager")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown()
+
+	var xpub, branchXpub string
+	addresses := make(map[string]struct{})
+	var nextAddress string
+	err = mwdb.Update(ldb, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km, err := NewKeystoreManager(bucket, pubPassphrase, &config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+		am, err := km.ImportKeystoreWithMnemonic(tx, alwaysFalseCheck, &WalletParams{
+			Version:           KeystoreVersion0,
+			Mnemonic:          mnemonic1,
+			PrivatePassphrase: privPassphrase,
+			AddressGapLimit:   addressGapLimit,
+			ExternalIndex:     3,
+			InternalIndex:     1,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to import keystore, %v", err)
+		}
+		xpub = am.AccountXpub()
+		branchKey, err := am.acctInfo.acctKeyPub.Child(ExternalBranch)
+		if err != nil {
+			return err
+		}
+		branchXpub = branchKey.String()
+		for _, addr := range am.ListAddresses() {
+			addresses[addr] = struct{}{}
+		}
+
+		err = km.UseKeystoreForWallet(am.Name())
+		if err != nil {
+			return fmt.Errorf("failed to use keystore, %v", err)
+		}
+		mas, err := km.NextAddresses(tx, alwaysTrueCheck, false, 1, addressGapLimit, massutil.AddressClassWitnessV0)
+		if err != nil {
+			return fmt.Errorf("failed to new address, %v", err)
+		}
+		nextAddress = mas[0].String()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ldb1, tearDown1, err := GetDb("Tst_Manager1")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown1()
+
+	newParams := func(xpub string) *WalletParams {
+		return &WalletParams{
+			AccountXpub:     xpub,
+			Remarks:         "watch-only",
+			AddressGapLimit: addressGapLimit,
+			ExternalIndex:   3,
+			InternalIndex:   1,
+		}
+	}
+
+	km := &KeystoreManager{}
+	var walletID string
+	var pk *btcec.PublicKey
+	err = mwdb.Update(ldb1, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km, err = NewKeystoreManager(bucket, pubPassphrase, &config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+
+		for i, invalid := range []string{"", "xpub", branchXpub} {
+			_, err = km.ImportWatchOnlyKeystore(tx, alwaysFalseCheck, newParams(invalid))
+			if err != ErrInvalidAccountXpub {
+				return fmt.Errorf("%d: expected error %v, got %v", i, ErrInvalidAccountXpub, err)
+			}
+		}
+
+		am, err := km.ImportWatchOnlyKeystore(tx, alwaysFalseCheck, newParams(xpub))
+		if err != nil {
+			return fmt.Errorf("failed to import watch-only keystore, %v", err)
+		}
+		walletID = am.Name()
+		if !am.IsWatchOnly() || am.Remarks() != "watch-only" {
+			return fmt.Errorf("unexpected keystore, watch-only: %v, remarks: %s", am.IsWatchOnly(), am.Remarks())
+		}
+		if am.AccountXpub() != xpub {
+			return fmt.Errorf("unexpected account xpub %s", am.AccountXpub())
+		}
+		if len(am.addrs) != len(addresses) {
+			return fmt.Errorf("unexpected number of addresses, %d", len(am.addrs))
+		}
+		for addr := range am.addrs {
+			if _, ok := addresses[addr]; !ok {
+				return fmt.Errorf("mismatched address %s", addr)
+			}
+		}
+
+		_, err = km.ImportWatchOnlyKeystore(tx, alwaysFalseCheck, newParams(xpub))
+		if err != ErrDuplicateSeed {
+			return fmt.Errorf("failed to catch error, %v", err)
+		}
+
+		err = km.UseKeystoreForWallet(walletID)
+		if err != nil {
+			return fmt.Errorf("failed to use keystore, %v", err)
+		}
+		mas, err := km.NextAddresses(tx, alwaysTrueCheck, false, 1, addressGapLimit, massutil.AddressClassWitnessV0)
+		if err != nil {
+			return fmt.Errorf("failed to new address, %v", err)
+		}
+		if mas[0].String() != nextAddress {
+			return fmt.Errorf("mismatched new address %s, %s", mas[0].String(), nextAddress)
+		}
+		pk = mas[0].PubKey()
+
+		_, err = km.ExportKeystore(tx, walletID, privPassphrase)
+		if err != ErrWatchOnly {
+			return fmt.Errorf("export: expected error %v, got %v", ErrWatchOnly, err)
+		}
+		_, _, err = km.GetMnemonic(tx, walletID, privPassphrase)
+		if err != ErrWatchOnly {
+			return fmt.Errorf("mnemonic: expected error %v, got %v", ErrWatchOnly, err)
+		}
+		err = km.ChangePrivPassphrase(tx, privPassphrase, privPassphrase2, fastScrypt)
+		if err != ErrWatchOnly {
+			return fmt.Errorf("change passphrase: expected error %v, got %v", ErrWatchOnly, err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every signing path is rejected
+	hash := sha256.Sum256([]byte("test watch-only"))
+	if _, err = km.SignHash(pk, hash[:], privPassphrase); err != ErrWatchOnly {
+		t.Fatalf("sign: expected error %v, got %v", ErrWatchOnly, err)
+	}
+	if err = km.Unlock(privPassphrase, time.Minute); err != ErrWatchOnly {
+		t.Fatalf("unlock: expected error %v, got %v", ErrWatchOnly, err)
+	}
+	if err = km.CheckPrivPassphrase(walletID, privPassphrase); err != ErrWatchOnly {
+		t.Fatalf("check passphrase: expected error %v, got %v", ErrWatchOnly, err)
+	}
+
+	// reload
+	err = mwdb.View(ldb1, func(tx mwdb.ReadTransaction) error {
+		bucket := tx.FetchBucket(km.ksMgrMeta)
+		if bucket == nil {
+			return fmt.Errorf("failed to get bucket")
+		}
+		am, err := loadAddrManager(bucket.Bucket(walletID), pubPassphrase, &config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to load watch-only keystore, %v", err)
+		}
+		if !am.IsWatchOnly() {
+			return fmt.Errorf("reloaded keystore is not watch-only")
+		}
+		if _, err = am.signBtcec(hash[:], nextAddress, privPassphrase); err != ErrWatchOnly {
+			return fmt.Errorf("sign: expected error %v, got %v", ErrWatchOnly, err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestKeystoreManager_ExportKeystore(t *testing.T) {
 	ldb, tearDown, err := GetDb("Tst_Manager")
 	if err != nil {
@@ -1120,6 +1491,193 @@ func TestKeystoreManager_SignHash(t *testing.T) {
 	return
 }
 
+func TestKeystoreManager_Unlock(t *testing.T) {
+	ldb, tearDown, err := GetDb("Tst_Manager")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown()
+
+	km := &KeystoreManager{}
+	var pk *btcec.PublicKey
+	err = mwdb.Update(ldb, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km, err = NewKeystoreManager(bucket, pubPassphrase, &config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+		accountID, _, err := km.NewKeystore(tx, defaultBitSize, privPassphrase, "first", &config.ChainParams, fastScrypt, addressGapLimit)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore, %v", err)
+		}
+		err = km.UseKeystoreForWallet(accountID)
+		if err != nil {
+			return fmt.Errorf("failed to use keystore, %v", err)
+		}
+		addrs, err := km.NextAddresses(tx, alwaysTrueCheck, false, 2, addressGapLimit, massutil.AddressClassWitnessV0)
+		if err != nil {
+			return fmt.Errorf("failed to new address, %v", err)
+		}
+		pk = addrs[0].pubKey
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer km.ClearPrivKey()
+
+	hash := sha256.Sum256([]byte("test unlock"))
+
+	// locked, empty pass
+	_, err = km.SignHash(pk, hash[:], nil)
+	if err != ErrWalletLocked {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+
+	// wrong pass
+	err = km.Unlock(privPassphrase2, time.Second)
+	if err != ErrInvalidPassphrase {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+	if km.IsUnlocked() {
+		t.Fatal("unexpected unlocked keystore")
+	}
+
+	// right pass
+	err = km.Unlock(privPassphrase, time.Second)
+	if err != nil {
+		t.Fatalf("failed to unlock, %v", err)
+	}
+	if !km.IsUnlocked() {
+		t.Fatal("keystore is expected to be unlocked")
+	}
+
+	// keys are kept after signing
+	km.ReleasePrivKey()
+	sign, err := km.SignHash(pk, hash[:], nil)
+	if err != nil {
+		t.Fatalf("failed to sign hash when unlocked, %v", err)
+	}
+	if !sign.Verify(hash[:], pk) {
+		t.Fatal("failed to verify signature")
+	}
+
+	// wrong pass is still rejected when unlocked
+	_, err = km.SignHash(pk, hash[:], privPassphrase2)
+	if err != ErrInvalidPassphrase {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+
+	// timeout
+	time.Sleep(1500 * time.Millisecond)
+	if km.IsUnlocked() {
+		t.Fatal("keystore is expected to be locked after timeout")
+	}
+	_, err = km.SignHash(pk, hash[:], nil)
+	if err != ErrWalletLocked {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+
+	// lock before timeout
+	err = km.Unlock(privPassphrase, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to unlock, %v", err)
+	}
+	km.ClearPrivKey()
+	if km.IsUnlocked() {
+		t.Fatal("keystore is expected to be locked")
+	}
+	_, err = km.SignHash(pk, hash[:], nil)
+	if err != ErrWalletLocked {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+}
+
+func TestKeystoreManager_ForKeystore(t *testing.T) {
+	ldb, tearDown, err := GetDb("Tst_Manager")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown()
+
+	km := &KeystoreManager{}
+	var accountID1, accountID2 string
+	err = mwdb.Update(ldb, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km, err = NewKeystoreManager(bucket, pubPassphrase, &config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+		accountID1, _, err = km.NewKeystore(tx, defaultBitSize, privPassphrase, "first", &config.ChainParams, fastScrypt, addressGapLimit)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore, %v", err)
+		}
+		accountID2, _, err = km.NewKeystore(tx, defaultBitSize, privPassphrase2, "second", &config.ChainParams, fastScrypt, addressGapLimit)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore, %v", err)
+		}
+		return km.UseKeystoreForWallet(accountID1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer km.ClearPrivKey()
+
+	if _, err = km.ForKeystore("unknown"); err != ErrAccountNotFound {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+	view, err := km.ForKeystore(accountID2)
+	if err != nil {
+		t.Fatalf("failed to get view, %v", err)
+	}
+	if view.CurrentKeystore().Name() != accountID2 {
+		t.Fatal("view is expected to serve the second keystore")
+	}
+	if km.CurrentKeystore().Name() != accountID1 {
+		t.Fatal("current keystore is expected to be unchanged")
+	}
+
+	// addresses are created for the keystore of the view
+	err = mwdb.Update(ldb, func(tx mwdb.DBTransaction) error {
+		_, err := view.NextAddresses(tx, alwaysTrueCheck, false, 1, addressGapLimit, massutil.AddressClassWitnessV0)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("failed to new address, %v", err)
+	}
+	ext1, _ := km.CurrentKeystore().CountAddresses()
+	ext2, _ := view.CurrentKeystore().CountAddresses()
+	if ext1 != 0 || ext2 != 1 {
+		t.Fatalf("unexpected address count, %d, %d", ext1, ext2)
+	}
+
+	// keystores are unlocked separately
+	if err = km.Unlock(privPassphrase, time.Minute); err != nil {
+		t.Fatalf("failed to unlock, %v", err)
+	}
+	if view.IsUnlocked() {
+		t.Fatal("second keystore is expected to be locked")
+	}
+	if err = view.Unlock(privPassphrase2, time.Minute); err != nil {
+		t.Fatalf("failed to unlock, %v", err)
+	}
+	if !km.IsUnlocked() || !view.IsUnlocked() {
+		t.Fatal("both keystores are expected to be unlocked")
+	}
+	if err = view.Lock(); err != nil {
+		t.Fatalf("failed to lock, %v", err)
+	}
+	if !km.IsUnlocked() || view.IsUnlocked() {
+		t.Fatal("only the first keystore is expected to be unlocked")
+	}
+}
+
 func TestKeystoreManager_ChangePubPassphrase(t *testing.T) {
 	ldb, tearDown, err := GetDb("Tst_Manager")
 	if err != nil {
@@ -0,0 +1,189 @@
+package keystore
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/masswallet/keystore/hdkeychain"
+	"massnet.org/mass-wallet/txscript"
+)
+
+// multisigInfo holds the policy of a multisig keystore. Every address of such
+// keystore is a P2WSH m-of-n multisig script over the keystore's own key and
+// the cosigners' keys derived at the same branch and index.
+type multisigInfo struct {
+	nRequired int
+	// account extended public keys of the cosigners, in the order given
+	cosignerXpubs []*hdkeychain.ExtendedKey
+	// branch extended public keys of the cosigners, indexed by branch
+	cosignerBranches [][2]*hdkeychain.ExtendedKey
+}
+
+// newMultisigInfo validates the multisig parameters and derives the branch
+// keys of all cosigners.
+func newMultisigInfo(params *MultisigParams, net *config.Params) (*multisigInfo, error) {
+	if params == nil {
+		return nil, ErrNilPointer
+	}
+	numKeys := len(params.CosignerXpubs) + 1
+	if len(params.CosignerXpubs) == 0 || numKeys > txscript.MaxPubKeysPerMultiSig {
+		logging.CPrint(logging.ERROR, "invalid number of cosigners",
+			logging.LogFormat{
+				"cosigners": len(params.CosignerXpubs),
+				"maximum":   txscript.MaxPubKeysPerMultiSig - 1,
+			})
+		return nil, ErrInvalidCosignerNumber
+	}
+	if params.RequiredSigs <= 0 || params.RequiredSigs > numKeys {
+		logging.CPrint(logging.ERROR, "invalid number of required signatures",
+			logging.LogFormat{
+				"required": params.RequiredSigs,
+				"keys":     numKeys,
+			})
+		return nil, ErrInvalidRequiredSigs
+	}
+
+	info := &multisigInfo{
+		nRequired:        params.RequiredSigs,
+		cosignerXpubs:    make([]*hdkeychain.ExtendedKey, 0, len(params.CosignerXpubs)),
+		cosignerBranches: make([][2]*hdkeychain.ExtendedKey, 0, len(params.CosignerXpubs)),
+	}
+	seen := make(map[string]struct{})
+	for _, xpub := range params.CosignerXpubs {
+		key, err := hdkeychain.NewKeyFromString(xpub)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to parse cosigner xpub",
+				logging.LogFormat{
+					"xpub": xpub,
+					"err":  err,
+				})
+			return nil, ErrInvalidCosignerXpub
+		}
+		if key.IsPrivate() || !key.IsForNet(net) || key.Depth() != 3 {
+			logging.CPrint(logging.ERROR, "cosigner xpub is not a public account key of the connected network",
+				logging.LogFormat{
+					"xpub":  xpub,
+					"depth": key.Depth(),
+				})
+			return nil, ErrInvalidCosignerXpub
+		}
+		pubKey, err := key.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+		serialized := string(pubKey.SerializeCompressed())
+		if _, ok := seen[serialized]; ok {
+			return nil, ErrDuplicateCosigner
+		}
+		seen[serialized] = struct{}{}
+
+		var branches [2]*hdkeychain.ExtendedKey
+		for _, branch := range []uint32{ExternalBranch, InternalBranch} {
+			branches[branch], err = key.Child(branch)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "new childKey failed",
+					logging.LogFormat{
+						"err": err,
+					})
+				return nil, err
+			}
+		}
+		info.cosignerXpubs = append(info.cosignerXpubs, key)
+		info.cosignerBranches = append(info.cosignerBranches, branches)
+	}
+	return info, nil
+}
+
+// checkOwnKey ensures the keystore's own account key is not listed as a cosigner.
+func (m *multisigInfo) checkOwnKey(acctKeyPub *hdkeychain.ExtendedKey) error {
+	ownPubKey, err := acctKeyPub.ECPubKey()
+	if err != nil {
+		return err
+	}
+	for _, xpub := range m.cosignerXpubs {
+		pubKey, err := xpub.ECPubKey()
+		if err != nil {
+			return err
+		}
+		if pubKey.IsEqual(ownPubKey) {
+			return ErrDuplicateCosigner
+		}
+	}
+	return nil
+}
+
+// cosignerPubKeys derives the public keys of all cosigners at the given
+// branch and index.
+func (m *multisigInfo) cosignerPubKeys(branch, index uint32) ([]*btcec.PublicKey, error) {
+	if branch != ExternalBranch && branch != InternalBranch {
+		return nil, ErrUnexpectError
+	}
+	pubKeys := make([]*btcec.PublicKey, 0, len(m.cosignerBranches))
+	for _, branches := range m.cosignerBranches {
+		child, err := branches[branch].Child(index)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "new cosigner childKey failed",
+				logging.LogFormat{
+					"branch": branch,
+					"index":  index,
+					"err":    err,
+				})
+			return nil, err
+		}
+		pubKey, err := child.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
+
+// accountID returns the account id of the multisig keystore. It commits to
+// the required number of signatures and all account keys, so that every
+// cosigner ends up with the same id regardless of the order of the xpubs.
+func (m *multisigInfo) accountID(acctKeyPub *hdkeychain.ExtendedKey) (string, error) {
+	ownPubKey, err := acctKeyPub.ECPubKey()
+	if err != nil {
+		return "", err
+	}
+	pubKeys := []*btcec.PublicKey{ownPubKey}
+	for _, xpub := range m.cosignerXpubs {
+		pubKey, err := xpub.ECPubKey()
+		if err != nil {
+			return "", err
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sortPubKeys(pubKeys)
+
+	var buf bytes.Buffer
+	buf.WriteByte(byte(m.nRequired))
+	for _, pubKey := range pubKeys {
+		buf.Write(pubKey.SerializeCompressed())
+	}
+	return encodeSegWitAddress("ac", 15, massutil.Hash160(buf.Bytes()))
+}
+
+func (m *multisigInfo) params() *MultisigParams {
+	xpubs := make([]string, 0, len(m.cosignerXpubs))
+	for _, xpub := range m.cosignerXpubs {
+		xpubs = append(xpubs, xpub.String())
+	}
+	return &MultisigParams{
+		RequiredSigs:  m.nRequired,
+		CosignerXpubs: xpubs,
+	}
+}
+
+// sortPubKeys sorts public keys by their compressed serialization, so that
+// all cosigners build the same multisig script.
+func sortPubKeys(pubKeys []*btcec.PublicKey) {
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i].SerializeCompressed(), pubKeys[j].SerializeCompressed()) < 0
+	})
+}
@@ -26,4 +26,16 @@ type WalletParams struct {
 	ExternalIndex     uint32
 	InternalIndex     uint32
 	AddressGapLimit   uint32
+	// Multisig is set when importing a multisig keystore
+	Multisig *MultisigParams
+	// AccountXpub is set when importing a watch-only keystore
+	AccountXpub string
+}
+
+// MultisigParams describes an m-of-n multisig keystore, the own key being
+// one of the n keys.
+type MultisigParams struct {
+	RequiredSigs int
+	// account extended public keys of the other cosigners
+	CosignerXpubs []string
 }
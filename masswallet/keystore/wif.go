@@ -0,0 +1,64 @@
+package keystore
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/massutil/base58"
+)
+
+// compressMagic is the byte following the private key of a WIF, which
+// indicates the compressed public key is used.
+const compressMagic byte = 0x01
+
+// DecodeWIF decodes a private key in wallet import format. The key must be
+// encoded with net.PrivateKeyID, with or without the compression flag.
+func DecodeWIF(wif string, net *config.Params) (*btcec.PrivateKey, error) {
+	decoded, netID, err := base58.CheckDecode(wif)
+	if err != nil {
+		return nil, ErrInvalidWIF
+	}
+	if netID != net.PrivateKeyID {
+		return nil, ErrInvalidWIF
+	}
+	switch len(decoded) {
+	case btcec.PrivKeyBytesLen:
+	case btcec.PrivKeyBytesLen + 1:
+		if decoded[btcec.PrivKeyBytesLen] != compressMagic {
+			return nil, ErrInvalidWIF
+		}
+	default:
+		return nil, ErrInvalidWIF
+	}
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), decoded[:btcec.PrivKeyBytesLen])
+	if privKey.D.Sign() == 0 || privKey.D.Cmp(btcec.S256().N) >= 0 {
+		return nil, ErrInvalidWIF
+	}
+	return privKey, nil
+}
+
+// EncodeWIF encodes privKey in wallet import format, with the compression
+// flag.
+func EncodeWIF(privKey *btcec.PrivateKey, net *config.Params) string {
+	payload := make([]byte, 0, btcec.PrivKeyBytesLen+1)
+	payload = append(payload, paddedPrivKeyBytes(privKey)...)
+	payload = append(payload, compressMagic)
+	return base58.CheckEncode(payload, net.PrivateKeyID)
+}
+
+// NewSingleKeyWitnessAddress returns the witness script and address of
+// pubKey, the same as of a managed address of a single signature keystore.
+func NewSingleKeyWitnessAddress(pubKey *btcec.PublicKey, net *config.Params) ([]byte, massutil.Address, error) {
+	return NewNonPersistentWitSAddrForBtcec([]*btcec.PublicKey{pubKey}, nRequiredDefault,
+		massutil.AddressClassWitnessV0, net)
+}
+
+func paddedPrivKeyBytes(privKey *btcec.PrivateKey) []byte {
+	b := privKey.D.Bytes()
+	if len(b) >= btcec.PrivKeyBytesLen {
+		return b
+	}
+	padded := make([]byte, btcec.PrivKeyBytesLen)
+	copy(padded[btcec.PrivKeyBytesLen-len(b):], b)
+	return padded
+}
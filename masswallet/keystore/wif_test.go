@@ -0,0 +1,78 @@
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/massutil"
+)
+
+func TestDecodeWIF(t *testing.T) {
+	keyBytes, _ := hex.DecodeString("0c28fca386c7a227600b2fe50b7cae11ec86d3bf1fbe471be89827e19d72aa1d")
+
+	tests := []struct {
+		name    string
+		wif     string
+		wantErr error
+	}{
+		{"uncompressed", "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ", nil},
+		{"compressed", "KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617", nil},
+		{"bad checksum", "KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98618", ErrInvalidWIF},
+		{"not base58", "0OIl", ErrInvalidWIF},
+		{"empty", "", ErrInvalidWIF},
+	}
+	for _, test := range tests {
+		privKey, err := DecodeWIF(test.wif, &config.ChainParams)
+		if err != test.wantErr {
+			t.Fatalf("%s: got error %v, want %v", test.name, err, test.wantErr)
+		}
+		if err != nil {
+			continue
+		}
+		if !bytes.Equal(paddedPrivKeyBytes(privKey), keyBytes) {
+			t.Fatalf("%s: got key %x", test.name, privKey.Serialize())
+		}
+	}
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeWIF(EncodeWIF(privKey, &config.ChainParams), &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.D.Cmp(privKey.D) != 0 {
+		t.Fatal("round trip mismatched")
+	}
+}
+
+func TestNewSingleKeyWitnessAddress(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := (*btcec.PublicKey)(&privKey.PublicKey)
+	mAddr, err := newManagedAddressWithoutPrivKey("ks", DerivationPath{}, pubKey, nil, nRequiredDefault,
+		massutil.AddressClassWitnessV0, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	redeemScript, addr, err := NewSingleKeyWitnessAddress(pubKey, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr.EncodeAddress() != mAddr.String() {
+		t.Fatalf("got address %s, want %s", addr.EncodeAddress(), mAddr.String())
+	}
+	script, err := mAddr.RedeemScript(&config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(redeemScript, script) {
+		t.Fatal("redeem script mismatched")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"container/list"
 	"fmt"
 	"runtime/debug"
+	"sort"
 	"sync"
 	"time"
 
@@ -44,6 +45,11 @@ type NtfnsHandler struct {
 
 	sigSuspend chan struct{}
 	sigResume  chan struct{}
+
+	events *eventHub
+
+	// webhooks is nil if no webhook url is configured
+	webhooks *webhookManager
 }
 
 // NewNtfnsHandler ...
@@ -59,6 +65,8 @@ func NewNtfnsHandler(w *WalletManager) (*NtfnsHandler, error) {
 		sigSuspend: make(chan struct{}),
 		sigResume:  make(chan struct{}),
 		quit:       make(chan struct{}),
+
+		events: newEventHub(),
 	}
 	var syncedTo *txmgr.BlockMeta
 	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) (err error) {
@@ -69,6 +77,13 @@ func NewNtfnsHandler(w *WalletManager) (*NtfnsHandler, error) {
 		return nil, err
 	}
 	h.bestBlock = *syncedTo
+
+	if wh := w.config.Webhook; wh != nil && len(wh.Urls) > 0 {
+		h.webhooks, err = newWebhookManager(w.db, wh)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return h, nil
 }
 
@@ -150,12 +165,18 @@ func (h *NtfnsHandler) Start() error {
 	h.quitWg.Add(2)
 	go handle(h)
 	go worker(h)
+	if h.webhooks != nil {
+		h.webhooks.start()
+	}
 	return nil
 }
 
 func (h *NtfnsHandler) Stop() {
 	close(h.quit)
 	h.quitWg.Wait()
+	if h.webhooks != nil {
+		h.webhooks.stop()
+	}
 	h.walletMgr.CloseDB()
 }
 
@@ -197,8 +218,18 @@ func handle(h *NtfnsHandler) {
 }
 
 func (h *NtfnsHandler) onRelevantTx(rec *txmgr.TxRecord) error {
-	err := mwdb.Update(h.walletMgr.db, func(tx mwdb.DBTransaction) error {
-		return h.walletMgr.txStore.AddRelevantTx(tx, nil, rec, nil)
+	var replaced []wire.Hash
+	err := mwdb.Update(h.walletMgr.db, func(tx mwdb.DBTransaction) (err error) {
+		// rec may replace unmined transactions spending the same outputs
+		replaced, err = h.walletMgr.txStore.RemoveUnminedDoubleSpends(tx, rec)
+		if err != nil {
+			return err
+		}
+		err = h.walletMgr.txStore.AddRelevantTx(tx, nil, rec, nil)
+		if err != nil || h.webhooks == nil {
+			return err
+		}
+		return h.webhooks.onReceived(tx, rec, nil)
 	})
 	if err != nil {
 		logging.VPrint(logging.ERROR, "Cannot add relevant transaction",
@@ -206,8 +237,25 @@ func (h *NtfnsHandler) onRelevantTx(rec *txmgr.TxRecord) error {
 				"tx":  rec.Hash.String(),
 				"err": err,
 			})
+		return err
 	}
-	return err
+	h.memMtx.Lock()
+	for _, hash := range replaced {
+		delete(h.mempool, hash)
+		logging.CPrint(logging.INFO, "unmined transaction replaced", logging.LogFormat{
+			"tx":          hash.String(),
+			"replacement": rec.Hash.String(),
+		})
+	}
+	h.mempool[rec.Hash] = struct{}{}
+	h.memMtx.Unlock()
+
+	// events are only published once the record has been committed
+	h.events.publish(txEvents(EventMempoolTx, rec, nil)...)
+	if h.webhooks != nil {
+		h.webhooks.notify()
+	}
+	return nil
 }
 
 func (h *NtfnsHandler) onRelevantBlockConnected(tx mwdb.DBTransaction, readyWallets map[string]struct{},
@@ -530,16 +578,14 @@ func (h *NtfnsHandler) filterTx(tx *wire.MsgTx, blockMeta *txmgr.BlockMeta,
 		if err := h.onRelevantTx(rec); err != nil {
 			return false, nil, err
 		}
-		h.memMtx.Lock()
-		h.mempool[rec.Hash] = struct{}{}
-		h.memMtx.Unlock()
 	}
 
 	return true, rec, nil
 }
 
 func (h *NtfnsHandler) filterBlock(dbtx mwdb.DBTransaction, readyWallets map[string]struct{},
-	block *wire.MsgBlock, addedExpireMempool map[uint64]map[wire.Hash]struct{}) (err error) {
+	block *wire.MsgBlock, addedExpireMempool map[uint64]map[wire.Hash]struct{},
+	addedEvents map[uint64][]*WalletEvent) (err error) {
 
 	blockMeta := &txmgr.BlockMeta{
 		Hash:      block.BlockHash(),
@@ -590,6 +636,16 @@ func (h *NtfnsHandler) filterBlock(dbtx mwdb.DBTransaction, readyWallets map[str
 
 	addedExpireMempool[block.Header.Height] = confirmedTxs
 
+	events := make([]*WalletEvent, 0, len(relevantTxs)+1)
+	for _, rec := range relevantTxs {
+		events = append(events, txEvents(EventTxConfirmed, rec, blockMeta)...)
+	}
+	addedEvents[block.Header.Height] = append(events, &WalletEvent{
+		Type:      EventBlockConnected,
+		BlockHash: blockMeta.Hash,
+		Height:    blockMeta.Height,
+	})
+
 	err = h.onRelevantBlockConnected(dbtx, readyWallets, blockMeta, relevantTxs)
 	if err != nil {
 		logging.VPrint(logging.ERROR, "onRelevantBlockConnected error",
@@ -600,9 +656,49 @@ func (h *NtfnsHandler) filterBlock(dbtx mwdb.DBTransaction, readyWallets map[str
 			})
 		return err
 	}
+
+	// only the coinbase, coming first, could pay staking rewards
+	if len(relevantTxs) > 0 {
+		if err = h.walletMgr.txStore.AddStakingRewards(dbtx, relevantTxs[0], blockMeta); err != nil {
+			logging.CPrint(logging.ERROR, "failed to record staking rewards",
+				logging.LogFormat{
+					"block":  blockMeta.Hash.String(),
+					"height": blockMeta.Height,
+					"err":    err,
+				})
+			return err
+		}
+	}
+
+	if h.webhooks != nil {
+		if err = h.onWebhookBlockConnected(dbtx, blockMeta, relevantTxs); err != nil {
+			logging.CPrint(logging.ERROR, "failed to record webhook events",
+				logging.LogFormat{
+					"block":  blockMeta.Hash.String(),
+					"height": blockMeta.Height,
+					"err":    err,
+				})
+			return err
+		}
+	}
 	return h.walletMgr.syncStore.SetSyncedTo(dbtx, blockMeta)
 }
 
+// onWebhookBlockConnected records the webhook events of relevantTxs, and
+// queues the pending events that reach their confirmations at blockMeta.
+func (h *NtfnsHandler) onWebhookBlockConnected(dbtx mwdb.DBTransaction, blockMeta *txmgr.BlockMeta,
+	relevantTxs []*txmgr.TxRecord) error {
+	for _, rec := range relevantTxs {
+		h.memMtx.Lock()
+		_, seen := h.mempool[rec.Hash]
+		h.memMtx.Unlock()
+		if err := h.webhooks.onBlockTx(dbtx, rec, blockMeta, !seen); err != nil {
+			return err
+		}
+	}
+	return h.webhooks.releasePending(dbtx, blockMeta.Height)
+}
+
 func (h *NtfnsHandler) disconnectBlock(tx mwdb.DBTransaction, height uint64) error {
 	if height == 0 {
 		return fmt.Errorf("genesis block cannot be disconnected")
@@ -619,6 +715,11 @@ func (h *NtfnsHandler) disconnectBlock(tx mwdb.DBTransaction, height uint64) err
 	if err != nil {
 		return err
 	}
+	if h.webhooks != nil {
+		if err = h.webhooks.rollback(tx, height); err != nil {
+			return err
+		}
+	}
 
 	resetHeight := height - 1
 	err = h.walletMgr.syncStore.ResetSyncedTo(tx, resetHeight)
@@ -648,7 +749,8 @@ func (h *NtfnsHandler) disconnectBlock(tx mwdb.DBTransaction, height uint64) err
 }
 
 func (h *NtfnsHandler) reorg(dbtx mwdb.DBTransaction, currentBest txmgr.BlockMeta, newBest *wire.MsgBlock,
-	rollbackBlock map[uint64]struct{}, addedExpireMempool map[uint64]map[wire.Hash]struct{}) error {
+	rollbackBlock map[uint64]struct{}, addedExpireMempool map[uint64]map[wire.Hash]struct{},
+	addedEvents map[uint64][]*WalletEvent) error {
 
 	blocksToConnect := list.New()
 
@@ -739,7 +841,7 @@ func (h *NtfnsHandler) reorg(dbtx mwdb.DBTransaction, currentBest txmgr.BlockMet
 	for blocksToConnect.Front() != nil {
 		block := blocksToConnect.Front().Value.(*wire.MsgBlock)
 
-		if err := h.filterBlock(dbtx, readyWallets, block, addedExpireMempool); err != nil {
+		if err := h.filterBlock(dbtx, readyWallets, block, addedExpireMempool, addedEvents); err != nil {
 			return err
 		}
 
@@ -942,6 +1044,10 @@ func (h *NtfnsHandler) asyncImport(walletId string) (finish bool, err error) {
 					}
 					return err
 				}
+				err = h.walletMgr.txStore.AddStakingRewards(dbtx, rec, blockMeta)
+				if err != nil {
+					return err
+				}
 				added = append(added, msg.TxHash())
 			}
 
@@ -969,6 +1075,17 @@ func (h *NtfnsHandler) asyncImport(walletId string) (finish bool, err error) {
 		return false, err
 	}
 
+	ev := &WalletEvent{
+		Type:         EventImportProgress,
+		WalletID:     walletId,
+		SyncedHeight: stop,
+		BestHeight:   h.bestBlock.Height,
+	}
+	if finish {
+		ev.Type = EventImportFinished
+	}
+	h.events.publish(ev)
+
 	// update mem pol
 	for height, added := range heightAdded {
 		m, ok := h.expiredMempool[height]
@@ -991,7 +1108,7 @@ func (h *NtfnsHandler) asyncRemove(walletId string) error {
 		return nil
 	}
 
-	h.suspend(true, "[asyncRemove-1] deleting balance, address, staking/binding histories", logging.LogFormat{"walletId": walletId})
+	h.suspend(true, "[asyncRemove-1] deleting balance, address, staking/binding histories, tx histories, staking rewards, coin controls", logging.LogFormat{"walletId": walletId})
 	err = mwdb.Update(h.walletMgr.db, func(wtx mwdb.DBTransaction) error {
 		err := h.walletMgr.utxoStore.RemoveUnspentByWalletId(wtx, walletId)
 		if err != nil {
@@ -1008,6 +1125,21 @@ func (h *NtfnsHandler) asyncRemove(walletId string) error {
 			logging.CPrint(logging.ERROR, "RemoveGameHistoryByWalletId error", logging.LogFormat{"err": err})
 			return err
 		}
+		err = h.walletMgr.txStore.RemoveTxHistoryByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemoveTxHistoryByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
+		err = h.walletMgr.txStore.RemoveStakingRewardsByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemoveStakingRewardsByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
+		err = h.walletMgr.utxoStore.RemoveUtxoControlsByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemoveUtxoControlsByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
 
 		return h.walletMgr.utxoStore.RemoveMinedBalance(wtx, walletId)
 	})
@@ -1016,6 +1148,7 @@ func (h *NtfnsHandler) asyncRemove(walletId string) error {
 		logging.CPrint(logging.ERROR, "[asyncRemove-1] failed", logging.LogFormat{"err": err})
 		return err
 	}
+	h.events.publish(&WalletEvent{Type: EventRemoveProgress, WalletID: walletId})
 
 	for {
 		select {
@@ -1054,6 +1187,7 @@ func (h *NtfnsHandler) asyncRemove(walletId string) error {
 			h.RemoveMempoolTx(removedTx)
 
 			if finish {
+				h.events.publish(&WalletEvent{Type: EventRemoveFinished, WalletID: walletId})
 				return nil
 			}
 		}
@@ -1102,20 +1236,21 @@ func (h *NtfnsHandler) processConnectedBlock(newBlock *wire.MsgBlock) error {
 
 	rollbackBlock := make(map[uint64]struct{})
 	addedExpireMempool := make(map[uint64]map[wire.Hash]struct{})
+	addedEvents := make(map[uint64][]*WalletEvent)
 	err := mwdb.Update(h.walletMgr.db, func(tx mwdb.DBTransaction) error {
 		if newBlock.Header.Previous == bestBlock.Hash {
 			readyWallets, err := h.getReadyWallets(tx)
 			if err != nil {
 				return err
 			}
-			if err := h.filterBlock(tx, readyWallets, newBlock, addedExpireMempool); err != nil {
+			if err := h.filterBlock(tx, readyWallets, newBlock, addedExpireMempool, addedEvents); err != nil {
 				logging.CPrint(logging.ERROR, "failed to filter block", logging.LogFormat{"err": err})
 				return err
 			}
 			return nil
 		}
 
-		err := h.reorg(tx, bestBlock, newBlock, rollbackBlock, addedExpireMempool)
+		err := h.reorg(tx, bestBlock, newBlock, rollbackBlock, addedExpireMempool, addedEvents)
 		if err != nil {
 			if err != ErrMaybeChainRevoked {
 				logging.CPrint(logging.ERROR, "failed to reorg",
@@ -1165,11 +1300,38 @@ func (h *NtfnsHandler) processConnectedBlock(newBlock *wire.MsgBlock) error {
 		bestBlock.Timestamp = newBlock.Header.Timestamp
 		h.bestBlock = bestBlock
 		h.memMtx.Unlock()
+
+		h.publishBlockEvents(rollbackBlock, addedEvents)
+		if h.webhooks != nil {
+			h.webhooks.notify()
+		}
 	}
 
 	return err
 }
 
+// publishBlockEvents sends the rollbacks from the highest block, followed by
+// the connected blocks from the lowest.
+func (h *NtfnsHandler) publishBlockEvents(rollbackBlock map[uint64]struct{}, addedEvents map[uint64][]*WalletEvent) {
+	rollbackHeights := make([]uint64, 0, len(rollbackBlock))
+	for height := range rollbackBlock {
+		rollbackHeights = append(rollbackHeights, height)
+	}
+	sort.Slice(rollbackHeights, func(i, j int) bool { return rollbackHeights[i] > rollbackHeights[j] })
+	for _, height := range rollbackHeights {
+		h.events.publish(&WalletEvent{Type: EventBlockDisconnected, Height: height})
+	}
+
+	addedHeights := make([]uint64, 0, len(addedEvents))
+	for height := range addedEvents {
+		addedHeights = append(addedHeights, height)
+	}
+	sort.Slice(addedHeights, func(i, j int) bool { return addedHeights[i] < addedHeights[j] })
+	for _, height := range addedHeights {
+		h.events.publish(addedEvents[height]...)
+	}
+}
+
 func (h *NtfnsHandler) proccessReceivedTx(tx *wire.MsgTx) error {
 	knownBestHeight := h.walletMgr.ChainIndexerSyncedHeight()
 	bestPeer := h.walletMgr.server.SyncManager().BestPeer()
@@ -0,0 +1,99 @@
+package masswallet
+
+import (
+	"sort"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/logging"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/signer"
+)
+
+// OfflineSigner signs partially signed transactions with the keystores of a
+// wallet database on an air-gapped machine. It opens the keystore bucket
+// only, so neither the chain database nor a synced node is needed, and every
+// input of the packets it signs must carry the output it spends.
+type OfflineSigner struct {
+	chainParams *config.Params
+	ksmgr       *keystore.KeystoreManager
+}
+
+func NewOfflineSigner(db mwdb.DB, chainParams *config.Params, pubpass string) (*OfflineSigner, error) {
+	if db == nil {
+		logging.CPrint(logging.ERROR, "db is nil", logging.LogFormat{
+			"err": ErrNilDB,
+		})
+		return nil, ErrNilDB
+	}
+
+	s := &OfflineSigner{chainParams: chainParams}
+	err := mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to get bucket", logging.LogFormat{
+				"err": err,
+			})
+			return err
+		}
+		s.ksmgr, err = keystore.NewKeystoreManager(bucket, []byte(pubpass), chainParams)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to new keystore", logging.LogFormat{
+				"err": err,
+			})
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Wallets returns the sorted ids of the wallets in the database.
+func (s *OfflineSigner) Wallets() []string {
+	ids := s.ksmgr.ListKeystoreNames()
+	sort.Strings(ids)
+	return ids
+}
+
+// SignPsbt adds the signatures of walletID to the packet, filling in the
+// witness scripts and derivation paths of its inputs and outputs first. It
+// returns the number of signatures added.
+func (s *OfflineSigner) SignPsbt(walletID string, password []byte, flag string, p *psbt.Packet) (int, error) {
+	ks, err := s.ksmgr.GetAddrManagerByAccountID(walletID)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "wallet not found", logging.LogFormat{
+			"walletId": walletID,
+			"err":      err,
+		})
+		return 0, err
+	}
+	hashType, err := parseSigHashFlag(flag)
+	if err != nil {
+		return 0, err
+	}
+	if err = p.SanityCheck(); err != nil {
+		return 0, err
+	}
+	for i, pIn := range p.Inputs {
+		if len(pIn.FinalWitness) == 0 && pIn.WitnessUtxo == nil {
+			logging.CPrint(logging.ERROR, "spent output of input is missing", logging.LogFormat{
+				"index": i,
+			})
+			return 0, psbt.ErrMissingUtxo
+		}
+	}
+	if err = updatePsbtScripts(p, ks, s.chainParams); err != nil {
+		return 0, err
+	}
+	signed, err := signPsbt(signer.NewKeystoreSigner(s.ksmgr), ks, s.chainParams, password, p, hashType)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "Failed to sign the psbt", logging.LogFormat{
+			"err": err,
+		})
+		return 0, err
+	}
+	return signed, nil
+}
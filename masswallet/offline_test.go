@@ -0,0 +1,104 @@
+package masswallet
+
+import (
+	"testing"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+func TestOfflineSigner_SignPsbt(t *testing.T) {
+	databaseDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("new databaseDb error")
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testOfflineSigner")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{databaseDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	walletId, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = w.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	addr, err := w.NewAddress(0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	decoded, err := massutil.DecodeAddress(addr, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the signer only opens the keystore bucket of the wallet database
+	signer, err := NewOfflineSigner(walletDb, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new offline signer error", err.Error())
+	}
+	if ids := signer.Wallets(); len(ids) != 1 || ids[0] != walletId {
+		t.Fatalf("unexpected wallets %v", ids)
+	}
+
+	const value = 5e8
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: wire.DoubleHashH([]byte("offline")), Index: 0}, nil))
+	tx.AddTxOut(wire.NewTxOut(value-1e6, pkScript))
+
+	p, err := psbt.New(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = signer.SignPsbt(walletId, []byte(privPassphrase), "ALL", p); err != psbt.ErrMissingUtxo {
+		t.Fatalf("expected ErrMissingUtxo, got %v", err)
+	}
+	p.Inputs[0].WitnessUtxo = wire.NewTxOut(value, pkScript)
+
+	if _, err = signer.SignPsbt("unknown", []byte(privPassphrase), "ALL", p); err != keystore.ErrAccountNotFound {
+		t.Fatalf("expected ErrAccountNotFound, got %v", err)
+	}
+	if _, err = signer.SignPsbt(walletId, []byte(privPassphrase), "ANY", p); err != ErrInvalidFlag {
+		t.Fatalf("expected ErrInvalidFlag, got %v", err)
+	}
+
+	signed, err := signer.SignPsbt(walletId, []byte(privPassphrase), "ALL", p)
+	if err != nil {
+		t.Fatal("sign psbt error", err.Error())
+	}
+	if signed != 1 {
+		t.Fatalf("expected 1 signature, got %d", signed)
+	}
+	if len(p.Outputs[0].Derivations) != 1 {
+		t.Fatal("derivation of output is missing")
+	}
+	if err = psbt.Finalize(p, &config.ChainParams); err != nil {
+		t.Fatal("finalize error", err.Error())
+	}
+	signedTx, err := psbt.Extract(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm, err := txscript.NewEngine(pkScript, signedTx, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(signedTx), value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = vm.Execute(); err != nil {
+		t.Fatal(err)
+	}
+}
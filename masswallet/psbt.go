@@ -0,0 +1,191 @@
+package masswallet
+
+import (
+	"bytes"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/keystore/hdkeychain"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/masswallet/utils"
+	"massnet.org/mass-wallet/wire"
+)
+
+// CreatePsbt wraps an unsigned transaction spending outputs of the current
+// wallet into a partially signed transaction.
+func (w *WalletManager) CreatePsbt(tx *wire.MsgTx) (*psbt.Packet, error) {
+	if w.ksmgr.CurrentKeystore() == nil {
+		return nil, ErrNoWalletInUse
+	}
+	p, err := psbt.New(tx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to create psbt", logging.LogFormat{
+			"err": err,
+		})
+		return nil, err
+	}
+	if err = w.updatePsbt(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// SignPsbt adds the signatures of the current wallet to the packet. It
+// returns the number of signatures added.
+func (w *WalletManager) SignPsbt(password []byte, flag string, p *psbt.Packet) (int, error) {
+	if w.ksmgr.CurrentKeystore() == nil {
+		logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{
+			"err": ErrNoWalletInUse,
+		})
+		return 0, ErrNoWalletInUse
+	}
+	hashType, err := parseSigHashFlag(flag)
+	if err != nil {
+		return 0, err
+	}
+	if err = p.SanityCheck(); err != nil {
+		return 0, err
+	}
+	if err = w.updatePsbt(p); err != nil {
+		return 0, err
+	}
+	signed, err := w.signWitnessTx(password, p, hashType)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "Failed to sign the psbt", logging.LogFormat{
+			"err": err,
+		})
+		return 0, err
+	}
+	return signed, nil
+}
+
+// updatePsbt fills in the spent outputs of the inputs, and the witness
+// scripts and derivation paths of the inputs and outputs belonging to the
+// current wallet.
+func (w *WalletManager) updatePsbt(p *psbt.Packet) error {
+	cache := make(map[wire.Hash]*wire.MsgTx)
+	for i, txIn := range p.UnsignedTx.TxIn {
+		pIn := p.Inputs[i]
+		if len(pIn.FinalWitness) != 0 || pIn.WitnessUtxo != nil {
+			continue
+		}
+		prevTxOut, err := w.prevOutput(&txIn.PreviousOutPoint, cache)
+		if err != nil {
+			return err
+		}
+		pIn.WitnessUtxo = prevTxOut
+	}
+	return updatePsbtScripts(p, w.ksmgr.CurrentKeystore(), w.chainParams)
+}
+
+// updatePsbtScripts fills in the witness scripts and derivation paths of the
+// inputs and outputs paid to ks. Inputs lacking the spent output are skipped.
+func updatePsbtScripts(p *psbt.Packet, ks *keystore.AddrManager, chainParams *config.Params) error {
+	for i, pIn := range p.Inputs {
+		if len(pIn.FinalWitness) != 0 || pIn.WitnessUtxo == nil {
+			continue
+		}
+		mAddr, err := managedAddressOf(pIn.WitnessUtxo.PkScript, ks, chainParams)
+		if err != nil {
+			// input of other wallet
+			continue
+		}
+		script, err := mAddr.RedeemScript(chainParams)
+		if err != nil {
+			return err
+		}
+		if len(pIn.WitnessScript) == 0 {
+			pIn.WitnessScript = script
+		} else if !bytes.Equal(pIn.WitnessScript, script) {
+			logging.CPrint(logging.ERROR, "witness script of input mismatched", logging.LogFormat{
+				"index":   i,
+				"address": mAddr.String(),
+			})
+			return psbt.ErrConflictingScript
+		}
+		pIn.AddDerivation(mAddr.PubKey().SerializeCompressed(), bip44Path(mAddr.DerivationPath(), chainParams))
+	}
+
+	for i, txOut := range p.UnsignedTx.TxOut {
+		mAddr, err := managedAddressOf(txOut.PkScript, ks, chainParams)
+		if err != nil {
+			continue
+		}
+		script, err := mAddr.RedeemScript(chainParams)
+		if err != nil {
+			return err
+		}
+		pOut := p.Outputs[i]
+		if len(pOut.WitnessScript) == 0 {
+			pOut.WitnessScript = script
+		}
+		pOut.AddDerivation(mAddr.PubKey().SerializeCompressed(), bip44Path(mAddr.DerivationPath(), chainParams))
+	}
+	return nil
+}
+
+// prevOutput returns the unspent output of the current wallet referenced by op.
+func (w *WalletManager) prevOutput(op *wire.OutPoint, cache map[wire.Hash]*wire.MsgTx) (*wire.TxOut, error) {
+	prevTx, ok := cache[op.Hash]
+	if !ok {
+		var err error
+		prevTx, err = w.existsMsgTx(op)
+		if err == txmgr.ErrNotFound {
+			prevTx, err = w.existsUnminedTx(&op.Hash)
+		}
+
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to check previous transaction", logging.LogFormat{
+				"err": err,
+			})
+			return nil, ErrUTXONotExists
+		}
+		cache[op.Hash] = prevTx
+	}
+	// check index
+	if op.Index > uint32(len(prevTx.TxOut)-1) {
+		logging.CPrint(logging.ERROR, "Ouput index number (vout) does not exist for transaction", logging.LogFormat{
+			"index": op.Index,
+		})
+		return nil, ErrInvalidIndex
+	}
+
+	flags, err := w.existsOutPoint(op)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to check previous output", logging.LogFormat{
+			"err": err,
+		})
+		return nil, ErrUTXONotExists
+	}
+	if flags.Spent {
+		logging.CPrint(logging.ERROR, "Ouput index for txid has been spent", logging.LogFormat{
+			"index": op.Index,
+			"txid":  &op.Hash,
+		})
+		return nil, ErrDoubleSpend
+	}
+	return prevTx.TxOut[op.Index], nil
+}
+
+// managedAddressOf returns the address of the keystore paid by pkScript.
+func managedAddressOf(pkScript []byte, ks *keystore.AddrManager, chainParams *config.Params) (*keystore.ManagedAddress, error) {
+	pks, err := utils.ParsePkScript(pkScript, chainParams)
+	if err != nil {
+		return nil, err
+	}
+	return ks.Address(pks.StdEncodeAddress())
+}
+
+// bip44Path returns the full derivation path of a wallet key.
+func bip44Path(path keystore.DerivationPath, chainParams *config.Params) []uint32 {
+	scope := keystore.Net2KeyScope[chainParams.HDCoinType]
+	return []uint32{
+		scope.Purpose + hdkeychain.HardenedKeyStart,
+		scope.Coin + hdkeychain.HardenedKeyStart,
+		path.Account + hdkeychain.HardenedKeyStart,
+		path.Branch,
+		path.Index,
+	}
+}
@@ -0,0 +1,220 @@
+package psbt
+
+import (
+	"bytes"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+// Combine merges the signatures, scripts and derivations of several packets
+// for the same transaction into a new packet.
+func Combine(packets ...*Packet) (*Packet, error) {
+	if len(packets) == 0 {
+		return nil, ErrInvalidFormat
+	}
+	for _, p := range packets {
+		if err := p.SanityCheck(); err != nil {
+			return nil, err
+		}
+	}
+	txHash := packets[0].UnsignedTx.TxHash()
+	combined, err := New(packets[0].UnsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range packets {
+		if p.UnsignedTx.TxHash() != txHash {
+			return nil, ErrMismatchedTx
+		}
+		for i, pIn := range p.Inputs {
+			if err := combined.Inputs[i].merge(pIn); err != nil {
+				return nil, err
+			}
+		}
+		for i, pOut := range p.Outputs {
+			if err := combined.Outputs[i].merge(pOut); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return combined, nil
+}
+
+func (pi *PInput) merge(other *PInput) error {
+	if other.WitnessUtxo != nil {
+		if pi.WitnessUtxo == nil {
+			pi.WitnessUtxo = other.WitnessUtxo
+		} else if pi.WitnessUtxo.Value != other.WitnessUtxo.Value ||
+			!bytes.Equal(pi.WitnessUtxo.PkScript, other.WitnessUtxo.PkScript) {
+			return ErrConflictingUtxo
+		}
+	}
+	if len(other.WitnessScript) != 0 {
+		if len(pi.WitnessScript) == 0 {
+			pi.WitnessScript = other.WitnessScript
+		} else if !bytes.Equal(pi.WitnessScript, other.WitnessScript) {
+			return ErrConflictingScript
+		}
+	}
+	for _, ps := range other.PartialSigs {
+		pi.AddPartialSig(ps.PubKey, ps.Signature)
+	}
+	for _, d := range other.Derivations {
+		pi.AddDerivation(d.PubKey, d.Path)
+	}
+	if len(pi.FinalWitness) == 0 {
+		pi.FinalWitness = other.FinalWitness
+	}
+	return nil
+}
+
+func (po *POutput) merge(other *POutput) error {
+	if len(other.WitnessScript) != 0 {
+		if len(po.WitnessScript) == 0 {
+			po.WitnessScript = other.WitnessScript
+		} else if !bytes.Equal(po.WitnessScript, other.WitnessScript) {
+			return ErrConflictingScript
+		}
+	}
+	for _, d := range other.Derivations {
+		po.AddDerivation(d.PubKey, d.Path)
+	}
+	return nil
+}
+
+// Signatures returns the number of partial signatures matching a key of the
+// witness script, and the number of signatures the script requires.
+func (pi *PInput) Signatures(params *config.Params) (int, int, error) {
+	pubKeys, nRequired, err := parseMultisigScript(pi.WitnessScript, params)
+	if err != nil {
+		return 0, 0, err
+	}
+	return len(pi.orderedSigs(pubKeys)), nRequired, nil
+}
+
+// orderedSigs returns the partial signatures in the order of the public keys
+// of the witness script, as OP_CHECKMULTISIG expects them.
+func (pi *PInput) orderedSigs(pubKeys [][]byte) [][]byte {
+	sigs := make([][]byte, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		for _, ps := range pi.PartialSigs {
+			if bytes.Equal(ps.PubKey, pubKey) {
+				sigs = append(sigs, ps.Signature)
+				break
+			}
+		}
+	}
+	return sigs
+}
+
+// Finalize builds the final witness of every input holding enough partial
+// signatures, and verifies it against the spent output. Inputs lacking
+// signatures are left untouched and ErrNotEnoughSignatures is returned after
+// all other inputs have been finalized.
+func Finalize(p *Packet, params *config.Params) error {
+	if err := p.SanityCheck(); err != nil {
+		return err
+	}
+	var incomplete bool
+	for i := range p.Inputs {
+		err := finalizeInput(p, i, params)
+		if err == ErrNotEnoughSignatures {
+			incomplete = true
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if incomplete {
+		return ErrNotEnoughSignatures
+	}
+	return nil
+}
+
+func finalizeInput(p *Packet, idx int, params *config.Params) error {
+	pIn := p.Inputs[idx]
+	if len(pIn.FinalWitness) != 0 {
+		return nil
+	}
+	if pIn.WitnessUtxo == nil {
+		return ErrMissingUtxo
+	}
+	pubKeys, nRequired, err := parseMultisigScript(pIn.WitnessScript, params)
+	if err != nil {
+		return err
+	}
+	sigs := pIn.orderedSigs(pubKeys)
+	if len(sigs) < nRequired {
+		return ErrNotEnoughSignatures
+	}
+
+	builder := txscript.NewScriptBuilder()
+	for _, sig := range sigs[:nRequired] {
+		builder.AddData(sig)
+	}
+	sigScript, err := builder.Script()
+	if err != nil {
+		return err
+	}
+	witness := [][]byte{sigScript, pIn.WitnessScript}
+
+	// verify on a copy, so that a bad signature never leaks into the packet
+	tx, err := copyTx(p.UnsignedTx)
+	if err != nil {
+		return err
+	}
+	tx.TxIn[idx].Witness = witness
+	hashCache := txscript.NewTxSigHashes(tx)
+	vm, err := txscript.NewEngine(pIn.WitnessUtxo.PkScript, tx, idx,
+		txscript.StandardVerifyFlags, nil, hashCache, pIn.WitnessUtxo.Value)
+	if err == nil {
+		err = vm.Execute()
+	}
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	pIn.FinalWitness = witness
+	pIn.PartialSigs = nil
+	pIn.Derivations = nil
+	return nil
+}
+
+// Extract returns the signed transaction of a finalized packet.
+func Extract(p *Packet) (*wire.MsgTx, error) {
+	if err := p.SanityCheck(); err != nil {
+		return nil, err
+	}
+	if !p.IsComplete() {
+		return nil, ErrIncomplete
+	}
+	tx, err := copyTx(p.UnsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	for i, pIn := range p.Inputs {
+		tx.TxIn[i].Witness = pIn.FinalWitness
+	}
+	return tx, nil
+}
+
+// parseMultisigScript returns the compressed public keys and the number of
+// required signatures of a multisig witness script.
+func parseMultisigScript(script []byte, params *config.Params) ([][]byte, int, error) {
+	if len(script) == 0 {
+		return nil, 0, ErrMissingWitnessScript
+	}
+	class, _, pks, nRequired, err := txscript.ExtractPkScriptAddrs(script, params)
+	if err != nil || class != txscript.MultiSigTy || len(pks) == 0 {
+		return nil, 0, ErrUnsupportedScript
+	}
+	pubKeys := make([][]byte, 0, len(pks))
+	for _, pk := range pks {
+		pubKeys = append(pubKeys, pk.SerializeCompressed())
+	}
+	return pubKeys, nRequired, nil
+}
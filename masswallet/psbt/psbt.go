@@ -0,0 +1,543 @@
+// Package psbt implements a partially signed transaction container for
+// MassNet witness transactions, modelled after BIP0174.
+//
+// A packet carries an unsigned transaction together with everything a signer
+// needs but cannot find in the transaction itself: the amounts and scripts of
+// the spent outputs, the witness scripts, the derivation paths of the keys and
+// the signatures collected so far. Packets are passed around base64-encoded,
+// signed by each cosigner, combined and finally turned into a complete
+// transaction.
+package psbt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"massnet.org/mass-wallet/wire"
+)
+
+// magic prefixes every serialized packet.
+var magic = []byte{'m', 'p', 's', 'b', 't', 0xff}
+
+const (
+	// maxEntrySize bounds keys and values read from untrusted input.
+	maxEntrySize = 1 << 22
+
+	// key types of the global map
+	globalUnsignedTx byte = 0x00
+
+	// key types of the input maps
+	inputWitnessUtxo   byte = 0x01
+	inputPartialSig    byte = 0x02
+	inputWitnessScript byte = 0x05
+	inputDerivation    byte = 0x06
+	inputFinalWitness  byte = 0x08
+
+	// key types of the output maps
+	outputWitnessScript byte = 0x01
+	outputDerivation    byte = 0x02
+)
+
+var (
+	ErrInvalidMagic         = errors.New("invalid psbt magic bytes")
+	ErrInvalidFormat        = errors.New("invalid psbt format")
+	ErrDuplicateKey         = errors.New("duplicate key in psbt")
+	ErrInputHasWitness      = errors.New("unsigned transaction has witness")
+	ErrInputCountMismatch   = errors.New("input count does not match transaction")
+	ErrOutputCountMismatch  = errors.New("output count does not match transaction")
+	ErrMismatchedTx         = errors.New("psbts are not for the same transaction")
+	ErrConflictingScript    = errors.New("conflicting witness script")
+	ErrConflictingUtxo      = errors.New("conflicting witness utxo")
+	ErrMissingUtxo          = errors.New("missing witness utxo")
+	ErrMissingWitnessScript = errors.New("missing witness script")
+	ErrUnsupportedScript    = errors.New("unsupported witness script")
+	ErrNotEnoughSignatures  = errors.New("not enough signatures")
+	ErrInvalidSignature     = errors.New("signatures do not satisfy the spent output")
+	ErrIncomplete           = errors.New("psbt is not finalized")
+)
+
+// PartialSig is a signature of one key over one input.
+type PartialSig struct {
+	PubKey    []byte // compressed public key
+	Signature []byte // DER signature with the sighash type appended
+}
+
+// Derivation maps a public key to its BIP0032 derivation path.
+type Derivation struct {
+	PubKey []byte // compressed public key
+	Path   []uint32
+}
+
+// PInput holds the signing data of one transaction input.
+type PInput struct {
+	WitnessUtxo   *wire.TxOut
+	WitnessScript []byte
+	PartialSigs   []*PartialSig
+	Derivations   []*Derivation
+	FinalWitness  wire.TxWitness
+}
+
+// POutput holds the data a signer needs to recognize its own outputs, such
+// as the change.
+type POutput struct {
+	WitnessScript []byte
+	Derivations   []*Derivation
+}
+
+// Packet is a partially signed transaction.
+type Packet struct {
+	UnsignedTx *wire.MsgTx
+	Inputs     []*PInput
+	Outputs    []*POutput
+}
+
+// New creates an empty packet for the unsigned transaction. The transaction
+// is copied, none of its inputs may carry a witness.
+func New(tx *wire.MsgTx) (*Packet, error) {
+	for _, txIn := range tx.TxIn {
+		if len(txIn.Witness) != 0 {
+			return nil, ErrInputHasWitness
+		}
+	}
+	unsignedTx, err := copyTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	p := &Packet{
+		UnsignedTx: unsignedTx,
+		Inputs:     make([]*PInput, len(tx.TxIn)),
+		Outputs:    make([]*POutput, len(tx.TxOut)),
+	}
+	for i := range p.Inputs {
+		p.Inputs[i] = &PInput{}
+	}
+	for i := range p.Outputs {
+		p.Outputs[i] = &POutput{}
+	}
+	return p, nil
+}
+
+// B64Decode parses a base64-encoded packet.
+func B64Decode(s string) (*Packet, error) {
+	bs, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidFormat
+	}
+	return Parse(bytes.NewReader(bs))
+}
+
+// B64Encode returns the base64 encoding of the serialized packet.
+func (p *Packet) B64Encode() (string, error) {
+	var buf bytes.Buffer
+	if err := p.Serialize(&buf); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// SanityCheck checks that the packet matches its unsigned transaction.
+func (p *Packet) SanityCheck() error {
+	if p.UnsignedTx == nil {
+		return ErrInvalidFormat
+	}
+	if len(p.Inputs) != len(p.UnsignedTx.TxIn) {
+		return ErrInputCountMismatch
+	}
+	if len(p.Outputs) != len(p.UnsignedTx.TxOut) {
+		return ErrOutputCountMismatch
+	}
+	for _, txIn := range p.UnsignedTx.TxIn {
+		if len(txIn.Witness) != 0 {
+			return ErrInputHasWitness
+		}
+	}
+	return nil
+}
+
+// IsComplete returns whether all inputs have been finalized.
+func (p *Packet) IsComplete() bool {
+	for _, pIn := range p.Inputs {
+		if len(pIn.FinalWitness) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// AddPartialSig adds the signature of pubKey unless the input already holds
+// one for that key.
+func (pi *PInput) AddPartialSig(pubKey, sig []byte) bool {
+	for _, ps := range pi.PartialSigs {
+		if bytes.Equal(ps.PubKey, pubKey) {
+			return false
+		}
+	}
+	pi.PartialSigs = append(pi.PartialSigs, &PartialSig{PubKey: pubKey, Signature: sig})
+	return true
+}
+
+// AddDerivation records the derivation path of pubKey unless already known.
+func (pi *PInput) AddDerivation(pubKey []byte, path []uint32) {
+	pi.Derivations = addDerivation(pi.Derivations, pubKey, path)
+}
+
+// AddDerivation records the derivation path of pubKey unless already known.
+func (po *POutput) AddDerivation(pubKey []byte, path []uint32) {
+	po.Derivations = addDerivation(po.Derivations, pubKey, path)
+}
+
+func addDerivation(ds []*Derivation, pubKey []byte, path []uint32) []*Derivation {
+	for _, d := range ds {
+		if bytes.Equal(d.PubKey, pubKey) {
+			return ds
+		}
+	}
+	return append(ds, &Derivation{PubKey: pubKey, Path: path})
+}
+
+// Serialize writes the binary encoding of the packet to w.
+//
+// The encoding is the magic followed by the global map, one map per input and
+// one map per output. A map is a sequence of <key><value> pairs, each one
+// prefixed with its length as a uvarint, and terminated by a zero length key.
+// The first byte of every key is its type.
+func (p *Packet) Serialize(w io.Writer) error {
+	if err := p.SanityCheck(); err != nil {
+		return err
+	}
+	if _, err := w.Write(magic); err != nil {
+		return err
+	}
+
+	txBytes, err := p.UnsignedTx.Bytes(wire.Packet)
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(w, []byte{globalUnsignedTx}, txBytes); err != nil {
+		return err
+	}
+	if err := writeSeparator(w); err != nil {
+		return err
+	}
+
+	for _, pIn := range p.Inputs {
+		if err := pIn.serialize(w); err != nil {
+			return err
+		}
+	}
+	for _, pOut := range p.Outputs {
+		if err := pOut.serialize(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parse reads a binary encoded packet from r.
+func Parse(r io.Reader) (*Packet, error) {
+	br := newByteReader(r)
+	var m [6]byte
+	if _, err := io.ReadFull(br, m[:]); err != nil || !bytes.Equal(m[:], magic) {
+		return nil, ErrInvalidMagic
+	}
+
+	var unsignedTx *wire.MsgTx
+	err := readMap(br, func(key, value []byte) error {
+		if key[0] != globalUnsignedTx || len(key) != 1 {
+			// unknown global entries are ignored
+			return nil
+		}
+		if unsignedTx != nil {
+			return ErrDuplicateKey
+		}
+		unsignedTx = &wire.MsgTx{}
+		if err := unsignedTx.SetBytes(value, wire.Packet); err != nil {
+			return ErrInvalidFormat
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if unsignedTx == nil {
+		return nil, ErrInvalidFormat
+	}
+
+	p, err := New(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pIn := range p.Inputs {
+		if err := pIn.deserialize(br); err != nil {
+			return nil, err
+		}
+	}
+	for _, pOut := range p.Outputs {
+		if err := pOut.deserialize(br); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (pi *PInput) serialize(w io.Writer) error {
+	if pi.WitnessUtxo != nil {
+		if err := writeEntry(w, []byte{inputWitnessUtxo}, encodeTxOut(pi.WitnessUtxo)); err != nil {
+			return err
+		}
+	}
+	for _, ps := range pi.PartialSigs {
+		if err := writeEntry(w, append([]byte{inputPartialSig}, ps.PubKey...), ps.Signature); err != nil {
+			return err
+		}
+	}
+	if len(pi.WitnessScript) != 0 {
+		if err := writeEntry(w, []byte{inputWitnessScript}, pi.WitnessScript); err != nil {
+			return err
+		}
+	}
+	for _, d := range pi.Derivations {
+		if err := writeEntry(w, append([]byte{inputDerivation}, d.PubKey...), encodePath(d.Path)); err != nil {
+			return err
+		}
+	}
+	if len(pi.FinalWitness) != 0 {
+		if err := writeEntry(w, []byte{inputFinalWitness}, encodeWitness(pi.FinalWitness)); err != nil {
+			return err
+		}
+	}
+	return writeSeparator(w)
+}
+
+func (pi *PInput) deserialize(r byteReader) error {
+	return readMap(r, func(key, value []byte) error {
+		var err error
+		switch key[0] {
+		case inputWitnessUtxo:
+			if pi.WitnessUtxo != nil {
+				return ErrDuplicateKey
+			}
+			pi.WitnessUtxo, err = decodeTxOut(value)
+		case inputPartialSig:
+			if len(key) == 1 || !pi.AddPartialSig(key[1:], value) {
+				return ErrDuplicateKey
+			}
+		case inputWitnessScript:
+			if pi.WitnessScript != nil {
+				return ErrDuplicateKey
+			}
+			pi.WitnessScript = value
+		case inputDerivation:
+			path, err := decodePath(value)
+			if err != nil {
+				return err
+			}
+			pi.AddDerivation(key[1:], path)
+		case inputFinalWitness:
+			if pi.FinalWitness != nil {
+				return ErrDuplicateKey
+			}
+			pi.FinalWitness, err = decodeWitness(value)
+		}
+		return err
+	})
+}
+
+func (po *POutput) serialize(w io.Writer) error {
+	if len(po.WitnessScript) != 0 {
+		if err := writeEntry(w, []byte{outputWitnessScript}, po.WitnessScript); err != nil {
+			return err
+		}
+	}
+	for _, d := range po.Derivations {
+		if err := writeEntry(w, append([]byte{outputDerivation}, d.PubKey...), encodePath(d.Path)); err != nil {
+			return err
+		}
+	}
+	return writeSeparator(w)
+}
+
+func (po *POutput) deserialize(r byteReader) error {
+	return readMap(r, func(key, value []byte) error {
+		switch key[0] {
+		case outputWitnessScript:
+			if po.WitnessScript != nil {
+				return ErrDuplicateKey
+			}
+			po.WitnessScript = value
+		case outputDerivation:
+			path, err := decodePath(value)
+			if err != nil {
+				return err
+			}
+			po.AddDerivation(key[1:], path)
+		}
+		return nil
+	})
+}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+type simpleByteReader struct {
+	io.Reader
+}
+
+func (r simpleByteReader) ReadByte() (byte, error) {
+	var b [1]byte
+	_, err := io.ReadFull(r.Reader, b[:])
+	return b[0], err
+}
+
+func newByteReader(r io.Reader) byteReader {
+	if br, ok := r.(byteReader); ok {
+		return br
+	}
+	return simpleByteReader{r}
+}
+
+func writeVarBytes(w io.Writer, bs []byte) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(len(bs)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(bs)
+	return err
+}
+
+func readVarBytes(r byteReader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, ErrInvalidFormat
+	}
+	if size > maxEntrySize {
+		return nil, ErrInvalidFormat
+	}
+	bs := make([]byte, size)
+	if _, err := io.ReadFull(r, bs); err != nil {
+		return nil, ErrInvalidFormat
+	}
+	return bs, nil
+}
+
+func writeEntry(w io.Writer, key, value []byte) error {
+	if err := writeVarBytes(w, key); err != nil {
+		return err
+	}
+	return writeVarBytes(w, value)
+}
+
+func writeSeparator(w io.Writer) error {
+	_, err := w.Write([]byte{0x00})
+	return err
+}
+
+// readMap calls fn for each entry of a map until the separator is reached.
+func readMap(r byteReader, fn func(key, value []byte) error) error {
+	for {
+		key, err := readVarBytes(r)
+		if err != nil {
+			return err
+		}
+		if len(key) == 0 {
+			return nil
+		}
+		value, err := readVarBytes(r)
+		if err != nil {
+			return err
+		}
+		if err := fn(key, value); err != nil {
+			return err
+		}
+	}
+}
+
+func encodeTxOut(txOut *wire.TxOut) []byte {
+	var buf bytes.Buffer
+	var value [8]byte
+	binary.LittleEndian.PutUint64(value[:], uint64(txOut.Value))
+	buf.Write(value[:])
+	writeVarBytes(&buf, txOut.PkScript)
+	return buf.Bytes()
+}
+
+func decodeTxOut(bs []byte) (*wire.TxOut, error) {
+	if len(bs) < 8 {
+		return nil, ErrInvalidFormat
+	}
+	value := int64(binary.LittleEndian.Uint64(bs[:8]))
+	r := bytes.NewReader(bs[8:])
+	pkScript, err := readVarBytes(r)
+	if err != nil || r.Len() != 0 {
+		return nil, ErrInvalidFormat
+	}
+	return wire.NewTxOut(value, pkScript), nil
+}
+
+func encodePath(path []uint32) []byte {
+	bs := make([]byte, 4*len(path))
+	for i, p := range path {
+		binary.LittleEndian.PutUint32(bs[4*i:], p)
+	}
+	return bs
+}
+
+func decodePath(bs []byte) ([]uint32, error) {
+	if len(bs)%4 != 0 {
+		return nil, ErrInvalidFormat
+	}
+	path := make([]uint32, len(bs)/4)
+	for i := range path {
+		path[i] = binary.LittleEndian.Uint32(bs[4*i:])
+	}
+	return path, nil
+}
+
+func encodeWitness(witness wire.TxWitness) []byte {
+	var buf bytes.Buffer
+	var count [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(count[:], uint64(len(witness)))
+	buf.Write(count[:n])
+	for _, item := range witness {
+		writeVarBytes(&buf, item)
+	}
+	return buf.Bytes()
+}
+
+func decodeWitness(bs []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(bs)
+	count, err := binary.ReadUvarint(r)
+	if err != nil || count == 0 || count > uint64(len(bs)) {
+		return nil, ErrInvalidFormat
+	}
+	witness := make(wire.TxWitness, 0, count)
+	for i := uint64(0); i < count; i++ {
+		item, err := readVarBytes(r)
+		if err != nil {
+			return nil, err
+		}
+		witness = append(witness, item)
+	}
+	if r.Len() != 0 {
+		return nil, ErrInvalidFormat
+	}
+	return witness, nil
+}
+
+func copyTx(tx *wire.MsgTx) (*wire.MsgTx, error) {
+	bs, err := tx.Bytes(wire.Packet)
+	if err != nil {
+		return nil, err
+	}
+	cp := &wire.MsgTx{}
+	if err := cp.SetBytes(bs, wire.Packet); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
@@ -0,0 +1,230 @@
+package psbt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+var params = &config.ChainParams
+
+type multisigFixture struct {
+	privKeys      []*btcec.PrivateKey
+	witnessScript []byte
+	pkScript      []byte
+	value         int64
+	tx            *wire.MsgTx
+}
+
+func newMultisigFixture(t *testing.T, nRequired, nKeys int) *multisigFixture {
+	f := &multisigFixture{value: 10e8}
+	addrPubKeys := make([]*massutil.AddressPubKey, 0, nKeys)
+	for i := 0; i < nKeys; i++ {
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		addrPubKey, err := massutil.NewAddressPubKey(privKey.PubKey().SerializeCompressed(), params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.privKeys = append(f.privKeys, privKey)
+		addrPubKeys = append(addrPubKeys, addrPubKey)
+	}
+	var err error
+	f.witnessScript, err = txscript.MultiSigScript(addrPubKeys, nRequired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptHash := sha256.Sum256(f.witnessScript)
+	f.pkScript, err = txscript.PayToWitnessScriptHashScript(scriptHash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.tx = wire.NewMsgTx()
+	f.tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: wire.DoubleHashH([]byte("prev")), Index: 1}, nil))
+	f.tx.AddTxOut(wire.NewTxOut(f.value-1e6, f.pkScript))
+	return f
+}
+
+func (f *multisigFixture) newPacket(t *testing.T) *Packet {
+	p, err := New(f.tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Inputs[0].WitnessUtxo = wire.NewTxOut(f.value, f.pkScript)
+	p.Inputs[0].WitnessScript = f.witnessScript
+	return p
+}
+
+func (f *multisigFixture) sign(t *testing.T, p *Packet, keyIdx int) {
+	privKey := f.privKeys[keyIdx]
+	getSign := txscript.SignClosure(func(pub *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
+		return privKey.Sign(hash)
+	})
+	sig, err := txscript.RawTxInWitnessSignature(p.UnsignedTx, txscript.NewTxSigHashes(p.UnsignedTx), 0,
+		f.value, f.witnessScript, txscript.SigHashAll, privKey.PubKey(), getSign)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Inputs[0].AddPartialSig(privKey.PubKey().SerializeCompressed(), sig) {
+		t.Fatal("partial signature already exists")
+	}
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	f := newMultisigFixture(t, 2, 3)
+	p := f.newPacket(t)
+	f.sign(t, p, 1)
+	p.Inputs[0].AddDerivation(f.privKeys[1].PubKey().SerializeCompressed(), []uint32{44 + 0x80000000, 1, 2})
+	p.Outputs[0].WitnessScript = f.witnessScript
+
+	encoded, err := p.B64Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := B64Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.UnsignedTx.TxHash() != p.UnsignedTx.TxHash() {
+		t.Fatal("unsigned tx mismatched")
+	}
+	pIn := decoded.Inputs[0]
+	if pIn.WitnessUtxo.Value != f.value || !bytes.Equal(pIn.WitnessUtxo.PkScript, f.pkScript) {
+		t.Fatal("witness utxo mismatched")
+	}
+	if !bytes.Equal(pIn.WitnessScript, f.witnessScript) {
+		t.Fatal("witness script mismatched")
+	}
+	if len(pIn.PartialSigs) != 1 || !bytes.Equal(pIn.PartialSigs[0].Signature, p.Inputs[0].PartialSigs[0].Signature) {
+		t.Fatal("partial signature mismatched")
+	}
+	if len(pIn.Derivations) != 1 || len(pIn.Derivations[0].Path) != 3 || pIn.Derivations[0].Path[0] != 44+0x80000000 {
+		t.Fatal("derivation mismatched")
+	}
+	if !bytes.Equal(decoded.Outputs[0].WitnessScript, f.witnessScript) {
+		t.Fatal("output witness script mismatched")
+	}
+
+	reencoded, err := decoded.B64Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reencoded != encoded {
+		t.Fatal("encoding is not stable")
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"bm90IGEgcHNidA==",
+		"bXBzYnT/",
+	}
+	for i, test := range tests {
+		if _, err := B64Decode(test); err == nil {
+			t.Errorf("#%d: expected error", i)
+		}
+	}
+
+	p, err := New(wire.NewMsgTx())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Inputs = append(p.Inputs, &PInput{})
+	if _, err := p.B64Encode(); err != ErrInputCountMismatch {
+		t.Fatalf("expected ErrInputCountMismatch, got %v", err)
+	}
+
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, [][]byte{{0x01}}))
+	if _, err := New(tx); err != ErrInputHasWitness {
+		t.Fatalf("expected ErrInputHasWitness, got %v", err)
+	}
+}
+
+func TestCombineAndFinalize(t *testing.T) {
+	f := newMultisigFixture(t, 2, 3)
+
+	p1 := f.newPacket(t)
+	f.sign(t, p1, 2)
+	if err := Finalize(p1, params); err != ErrNotEnoughSignatures {
+		t.Fatalf("expected ErrNotEnoughSignatures, got %v", err)
+	}
+	if _, err := Extract(p1); err != ErrIncomplete {
+		t.Fatalf("expected ErrIncomplete, got %v", err)
+	}
+
+	p2 := f.newPacket(t)
+	p2.Inputs[0].WitnessScript = nil
+	f.sign(t, p2, 0)
+
+	combined, err := Combine(p1, p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, required, err := combined.Inputs[0].Signatures(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signed != 2 || required != 2 {
+		t.Fatalf("expected 2 of 2 signatures, got %d of %d", signed, required)
+	}
+
+	if err := Finalize(combined, params); err != nil {
+		t.Fatal(err)
+	}
+	if !combined.IsComplete() {
+		t.Fatal("packet is not complete")
+	}
+	tx, err := Extract(combined)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm, err := txscript.NewEngine(f.pkScript, tx, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(tx), f.value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCombineMismatched(t *testing.T) {
+	f1 := newMultisigFixture(t, 1, 2)
+	f2 := newMultisigFixture(t, 1, 2)
+	if _, err := Combine(f1.newPacket(t), f2.newPacket(t)); err != ErrMismatchedTx {
+		t.Fatalf("expected ErrMismatchedTx, got %v", err)
+	}
+
+	p1 := f1.newPacket(t)
+	p2 := f1.newPacket(t)
+	p2.Inputs[0].WitnessScript = f2.witnessScript
+	if _, err := Combine(p1, p2); err != ErrConflictingScript {
+		t.Fatalf("expected ErrConflictingScript, got %v", err)
+	}
+}
+
+func TestFinalizeBadSignature(t *testing.T) {
+	f := newMultisigFixture(t, 1, 2)
+	p := f.newPacket(t)
+	f.sign(t, p, 0)
+	sig := p.Inputs[0].PartialSigs[0].Signature
+	sig[len(sig)-2] ^= 0x01
+
+	if err := Finalize(p, params); err == nil {
+		t.Fatal("expected verification error")
+	}
+	if len(p.Inputs[0].FinalWitness) != 0 {
+		t.Fatal("invalid witness leaked into packet")
+	}
+}
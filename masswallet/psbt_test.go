@@ -0,0 +1,122 @@
+package masswallet
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/signer"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+func TestWalletManager_SignPsbt_ExternalSigner(t *testing.T) {
+	databaseDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("new databaseDb error")
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testExternalSigner")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{databaseDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	walletId, mnemonic, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = w.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	addr, err := w.NewAddress(0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	decoded, err := massutil.DecodeAddress(addr, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// serve the mock signer holding the seed of the wallet on a local socket
+	mock, err := signer.NewMockSigner(keystore.NewSeed(mnemonic, privPassphrase), &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sock := filepath.Join(dir, "signer.sock")
+	l, err := net.Listen("unix", sock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				signer.Serve(conn, conn, mock)
+			}()
+		}
+	}()
+	w.extSigner, err = signer.NewSocketSigner("unix:"+sock, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.extSigner.Close()
+
+	const value = 5e8
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: wire.DoubleHashH([]byte("external")), Index: 0}, nil))
+	tx.AddTxOut(wire.NewTxOut(value-1e6, pkScript))
+	p, err := psbt.New(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Inputs[0].WitnessUtxo = wire.NewTxOut(value, pkScript)
+
+	// the passphrase is left to the external signer
+	signed, err := w.SignPsbt(nil, "ALL", p)
+	if err != nil {
+		t.Fatal("sign psbt error", err.Error())
+	}
+	if signed != 1 {
+		t.Fatalf("expected 1 signature, got %d", signed)
+	}
+	if err = psbt.Finalize(p, &config.ChainParams); err != nil {
+		t.Fatal("finalize error", err.Error())
+	}
+	signedTx, err := psbt.Extract(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm, err := txscript.NewEngine(pkScript, signedTx, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(signedTx), value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = vm.Execute(); err != nil {
+		t.Fatal(err)
+	}
+}
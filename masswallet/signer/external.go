@@ -0,0 +1,306 @@
+package signer
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/masswallet/keystore"
+)
+
+const methodSignHash = "sign_hash"
+
+var ErrInvalidAddress = errors.New(`invalid signer address, expect "unix:<path>" or "tcp:<host:port>"`)
+
+// signRequest and signResponse are the messages exchanged with external
+// signers, one JSON object per line.
+type signRequest struct {
+	Id       uint64   `json:"id"`
+	Method   string   `json:"method"`
+	WalletId string   `json:"wallet_id"`
+	PubKey   string   `json:"pubkey"` // hex-encoded compressed public key
+	Path     []uint32 `json:"path"`
+	Hash     string   `json:"hash"` // hex-encoded sighash
+}
+
+type signResponse struct {
+	Id        uint64 `json:"id"`
+	Signature string `json:"signature,omitempty"` // hex-encoded DER signature
+	Error     string `json:"error,omitempty"`
+}
+
+// ExternalSigner forwards the requests to a separate signing process, over a
+// local socket or the stdin/stdout of the process. The connection is set up
+// on the first request and again after any failure, and the signatures are
+// verified against the requested keys.
+type ExternalSigner struct {
+	mu      sync.Mutex
+	dial    func() (io.ReadWriteCloser, error)
+	timeout time.Duration
+	conn    io.ReadWriteCloser
+	reader  *bufio.Reader
+	nextId  uint64
+}
+
+// ParseAddress splits the address "unix:<path>" or "tcp:<host:port>" of an
+// external signer into network and address.
+func ParseAddress(address string) (network, addr string, err error) {
+	i := strings.IndexByte(address, ':')
+	if i < 0 {
+		return "", "", ErrInvalidAddress
+	}
+	network, addr = address[:i], address[i+1:]
+	if (network != "unix" && network != "tcp") || addr == "" {
+		return "", "", ErrInvalidAddress
+	}
+	return network, addr, nil
+}
+
+// NewSocketSigner returns a signer connecting to the signing process listening
+// on address, see ParseAddress.
+func NewSocketSigner(address string, timeout time.Duration) (*ExternalSigner, error) {
+	network, addr, err := ParseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return &ExternalSigner{
+		dial: func() (io.ReadWriteCloser, error) {
+			return net.DialTimeout(network, addr, timeout)
+		},
+		timeout: timeout,
+	}, nil
+}
+
+// NewProcessSigner returns a signer starting the signing process by the
+// command name with args, and serving requests over its stdin and stdout.
+func NewProcessSigner(name string, args []string, timeout time.Duration) *ExternalSigner {
+	return &ExternalSigner{
+		dial: func() (io.ReadWriteCloser, error) {
+			return startProcess(name, args)
+		},
+		timeout: timeout,
+	}
+}
+
+func (s *ExternalSigner) Prepare(ks *keystore.AddrManager) error {
+	return nil
+}
+
+func (s *ExternalSigner) SignHash(req *Request) (*btcec.Signature, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		conn, err := s.dial()
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to connect external signer", logging.LogFormat{"err": err})
+			return nil, ErrSignerUnavailable
+		}
+		s.conn, s.reader = conn, bufio.NewReader(conn)
+	}
+
+	s.nextId++
+	msg := &signRequest{
+		Id:       s.nextId,
+		Method:   methodSignHash,
+		WalletId: req.WalletId,
+		PubKey:   hex.EncodeToString(req.PubKey.SerializeCompressed()),
+		Path:     req.Path,
+		Hash:     hex.EncodeToString(req.Hash),
+	}
+	resp, err := s.roundTrip(msg)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to request external signer", logging.LogFormat{
+			"id":  msg.Id,
+			"err": err,
+		})
+		s.conn.Close()
+		s.conn, s.reader = nil, nil
+		if err == ErrSignerTimeout {
+			return nil, err
+		}
+		return nil, ErrSignerUnavailable
+	}
+	if resp.Error != "" {
+		logging.CPrint(logging.ERROR, "external signer rejected the request", logging.LogFormat{
+			"id":     msg.Id,
+			"pubkey": msg.PubKey,
+			"err":    resp.Error,
+		})
+		return nil, ErrSignerRejected
+	}
+
+	bs, err := hex.DecodeString(resp.Signature)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	sig, err := btcec.ParseDERSignature(bs, btcec.S256())
+	if err != nil || !sig.Verify(req.Hash, req.PubKey) {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
+
+func (s *ExternalSigner) Release() {}
+
+// Close closes the connection to the signing process, and stops the process
+// started by the signer.
+func (s *ExternalSigner) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn, s.reader = nil, nil
+	return err
+}
+
+// roundTrip sends msg and waits for its response, closing the connection to
+// interrupt if the signer does not respond in time.
+func (s *ExternalSigner) roundTrip(msg *signRequest) (*signResponse, error) {
+	type result struct {
+		resp *signResponse
+		err  error
+	}
+	conn, reader := s.conn, s.reader
+	ch := make(chan result, 1)
+	go func() {
+		resp, err := exchange(conn, reader, msg)
+		ch <- result{resp, err}
+	}()
+
+	timer := time.NewTimer(s.timeout)
+	defer timer.Stop()
+	select {
+	case r := <-ch:
+		return r.resp, r.err
+	case <-timer.C:
+		conn.Close()
+		return nil, ErrSignerTimeout
+	}
+}
+
+func exchange(w io.Writer, r *bufio.Reader, msg *signRequest) (*signResponse, error) {
+	if err := json.NewEncoder(w).Encode(msg); err != nil {
+		return nil, err
+	}
+	for {
+		line, err := r.ReadBytes('\n')
+		if err != nil {
+			return nil, err
+		}
+		resp := &signResponse{}
+		if err = json.Unmarshal(line, resp); err != nil {
+			return nil, err
+		}
+		// skip responses to former requests
+		if resp.Id == msg.Id {
+			return resp, nil
+		}
+	}
+}
+
+// Serve serves the requests read from r by s and writes the responses to w,
+// until r is exhausted. It is the main loop of external signing processes.
+func Serve(r io.Reader, w io.Writer, s Signer) error {
+	reader := bufio.NewReader(r)
+	enc := json.NewEncoder(w)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(strings.TrimSpace(string(line))) > 0 {
+			if err := enc.Encode(serveRequest(line, s)); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func serveRequest(line []byte, s Signer) *signResponse {
+	msg := &signRequest{}
+	if err := json.Unmarshal(line, msg); err != nil {
+		return &signResponse{Error: err.Error()}
+	}
+	resp := &signResponse{Id: msg.Id}
+	if msg.Method != methodSignHash {
+		resp.Error = "unknown method " + msg.Method
+		return resp
+	}
+	hash, err := hex.DecodeString(msg.Hash)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	bs, err := hex.DecodeString(msg.PubKey)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	pubKey, err := btcec.ParsePubKey(bs, btcec.S256())
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	sig, err := s.SignHash(&Request{
+		WalletId: msg.WalletId,
+		PubKey:   pubKey,
+		Path:     msg.Path,
+		Hash:     hash,
+	})
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	resp.Signature = hex.EncodeToString(sig.Serialize())
+	return resp
+}
+
+// process is the connection to a signing process over its stdin and stdout.
+type process struct {
+	cmd *exec.Cmd
+	io.WriteCloser
+	io.Reader
+	once sync.Once
+	err  error
+}
+
+func startProcess(name string, args []string) (*process, error) {
+	cmd := exec.Command(name, args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &process{cmd: cmd, WriteCloser: stdin, Reader: stdout}, nil
+}
+
+func (p *process) Close() error {
+	p.once.Do(func() {
+		p.WriteCloser.Close()
+		p.cmd.Process.Kill()
+		p.err = p.cmd.Wait()
+	})
+	return p.err
+}
@@ -0,0 +1,51 @@
+package signer
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/keystore/hdkeychain"
+)
+
+// MockSigner signs with the keys derived by the requested paths from the
+// master key of a seed. It is the reference of external signers for local
+// tests, and must not hold the seed of real funds.
+type MockSigner struct {
+	master *hdkeychain.ExtendedKey
+}
+
+func NewMockSigner(seed []byte, net *config.Params) (*MockSigner, error) {
+	master, err := hdkeychain.NewMaster(seed, net)
+	if err != nil {
+		return nil, err
+	}
+	return &MockSigner{master: master}, nil
+}
+
+func (s *MockSigner) Prepare(ks *keystore.AddrManager) error {
+	return nil
+}
+
+func (s *MockSigner) SignHash(req *Request) (*btcec.Signature, error) {
+	if len(req.Path) == 0 {
+		return nil, ErrUnknownKey
+	}
+	key := s.master
+	for _, i := range req.Path {
+		child, err := key.Child(i)
+		if err != nil {
+			return nil, err
+		}
+		key = child
+	}
+	privKey, err := key.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	if !privKey.PubKey().IsEqual(req.PubKey) {
+		return nil, ErrUnknownKey
+	}
+	return privKey.Sign(req.Hash)
+}
+
+func (s *MockSigner) Release() {}
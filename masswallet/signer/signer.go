@@ -0,0 +1,66 @@
+// Package signer provides the signers of transaction sighashes, either the
+// keystores of the wallet, which decrypt the private keys in process memory,
+// or an external signing process, such as a bridge to hardware security
+// modules, served over a local socket or stdin/stdout.
+package signer
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec"
+	"massnet.org/mass-wallet/masswallet/keystore"
+)
+
+var (
+	ErrSignerUnavailable = errors.New("external signer unavailable")
+	ErrSignerTimeout     = errors.New("external signer timed out")
+	ErrSignerRejected    = errors.New("external signer rejected the request")
+	ErrInvalidSignature  = errors.New("invalid signature from external signer")
+	ErrUnknownKey        = errors.New("key not held by signer")
+)
+
+// Request is a request to sign the sighash of a transaction input by the key
+// PubKey of the wallet WalletId.
+type Request struct {
+	WalletId string
+	PubKey   *btcec.PublicKey
+	Path     []uint32 // full BIP44 derivation path of PubKey
+	Hash     []byte
+	Password []byte // private passphrase of keystores, never sent to external signers
+}
+
+// Signer signs the sighashes of transaction inputs.
+type Signer interface {
+	// Prepare is called before signing with the keys of ks, and fails if the
+	// signer can not sign for ks.
+	Prepare(ks *keystore.AddrManager) error
+	SignHash(req *Request) (*btcec.Signature, error)
+	// Release is called when signing is done, so that the key material may be
+	// released.
+	Release()
+}
+
+// KeystoreSigner signs with the private keys of keystores, which is the
+// default signer of wallets.
+type KeystoreSigner struct {
+	ksmgr *keystore.KeystoreManager
+}
+
+func NewKeystoreSigner(ksmgr *keystore.KeystoreManager) *KeystoreSigner {
+	return &KeystoreSigner{ksmgr: ksmgr}
+}
+
+func (s *KeystoreSigner) Prepare(ks *keystore.AddrManager) error {
+	if ks.IsWatchOnly() {
+		return keystore.ErrWatchOnly
+	}
+	return nil
+}
+
+func (s *KeystoreSigner) SignHash(req *Request) (*btcec.Signature, error) {
+	return s.ksmgr.SignHash(req.PubKey, req.Hash, req.Password)
+}
+
+func (s *KeystoreSigner) Release() {
+	s.ksmgr.ReleasePrivKey()
+}
@@ -0,0 +1,188 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/keystore/hdkeychain"
+)
+
+var (
+	testSeed = bytes.Repeat([]byte{0x5a}, 32)
+	testPath = []uint32{44 + hdkeychain.HardenedKeyStart, 1 + hdkeychain.HardenedKeyStart, 1 + hdkeychain.HardenedKeyStart, 0, 3}
+)
+
+// testRequest returns a request to sign by the key of testPath.
+func testRequest(t *testing.T) *Request {
+	master, err := hdkeychain.NewMaster(testSeed, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := master
+	for _, i := range testPath {
+		if key, err = key.Child(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pubKey, err := key.ECPubKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte("sighash"))
+	return &Request{WalletId: "ac10test", PubKey: pubKey, Path: testPath, Hash: hash[:]}
+}
+
+func listenMockSigner(t *testing.T, sock string) net.Listener {
+	mock, err := NewMockSigner(testSeed, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen("unix", sock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				Serve(conn, conn, mock)
+			}()
+		}
+	}()
+	return l
+}
+
+func checkSignerSignHash(t *testing.T, s Signer) {
+	req := testRequest(t)
+	for i := 0; i < 2; i++ {
+		sig, err := s.SignHash(req)
+		if err != nil {
+			t.Fatal("sign hash error", err)
+		}
+		if !sig.Verify(req.Hash, req.PubKey) {
+			t.Fatal("invalid signature")
+		}
+	}
+
+	// key of other path
+	req.Path = append([]uint32{}, testPath...)
+	req.Path[len(req.Path)-1]++
+	if _, err := s.SignHash(req); err != ErrSignerRejected {
+		t.Fatalf("expected ErrSignerRejected, got %v", err)
+	}
+}
+
+func TestSocketSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sock := filepath.Join(dir, "signer.sock")
+
+	s, err := NewSocketSigner("unix:"+sock, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if _, err = s.SignHash(testRequest(t)); err != ErrSignerUnavailable {
+		t.Fatalf("expected ErrSignerUnavailable, got %v", err)
+	}
+
+	// connects once the signer is up
+	l := listenMockSigner(t, sock)
+	defer l.Close()
+	checkSignerSignHash(t, s)
+}
+
+func TestProcessSigner(t *testing.T) {
+	os.Setenv("GO_WANT_SIGNER_PROCESS", "1")
+	defer os.Unsetenv("GO_WANT_SIGNER_PROCESS")
+
+	s := NewProcessSigner(os.Args[0], []string{"-test.run=TestSignerProcess"}, 5*time.Second)
+	defer s.Close()
+	checkSignerSignHash(t, s)
+}
+
+// TestSignerProcess is the signing process started by TestProcessSigner.
+func TestSignerProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_SIGNER_PROCESS") != "1" {
+		return
+	}
+	mock, err := NewMockSigner(testSeed, &config.ChainParams)
+	if err != nil {
+		os.Exit(1)
+	}
+	Serve(os.Stdin, os.Stdout, mock)
+	os.Exit(0)
+}
+
+func TestExternalSigner_Timeout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sock := filepath.Join(dir, "signer.sock")
+
+	// never responds
+	l, err := net.Listen("unix", sock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		ioutil.ReadAll(conn)
+	}()
+
+	s, err := NewSocketSigner("unix:"+sock, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if _, err = s.SignHash(testRequest(t)); err != ErrSignerTimeout {
+		t.Fatalf("expected ErrSignerTimeout, got %v", err)
+	}
+	l.Close()
+
+	// reconnects on the next request
+	l = listenMockSigner(t, sock)
+	defer l.Close()
+	checkSignerSignHash(t, s)
+}
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		network string
+		addr    string
+		err     error
+	}{
+		{"unix:/tmp/signer.sock", "unix", "/tmp/signer.sock", nil},
+		{"tcp:127.0.0.1:9700", "tcp", "127.0.0.1:9700", nil},
+		{"udp:127.0.0.1:9700", "", "", ErrInvalidAddress},
+		{"unix:", "", "", ErrInvalidAddress},
+		{"/tmp/signer.sock", "", "", ErrInvalidAddress},
+	}
+	for _, test := range tests {
+		network, addr, err := ParseAddress(test.address)
+		if network != test.network || addr != test.addr || err != test.err {
+			t.Fatalf("%s: expected (%s, %s, %v), got (%s, %s, %v)",
+				test.address, test.network, test.addr, test.err, network, addr, err)
+		}
+	}
+}
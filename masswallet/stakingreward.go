@@ -0,0 +1,168 @@
+package masswallet
+
+import (
+	"sort"
+	"time"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// StakingRewardDay is the staking reward earned by a staking address of the
+// wallet in a day, in UTC.
+type StakingRewardDay struct {
+	Date           time.Time // start of the day
+	StakingAddress string
+	Blocks         uint64 // number of blocks rewarding the address
+	FirstHeight    uint64
+	LastHeight     uint64
+	Reward         massutil.Amount
+}
+
+// GetStakingRewardHistory returns the staking rewards received by current
+// wallet in blocks with time between from and until, summed up per day and
+// staking address, oldest first. A zero from or until leaves the range open.
+func (w *WalletManager) GetStakingRewardHistory(from, until time.Time) ([]*StakingRewardDay, error) {
+	am := w.ksmgr.CurrentKeystore()
+	if am == nil {
+		return nil, ErrNoWalletInUse
+	}
+
+	minHeight, maxHeight, ok, err := w.blockHeightRange(from, until)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to search block heights", logging.LogFormat{
+			"err":   err,
+			"from":  from,
+			"until": until,
+		})
+		return nil, err
+	}
+	if !ok {
+		return []*StakingRewardDay{}, nil
+	}
+
+	var rewards []*txmgr.StakingReward
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) (err error) {
+		rewards, err = w.txStore.ListStakingRewards(tx, am.Name(), minHeight, maxHeight)
+		return err
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to load staking rewards", logging.LogFormat{
+			"err":      err,
+			"walletId": am.Name(),
+		})
+		return nil, err
+	}
+	return aggregateStakingRewards(rewards, from, until, w.chainParams)
+}
+
+// blockHeightRange returns the heights of the first and the last block of
+// main chain with time between from and until, searched by the block
+// timestamps, which increase with height. A zero from or until leaves the
+// range open, as the zero heights do for ListStakingRewards. It returns false
+// if no block is within the range.
+func (w *WalletManager) blockHeightRange(from, until time.Time) (uint64, uint64, bool, error) {
+	_, best, err := w.chainFetcher.NewestSha()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	// searchHeight returns the lowest height whose block time satisfies f,
+	// or best+1 if none does.
+	searchHeight := func(f func(ts time.Time) bool) (uint64, error) {
+		var searchErr error
+		h := sort.Search(int(best)+1, func(i int) bool {
+			if searchErr != nil {
+				return true
+			}
+			header, err := w.chainFetcher.FetchBlockHeaderByHeight(uint64(i))
+			if err != nil {
+				searchErr = err
+				return true
+			}
+			return f(header.Timestamp)
+		})
+		return uint64(h), searchErr
+	}
+
+	var minHeight, maxHeight uint64
+	if !from.IsZero() {
+		if minHeight, err = searchHeight(func(ts time.Time) bool { return !ts.Before(from) }); err != nil {
+			return 0, 0, false, err
+		}
+		if minHeight > best {
+			return 0, 0, false, nil
+		}
+	}
+	if !until.IsZero() {
+		next, err := searchHeight(func(ts time.Time) bool { return ts.After(until) })
+		if err != nil {
+			return 0, 0, false, err
+		}
+		if next == 0 || next-1 < minHeight {
+			return 0, 0, false, nil
+		}
+		if next <= best {
+			maxHeight = next - 1
+		}
+	}
+	return minHeight, maxHeight, true, nil
+}
+
+// aggregateStakingRewards sums up rewards with block time between from and
+// until per day and staking address.
+func aggregateStakingRewards(rewards []*txmgr.StakingReward, from, until time.Time,
+	chainParams *config.Params) ([]*StakingRewardDay, error) {
+	type dayKey struct {
+		date       int64
+		scriptHash string
+	}
+	days := make(map[dayKey]*StakingRewardDay)
+	ret := make([]*StakingRewardDay, 0)
+	for _, r := range rewards {
+		if !from.IsZero() && r.Block.Timestamp.Before(from) {
+			continue
+		}
+		if !until.IsZero() && r.Block.Timestamp.After(until) {
+			continue
+		}
+		date := r.Block.Timestamp.UTC().Truncate(24 * time.Hour)
+		key := dayKey{date: date.Unix(), scriptHash: string(r.ScriptHash)}
+		day, ok := days[key]
+		if !ok {
+			addr, err := massutil.NewAddressStakingScriptHash(r.ScriptHash, chainParams)
+			if err != nil {
+				return nil, err
+			}
+			day = &StakingRewardDay{
+				Date:           date,
+				StakingAddress: addr.EncodeAddress(),
+				FirstHeight:    r.Block.Height,
+				Reward:         massutil.ZeroAmount(),
+			}
+			days[key] = day
+			ret = append(ret, day)
+		}
+		var err error
+		if day.Reward, err = day.Reward.Add(r.Amount); err != nil {
+			return nil, err
+		}
+		if r.Block.Height < day.FirstHeight {
+			day.FirstHeight = r.Block.Height
+		}
+		if r.Block.Height > day.LastHeight {
+			day.LastHeight = r.Block.Height
+		}
+		day.Blocks++
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		if !ret[i].Date.Equal(ret[j].Date) {
+			return ret[i].Date.Before(ret[j].Date)
+		}
+		return ret[i].StakingAddress < ret[j].StakingAddress
+	})
+	return ret, nil
+}
@@ -0,0 +1,129 @@
+package masswallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+func TestAggregateStakingRewards(t *testing.T) {
+	hashA := bytes.Repeat([]byte{0xaa}, 32)
+	hashB := bytes.Repeat([]byte{0xbb}, 32)
+	day := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	newReward := func(height uint64, ts time.Time, scriptHash []byte, value uint64) *txmgr.StakingReward {
+		amt, err := massutil.NewAmountFromUint(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &txmgr.StakingReward{
+			Block:      txmgr.BlockMeta{Height: height, Timestamp: ts},
+			Amount:     amt,
+			ScriptHash: scriptHash,
+		}
+	}
+	rewards := []*txmgr.StakingReward{
+		newReward(10, day.Add(time.Hour), hashB, 100),
+		newReward(10, day.Add(time.Hour), hashA, 200),
+		newReward(11, day.Add(23*time.Hour), hashB, 300),
+		newReward(12, day.Add(25*time.Hour), hashB, 400),
+		newReward(13, day.Add(49*time.Hour), hashA, 500),
+	}
+
+	days, err := aggregateStakingRewards(rewards, time.Time{}, time.Time{}, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addrA, _ := massutil.NewAddressStakingScriptHash(hashA, &config.ChainParams)
+	addrB, _ := massutil.NewAddressStakingScriptHash(hashB, &config.ChainParams)
+	addresses := map[string]string{addrA.EncodeAddress(): "a", addrB.EncodeAddress(): "b"}
+	sortedB := addrB.EncodeAddress() < addrA.EncodeAddress()
+
+	expected := []struct {
+		date   time.Time
+		addr   string
+		blocks uint64
+		first  uint64
+		last   uint64
+		reward uint64
+	}{
+		{day, "a", 1, 10, 10, 200},
+		{day, "b", 2, 10, 11, 400},
+		{day.Add(24 * time.Hour), "b", 1, 12, 12, 400},
+		{day.Add(48 * time.Hour), "a", 1, 13, 13, 500},
+	}
+	if sortedB {
+		expected[0], expected[1] = expected[1], expected[0]
+	}
+	if len(days) != len(expected) {
+		t.Fatalf("expected %d days, got %d", len(expected), len(days))
+	}
+	for i, exp := range expected {
+		d := days[i]
+		if !d.Date.Equal(exp.date) || addresses[d.StakingAddress] != exp.addr || d.Blocks != exp.blocks ||
+			d.FirstHeight != exp.first || d.LastHeight != exp.last || d.Reward.UintValue() != exp.reward {
+			t.Fatalf("day %d: unexpected %+v", i, d)
+		}
+	}
+
+	// range of time
+	days, err = aggregateStakingRewards(rewards, day.Add(2*time.Hour), day.Add(25*time.Hour), &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(days) != 2 || days[0].Reward.UintValue() != 300 || days[1].Reward.UintValue() != 400 {
+		t.Fatalf("unexpected days in range: %d", len(days))
+	}
+}
+
+func TestWalletManager_blockHeightRange(t *testing.T) {
+	databaseDb, close, err := newTestChainDB(20)
+	if err != nil {
+		t.Fatal("new databaseDb error")
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testBlockHeightRange")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{databaseDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	blockTime := func(height int) time.Time {
+		return blks200[height].MsgBlock().Header.Timestamp
+	}
+
+	tests := []struct {
+		name      string
+		from      time.Time
+		until     time.Time
+		ok        bool
+		minHeight uint64
+		maxHeight uint64
+	}{
+		{"open", time.Time{}, time.Time{}, true, 0, 0},
+		{"exact", blockTime(5), blockTime(10), true, 5, 10},
+		{"between", blockTime(5).Add(time.Second), blockTime(10).Add(-time.Second), true, 6, 9},
+		{"from only", blockTime(5), time.Time{}, true, 5, 0},
+		{"until only", time.Time{}, blockTime(10), true, 0, 10},
+		{"until after best", blockTime(5), blockTime(19).Add(time.Hour), true, 5, 0},
+		{"from after best", blockTime(19).Add(time.Hour), time.Time{}, false, 0, 0},
+		{"until before genesis", time.Time{}, blockTime(0).Add(-time.Hour), false, 0, 0},
+		{"no block between", blockTime(5).Add(time.Second), blockTime(6).Add(-time.Second), false, 0, 0},
+	}
+	for _, test := range tests {
+		minHeight, maxHeight, ok, err := w.blockHeightRange(test.from, test.until)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if ok != test.ok || ok && (minHeight != test.minHeight || maxHeight != test.maxHeight) {
+			t.Fatalf("%s: expected %v [%d, %d], got %v [%d, %d]", test.name,
+				test.ok, test.minHeight, test.maxHeight, ok, minHeight, maxHeight)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package masswallet
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/btcec"
+	"massnet.org/mass-wallet/blockchain"
+	"massnet.org/mass-wallet/consensus"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+// SweptTx is a signed transaction sweeping a private key into the wallet.
+type SweptTx struct {
+	MsgTx   *wire.MsgTx
+	Address string // of current wallet, receiving Amount
+	Amount  massutil.Amount
+	Fee     massutil.Amount
+}
+
+// sweptOutput is an unspent output paying the swept key.
+type sweptOutput struct {
+	OutPoint wire.OutPoint
+	Value    int64
+}
+
+// SweepPrivateKey creates a transaction spending every unspent output of the
+// address of the private key wif, as derived for a single signature wallet,
+// to an unused address of current wallet, see sweepTarget. The transaction is
+// signed with the swept key and pays the fee at feeRate, in MASS per KB. It
+// fails with ErrOverfullUtxo if the outputs don't fit in a standard
+// transaction.
+func (w *WalletManager) SweepPrivateKey(wif string, feeRate massutil.Amount) (*SweptTx, error) {
+	if w.ksmgr.CurrentKeystore() == nil {
+		return nil, ErrNoWalletInUse
+	}
+	privKey, err := keystore.DecodeWIF(wif, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	pubKey := (*btcec.PublicKey)(&privKey.PublicKey)
+	redeemScript, addr, err := keystore.NewSingleKeyWitnessAddress(pubKey, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := w.fetchSweptOutputs(addr.ScriptAddress(), pkScript)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to fetch outputs to sweep", logging.LogFormat{
+			"err":     err,
+			"address": addr.EncodeAddress(),
+		})
+		return nil, err
+	}
+	if len(outputs) == 0 {
+		return nil, ErrNothingToSweep
+	}
+
+	if feeRate.IsZero() {
+		feeRate = massutil.MinRelayTxFee()
+	}
+	total := massutil.ZeroAmount()
+	for _, out := range outputs {
+		if total, err = total.AddInt(out.Value); err != nil {
+			return nil, err
+		}
+	}
+	// a signature size is 73 at most, see estimateSignedSize
+	size := int64(len(outputs)*(len(redeemScript)+73+8+32+4) + 63 + 12)
+	if size > int64(blockchain.GetMaxStandardTxSize()) {
+		logging.CPrint(logging.ERROR, "too many outputs to sweep", logging.LogFormat{
+			"outputs": len(outputs),
+			"size":    size,
+		})
+		return nil, ErrOverfullUtxo
+	}
+	fee, err := blockchain.CalcMinRequiredTxRelayFee(size, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := total.Sub(fee)
+	if err != nil || amount.Cmp(massutil.MinRelayTxFee()) < 0 {
+		return nil, ErrInsufficientFunds
+	}
+
+	target, err := w.sweepTarget()
+	if err != nil {
+		return nil, err
+	}
+	txOut, err := amountToTxOut(target, amount)
+	if err != nil {
+		return nil, err
+	}
+	msgTx := wire.NewMsgTx()
+	for i := range outputs {
+		msgTx.AddTxIn(wire.NewTxIn(&outputs[i].OutPoint, nil))
+	}
+	msgTx.AddTxOut(txOut)
+	msgTx.Version = wire.TxVersion
+
+	getSign := txscript.SignClosure(func(pub *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
+		return privKey.Sign(hash)
+	})
+	hashCache := txscript.NewTxSigHashes(msgTx)
+	for i, out := range outputs {
+		sig, err := txscript.RawTxInWitnessSignature(msgTx, hashCache, i, out.Value,
+			redeemScript, txscript.SigHashAll, pubKey, getSign)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "Err in txscript.RawTxInWitnessSignature", logging.LogFormat{
+				"index": i,
+				"err":   err,
+			})
+			return nil, err
+		}
+		sigScript, err := txscript.NewScriptBuilder().AddData(sig).Script()
+		if err != nil {
+			return nil, err
+		}
+		msgTx.TxIn[i].Witness = wire.TxWitness{sigScript, redeemScript}
+	}
+	return &SweptTx{
+		MsgTx:   msgTx,
+		Address: target,
+		Amount:  amount,
+		Fee:     fee,
+	}, nil
+}
+
+// sweepTarget returns the address receiving a sweep, the witness v0 receiving
+// address of current wallet with the lowest index not yet used in the chain.
+// Only if all are used, a new address is derived, so a sweep not broadcast
+// doesn't take up an address of the wallet.
+func (w *WalletManager) sweepTarget() (string, error) {
+	mas, err := w.receivingAddresses(w.ksmgr.CurrentKeystore())
+	if err != nil {
+		return "", err
+	}
+	for _, ma := range mas {
+		used, err := w.chainFetcher.CheckScriptHashUsed(ma.ScriptAddress())
+		if err != nil {
+			return "", err
+		}
+		if !used {
+			return ma.String(), nil
+		}
+	}
+	return w.NewAddress(massutil.AddressClassWitnessV0)
+}
+
+// fetchSweptOutputs returns the mature outputs paying pkScript, neither spent
+// in the chain nor by the mempool, according to the address index.
+func (w *WalletManager) fetchSweptOutputs(scriptHash, pkScript []byte) ([]*sweptOutput, error) {
+	_, bestHeight, err := w.chainFetcher.NewestSha()
+	if err != nil {
+		return nil, err
+	}
+	rTxs, err := w.chainFetcher.FetchScriptHashRelatedTx([][]byte{scriptHash}, 1, bestHeight+1, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*sweptOutput, 0)
+	spent := make(map[wire.OutPoint]struct{})
+	for _, height := range rTxs.SortedHeights {
+		for _, txLoc := range rTxs.Data[height] {
+			mtx, err := w.chainFetcher.FetchTxByLoc(height, txLoc)
+			if err != nil {
+				return nil, err
+			}
+			isCoinbase := blockchain.IsCoinBaseTx(mtx)
+			if !isCoinbase {
+				for _, txIn := range mtx.TxIn {
+					spent[txIn.PreviousOutPoint] = struct{}{}
+				}
+			}
+			if isCoinbase && bestHeight-height < consensus.CoinbaseMaturity {
+				continue
+			}
+			txHash := mtx.TxHash()
+			for i, txOut := range mtx.TxOut {
+				if !bytes.Equal(txOut.PkScript, pkScript) {
+					continue
+				}
+				outputs = append(outputs, &sweptOutput{
+					OutPoint: wire.OutPoint{Hash: txHash, Index: uint32(i)},
+					Value:    txOut.Value,
+				})
+			}
+		}
+	}
+
+	ret := make([]*sweptOutput, 0, len(outputs))
+	for _, out := range outputs {
+		if _, ok := spent[out.OutPoint]; ok {
+			continue
+		}
+		if w.server.TxMemPool().CheckPoolOutPointSpend(&out.OutPoint) {
+			continue
+		}
+		ret = append(ret, out)
+	}
+	return ret, nil
+}
@@ -0,0 +1,214 @@
+package masswallet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/database"
+	"massnet.org/mass-wallet/database/ldb"
+	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+func TestWalletManager_SweepPrivateKey(t *testing.T) {
+	chainDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("new chainDb error")
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testSweepWallet")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{chainDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wif := keystore.EncodeWIF(privKey, &config.ChainParams)
+
+	_, err = w.SweepPrivateKey(wif, massutil.ZeroAmount())
+	assert.Equal(t, ErrNoWalletInUse, err)
+
+	walletId, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = w.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+
+	_, err = w.SweepPrivateKey("not a wif", massutil.ZeroAmount())
+	assert.Equal(t, keystore.ErrInvalidWIF, err)
+
+	// pay the swept key by two outputs
+	_, addr, err := keystore.NewSingleKeyWitnessAddress((*btcec.PublicKey)(&privKey.PublicKey), &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block15T2.TxOut[0].PkScript = pkScript
+	block15T3.TxOut[0].PkScript = pkScript
+	newBlock15T2Hash := block15T2.TxHash()
+	newBlock15T3Hash := block15T3.TxHash()
+	for i := 1; i <= int(block15Meta.Height); i++ {
+		blk := blks200[i]
+		blk.ResetGenerated()
+		for _, tx := range blk.MsgBlock().Transactions {
+			for _, txin := range tx.TxIn {
+				if bytes.Equal(txin.PreviousOutPoint.Hash[:], b15T2Hash[:]) {
+					txin.PreviousOutPoint.Hash = newBlock15T2Hash
+					continue
+				}
+				if bytes.Equal(txin.PreviousOutPoint.Hash[:], b15T3Hash[:]) {
+					txin.PreviousOutPoint.Hash = newBlock15T3Hash
+				}
+			}
+		}
+		err = chainDb.SubmitBlock(blk)
+		if err != nil {
+			t.Fatal("init db error:", err)
+		}
+		chainDb.(*ldb.ChainDb).Batch(1).Set(blk.MsgBlock().BlockHash())
+		chainDb.(*ldb.ChainDb).Batch(1).Done()
+		if i == int(block15Meta.Height) {
+			// index the outputs paying the swept key
+			txLocs, err := blk.TxLoc()
+			if err != nil {
+				t.Fatal("TxLoc error", err)
+			}
+			var scriptHash [sha256.Size]byte
+			copy(scriptHash[:], addr.ScriptAddress())
+			err = chainDb.SubmitAddrIndex(blk.Hash(), blk.Height(), &database.AddrIndexData{
+				TxIndex: database.TxAddrIndex{scriptHash: {&txLocs[2], &txLocs[3]}},
+			})
+			if err != nil {
+				t.Fatal("index error:", err)
+			}
+		}
+		err = chainDb.Commit(blk.MsgBlock().BlockHash())
+		if err != nil {
+			t.Fatal("init db error:", err)
+		}
+	}
+	defer func() {
+		block15T2.TxOut[0].PkScript = b15T2O0PkScript
+		block15T3.TxOut[0].PkScript = b15T3O0PkScript
+		for i := 1; i <= int(block15Meta.Height); i++ {
+			blk := blks200[i]
+			for _, tx := range blk.MsgBlock().Transactions {
+				for _, txin := range tx.TxIn {
+					if bytes.Equal(txin.PreviousOutPoint.Hash[:], newBlock15T2Hash[:]) {
+						txin.PreviousOutPoint.Hash = b15T2Hash
+						continue
+					}
+					if bytes.Equal(txin.PreviousOutPoint.Hash[:], newBlock15T3Hash[:]) {
+						txin.PreviousOutPoint.Hash = b15T3Hash
+					}
+				}
+			}
+		}
+	}()
+
+	swept, err := w.SweepPrivateKey(wif, massutil.ZeroAmount())
+	if err != nil {
+		t.Fatal("sweep error", err.Error())
+	}
+	assert.Equal(t, 2, len(swept.MsgTx.TxIn))
+	assert.Equal(t, 1, len(swept.MsgTx.TxOut))
+	total := block15T2.TxOut[0].Value + block15T3.TxOut[0].Value
+	assert.Equal(t, total, swept.Amount.IntValue()+swept.Fee.IntValue())
+	assert.Equal(t, swept.Amount.IntValue(), swept.MsgTx.TxOut[0].Value)
+
+	// paid to current wallet
+	_, err = w.ksmgr.CurrentKeystore().Address(swept.Address)
+	assert.Nil(t, err)
+
+	// sweeping again before broadcast reuses the unused address
+	again, err := w.SweepPrivateKey(wif, massutil.ZeroAmount())
+	if err != nil {
+		t.Fatal("sweep error", err.Error())
+	}
+	assert.Equal(t, swept.Address, again.Address)
+	assert.Equal(t, 1, len(w.ksmgr.CurrentKeystore().ListAddresses()))
+
+	// spends the outputs paying the swept key
+	prevValues := map[wire.Hash]int64{
+		newBlock15T2Hash: block15T2.TxOut[0].Value,
+		newBlock15T3Hash: block15T3.TxOut[0].Value,
+	}
+	hashCache := txscript.NewTxSigHashes(swept.MsgTx)
+	for i, txIn := range swept.MsgTx.TxIn {
+		value, ok := prevValues[txIn.PreviousOutPoint.Hash]
+		if !ok || txIn.PreviousOutPoint.Index != 0 {
+			t.Fatalf("unexpected input %v", txIn.PreviousOutPoint)
+		}
+		vm, err := txscript.NewEngine(pkScript, swept.MsgTx, i, txscript.StandardVerifyFlags, nil, hashCache, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = vm.Execute(); err != nil {
+			t.Fatalf("input %d: %v", i, err)
+		}
+	}
+}
+
+func TestWalletManager_sweepTarget(t *testing.T) {
+	chainDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("new chainDb error")
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testSweepTarget")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{chainDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	walletId, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = w.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+
+	// the unused staking address at the lowest index is not a target
+	if _, err = w.NewAddress(massutil.AddressClassWitnessStaking); err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	target, err := w.sweepTarget()
+	if err != nil {
+		t.Fatal("sweepTarget error", err.Error())
+	}
+	ma, err := w.ksmgr.CurrentKeystore().Address(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, uint32(1), ma.DerivationPath().Index)
+	assert.Equal(t, 2, len(w.ksmgr.CurrentKeystore().ListAddresses()))
+
+	// the unused witness address is reused
+	again, err := w.sweepTarget()
+	if err != nil {
+		t.Fatal("sweepTarget error", err.Error())
+	}
+	assert.Equal(t, target, again)
+}
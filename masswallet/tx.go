@@ -5,8 +5,6 @@ import (
 	"encoding/hex"
 	"fmt"
 
-	"massnet.org/mass-wallet/masswallet/keystore"
-
 	pb "massnet.org/mass-wallet/api/proto"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -15,6 +13,9 @@ import (
 	"massnet.org/mass-wallet/logging"
 	"massnet.org/mass-wallet/massutil"
 	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/signer"
 	"massnet.org/mass-wallet/masswallet/utils"
 	"massnet.org/mass-wallet/txscript"
 	"massnet.org/mass-wallet/wire"
@@ -266,6 +267,7 @@ func (w *WalletManager) EstimateTxFee(
 	userTxFee massutil.Amount,
 	fromAddr,
 	changeAddr string,
+	cc *CoinControl,
 ) (msgTx *wire.MsgTx, fee massutil.Amount, err error) {
 
 	addrs, err := w.prepareFromAddresses(fromAddr)
@@ -282,7 +284,7 @@ func (w *WalletManager) EstimateTxFee(
 		}
 		msgTx.AddTxOut(txOut)
 	}
-	u, err := w.autoConstructTxInAndChangeTxOut(msgTx, lockTime, addrs, userTxFee, changeAddr)
+	u, err := w.autoConstructTxInAndChangeTxOut(msgTx, lockTime, addrs, userTxFee, changeAddr, cc)
 	if err != nil {
 		return nil, massutil.ZeroAmount(), err
 	}
@@ -290,7 +292,7 @@ func (w *WalletManager) EstimateTxFee(
 }
 
 func (w *WalletManager) EstimateStakingTxFee(outputs []*StakingTxOut, LockTime uint64, userTxFee massutil.Amount,
-	fromAddr, changeAddr string) (msgTx *wire.MsgTx, fee massutil.Amount, err error) {
+	fromAddr, changeAddr string, cc *CoinControl) (msgTx *wire.MsgTx, fee massutil.Amount, err error) {
 
 	addrs, err := w.prepareFromAddresses(fromAddr)
 	if err != nil {
@@ -303,7 +305,7 @@ func (w *WalletManager) EstimateStakingTxFee(outputs []*StakingTxOut, LockTime u
 		return nil, massutil.ZeroAmount(), err
 	}
 
-	u, err := w.autoConstructTxInAndChangeTxOut(msgTx, LockTime, addrs, userTxFee, changeAddr)
+	u, err := w.autoConstructTxInAndChangeTxOut(msgTx, LockTime, addrs, userTxFee, changeAddr, cc)
 	if err != nil {
 		return nil, massutil.ZeroAmount(), err
 	}
@@ -324,7 +326,7 @@ func (w *WalletManager) EstimateBindingTxFee(outputs []*BindingOutput, LockTime
 		return nil, massutil.ZeroAmount(), err
 	}
 
-	u, err := w.autoConstructTxInAndChangeTxOut(msgTx, LockTime, addrs, userTxFee, changeAddr)
+	u, err := w.autoConstructTxInAndChangeTxOut(msgTx, LockTime, addrs, userTxFee, changeAddr, nil)
 	if err != nil {
 		return nil, massutil.ZeroAmount(), err
 	}
@@ -385,7 +387,7 @@ func (w *WalletManager) estimateSignedSize(utxos []*txmgr.Credit, TxOutLen int)
 	return int64(signedSize + 63*TxOutLen + 12), nil
 }
 
-func (w *WalletManager) findEligibleUtxos(amount massutil.Amount, witnessAddr []string) (
+func (w *WalletManager) findEligibleUtxos(amount massutil.Amount, witnessAddr []string, cc *CoinControl) (
 	[]*txmgr.Credit, string, massutil.Amount, bool, error) {
 	zeroAmount := massutil.ZeroAmount()
 	if len(witnessAddr) == 0 {
@@ -403,15 +405,31 @@ func (w *WalletManager) findEligibleUtxos(amount massutil.Amount, witnessAddr []
 		return nil, "", zeroAmount, false, ErrInvalidParameter
 	}
 
-	utxos, overfull, err := w.getUtxosExcludeBindingAndStaking(witnessAddr, amount)
+	included, utxos, overfull, err := w.getUtxosExcludeBindingAndStaking(witnessAddr, amount, cc)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "get utxos failed", logging.LogFormat{"err": err})
 		return nil, "", zeroAmount, false, err
 	}
 
-	selections, sumSelection, _, err := optOutputs(amount, utxos)
-	if err != nil {
-		return nil, "", zeroAmount, false, err
+	// outputs included by coin control go first, the rest is selected
+	selections := make([]*txmgr.Credit, 0, len(included))
+	sumSelection := zeroAmount
+	for _, item := range included {
+		if sumSelection, err = sumSelection.Add(item.Amount); err != nil {
+			return nil, "", zeroAmount, false, err
+		}
+		selections = append(selections, item)
+	}
+	if sumSelection.Cmp(amount) < 0 {
+		remain, _ := amount.Sub(sumSelection)
+		selected, sumSelected, err := cc.selector().Select(remain, utxos)
+		if err != nil {
+			return nil, "", zeroAmount, false, err
+		}
+		if sumSelection, err = sumSelection.Add(sumSelected); err != nil {
+			return nil, "", zeroAmount, false, err
+		}
+		selections = append(selections, selected...)
 	}
 	firstAddr := ""
 	if len(selections) > 0 {
@@ -433,7 +451,7 @@ func (w *WalletManager) findEligibleUtxos(amount massutil.Amount, witnessAddr []
 		}
 	}
 
-	return selections, firstAddr, sumSelection, overfull && len(utxos) == len(selections), nil
+	return selections, firstAddr, sumSelection, overfull && len(included)+len(utxos) == len(selections), nil
 }
 
 func (w *WalletManager) getUtxos(addrs []string) (map[string][]*txmgr.Credit, []*txmgr.Credit, error) {
@@ -464,7 +482,7 @@ func (w *WalletManager) getUtxos(addrs []string) (map[string][]*txmgr.Credit, []
 		if err != nil {
 			return err
 		}
-		m, err := w.utxoStore.ScriptAddressUnspents(tx, scriptSet, syncedTo.Height,
+		m, err := w.utxoStore.ScriptAddressUnspents(tx, am.Name(), scriptSet, syncedTo.Height,
 			func(item *txmgr.Credit) (bool, bool) {
 				if !item.Flags.Spent &&
 					!w.server.TxMemPool().CheckPoolOutPointSpend(&item.OutPoint) {
@@ -496,45 +514,88 @@ func (w *WalletManager) getUtxos(addrs []string) (map[string][]*txmgr.Credit, []
 	return ret, retList, nil
 }
 
+// getUtxosExcludeBindingAndStaking returns the outputs included by cc, and
+// the candidates for automatic selection, which are neither frozen nor
+// excluded by cc.
 func (w *WalletManager) getUtxosExcludeBindingAndStaking(stdAddresses []string,
-	wantAmt massutil.Amount) ([]*txmgr.Credit, bool, error) {
+	wantAmt massutil.Amount, cc *CoinControl) ([]*txmgr.Credit, []*txmgr.Credit, bool, error) {
 
 	am := w.ksmgr.CurrentKeystore()
 	if am == nil {
-		return nil, false, ErrNoWalletInUse
+		return nil, nil, false, ErrNoWalletInUse
 	}
 
 	scriptSet := make(map[string]struct{})
 	for _, addr := range stdAddresses {
 		ma, err := am.Address(addr)
 		if err != nil {
-			return nil, false, err
+			return nil, nil, false, err
 		}
 		scriptSet[string(ma.ScriptAddress())] = struct{}{}
 	}
 
-	selector := newTopKSelector(wantAmt)
+	include := make(map[wire.OutPoint]struct{})
+	exclude := make(map[wire.OutPoint]struct{})
+	if cc != nil {
+		for _, op := range cc.Include {
+			include[op] = struct{}{}
+		}
+		for _, op := range cc.Exclude {
+			exclude[op] = struct{}{}
+		}
+	}
+
+	included := make([]*txmgr.Credit, 0, len(include))
+	pool := cc.selector().newPool(wantAmt)
 	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
 		syncedTo, err := w.syncStore.SyncedTo(tx)
 		if err != nil {
 			return err
 		}
-		_, err = w.utxoStore.ScriptAddressUnspents(tx, scriptSet, syncedTo.Height,
+		controls, err := w.utxoStore.FetchUtxoControls(tx, am.Name())
+		if err != nil {
+			return err
+		}
+		_, err = w.utxoStore.ScriptAddressUnspents(tx, am.Name(), scriptSet, syncedTo.Height,
 			func(item *txmgr.Credit) (stopIter, selected bool) {
-				if item.Confirmations >= item.Maturity && !item.Flags.SpentByUnmined && !item.Flags.Spent &&
-					item.Flags.Class != txmgr.ClassBindingUtxo && item.Flags.Class != txmgr.ClassStakingUtxo &&
-					!w.UTXOUsed(&item.OutPoint) && !w.server.TxMemPool().CheckPoolOutPointSpend(&item.OutPoint) {
-					selector.submit(item)
+				_, isIncluded := include[item.OutPoint]
+				if w.isSpendableCredit(item) {
+					if isIncluded {
+						included = append(included, item)
+						delete(include, item.OutPoint)
+						return
+					}
+					if _, ok := exclude[item.OutPoint]; ok {
+						return
+					}
+					if ctl, ok := controls[item.OutPoint]; ok && ctl.Frozen {
+						return
+					}
+					pool.submit(item)
 				}
 				return
 			})
 		return err
 	})
 	if err != nil {
-		return nil, false, err
+		return nil, nil, false, err
 	}
-	items := selector.Items()
-	return items, len(items) == selector.K(), nil
+	for op := range include {
+		logging.CPrint(logging.ERROR, "included output not spendable", logging.LogFormat{
+			"tx":   op.Hash.String(),
+			"vout": op.Index,
+		})
+		return nil, nil, false, ErrUnspendableUtxo
+	}
+	return included, pool.Items(), pool.full(), nil
+}
+
+// isSpendableCredit reports whether item may be spent by a new transaction,
+// it excludes staking and binding outputs.
+func (w *WalletManager) isSpendableCredit(item *txmgr.Credit) bool {
+	return item.Confirmations >= item.Maturity && !item.Flags.SpentByUnmined && !item.Flags.Spent &&
+		item.Flags.Class != txmgr.ClassBindingUtxo && item.Flags.Class != txmgr.ClassStakingUtxo &&
+		!w.UTXOUsed(&item.OutPoint) && !w.server.TxMemPool().CheckPoolOutPointSpend(&item.OutPoint)
 }
 
 func optOutputs(amount massutil.Amount, utxos []*txmgr.Credit) ([]*txmgr.Credit, massutil.Amount, massutil.Amount, error) {
@@ -611,122 +672,95 @@ func (w *WalletManager) SignHash(pub *btcec.PublicKey, hash, password []byte) (*
 	return w.ksmgr.SignHash(pub, hash, password)
 }
 
-func (w *WalletManager) signWitnessTx(password []byte, tx *wire.MsgTx, hashType txscript.SigHashType, params *config.Params) error {
-
-	hashCache := txscript.NewTxSigHashes(tx)
+// signWitnessTx adds to the packet the signatures the current keystore can
+// produce, by the external signer if configured. Inputs of other keystores and
+// inputs the keystore has already signed are left untouched, so a multisig
+// packet may still require the signatures of cosigners afterwards. It returns
+// the number of signatures added.
+func (w *WalletManager) signWitnessTx(password []byte, p *psbt.Packet, hashType txscript.SigHashType) (int, error) {
+	ks := w.ksmgr.CurrentKeystore()
+	if ks == nil {
+		return 0, ErrNoWalletInUse
+	}
+	return signPsbt(w.signer(), ks, w.chainParams, password, p, hashType)
+}
 
-	var err error
+// signer returns the external signer if configured, or the keystores.
+func (w *WalletManager) signer() signer.Signer {
+	if w.extSigner != nil {
+		return w.extSigner
+	}
+	return signer.NewKeystoreSigner(w.ksmgr)
+}
 
-	getSign := txscript.SignClosure(func(pub *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
-		sig, err := w.ksmgr.SignHash(pub, hash, password)
-		if err != nil {
-			return nil, err
-		}
-		return sig, nil
-	})
+// signPsbt adds to the packet the signatures of ks by s. It only reads the
+// spent outputs and witness scripts carried by the packet, so it needs no chain
+// data.
+func signPsbt(s signer.Signer, ks *keystore.AddrManager, chainParams *config.Params,
+	password []byte, p *psbt.Packet, hashType txscript.SigHashType) (int, error) {
+	if err := s.Prepare(ks); err != nil {
+		return 0, err
+	}
 
-	getScript := txscript.ScriptClosure(func(addr massutil.Address) ([]byte, error) {
-		scriptHash := addr.ScriptAddress()
+	tx := p.UnsignedTx
+	hashCache := txscript.NewTxSigHashes(tx)
 
-		address, err := massutil.NewAddressWitnessScriptHash(scriptHash, w.chainParams)
-		if err != nil {
-			logging.CPrint(logging.ERROR, "ScriptClosure error", logging.LogFormat{"err": err})
-			return nil, keystore.ErrBuildWitnessScript
+	defer s.Release()
+	signed := 0
+	for i := range tx.TxIn {
+		pIn := p.Inputs[i]
+		if len(pIn.FinalWitness) != 0 || pIn.WitnessUtxo == nil {
+			continue
 		}
-		addrStr := address.EncodeAddress()
 
-		acctM := w.ksmgr.CurrentKeystore()
-		mAddr, err := acctM.Address(addrStr)
-		if err != nil {
-			logging.CPrint(logging.ERROR, "ScriptClosure error", logging.LogFormat{"err": err})
-			return nil, keystore.ErrUnexpectedPubKeyToSign
+		// SigHashSingle inputs can only be signed if there's a
+		// corresponding output.
+		if (hashType&txscript.SigHashSingle) == txscript.SigHashSingle &&
+			i >= len(tx.TxOut) {
+			continue
 		}
-		script, err := mAddr.RedeemScript(w.chainParams)
+
+		mAddr, err := managedAddressOf(pIn.WitnessUtxo.PkScript, ks, chainParams)
 		if err != nil {
-			logging.CPrint(logging.ERROR, "ScriptClosure error", logging.LogFormat{"err": err})
-			return nil, keystore.ErrBuildWitnessScript
+			continue
 		}
-		return script, nil
-	})
-
-	cache := make(map[wire.Hash]*wire.MsgTx)
-	defer w.ksmgr.ClearPrivKey()
-	for i, txIn := range tx.TxIn {
-		prevTx, ok := cache[txIn.PreviousOutPoint.Hash]
-		if !ok {
-			prevTx, err = w.existsMsgTx(&txIn.PreviousOutPoint)
-			if err == txmgr.ErrNotFound {
-				prevTx, err = w.existsUnminedTx(&txIn.PreviousOutPoint.Hash)
-			}
-
-			if err != nil {
-				logging.CPrint(logging.ERROR, "failed to check previous transaction", logging.LogFormat{
-					"err": err,
-				})
-				return ErrUTXONotExists
-			}
-			cache[txIn.PreviousOutPoint.Hash] = prevTx
+		pubKey := mAddr.PubKey().SerializeCompressed()
+		if hasPartialSig(pIn, pubKey) {
+			continue
 		}
-		// check index
-		if txIn.PreviousOutPoint.Index > uint32(len(prevTx.TxOut)-1) {
-			logging.CPrint(logging.ERROR, "Ouput index number (vout) does not exist for transaction", logging.LogFormat{
-				"index": txIn.PreviousOutPoint.Index,
+		getSign := txscript.SignClosure(func(pub *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
+			return s.SignHash(&signer.Request{
+				WalletId: ks.Name(),
+				PubKey:   pub,
+				Path:     bip44Path(mAddr.DerivationPath(), chainParams),
+				Hash:     hash,
+				Password: password,
 			})
-			return ErrInvalidIndex
-		}
+		})
 
-		flags, err := w.existsOutPoint(&txIn.PreviousOutPoint)
+		sig, err := txscript.RawTxInWitnessSignature(tx, hashCache, i, pIn.WitnessUtxo.Value,
+			pIn.WitnessScript, hashType, mAddr.PubKey(), getSign)
 		if err != nil {
-			logging.CPrint(logging.ERROR, "failed to check previous output", logging.LogFormat{
-				"err": err,
+			logging.CPrint(logging.ERROR, "Err in txscript.RawTxInWitnessSignature", logging.LogFormat{
+				"index": i,
+				"err":   err,
 			})
-			return ErrUTXONotExists
-		}
-		if flags.Spent {
-			logging.CPrint(logging.ERROR, "Ouput index for txid has been spent", logging.LogFormat{
-				"index": txIn.PreviousOutPoint.Index,
-				"txid":  &txIn.PreviousOutPoint.Hash,
-			})
-			return ErrDoubleSpend
+			return 0, err
 		}
+		pIn.AddPartialSig(pubKey, sig)
+		signed++
+	}
 
-		prevTxOut := prevTx.TxOut[txIn.PreviousOutPoint.Index]
-
-		// SigHashSingle inputs can only be signed if there's a
-		// corresponding output. However this could be already signed,
-		// so we always verify the output.
-		if (hashType&txscript.SigHashSingle) !=
-			txscript.SigHashSingle || i < len(tx.TxOut) {
-
-			script, err := txscript.SignTxOutputWit(params, tx, i, prevTxOut.Value, prevTxOut.PkScript, hashCache, hashType, getSign, getScript)
-
-			// Failure to sign isn't an error, it just means that
-			// the tx isn't complete.
-			if err != nil {
-				logging.CPrint(logging.ERROR, "Err in txscript.SignTxOutputWit", logging.LogFormat{
-					"err": err,
-				})
-				return err
-			}
-			txIn.Witness = script
-		}
+	return signed, nil
+}
 
-		// Either it was already signed or we just signed it.
-		// Find out if it is completely satisfied or still needs more.
-		vm, err := txscript.NewEngine(prevTxOut.PkScript, tx, i,
-			txscript.StandardVerifyFlags, nil, hashCache, prevTxOut.Value)
-		if err == nil {
-			err = vm.Execute()
-			if err != nil {
-				return err
-			}
-		}
-		if err != nil {
-			return err
+func hasPartialSig(pIn *psbt.PInput, pubKey []byte) bool {
+	for _, ps := range pIn.PartialSigs {
+		if bytes.Equal(ps.PubKey, pubKey) {
+			return true
 		}
 	}
-
-	return nil
+	return false
 }
 
 func (w *WalletManager) EstimateManualTxFee(txins []*TxIn, txoutLen int) (massutil.Amount, error) {
@@ -954,6 +988,38 @@ func (w *WalletManager) GetTxHistory(wanted int, addr string) ([]*pb.TxHistoryDe
 	return histories, nil
 }
 
+// ListTransactions returns a page of the mined transactions of current wallet
+// selected by filter, the synced height of the wallet to calculate
+// confirmations, and the cursor of the next page.
+func (w *WalletManager) ListTransactions(filter *txmgr.TxHistoryFilter) ([]*txmgr.TxHistory, uint64, []byte, error) {
+	am := w.ksmgr.CurrentKeystore()
+	if am == nil {
+		return nil, 0, nil, ErrNoWalletInUse
+	}
+
+	var (
+		histories []*txmgr.TxHistory
+		cursor    []byte
+		syncedTo  *txmgr.BlockMeta
+	)
+	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) (err error) {
+		syncedTo, err = w.syncStore.SyncedTo(tx)
+		if err != nil {
+			return err
+		}
+		histories, cursor, err = w.txStore.ListTxHistory(tx, am.Name(), filter)
+		return err
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ListTxHistory failed", logging.LogFormat{
+			"err":      err,
+			"walletId": am.Name(),
+		})
+		return nil, 0, nil, err
+	}
+	return histories, syncedTo.Height, cursor, nil
+}
+
 func selectRelatedTx(h *ifc.HeightSortedRelatedTx, num int) *ifc.HeightSortedRelatedTx {
 	count := 0
 	result := &ifc.HeightSortedRelatedTx{
@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrChainReorg               = errors.New("chain reorganization")
 	ErrUnexpectedCreditNotFound = errors.New("unexpected credit not found")
+	ErrInvalidCursor            = errors.New("invalid cursor")
 )
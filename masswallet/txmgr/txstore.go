@@ -2,8 +2,10 @@ package txmgr
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 
 	"massnet.org/mass-wallet/blockchain"
 	"massnet.org/mass-wallet/config"
@@ -26,6 +28,13 @@ type TxStore struct {
 	utxoStore *UtxoStore
 	syncStore *SyncStore
 	ksmgr     *keystore.KeystoreManager
+
+	// false if bucketTxHistory is created by this instance, the index
+	// should be built from existing records by BuildTxHistoryIndex
+	historyIndexed bool
+	// false if bucketStakingReward is created by this instance, the index
+	// should be built from existing records by BuildStakingRewardIndex
+	stakingRewardIndexed bool
 }
 
 // NewTxStore ...
@@ -75,6 +84,22 @@ func NewTxStore(chainFetcher ifc.ChainFetcher, store mwdb.Bucket, utxoStore *Utx
 		return nil, err
 	}
 	t.bucketMeta.nsUnminedGameHistory = bucket.GetBucketMeta()
+
+	//bucketTxHistory
+	t.historyIndexed = store.Bucket(bucketTxHistory) != nil
+	bucket, err = mwdb.GetOrCreateBucket(store, bucketTxHistory)
+	if err != nil {
+		return nil, err
+	}
+	t.bucketMeta.nsTxHistory = bucket.GetBucketMeta()
+
+	//bucketStakingReward
+	t.stakingRewardIndexed = store.Bucket(bucketStakingReward) != nil
+	bucket, err = mwdb.GetOrCreateBucket(store, bucketStakingReward)
+	if err != nil {
+		return nil, err
+	}
+	t.bucketMeta.nsStakingReward = bucket.GetBucketMeta()
 	return
 }
 
@@ -166,7 +191,11 @@ func (s *TxStore) AddRelevantTx(tx mwdb.DBTransaction, allBalances map[string]ma
 		return err
 	}
 
-	return s.utxoStore.AddCredits(tx, allBalances, rec, block)
+	err = s.utxoStore.AddCredits(tx, allBalances, rec, block)
+	if err != nil || block == nil {
+		return err
+	}
+	return s.indexTxHistory(tx, &rec.MsgTx, &rec.Hash, block)
 }
 
 // InsertTx ...
@@ -271,7 +300,11 @@ func (s *TxStore) AddRelevantTxForImporting(tx mwdb.DBTransaction,
 		return err
 	}
 
-	return s.utxoStore.AddCredits(tx, allBalances, rec, block)
+	err = s.utxoStore.AddCredits(tx, allBalances, rec, block)
+	if err != nil {
+		return err
+	}
+	return s.indexTxHistory(tx, &rec.MsgTx, &rec.Hash, block)
 }
 
 func (s *TxStore) insertMinedTxForImporting(tx mwdb.DBTransaction,
@@ -353,6 +386,58 @@ func (s *TxStore) insertMinedTxForImporting(tx mwdb.DBTransaction,
 }
 
 func (s *TxStore) removeDoubleSpends(tx mwdb.DBTransaction, rec *TxRecord) error {
+	if err := s.removeUnminedDoubleSpends(tx, rec, nil); err != nil {
+		return err
+	}
+
+	// delete unmined inputs in case only the mined tx spend them
+	return s.utxoStore.deleteUnminedInputs(tx, rec)
+}
+
+// RemoveUnminedDoubleSpends removes the unmined transactions spending any
+// relevant input of rec, which replaces them in mempool, along with those
+// spending their outputs. It returns the hashes of removed transactions.
+func (s *TxStore) RemoveUnminedDoubleSpends(tx mwdb.DBTransaction, rec *TxRecord) ([]wire.Hash, error) {
+	removed := make(map[wire.Hash]struct{})
+	if err := s.removeUnminedDoubleSpends(tx, rec, removed); err != nil {
+		return nil, err
+	}
+	hashes := make([]wire.Hash, 0, len(removed))
+	for hash := range removed {
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+// RemoveUnminedTx removes the unmined transaction hash, along with those
+// spending its outputs. It returns the hashes of removed transactions.
+func (s *TxStore) RemoveUnminedTx(tx mwdb.DBTransaction, hash *wire.Hash) ([]wire.Hash, error) {
+	nsUnmined := tx.FetchBucket(s.bucketMeta.nsUnmined)
+	v, err := existsRawUnmined(nsUnmined, hash[:])
+	if err != nil {
+		return nil, err
+	}
+	if len(v) == 0 {
+		return nil, ErrNotFound
+	}
+	var rec TxRecord
+	rec.Hash = *hash
+	if err = readRawUnmined(v, &rec); err != nil {
+		return nil, err
+	}
+
+	removed := make(map[wire.Hash]struct{})
+	if err = s.removeConflict(tx, &rec, removed); err != nil {
+		return nil, err
+	}
+	hashes := make([]wire.Hash, 0, len(removed))
+	for h := range removed {
+		hashes = append(hashes, h)
+	}
+	return hashes, nil
+}
+
+func (s *TxStore) removeUnminedDoubleSpends(tx mwdb.DBTransaction, rec *TxRecord, removed map[wire.Hash]struct{}) error {
 
 	nsUnminedInputs := tx.FetchBucket(s.bucketMeta.nsUnminedInputs)
 	nsUnmined := tx.FetchBucket(s.bucketMeta.nsUnmined)
@@ -363,6 +448,9 @@ func (s *TxStore) removeDoubleSpends(tx mwdb.DBTransaction, rec *TxRecord) error
 
 		doubleSpendHashes := fetchUnminedInputSpendTxHashes(nsUnminedInputs, prevOutKey)
 		for _, doubleSpendHash := range doubleSpendHashes {
+			if doubleSpendHash == rec.Hash {
+				continue
+			}
 			doubleSpendVal, err := existsRawUnmined(nsUnmined, doubleSpendHash[:])
 			if err != nil {
 				return err
@@ -384,17 +472,15 @@ func (s *TxStore) removeDoubleSpends(tx mwdb.DBTransaction, rec *TxRecord) error
 					"tx": doubleSpend.Hash.String(),
 				})
 
-			if err := s.removeConflict(tx, &doubleSpend); err != nil {
+			if err := s.removeConflict(tx, &doubleSpend, removed); err != nil {
 				return err
 			}
 		}
 	}
-
-	// delete unmined inputs in case only the mined tx spend them
-	return s.utxoStore.deleteUnminedInputs(tx, rec)
+	return nil
 }
 
-func (s *TxStore) removeConflict(tx mwdb.DBTransaction, rec *TxRecord) error {
+func (s *TxStore) removeConflict(tx mwdb.DBTransaction, rec *TxRecord, removed map[wire.Hash]struct{}) error {
 
 	nsUnminedInputs := tx.FetchBucket(s.bucketMeta.nsUnminedInputs)
 	nsUnmined := tx.FetchBucket(s.bucketMeta.nsUnmined)
@@ -425,7 +511,7 @@ func (s *TxStore) removeConflict(tx mwdb.DBTransaction, rec *TxRecord) error {
 				logging.LogFormat{
 					"tx": spender.Hash.String(),
 				})
-			if err := s.removeConflict(tx, &spender); err != nil {
+			if err := s.removeConflict(tx, &spender, removed); err != nil {
 				return err
 			}
 		}
@@ -443,6 +529,9 @@ func (s *TxStore) removeConflict(tx mwdb.DBTransaction, rec *TxRecord) error {
 	if err := s.utxoStore.removeUnminedGameHistory(tx, rec); err != nil {
 		return err
 	}
+	if removed != nil {
+		removed[rec.Hash] = struct{}{}
+	}
 	return deleteRawUnmined(nsUnmined, rec.Hash[:])
 }
 
@@ -463,8 +552,8 @@ func (s *TxStore) ExistUnminedTx(tx mwdb.ReadTransaction, hash *wire.Hash) (mtx
 	return &rec.MsgTx, nil
 }
 
-// for current wallet
-func (s *TxStore) ExistsTx(tx mwdb.ReadTransaction, out *wire.OutPoint) (mtx *wire.MsgTx, err error) {
+// ExistsTx returns the transaction of an unspent output of walletId
+func (s *TxStore) ExistsTx(tx mwdb.ReadTransaction, walletId string, out *wire.OutPoint) (mtx *wire.MsgTx, err error) {
 	nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
 	nsCredits := tx.FetchBucket(s.bucketMeta.nsCredits)
 	nsTxRecords := tx.FetchBucket(s.bucketMeta.nsTxRecords)
@@ -473,7 +562,7 @@ func (s *TxStore) ExistsTx(tx mwdb.ReadTransaction, out *wire.OutPoint) (mtx *wi
 		block: &BlockMeta{},
 	}
 
-	_, credKey, err := existsUnspent(nsUnspent, s.ksmgr.CurrentKeystore().Name(), out)
+	_, credKey, err := existsUnspent(nsUnspent, walletId, out)
 	if err != nil {
 		return nil, err
 	}
@@ -531,9 +620,36 @@ func (s *TxStore) ExistsTx(tx mwdb.ReadTransaction, out *wire.OutPoint) (mtx *wi
 	return nil, ErrNotFound
 }
 
+// FetchMinedTx returns the transaction of txHash mined in block, or
+// ErrNotFound if it is not a transaction of the wallets.
+func (s *TxStore) FetchMinedTx(tx mwdb.ReadTransaction, txHash *wire.Hash, block *BlockMeta) (*wire.MsgTx, error) {
+	nsTxRecords := tx.FetchBucket(s.bucketMeta.nsTxRecords)
+	_, recVal := existsTxRecord(nsTxRecords, txHash, block)
+	if recVal == nil {
+		return nil, ErrNotFound
+	}
+	_, txLoc, err := readTxRecordLoc(recVal)
+	if err != nil {
+		return nil, err
+	}
+	msgTx, err := s.chainFetcher.FetchTxByLoc(block.Height, txLoc)
+	if err != nil {
+		return nil, err
+	}
+	if msgTx.TxHash() != *txHash {
+		logging.CPrint(logging.WARN, "tx hash mismatch", logging.LogFormat{
+			"expect": txHash,
+			"height": block.Height,
+			"txloc":  txLoc,
+		})
+		return nil, ErrNotFound
+	}
+	return msgTx, nil
+}
+
 // ExistsUtxo returns ErrNotFound if not exists
-// for current wallet
-func (s *TxStore) ExistsUtxo(tx mwdb.ReadTransaction, out *wire.OutPoint) (flags *UtxoFlags, err error) {
+// for walletId
+func (s *TxStore) ExistsUtxo(tx mwdb.ReadTransaction, walletId string, out *wire.OutPoint) (flags *UtxoFlags, err error) {
 	nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
 	nsCredits := tx.FetchBucket(s.bucketMeta.nsCredits)
 	nsUnminedInputs := tx.FetchBucket(s.bucketMeta.nsUnminedInputs)
@@ -543,7 +659,7 @@ func (s *TxStore) ExistsUtxo(tx mwdb.ReadTransaction, out *wire.OutPoint) (flags
 	}
 
 	// unspent exists
-	uspKey, credKey, err := existsUnspent(nsUnspent, s.ksmgr.CurrentKeystore().Name(), out)
+	uspKey, credKey, err := existsUnspent(nsUnspent, walletId, out)
 	if err != nil {
 		return nil, err
 	}
@@ -1067,6 +1183,24 @@ func (s *TxStore) Rollback(tx mwdb.DBTransaction, height uint64) error {
 		}
 	}
 
+	// delete tx history
+	nsTxHistory := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+	for _, walletId := range s.ksmgr.ListKeystoreNames() {
+		err = deleteTxHistoryFromHeight(nsTxHistory, walletId, height)
+		if err != nil {
+			return err
+		}
+	}
+
+	// delete staking rewards
+	nsStakingReward := tx.FetchBucket(s.bucketMeta.nsStakingReward)
+	for _, walletId := range s.ksmgr.ListKeystoreNames() {
+		err = deleteStakingRewardFromHeight(nsStakingReward, walletId, height)
+		if err != nil {
+			return err
+		}
+	}
+
 	// remove coinbase credits
 	for _, op := range coinBaseCredits {
 		opKey := canonicalOutPoint(&op.Hash, op.Index)
@@ -1097,7 +1231,7 @@ func (s *TxStore) Rollback(tx mwdb.DBTransaction, height uint64) error {
 					"tx": unminedRec.Hash.String(),
 				})
 
-			err = s.removeConflict(tx, &unminedRec)
+			err = s.removeConflict(tx, &unminedRec, nil)
 			if err != nil {
 				return err
 			}
@@ -1300,3 +1434,509 @@ func (s *TxStore) RemoveRelevantTx(tx mwdb.DBTransaction, addrmgr *keystore.Addr
 	return deletedTx, finish, nil
 
 }
+
+// indexTxHistory summarizes the mined transaction for every wallet it credits
+// or debits, from the credits and debits already recorded.
+func (s *TxStore) indexTxHistory(tx mwdb.DBTransaction, msgTx *wire.MsgTx, txHash *wire.Hash, block *BlockMeta) error {
+	nsCredits := tx.FetchBucket(s.bucketMeta.nsCredits)
+	nsDebits := tx.FetchBucket(s.bucketMeta.nsDebits)
+	nsTxHistory := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+
+	histories := make(map[string]*TxHistory)
+	// the wallet of credit, nil if the address is not found
+	record := func(credValue []byte) (*TxHistory, *credit, error) {
+		cred := &credit{}
+		if err := readCreditValue(credValue, cred); err != nil {
+			return nil, nil, err
+		}
+		ma, err := s.ksmgr.GetManagedAddressByScriptHash(cred.scriptHash)
+		if err != nil {
+			if err == keystore.ErrScriptHashNotFound {
+				return nil, cred, nil
+			}
+			return nil, nil, err
+		}
+		h, ok := histories[ma.Account()]
+		if !ok {
+			h = &TxHistory{
+				WalletID: ma.Account(),
+				TxHash:   *txHash,
+				Block:    *block,
+				Received: massutil.ZeroAmount(),
+				Sent:     massutil.ZeroAmount(),
+				Fee:      massutil.ZeroAmount(),
+			}
+			histories[ma.Account()] = h
+		}
+		switch cred.flags.Class {
+		case ClassStakingUtxo:
+			h.Category |= TxCategoryStaking
+		case ClassBindingUtxo:
+			h.Category |= TxCategoryBinding
+		}
+		return h, cred, nil
+	}
+
+	totalOut := massutil.ZeroAmount()
+	for i, txOut := range msgTx.TxOut {
+		amt, err := massutil.NewAmountFromInt(txOut.Value)
+		if err != nil {
+			return err
+		}
+		if totalOut, err = totalOut.Add(amt); err != nil {
+			return err
+		}
+		_, v, err := existsCredit(nsCredits, txHash, uint32(i), block)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+		h, cred, err := record(v)
+		if err != nil {
+			return err
+		}
+		if h == nil {
+			continue
+		}
+		if h.Received, err = h.Received.Add(cred.amount); err != nil {
+			return err
+		}
+		if !cred.flags.Change {
+			h.Category |= TxCategoryReceived
+		}
+	}
+
+	isCoinBase := blockchain.IsCoinBaseTx(msgTx)
+	inputs := make(map[string]int)
+	if !isCoinBase {
+		for i := range msgTx.TxIn {
+			_, credKey, err := existsDebit(nsDebits, txHash, uint32(i), block)
+			if err != nil {
+				return err
+			}
+			if credKey == nil {
+				continue
+			}
+			v, err := existsRawCredit(nsCredits, credKey)
+			if err != nil {
+				return err
+			}
+			if v == nil {
+				continue
+			}
+			h, cred, err := record(v)
+			if err != nil {
+				return err
+			}
+			if h == nil {
+				continue
+			}
+			if h.Sent, err = h.Sent.Add(cred.amount); err != nil {
+				return err
+			}
+			h.Category |= TxCategorySent
+			inputs[h.WalletID]++
+		}
+	}
+
+	for walletId, h := range histories {
+		// outputs paid to change addresses are received if nothing is sent
+		if h.Category&TxCategorySent == 0 {
+			h.Category |= TxCategoryReceived
+		}
+		if inputs[walletId] == len(msgTx.TxIn) && !isCoinBase {
+			fee, err := h.Sent.Sub(totalOut)
+			if err == nil {
+				h.Fee = fee
+			}
+		}
+		if err := putTxHistory(nsTxHistory, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildTxHistoryIndex builds the tx history index from the existing tx
+// records, if the index is newly created.
+func (s *TxStore) BuildTxHistoryIndex(tx mwdb.DBTransaction) error {
+	if s.historyIndexed {
+		return nil
+	}
+	nsTxRecords := tx.FetchBucket(s.bucketMeta.nsTxRecords)
+	nsBlocks := tx.FetchBucket(s.bucketMeta.nsBlocks)
+	entries, err := nsTxRecords.GetByPrefix(nil)
+	if err != nil {
+		return err
+	}
+	logging.CPrint(logging.INFO, "building tx history index", logging.LogFormat{"records": len(entries)})
+
+	blocks := make(map[uint64]*blockRecord)
+	for _, entry := range entries {
+		if len(entry.Key) < 72 {
+			return fmt.Errorf("%s: short key (expected 72 bytes, read %d)", bucketTxRecords, len(entry.Key))
+		}
+		var txHash wire.Hash
+		copy(txHash[:], entry.Key[0:32])
+		height, _, err := readTxRecordKey(entry.Key)
+		if err != nil {
+			return err
+		}
+		blk, ok := blocks[height]
+		if !ok {
+			blk, err = fetchBlockRecord(nsBlocks, height)
+			if err != nil {
+				return err
+			}
+			if blk == nil {
+				logging.CPrint(logging.WARN, "block record of tx not found",
+					logging.LogFormat{
+						"tx":     txHash.String(),
+						"height": height,
+					})
+				continue
+			}
+			blocks[height] = blk
+		}
+		blkLoc, txLoc, err := readTxRecordLoc(entry.Value)
+		if err != nil {
+			return err
+		}
+		msgTx, err := s.chainFetcher.FetchTxByFileLoc(blkLoc, txLoc)
+		if err != nil {
+			return err
+		}
+		if err = s.indexTxHistory(tx, msgTx, &txHash, &blk.BlockMeta); err != nil {
+			return err
+		}
+	}
+	s.historyIndexed = true
+	return nil
+}
+
+// ListTxHistory returns a page of the tx histories of walletId selected by
+// filter, and the cursor of the next page, which is nil on the last page.
+func (s *TxStore) ListTxHistory(tx mwdb.ReadTransaction, walletId string,
+	filter *TxHistoryFilter) ([]*TxHistory, []byte, error) {
+	if len(filter.Cursor) != 0 && len(filter.Cursor) != 40 {
+		return nil, nil, ErrInvalidCursor
+	}
+	if len(walletId) != 42 {
+		return nil, nil, fmt.Errorf("ListTxHistory expect 42 bytes wallet id(actual %d)", len(walletId))
+	}
+
+	// keys are ordered by height descending
+	start := []byte(walletId)
+	if filter.MaxHeight > 0 {
+		start = keyTxHistory(walletId, filter.MaxHeight, &wire.Hash{})[:50]
+	}
+	limit := mwdb.BytesPrefix([]byte(walletId)).Limit
+	if filter.MinHeight > 0 {
+		limit = keyTxHistory(walletId, filter.MinHeight-1, &wire.Hash{})[:50]
+	}
+	if len(filter.Cursor) != 0 {
+		k := append([]byte(walletId), filter.Cursor...)
+		if filter.Ascending {
+			if bytes.Compare(k, limit) < 0 {
+				limit = k
+			}
+		} else {
+			k = append(k, 0)
+			if bytes.Compare(k, start) > 0 {
+				start = k
+			}
+		}
+	}
+
+	// one more record than the limit tells whether there is a next page
+	max := 0
+	if filter.Limit > 0 {
+		max = filter.Limit + 1
+	}
+	nsTxHistory := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+	var (
+		ret []*TxHistory
+		err error
+	)
+	if filter.Ascending {
+		ret, err = readTxHistoryAscending(nsTxHistory, walletId, start, limit, filter, max)
+	} else {
+		ret, err = readTxHistoryRange(nsTxHistory, &mwdb.Range{Start: start, Limit: limit}, filter, max)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cursor []byte
+	if filter.Limit > 0 && len(ret) > filter.Limit {
+		ret = ret[:filter.Limit]
+		last := ret[len(ret)-1]
+		cursor = keyTxHistory(last.WalletID, last.Block.Height, &last.TxHash)[42:]
+	}
+	return ret, cursor, nil
+}
+
+// readTxHistoryRange returns at most max (0 for no limit) records of slice
+// matching filter, in key order.
+func readTxHistoryRange(bucket mwdb.Bucket, slice *mwdb.Range, filter *TxHistoryFilter, max int) ([]*TxHistory, error) {
+	iter := bucket.NewIterator(slice)
+	defer iter.Release()
+
+	ret := make([]*TxHistory, 0)
+	for iter.Next() {
+		h := &TxHistory{}
+		if err := readTxHistory(iter.Key(), iter.Value(), h); err != nil {
+			return nil, err
+		}
+		if filter.Category != 0 && h.Category&filter.Category == 0 {
+			continue
+		}
+		if !filter.Since.IsZero() && h.Block.Timestamp.Before(filter.Since) {
+			continue
+		}
+		if !filter.Until.IsZero() && h.Block.Timestamp.After(filter.Until) {
+			continue
+		}
+		ret = append(ret, h)
+		if max > 0 && len(ret) == max {
+			break
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// readTxHistoryAscending returns at most max (0 for no limit) records between
+// start and limit matching filter, from the lowest height. Keys are ordered by
+// height descending, so windows of growing height ranges are read upwards
+// from limit, each one forward and then reversed.
+func readTxHistoryAscending(bucket mwdb.Bucket, walletId string, start, limit []byte,
+	filter *TxHistoryFilter, max int) ([]*TxHistory, error) {
+	// the highest height of the range is its first key
+	iter := bucket.NewIterator(&mwdb.Range{Start: start, Limit: limit})
+	if !iter.Next() {
+		err := iter.Error()
+		iter.Release()
+		return []*TxHistory{}, err
+	}
+	top := ^binary.BigEndian.Uint64(iter.Key()[42:50])
+	iter.Release()
+
+	// the lowest height is bounded by limit, which is either the end of the
+	// wallet prefix or a key of the wallet
+	bottom := uint64(0)
+	if bytes.HasPrefix(limit, []byte(walletId)) && len(limit) >= 50 {
+		bottom = ^binary.BigEndian.Uint64(limit[42:50])
+	}
+	if bottom > top {
+		bottom = top
+	}
+
+	size := uint64(max)
+	if size == 0 {
+		size = top - bottom + 1
+	}
+	ret := make([]*TxHistory, 0)
+	for lo := bottom; ; size *= 2 {
+		hi := top
+		if top-lo >= size {
+			hi = lo + size - 1
+		}
+		slice := &mwdb.Range{
+			Start: keyTxHistory(walletId, hi, &wire.Hash{})[:50],
+			Limit: limit,
+		}
+		if bytes.Compare(slice.Start, start) < 0 {
+			slice.Start = start
+		}
+		if lo > bottom {
+			slice.Limit = keyTxHistory(walletId, lo-1, &wire.Hash{})[:50]
+		}
+		window, err := readTxHistoryRange(bucket, slice, filter, 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := len(window) - 1; i >= 0; i-- {
+			ret = append(ret, window[i])
+		}
+		if hi == top || (max > 0 && len(ret) >= max) {
+			break
+		}
+		lo = hi + 1
+	}
+	if max > 0 && len(ret) > max {
+		ret = ret[:max]
+	}
+	return ret, nil
+}
+
+// RemoveTxHistoryByWalletId ...
+func (s *TxStore) RemoveTxHistoryByWalletId(tx mwdb.DBTransaction, walletId string) error {
+	if len(walletId) == 0 {
+		return nil
+	}
+	nsTxHistory := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+	return deleteByPrefix(nsTxHistory, []byte(walletId))
+}
+
+// AddStakingRewards records the staking reward outputs of the coinbase rec
+// mined in block, which are the first outputs of the number given by the
+// coinbase payload, for the wallets they are relevant to.
+func (s *TxStore) AddStakingRewards(tx mwdb.DBTransaction, rec *TxRecord, block *BlockMeta) error {
+	if block.Height == 0 || !blockchain.IsCoinBaseTx(&rec.MsgTx) {
+		return nil
+	}
+	payload := blockchain.NewCoinbasePayload()
+	if err := payload.SetBytes(rec.MsgTx.Payload); err != nil {
+		logging.CPrint(logging.ERROR, "invalid coinbase payload",
+			logging.LogFormat{
+				"tx":     rec.Hash.String(),
+				"height": block.Height,
+				"err":    err,
+			})
+		return err
+	}
+
+	nsStakingReward := tx.FetchBucket(s.bucketMeta.nsStakingReward)
+	for _, rel := range rec.RelevantTxOut {
+		if uint32(rel.Index) >= payload.NumStakingReward() {
+			continue
+		}
+		amt, err := massutil.NewAmountFromInt(rec.MsgTx.TxOut[rel.Index].Value)
+		if err != nil {
+			return err
+		}
+		err = putStakingReward(nsStakingReward, &StakingReward{
+			WalletID:   rel.WalletId,
+			Block:      *block,
+			Index:      uint32(rel.Index),
+			Amount:     amt,
+			ScriptHash: rel.PkScript.StdScriptAddress(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildStakingRewardIndex builds the staking reward index from the existing
+// coinbase records, if the index is newly created.
+func (s *TxStore) BuildStakingRewardIndex(tx mwdb.DBTransaction) error {
+	if s.stakingRewardIndexed {
+		return nil
+	}
+	nsTxRecords := tx.FetchBucket(s.bucketMeta.nsTxRecords)
+	nsBlocks := tx.FetchBucket(s.bucketMeta.nsBlocks)
+	entries, err := nsTxRecords.GetByPrefix(nil)
+	if err != nil {
+		return err
+	}
+	logging.CPrint(logging.INFO, "building staking reward index", logging.LogFormat{"records": len(entries)})
+
+	for _, entry := range entries {
+		height, _, err := readTxRecordKey(entry.Key)
+		if err != nil {
+			return err
+		}
+		blkLoc, txLoc, err := readTxRecordLoc(entry.Value)
+		if err != nil {
+			return err
+		}
+		msgTx, err := s.chainFetcher.FetchTxByFileLoc(blkLoc, txLoc)
+		if err != nil {
+			return err
+		}
+		if !blockchain.IsCoinBaseTx(msgTx) {
+			continue
+		}
+		blk, err := fetchBlockRecord(nsBlocks, height)
+		if err != nil {
+			return err
+		}
+		if blk == nil {
+			logging.CPrint(logging.WARN, "block record of coinbase not found",
+				logging.LogFormat{
+					"height": height,
+				})
+			continue
+		}
+
+		rec, err := NewTxRecordFromMsgTx(msgTx, blk.Timestamp)
+		if err != nil {
+			return err
+		}
+		for i, txOut := range msgTx.TxOut {
+			ps, err := utils.ParsePkScript(txOut.PkScript, s.chainParams)
+			if err != nil {
+				if err == utils.ErrUnsupportedScript {
+					continue
+				}
+				return err
+			}
+			ma, err := s.ksmgr.GetManagedAddressByScriptHash(ps.StdScriptAddress())
+			if err != nil {
+				if err == keystore.ErrScriptHashNotFound {
+					continue
+				}
+				return err
+			}
+			rec.RelevantTxOut = append(rec.RelevantTxOut, &RelevantMeta{
+				Index:        i,
+				PkScript:     ps,
+				WalletId:     ma.Account(),
+				IsChangeAddr: ma.IsChangeAddr(),
+			})
+		}
+		if err = s.AddStakingRewards(tx, rec, &blk.BlockMeta); err != nil {
+			return err
+		}
+	}
+	s.stakingRewardIndexed = true
+	return nil
+}
+
+// ListStakingRewards returns the staking rewards of walletId in blocks from
+// minHeight to maxHeight, oldest first. A zero maxHeight leaves the range
+// open.
+func (s *TxStore) ListStakingRewards(tx mwdb.ReadTransaction, walletId string,
+	minHeight, maxHeight uint64) ([]*StakingReward, error) {
+	if len(walletId) != 42 {
+		return nil, fmt.Errorf("ListStakingRewards expect 42 bytes wallet id(actual %d)", len(walletId))
+	}
+	start := keyStakingReward(walletId, minHeight, 0)
+	limit := mwdb.BytesPrefix([]byte(walletId)).Limit
+	if maxHeight > 0 && maxHeight < math.MaxUint64 {
+		limit = keyStakingReward(walletId, maxHeight+1, 0)
+	}
+
+	nsStakingReward := tx.FetchBucket(s.bucketMeta.nsStakingReward)
+	iter := nsStakingReward.NewIterator(&mwdb.Range{Start: start, Limit: limit})
+	defer iter.Release()
+
+	ret := make([]*StakingReward, 0)
+	for iter.Next() {
+		r := &StakingReward{}
+		if err := readStakingReward(iter.Key(), iter.Value(), r); err != nil {
+			return nil, err
+		}
+		ret = append(ret, r)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RemoveStakingRewardsByWalletId ...
+func (s *TxStore) RemoveStakingRewardsByWalletId(tx mwdb.DBTransaction, walletId string) error {
+	if len(walletId) == 0 {
+		return nil
+	}
+	nsStakingReward := tx.FetchBucket(s.bucketMeta.nsStakingReward)
+	return deleteByPrefix(nsStakingReward, []byte(walletId))
+}
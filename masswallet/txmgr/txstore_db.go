@@ -7,6 +7,7 @@ import (
 
 	"massnet.org/mass-wallet/database"
 	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
 	mwdb "massnet.org/mass-wallet/masswallet/db"
 	"massnet.org/mass-wallet/wire"
 )
@@ -281,3 +282,152 @@ func fetchBlockRecord(ns mwdb.Bucket, height uint64) (block *blockRecord, err er
 	}
 	return
 }
+
+//    tx history
+func keyTxHistory(walletId string, height uint64, txHash *wire.Hash) []byte {
+	k := make([]byte, 82)
+	copy(k, walletId)
+	binary.BigEndian.PutUint64(k[42:50], ^height)
+	copy(k[50:82], txHash[:])
+	return k
+}
+
+func valueTxHistory(h *TxHistory) []byte {
+	v := make([]byte, 65)
+	copy(v[0:32], h.Block.Hash[:])
+	binary.BigEndian.PutUint64(v[32:40], uint64(h.Block.Timestamp.Unix()))
+	binary.BigEndian.PutUint64(v[40:48], h.Received.UintValue())
+	binary.BigEndian.PutUint64(v[48:56], h.Sent.UintValue())
+	binary.BigEndian.PutUint64(v[56:64], h.Fee.UintValue())
+	v[64] = byte(h.Category)
+	return v
+}
+
+func putTxHistory(ns mwdb.Bucket, h *TxHistory) error {
+	k := keyTxHistory(h.WalletID, h.Block.Height, &h.TxHash)
+	if err := ns.Put(k, valueTxHistory(h)); err != nil {
+		return fmt.Errorf("failed to put tx history: %v, err: %v", h.TxHash, err)
+	}
+	return nil
+}
+
+func readTxHistory(k, v []byte, h *TxHistory) (err error) {
+	if len(k) != 82 {
+		return fmt.Errorf("%s: invalid key length (expected %d bytes, read %d)",
+			bucketTxHistory, 82, len(k))
+	}
+	if len(v) < 65 {
+		return fmt.Errorf("%s: short value (expected %d bytes, read %d)",
+			bucketTxHistory, 65, len(v))
+	}
+	h.WalletID = string(k[0:42])
+	h.Block.Height = ^binary.BigEndian.Uint64(k[42:50])
+	copy(h.TxHash[:], k[50:82])
+	copy(h.Block.Hash[:], v[0:32])
+	h.Block.Timestamp = time.Unix(int64(binary.BigEndian.Uint64(v[32:40])), 0)
+	if h.Received, err = massutil.NewAmountFromUint(binary.BigEndian.Uint64(v[40:48])); err != nil {
+		return err
+	}
+	if h.Sent, err = massutil.NewAmountFromUint(binary.BigEndian.Uint64(v[48:56])); err != nil {
+		return err
+	}
+	if h.Fee, err = massutil.NewAmountFromUint(binary.BigEndian.Uint64(v[56:64])); err != nil {
+		return err
+	}
+	h.Category = TxCategory(v[64])
+	return nil
+}
+
+// deleteTxHistoryFromHeight deletes the tx histories of walletId mined at or
+// above height.
+func deleteTxHistoryFromHeight(ns mwdb.Bucket, walletId string, height uint64) error {
+	limit := make([]byte, 50)
+	copy(limit, walletId)
+	binary.BigEndian.PutUint64(limit[42:50], ^height+1)
+	if height == 0 {
+		limit = mwdb.BytesPrefix([]byte(walletId)).Limit
+	}
+	iter := ns.NewIterator(&mwdb.Range{Start: []byte(walletId), Limit: limit})
+	defer iter.Release()
+	keys := make([][]byte, 0)
+	for iter.Next() {
+		keys = append(keys, append([]byte(nil), iter.Key()...))
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := ns.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//    staking reward
+func keyStakingReward(walletId string, height uint64, index uint32) []byte {
+	k := make([]byte, 54)
+	copy(k, walletId)
+	binary.BigEndian.PutUint64(k[42:50], height)
+	binary.BigEndian.PutUint32(k[50:54], index)
+	return k
+}
+
+func valueStakingReward(r *StakingReward) []byte {
+	v := make([]byte, 80)
+	copy(v[0:32], r.Block.Hash[:])
+	binary.BigEndian.PutUint64(v[32:40], uint64(r.Block.Timestamp.Unix()))
+	binary.BigEndian.PutUint64(v[40:48], r.Amount.UintValue())
+	copy(v[48:80], r.ScriptHash)
+	return v
+}
+
+func putStakingReward(ns mwdb.Bucket, r *StakingReward) error {
+	k := keyStakingReward(r.WalletID, r.Block.Height, r.Index)
+	if err := ns.Put(k, valueStakingReward(r)); err != nil {
+		return fmt.Errorf("failed to put staking reward: %d:%d, err: %v", r.Block.Height, r.Index, err)
+	}
+	return nil
+}
+
+func readStakingReward(k, v []byte, r *StakingReward) (err error) {
+	if len(k) != 54 {
+		return fmt.Errorf("%s: invalid key length (expected %d bytes, read %d)",
+			bucketStakingReward, 54, len(k))
+	}
+	if len(v) < 80 {
+		return fmt.Errorf("%s: short value (expected %d bytes, read %d)",
+			bucketStakingReward, 80, len(v))
+	}
+	r.WalletID = string(k[0:42])
+	r.Block.Height = binary.BigEndian.Uint64(k[42:50])
+	r.Index = binary.BigEndian.Uint32(k[50:54])
+	copy(r.Block.Hash[:], v[0:32])
+	r.Block.Timestamp = time.Unix(int64(binary.BigEndian.Uint64(v[32:40])), 0)
+	if r.Amount, err = massutil.NewAmountFromUint(binary.BigEndian.Uint64(v[40:48])); err != nil {
+		return err
+	}
+	r.ScriptHash = append([]byte(nil), v[48:80]...)
+	return nil
+}
+
+// deleteStakingRewardFromHeight deletes the staking rewards of walletId at or
+// above height.
+func deleteStakingRewardFromHeight(ns mwdb.Bucket, walletId string, height uint64) error {
+	start := keyStakingReward(walletId, height, 0)
+	iter := ns.NewIterator(&mwdb.Range{Start: start, Limit: mwdb.BytesPrefix([]byte(walletId)).Limit})
+	defer iter.Release()
+	keys := make([][]byte, 0)
+	for iter.Next() {
+		keys = append(keys, append([]byte(nil), iter.Key()...))
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := ns.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
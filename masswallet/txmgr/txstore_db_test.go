@@ -5,8 +5,7 @@ import (
 	"encoding/hex"
 	"os"
 	"testing"
-
-	// "time"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"massnet.org/mass-wallet/database"
@@ -140,3 +139,179 @@ func TestBlockRecord(t *testing.T) {
 		return nil
 	})
 }
+
+func TestTxHistory(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("ChainTestDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+	s, walletDb, teardown, err := testTxStore("TstTxHistoryDb", chainDb)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	categories := []TxCategory{TxCategoryReceived, TxCategorySent, TxCategorySent | TxCategoryStaking}
+	histories := make([]*TxHistory, 0)
+	for height := uint64(1); height <= 9; height++ {
+		histories = append(histories, &TxHistory{
+			WalletID: walletID,
+			TxHash:   wire.DoubleHashH([]byte{byte(height)}),
+			Block: BlockMeta{
+				Height:    height,
+				Hash:      wire.DoubleHashH([]byte{0, byte(height)}),
+				Timestamp: time.Unix(int64(1000*height), 0),
+			},
+			Received: massutil.ZeroAmount(),
+			Sent:     massutil.ZeroAmount(),
+			Fee:      massutil.ZeroAmount(),
+			Category: categories[height%3],
+		})
+	}
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		ns := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+		for _, h := range histories {
+			if err := putTxHistory(ns, h); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+
+	list := func(filter *TxHistoryFilter) (heights []uint64, cursor []byte) {
+		err := mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+			ret, c, err := s.ListTxHistory(tx, walletID, filter)
+			if err != nil {
+				return err
+			}
+			for _, h := range ret {
+				heights = append(heights, h.Block.Height)
+			}
+			cursor = c
+			return nil
+		})
+		assert.Nil(t, err)
+		return
+	}
+
+	// pages, newest first
+	heights, cursor := list(&TxHistoryFilter{Limit: 4})
+	assert.Equal(t, []uint64{9, 8, 7, 6}, heights)
+	heights, cursor = list(&TxHistoryFilter{Limit: 4, Cursor: cursor})
+	assert.Equal(t, []uint64{5, 4, 3, 2}, heights)
+	heights, cursor = list(&TxHistoryFilter{Limit: 4, Cursor: cursor})
+	assert.Equal(t, []uint64{1}, heights)
+	assert.Nil(t, cursor)
+
+	// pages, oldest first
+	heights, cursor = list(&TxHistoryFilter{Limit: 5, Ascending: true})
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5}, heights)
+	heights, cursor = list(&TxHistoryFilter{Limit: 5, Ascending: true, Cursor: cursor})
+	assert.Equal(t, []uint64{6, 7, 8, 9}, heights)
+	assert.Nil(t, cursor)
+	heights, cursor = list(&TxHistoryFilter{Limit: 2, Ascending: true, Category: TxCategoryStaking})
+	assert.Equal(t, []uint64{2, 5}, heights)
+	heights, cursor = list(&TxHistoryFilter{Limit: 2, Ascending: true, Category: TxCategoryStaking, Cursor: cursor})
+	assert.Equal(t, []uint64{8}, heights)
+	assert.Nil(t, cursor)
+	heights, _ = list(&TxHistoryFilter{MinHeight: 4, MaxHeight: 7, Limit: 1, Ascending: true})
+	assert.Equal(t, []uint64{4}, heights)
+
+	// ranges and category
+	heights, _ = list(&TxHistoryFilter{MinHeight: 3, MaxHeight: 6})
+	assert.Equal(t, []uint64{6, 5, 4, 3}, heights)
+	heights, _ = list(&TxHistoryFilter{Since: time.Unix(2000, 0), Until: time.Unix(4000, 0), Ascending: true})
+	assert.Equal(t, []uint64{2, 3, 4}, heights)
+	heights, _ = list(&TxHistoryFilter{Category: TxCategoryStaking})
+	assert.Equal(t, []uint64{8, 5, 2}, heights)
+	heights, _ = list(&TxHistoryFilter{Category: TxCategoryReceived | TxCategoryStaking})
+	assert.Equal(t, []uint64{9, 8, 6, 5, 3, 2}, heights)
+
+	_, _, err = s.ListTxHistory(nil, walletID, &TxHistoryFilter{Cursor: []byte{1}})
+	assert.Equal(t, ErrInvalidCursor, err)
+
+	// rollback
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		return deleteTxHistoryFromHeight(tx.FetchBucket(s.bucketMeta.nsTxHistory), walletID, 7)
+	})
+	assert.Nil(t, err)
+	heights, _ = list(&TxHistoryFilter{})
+	assert.Equal(t, []uint64{6, 5, 4, 3, 2, 1}, heights)
+}
+
+func TestStakingReward(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("ChainTestDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+	s, walletDb, teardown, err := testTxStore("TstStakingRewardDb", chainDb)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		ns := tx.FetchBucket(s.bucketMeta.nsStakingReward)
+		for height := uint64(1); height <= 9; height++ {
+			amt, err := massutil.NewAmountFromUint(height * 100)
+			if err != nil {
+				return err
+			}
+			err = putStakingReward(ns, &StakingReward{
+				WalletID: walletID,
+				Block: BlockMeta{
+					Height:    height,
+					Hash:      wire.DoubleHashH([]byte{byte(height)}),
+					Timestamp: time.Unix(int64(1000*height), 0),
+				},
+				Index:      uint32(height % 2),
+				Amount:     amt,
+				ScriptHash: wire.DoubleHashB([]byte{0, byte(height % 3)}),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+
+	list := func(minHeight, maxHeight uint64) (heights []uint64) {
+		err := mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+			ret, err := s.ListStakingRewards(tx, walletID, minHeight, maxHeight)
+			if err != nil {
+				return err
+			}
+			for _, r := range ret {
+				heights = append(heights, r.Block.Height)
+				assert.Equal(t, r.Block.Height*100, r.Amount.UintValue())
+				assert.Equal(t, uint32(r.Block.Height%2), r.Index)
+				assert.Equal(t, wire.DoubleHashB([]byte{0, byte(r.Block.Height % 3)}), r.ScriptHash)
+				assert.Equal(t, int64(1000*r.Block.Height), r.Block.Timestamp.Unix())
+			}
+			return nil
+		})
+		assert.Nil(t, err)
+		return
+	}
+
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9}, list(0, 0))
+	assert.Equal(t, []uint64{3, 4, 5, 6}, list(3, 6))
+	assert.Equal(t, []uint64{8, 9}, list(8, 0))
+
+	// rollback
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		return deleteStakingRewardFromHeight(tx.FetchBucket(s.bucketMeta.nsStakingReward), walletID, 7)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, list(0, 0))
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		return s.RemoveStakingRewardsByWalletId(tx, walletID)
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, list(0, 0))
+}
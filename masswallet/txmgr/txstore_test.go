@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"massnet.org/mass-wallet/blockchain"
+	"massnet.org/mass-wallet/config"
 	"massnet.org/mass-wallet/errors"
 	"massnet.org/mass-wallet/logging"
 	"massnet.org/mass-wallet/massutil"
@@ -14,6 +15,7 @@ import (
 	_ "massnet.org/mass-wallet/masswallet/db/ldb"
 	"massnet.org/mass-wallet/masswallet/keystore"
 	"massnet.org/mass-wallet/masswallet/utils"
+	"massnet.org/mass-wallet/txscript"
 	"massnet.org/mass-wallet/wire"
 )
 
@@ -283,6 +285,109 @@ func TestRemoveRelevantTx(t *testing.T) {
 	})
 }
 
+func TestRollbackStakingReward(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("TstRollbackStakingRewardChainDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+	err = initBlocks(chainDb, 10)
+	if err != nil {
+		t.Fatal("initBlocks failed:", err)
+	}
+
+	s, walletDb, teardown, err := testTxStore("TstRollbackStakingReward", chainDb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	wIds := s.ksmgr.ListKeystoreNames()
+	err = mwdb.Update(walletDb, func(ns mwdb.DBTransaction) error {
+		err = s.ksmgr.UseKeystoreForWallet(wIds[0])
+		if err != nil {
+			return err
+		}
+
+		allMinedBalances := map[string]massutil.Amount{
+			wIds[0]: massutil.ZeroAmount(),
+		}
+		nsStakingReward := ns.FetchBucket(s.bucketMeta.nsStakingReward)
+		for _, block := range blks200[1:10] {
+			blockMeta := &BlockMeta{
+				Height:    block.MsgBlock().Header.Height,
+				Hash:      *block.Hash(),
+				Timestamp: block.MsgBlock().Header.Timestamp,
+			}
+			blockMeta.Loc, err = chainDb.FetchBlockLocByHeight(blockMeta.Height)
+			if err != nil {
+				return err
+			}
+			txlocs, err := block.TxLoc()
+			if err != nil {
+				return err
+			}
+			for i, tx := range block.Transactions() {
+				rec, err := NewTxRecordFromMsgTx(tx.MsgTx(), time.Now())
+				if err != nil {
+					return err
+				}
+				rec, err = simpleFilterTx(rec, tx.MsgTx(), s, blockMeta, wIds[0])
+				if err != nil {
+					return err
+				}
+				rec.TxLoc = &txlocs[i]
+				err = s.AddRelevantTx(ns, allMinedBalances, rec, blockMeta)
+				if err != nil {
+					return err
+				}
+			}
+			// mock blocks carry no staking reward, record one per block
+			coinbase := block.Transactions()[0].MsgTx()
+			amt, err := massutil.NewAmountFromInt(coinbase.TxOut[0].Value)
+			if err != nil {
+				return err
+			}
+			err = putStakingReward(nsStakingReward, &StakingReward{
+				WalletID:   wIds[0],
+				Block:      *blockMeta,
+				Amount:     amt,
+				ScriptHash: wire.DoubleHashB(coinbase.TxOut[0].PkScript),
+			})
+			if err != nil {
+				return err
+			}
+			err = s.syncStore.SetSyncedTo(ns, blockMeta)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	list := func() (heights []uint64) {
+		err := mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+			ret, err := s.ListStakingRewards(tx, wIds[0], 0, 0)
+			for _, r := range ret {
+				heights = append(heights, r.Block.Height)
+			}
+			return err
+		})
+		assert.Nil(t, err)
+		return
+	}
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9}, list())
+
+	err = mwdb.Update(walletDb, func(ns mwdb.DBTransaction) error {
+		return s.Rollback(ns, 7)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, list())
+}
+
 //
 func TestExistUtxo(t *testing.T) {
 	chainDb, chainDbTearDown, err := GetDb("TstExistUtoxChainDb")
@@ -351,7 +456,7 @@ func TestExistUtxo(t *testing.T) {
 				txhash := tx.Hash()
 				for i := range tx.MsgTx().TxOut {
 					outPoint := wire.OutPoint{Hash: *txhash, Index: uint32(i)}
-					f, err := s.ExistsUtxo(ns, &outPoint)
+					f, err := s.ExistsUtxo(ns, s.ksmgr.CurrentKeystore().Name(), &outPoint)
 					if j >= 10 {
 						assert.Equal(t, ErrNotFound, err)
 					} else {
@@ -366,3 +471,144 @@ func TestExistUtxo(t *testing.T) {
 		return nil
 	})
 }
+
+func TestRemoveUnminedDoubleSpends(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("TstRemoveUnminedDoubleSpendsChainDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+	s, walletDb, teardown, err := testTxStore("TstRemoveUnminedDoubleSpends", chainDb)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	addr, err := massutil.NewAddressWitnessScriptHash(make([]byte, 32), &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRec := func(prevOut *wire.OutPoint, value int64) *TxRecord {
+		msgTx := wire.NewMsgTx()
+		msgTx.AddTxIn(wire.NewTxIn(prevOut, nil))
+		msgTx.AddTxOut(wire.NewTxOut(value, pkScript))
+		rec, err := NewTxRecordFromMsgTx(msgTx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec.RelevantTxIn = append(rec.RelevantTxIn, &RelevantMeta{Index: 0, WalletId: walletID})
+		return rec
+	}
+	prevOut := &wire.OutPoint{Hash: wire.DoubleHashH([]byte("prev"))}
+	parent := newRec(prevOut, 2e8)
+	child := newRec(&wire.OutPoint{Hash: parent.Hash}, 1e8)
+	replacement := newRec(prevOut, 1e8)
+	unrelated := newRec(&wire.OutPoint{Hash: wire.DoubleHashH([]byte("other"))}, 2e8)
+
+	var removed []wire.Hash
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		for _, rec := range []*TxRecord{parent, child, unrelated} {
+			if err := s.AddRelevantTx(tx, nil, rec, nil); err != nil {
+				return err
+			}
+		}
+		removed, err = s.RemoveUnminedDoubleSpends(tx, replacement)
+		if err != nil {
+			return err
+		}
+		return s.AddRelevantTx(tx, nil, replacement, nil)
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	assert.ElementsMatch(t, []wire.Hash{parent.Hash, child.Hash}, removed)
+
+	mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+		for _, hash := range []wire.Hash{parent.Hash, child.Hash} {
+			_, err := s.ExistUnminedTx(tx, &hash)
+			assert.Equal(t, ErrNotFound, err)
+		}
+		for _, hash := range []wire.Hash{unrelated.Hash, replacement.Hash} {
+			_, err := s.ExistUnminedTx(tx, &hash)
+			assert.Nil(t, err)
+		}
+		nsUnminedInputs := tx.FetchBucket(s.bucketMeta.nsUnminedInputs)
+		spenders := fetchUnminedInputSpendTxHashes(nsUnminedInputs, canonicalOutPoint(&prevOut.Hash, prevOut.Index))
+		assert.Equal(t, []wire.Hash{replacement.Hash}, spenders)
+		return nil
+	})
+}
+
+func TestRemoveUnminedTx(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("TstRemoveUnminedTxChainDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+	s, walletDb, teardown, err := testTxStore("TstRemoveUnminedTx", chainDb)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	addr, err := massutil.NewAddressWitnessScriptHash(make([]byte, 32), &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRec := func(prevOut *wire.OutPoint, value int64) *TxRecord {
+		msgTx := wire.NewMsgTx()
+		msgTx.AddTxIn(wire.NewTxIn(prevOut, nil))
+		msgTx.AddTxOut(wire.NewTxOut(value, pkScript))
+		rec, err := NewTxRecordFromMsgTx(msgTx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec.RelevantTxIn = append(rec.RelevantTxIn, &RelevantMeta{Index: 0, WalletId: walletID})
+		return rec
+	}
+	prevOut := &wire.OutPoint{Hash: wire.DoubleHashH([]byte("prev"))}
+	parent := newRec(prevOut, 2e8)
+	child := newRec(&wire.OutPoint{Hash: parent.Hash}, 1e8)
+	unrelated := newRec(&wire.OutPoint{Hash: wire.DoubleHashH([]byte("other"))}, 2e8)
+
+	var removed []wire.Hash
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		for _, rec := range []*TxRecord{parent, child, unrelated} {
+			if err := s.AddRelevantTx(tx, nil, rec, nil); err != nil {
+				return err
+			}
+		}
+		removed, err = s.RemoveUnminedTx(tx, &parent.Hash)
+		if err != nil {
+			return err
+		}
+		_, err = s.RemoveUnminedTx(tx, &parent.Hash)
+		assert.Equal(t, ErrNotFound, err)
+		return nil
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	assert.ElementsMatch(t, []wire.Hash{parent.Hash, child.Hash}, removed)
+
+	mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+		for _, hash := range []wire.Hash{parent.Hash, child.Hash} {
+			_, err := s.ExistUnminedTx(tx, &hash)
+			assert.Equal(t, ErrNotFound, err)
+		}
+		_, err := s.ExistUnminedTx(tx, &unrelated.Hash)
+		assert.Nil(t, err)
+		nsUnminedInputs := tx.FetchBucket(s.bucketMeta.nsUnminedInputs)
+		spenders := fetchUnminedInputSpendTxHashes(nsUnminedInputs, canonicalOutPoint(&prevOut.Hash, prevOut.Index))
+		assert.Zero(t, len(spenders))
+		return nil
+	})
+}
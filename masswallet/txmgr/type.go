@@ -85,6 +85,34 @@ const (
 	//	  [0]         	- 0
 	bucketUnminedGameHistory = "LG"
 
+	// Key:
+	//    [0:42]  - wallet id
+	//    [42:50] - ^block.height, the newest comes first
+	//    [50:82] - txhash
+	// Value:
+	//    [0:32]  - block.hash
+	//    [32:40] - block.timestamp
+	//    [40:48] - received, amount of outputs paid to the wallet
+	//    [48:56] - sent, amount of inputs spent by the wallet
+	//    [56:64] - fee, 0 unless all inputs are spent by the wallet
+	//    [64:65] - category flags
+	//               0x01: Sent
+	//               0x02: Received
+	//               0x04: Staking
+	//               0x08: Binding
+	bucketTxHistory = "h"
+
+	// Key:
+	//    [0:42]  - wallet id
+	//    [42:50] - block.height
+	//    [50:54] - index of coinbase output
+	// Value:
+	//    [0:32]  - block.hash
+	//    [32:40] - block.timestamp
+	//    [40:48] - amount
+	//    [48:80] - witness script hash of the staking
+	bucketStakingReward = "sr"
+
 	//-----------------utxo buckets-----------------
 
 	// Key:
@@ -147,6 +175,16 @@ const (
 	//    [0:8]  - amount
 	//    [8:84]  - credit.key
 	bucketDebits = "d"
+
+	// Key:
+	//   [0:42]	   - wallet id(bech32 string)
+	//   [42:74]   - Transaction hash (32 bytes)
+	//   [74:78]   - Output index (4 bytes)
+	// Value:
+	//    [0:1]  - Flags (1 byte)
+	//               0x01: Frozen
+	//    [1:]   - label
+	bucketUtxoControl = "uc"
 )
 
 const (
@@ -191,6 +229,57 @@ var (
 	// ErrMaybeChainForks     = errors.New("maybe chain forks")
 )
 
+// TxCategory is a bit set of the roles a transaction plays for a wallet.
+type TxCategory uint8
+
+const (
+	TxCategorySent TxCategory = 1 << iota
+	TxCategoryReceived
+	TxCategoryStaking
+	TxCategoryBinding
+)
+
+// TxHistory is the summary of a mined transaction for one wallet.
+type TxHistory struct {
+	WalletID string
+	TxHash   wire.Hash
+	Block    BlockMeta
+	Received massutil.Amount
+	Sent     massutil.Amount
+	Fee      massutil.Amount
+	Category TxCategory
+}
+
+// NetAmount returns the change of wallet balance caused by the transaction.
+func (h *TxHistory) NetAmount() int64 {
+	return h.Received.IntValue() - h.Sent.IntValue()
+}
+
+// StakingReward is a coinbase output rewarding a staking of the wallet.
+type StakingReward struct {
+	WalletID   string
+	Block      BlockMeta
+	Index      uint32
+	Amount     massutil.Amount
+	ScriptHash []byte
+}
+
+// TxHistoryFilter selects the transactions of ListTxHistory. Zero values of
+// the fields mean no restriction.
+type TxHistoryFilter struct {
+	MinHeight uint64
+	MaxHeight uint64
+	Since     time.Time
+	Until     time.Time
+	// any of the categories
+	Category TxCategory
+	// oldest first if true
+	Ascending bool
+	// returned by the previous page
+	Cursor []byte
+	Limit  int
+}
+
 type RelevantMeta struct {
 	Index        int
 	PkScript     utils.PkScript
@@ -286,6 +375,13 @@ type Credit struct {
 	ScriptHash    []byte // for ScriptAddressUnspents
 }
 
+// UtxoControl is the coin control setting of an output. Frozen outputs are
+// never selected automatically.
+type UtxoControl struct {
+	Frozen bool
+	Label  string
+}
+
 type UtxoFlags struct {
 	Spent          bool
 	SpentByUnmined bool
@@ -419,6 +515,8 @@ type StoreBucketMeta struct {
 	nsAddresses          mwdb.BucketMeta
 	nsGameHistory        mwdb.BucketMeta
 	nsUnminedGameHistory mwdb.BucketMeta
+	nsTxHistory          mwdb.BucketMeta
+	nsStakingReward      mwdb.BucketMeta
 
 	// UtxoStore
 	nsUnspent        mwdb.BucketMeta
@@ -427,6 +525,7 @@ type StoreBucketMeta struct {
 	nsMinedBalance   mwdb.BucketMeta
 	nsCredits        mwdb.BucketMeta
 	nsDebits         mwdb.BucketMeta
+	nsUtxoControl    mwdb.BucketMeta
 
 	// SyncStore
 	nsSyncBucketName mwdb.BucketMeta
@@ -452,6 +551,12 @@ func (s *StoreBucketMeta) CheckInit() error {
 	if s.nsUnminedGameHistory == nil {
 		return errors.New("StoreBucketMeta.nsUnminedGameHistory not initialized")
 	}
+	if s.nsTxHistory == nil {
+		return errors.New("StoreBucketMeta.nsTxHistory not initialized")
+	}
+	if s.nsStakingReward == nil {
+		return errors.New("StoreBucketMeta.nsStakingReward not initialized")
+	}
 	if s.nsUnspent == nil {
 		return errors.New("StoreBucketMeta.nsUnspent not initialized")
 	}
@@ -470,6 +575,9 @@ func (s *StoreBucketMeta) CheckInit() error {
 	if s.nsDebits == nil {
 		return errors.New("StoreBucketMeta.nsDebits not initialized")
 	}
+	if s.nsUtxoControl == nil {
+		return errors.New("StoreBucketMeta.nsUtxoControl not initialized")
+	}
 	if s.nsSyncBucketName == nil {
 		return errors.New("StoreBucketMeta.nsSyncBucketName not initialized")
 	}
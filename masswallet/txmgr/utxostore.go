@@ -75,6 +75,12 @@ func NewUtxoStore(chainFetcher ifc.ChainFetcher, store mwdb.Bucket, ksmgr *keyst
 		return nil, err
 	}
 	s.bucketMeta.nsDebits = bucket.GetBucketMeta()
+	// coin control
+	bucket, err = mwdb.GetOrCreateBucket(store, bucketUtxoControl)
+	if err != nil {
+		return nil, err
+	}
+	s.bucketMeta.nsUtxoControl = bucket.GetBucketMeta()
 
 	//bucketAddresses
 	bucket, err = mwdb.GetOrCreateBucket(store, bucketAddresses)
@@ -630,7 +636,7 @@ func (s *UtxoStore) WalletBalance(tx mwdb.ReadTransaction, addrMgr *keystore.Add
 	if len(filteredScripts) == 0 {
 		return ret, nil
 	}
-	bal, err := s.ScriptAddressBalance(tx, filteredScripts, minConf, syncHeight, txpool)
+	bal, err := s.ScriptAddressBalance(tx, addrMgr.Name(), filteredScripts, minConf, syncHeight, txpool)
 	if err != nil {
 		return nil, fmt.Errorf("error to get address Balance: %v", err)
 	}
@@ -656,8 +662,8 @@ func (s *UtxoStore) WalletBalance(tx mwdb.ReadTransaction, addrMgr *keystore.Add
 	return ret, nil
 }
 
-// ScriptAddressBalance scripts -- script address in string format
-func (s *UtxoStore) ScriptAddressBalance(tx mwdb.ReadTransaction, scripts map[string]struct{},
+// ScriptAddressBalance scripts -- script address in string format of walletId
+func (s *UtxoStore) ScriptAddressBalance(tx mwdb.ReadTransaction, walletId string, scripts map[string]struct{},
 	minConf uint32, syncHeight uint64, txpool TxMemPool) (map[string]*BalanceDetail, error) {
 
 	s.muUtxo.Lock()
@@ -676,7 +682,7 @@ func (s *UtxoStore) ScriptAddressBalance(tx mwdb.ReadTransaction, scripts map[st
 	nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
 	nsCredits := tx.FetchBucket(s.bucketMeta.nsCredits)
 
-	iter := nsUnspent.NewIterator(mwdb.BytesPrefix([]byte(s.ksmgr.CurrentKeystore().Name())))
+	iter := nsUnspent.NewIterator(mwdb.BytesPrefix([]byte(walletId)))
 	defer iter.Release()
 
 	cred := &credit{
@@ -764,7 +770,7 @@ func (s *UtxoStore) ScriptAddressBalance(tx mwdb.ReadTransaction, scripts map[st
 
 // AddressUnspents returns all spendable UTXOs of specified addresses, including those spent by unmined transaction
 // return scriptHash->*Credit
-func (s *UtxoStore) ScriptAddressUnspents(tx mwdb.ReadTransaction, scriptAddrs map[string]struct{},
+func (s *UtxoStore) ScriptAddressUnspents(tx mwdb.ReadTransaction, walletId string, scriptAddrs map[string]struct{},
 	syncHeight uint64, filter CreditIterationFilter) (map[string][]*Credit, error) {
 
 	s.muUtxo.Lock()
@@ -782,7 +788,7 @@ func (s *UtxoStore) ScriptAddressUnspents(tx mwdb.ReadTransaction, scriptAddrs m
 	var op wire.OutPoint
 	var block BlockMeta
 
-	iter := nsUnspent.NewIterator(mwdb.BytesPrefix([]byte(s.ksmgr.CurrentKeystore().Name())))
+	iter := nsUnspent.NewIterator(mwdb.BytesPrefix([]byte(walletId)))
 	defer iter.Release()
 
 	for iter.Next() {
@@ -1198,3 +1204,61 @@ func (s *UtxoStore) ExistCreditFromTx(rtx mwdb.ReadTransaction, hash *wire.Hash)
 	defer iter.Release()
 	return iter.Next()
 }
+
+// FetchUtxoControl returns the coin control setting of op in walletId, or a
+// zero value if there is none.
+func (s *UtxoStore) FetchUtxoControl(tx mwdb.ReadTransaction, walletId string, op *wire.OutPoint) (*UtxoControl, error) {
+	nsUtxoControl := tx.FetchBucket(s.bucketMeta.nsUtxoControl)
+	v, err := nsUtxoControl.Get(canonicalUnspentKey(walletId, &op.Hash, op.Index))
+	if err != nil {
+		return nil, err
+	}
+	ctl := &UtxoControl{}
+	if v == nil {
+		return ctl, nil
+	}
+	return ctl, readUtxoControl(v, ctl)
+}
+
+// FetchUtxoControls returns the outputs of walletId that are frozen or
+// labeled.
+func (s *UtxoStore) FetchUtxoControls(tx mwdb.ReadTransaction, walletId string) (map[wire.OutPoint]*UtxoControl, error) {
+	nsUtxoControl := tx.FetchBucket(s.bucketMeta.nsUtxoControl)
+	entries, err := nsUtxoControl.GetByPrefix([]byte(walletId))
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[wire.OutPoint]*UtxoControl, len(entries))
+	for _, entry := range entries {
+		var op wire.OutPoint
+		if err = readCanonicalUnspentKey(entry.Key, &op); err != nil {
+			return nil, err
+		}
+		ctl := &UtxoControl{}
+		if err = readUtxoControl(entry.Value, ctl); err != nil {
+			return nil, err
+		}
+		ret[op] = ctl
+	}
+	return ret, nil
+}
+
+// PutUtxoControl saves the coin control setting of op in walletId. A zero
+// value clears the setting.
+func (s *UtxoStore) PutUtxoControl(tx mwdb.DBTransaction, walletId string, op *wire.OutPoint, ctl *UtxoControl) error {
+	nsUtxoControl := tx.FetchBucket(s.bucketMeta.nsUtxoControl)
+	k := canonicalUnspentKey(walletId, &op.Hash, op.Index)
+	if !ctl.Frozen && len(ctl.Label) == 0 {
+		return nsUtxoControl.Delete(k)
+	}
+	return nsUtxoControl.Put(k, valueUtxoControl(ctl))
+}
+
+// RemoveUtxoControlsByWalletId ...
+func (s *UtxoStore) RemoveUtxoControlsByWalletId(tx mwdb.DBTransaction, walletId string) error {
+	if len(walletId) == 0 {
+		return nil
+	}
+	nsUtxoControl := tx.FetchBucket(s.bucketMeta.nsUtxoControl)
+	return deleteByPrefix(nsUtxoControl, []byte(walletId))
+}
@@ -747,3 +747,24 @@ func deleteByPrefix(ns mwdb.Bucket, prefix []byte) error {
 	}
 	return nil
 }
+
+const utxoControlFrozen = 0x01
+
+func valueUtxoControl(ctl *UtxoControl) []byte {
+	v := make([]byte, 1+len(ctl.Label))
+	if ctl.Frozen {
+		v[0] |= utxoControlFrozen
+	}
+	copy(v[1:], ctl.Label)
+	return v
+}
+
+func readUtxoControl(v []byte, ctl *UtxoControl) error {
+	if len(v) < 1 {
+		return fmt.Errorf("%s: short value (expected %d bytes, read %d)",
+			bucketUtxoControl, 1, len(v))
+	}
+	ctl.Frozen = v[0]&utxoControlFrozen != 0
+	ctl.Label = string(v[1:])
+	return nil
+}
@@ -431,3 +431,84 @@ func TestAddRelevantTx(t *testing.T) {
 		return nil
 	})
 }
+
+func TestPutFetchUtxoControl(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("TstUtxoControlChainDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+
+	s, walletDb, teardown, err := testTxStore("TstUtxoControl", chainDb)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	const (
+		wallet1 = "ac10tcdcmxcatq0dp0ceucgdjc5m7azujzfenzzwfp"
+		wallet2 = "ac10jv5xfkywm9fu2elcjyqyq4gyz6yu6jzm7fq8fz"
+	)
+	op1 := wire.OutPoint{Hash: wire.DoubleHashH([]byte("op1")), Index: 1}
+	op2 := wire.OutPoint{Hash: wire.DoubleHashH([]byte("op2")), Index: 0}
+
+	put := func(walletId string, op *wire.OutPoint, ctl *UtxoControl) {
+		err := mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+			return s.utxoStore.PutUtxoControl(tx, walletId, op, ctl)
+		})
+		if !assert.Nil(t, err) {
+			t.Fatal(err)
+		}
+	}
+	fetchAll := func(walletId string) map[wire.OutPoint]*UtxoControl {
+		var ret map[wire.OutPoint]*UtxoControl
+		err := mwdb.View(walletDb, func(tx mwdb.ReadTransaction) (err error) {
+			ret, err = s.utxoStore.FetchUtxoControls(tx, walletId)
+			return err
+		})
+		if !assert.Nil(t, err) {
+			t.Fatal(err)
+		}
+		return ret
+	}
+
+	put(wallet1, &op1, &UtxoControl{Frozen: true})
+	put(wallet1, &op2, &UtxoControl{Label: "cold storage"})
+	put(wallet2, &op1, &UtxoControl{Frozen: true, Label: "标签"})
+
+	ctls := fetchAll(wallet1)
+	assert.Equal(t, 2, len(ctls))
+	assert.Equal(t, &UtxoControl{Frozen: true}, ctls[op1])
+	assert.Equal(t, &UtxoControl{Label: "cold storage"}, ctls[op2])
+
+	err = mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+		ctl, err := s.utxoStore.FetchUtxoControl(tx, wallet2, &op1)
+		assert.Equal(t, &UtxoControl{Frozen: true, Label: "标签"}, ctl)
+		if err != nil {
+			return err
+		}
+		// absent controls are zero
+		ctl, err = s.utxoStore.FetchUtxoControl(tx, wallet2, &op2)
+		assert.Equal(t, &UtxoControl{}, ctl)
+		return err
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	// zero controls are removed
+	put(wallet1, &op1, &UtxoControl{})
+	ctls = fetchAll(wallet1)
+	assert.Equal(t, 1, len(ctls))
+	_, ok := ctls[op1]
+	assert.False(t, ok)
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		return s.utxoStore.RemoveUtxoControlsByWalletId(tx, wallet1)
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(fetchAll(wallet1)))
+	assert.Equal(t, 1, len(fetchAll(wallet2)))
+}
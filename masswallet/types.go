@@ -5,6 +5,7 @@ import (
 	"massnet.org/mass-wallet/database"
 	"massnet.org/mass-wallet/errors"
 	"massnet.org/mass-wallet/massutil"
+	"massnet.org/mass-wallet/masswallet/keystore"
 	"massnet.org/mass-wallet/masswallet/txmgr"
 	"massnet.org/mass-wallet/netsync"
 )
@@ -37,6 +38,9 @@ type WalletSummary struct {
 	Version  uint8
 	Remarks  string
 	Status   *txmgr.WalletStatus
+	Multisig *keystore.MultisigParams
+	// WatchOnly is set for a wallet imported from an account xpub
+	WatchOnly bool
 }
 
 type WalletInfo struct {
@@ -58,6 +62,8 @@ type UnspentDetail struct {
 	Maturity       uint32          `json:"maturity"`
 	Confirmations  uint32          `json:"confirmations"`
 	SpentByUnmined bool            `json:"spent_by_unmined"`
+	Frozen         bool            `json:"frozen"`
+	Label          string          `json:"label"`
 	// IsCoinbase bool `json"is_coinbase"`
 }
 
@@ -1,11 +1,338 @@
 package masswallet
 
 import (
+	"container/heap"
+	"sort"
+
 	"massnet.org/mass-wallet/blockchain"
+	"massnet.org/mass-wallet/logging"
 	"massnet.org/mass-wallet/massutil"
 	"massnet.org/mass-wallet/masswallet/txmgr"
 )
 
+// strategies of coin selection
+const (
+	CoinSelectionDefault     = "default"
+	CoinSelectionBnB         = "bnb"
+	CoinSelectionOldestFirst = "oldest"
+	CoinSelectionConsolidate = "consolidate"
+)
+
+// bnbMaxTries bounds the branches searched by branch-and-bound.
+const bnbMaxTries = 100000
+
+// CoinSelector selects inputs of a transaction out of eligible outputs.
+type CoinSelector interface {
+	// Select returns candidates paying at least target along with their sum,
+	// or all of them if they are not enough.
+	Select(target massutil.Amount, candidates []*txmgr.Credit) ([]*txmgr.Credit, massutil.Amount, error)
+
+	// newPool returns the pool collecting the candidates of Select out of
+	// every eligible output.
+	newPool(target massutil.Amount) utxoPool
+}
+
+// changeAvoider is implemented by selectors paying change below the dust
+// threshold to miners, instead of selecting more inputs for a change output.
+type changeAvoider interface {
+	avoidsChange() bool
+}
+
+// NewCoinSelector returns the selector of strategy, an empty strategy is
+// CoinSelectionDefault.
+func NewCoinSelector(strategy string) (CoinSelector, error) {
+	switch strategy {
+	case "", CoinSelectionDefault:
+		return defaultSelector{}, nil
+	case CoinSelectionBnB:
+		return bnbSelector{}, nil
+	case CoinSelectionOldestFirst:
+		return oldestFirstSelector{}, nil
+	case CoinSelectionConsolidate:
+		return consolidationSelector{}, nil
+	default:
+		logging.CPrint(logging.ERROR, "unknown coin selection strategy", logging.LogFormat{"strategy": strategy})
+		return nil, ErrInvalidParameter
+	}
+}
+
+// utxoPool collects candidates out of eligible outputs, it keeps a bounded
+// number of them.
+type utxoPool interface {
+	submit(item *txmgr.Credit)
+	Items() []*txmgr.Credit
+	// full reports whether eligible outputs may have been left out.
+	full() bool
+}
+
+// defaultSelector spends the largest candidates not exceeding target first.
+type defaultSelector struct{}
+
+func (defaultSelector) Select(target massutil.Amount, candidates []*txmgr.Credit) ([]*txmgr.Credit, massutil.Amount, error) {
+	// optOutputs sorts in place
+	sorted := make([]*txmgr.Credit, len(candidates))
+	copy(sorted, candidates)
+	selected, sum, _, err := optOutputs(target, sorted)
+	return selected, sum, err
+}
+
+func (defaultSelector) newPool(target massutil.Amount) utxoPool {
+	return newTopKSelector(target)
+}
+
+// bnbSelector searches, by branch and bound, candidates exceeding target by
+// less than the dust threshold, so that no change output is needed. It falls
+// back to defaultSelector if there is no such match.
+type bnbSelector struct{}
+
+func (bnbSelector) Select(target massutil.Amount, candidates []*txmgr.Credit) ([]*txmgr.Credit, massutil.Amount, error) {
+	if selected, sum, ok := searchExactMatch(target, candidates, massutil.MinRelayTxFee(), bnbMaxTries); ok {
+		return selected, sum, nil
+	}
+	return defaultSelector{}.Select(target, candidates)
+}
+
+func (bnbSelector) newPool(target massutil.Amount) utxoPool {
+	return newTopKSelector(target)
+}
+
+func (bnbSelector) avoidsChange() bool {
+	return true
+}
+
+// searchExactMatch returns the candidates whose sum is in [target, target+window)
+// with the least excess, trying at most maxTries branches.
+func searchExactMatch(target massutil.Amount, candidates []*txmgr.Credit, window massutil.Amount,
+	maxTries int) ([]*txmgr.Credit, massutil.Amount, bool) {
+	if target.IsZero() {
+		return nil, massutil.ZeroAmount(), false
+	}
+	sorted := make([]*txmgr.Credit, len(candidates))
+	copy(sorted, candidates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Amount.Cmp(sorted[j].Amount) > 0
+	})
+
+	var (
+		want       = target.IntValue()
+		limit      = want + window.IntValue()
+		remaining  int64
+		tries      int
+		bestExcess int64 = -1
+		best       []bool
+		current    = make([]bool, len(sorted))
+	)
+	for _, item := range sorted {
+		remaining += item.Amount.IntValue()
+	}
+
+	// walk returns true to stop the search
+	var walk func(i int, sum, remaining int64) bool
+	walk = func(i int, sum, remaining int64) bool {
+		tries++
+		if tries > maxTries || sum >= limit || sum+remaining < want {
+			return tries > maxTries
+		}
+		if sum >= want {
+			if excess := sum - want; bestExcess < 0 || excess < bestExcess {
+				bestExcess = excess
+				best = append(best[:0], current...)
+			}
+			return bestExcess == 0
+		}
+		if i == len(sorted) {
+			return false
+		}
+		v := sorted[i].Amount.IntValue()
+		current[i] = true
+		if walk(i+1, sum+v, remaining-v) {
+			return true
+		}
+		current[i] = false
+		return walk(i+1, sum, remaining-v)
+	}
+	walk(0, 0, remaining)
+
+	if bestExcess < 0 {
+		return nil, massutil.ZeroAmount(), false
+	}
+	selected := make([]*txmgr.Credit, 0)
+	for i, ok := range best {
+		if ok {
+			selected = append(selected, sorted[i])
+		}
+	}
+	sum, _ := massutil.NewAmountFromInt(want + bestExcess)
+	return selected, sum, true
+}
+
+// oldestFirstSelector spends the candidates of lowest block height first.
+type oldestFirstSelector struct{}
+
+func (oldestFirstSelector) Select(target massutil.Amount, candidates []*txmgr.Credit) ([]*txmgr.Credit, massutil.Amount, error) {
+	sorted := make([]*txmgr.Credit, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return olderCredit(sorted[i], sorted[j])
+	})
+	return accumulateCredits(target, sorted)
+}
+
+func (oldestFirstSelector) newPool(target massutil.Amount) utxoPool {
+	return newRankedPool(blockchain.GetMaxStandardTxSize()/154, olderCredit)
+}
+
+func olderCredit(a, b *txmgr.Credit) bool {
+	if a.Height != b.Height {
+		return a.Height < b.Height
+	}
+	return a.Amount.Cmp(b.Amount) > 0
+}
+
+// consolidationSelector pays target like defaultSelector, then spends the
+// smallest of the other candidates, up to consolidationMaxInputs inputs. It
+// merges small outputs while fees are low.
+type consolidationSelector struct{}
+
+// consolidationMaxInputs leaves room for the witness of multisig inputs
+// within the standard transaction size.
+var consolidationMaxInputs = blockchain.GetMaxStandardTxSize() / 154 / 2
+
+func (consolidationSelector) Select(target massutil.Amount, candidates []*txmgr.Credit) ([]*txmgr.Credit, massutil.Amount, error) {
+	selected, sum, err := defaultSelector{}.Select(target, candidates)
+	if err != nil || sum.Cmp(target) < 0 {
+		return selected, sum, err
+	}
+	spent := make(map[*txmgr.Credit]struct{}, len(selected))
+	for _, item := range selected {
+		spent[item] = struct{}{}
+	}
+	rest := make([]*txmgr.Credit, 0, len(candidates))
+	for _, item := range candidates {
+		if _, ok := spent[item]; !ok {
+			rest = append(rest, item)
+		}
+	}
+	sort.SliceStable(rest, func(i, j int) bool {
+		return rest[i].Amount.Cmp(rest[j].Amount) < 0
+	})
+	for _, item := range rest {
+		if len(selected) >= consolidationMaxInputs {
+			break
+		}
+		if sum, err = sum.Add(item.Amount); err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+		selected = append(selected, item)
+	}
+	return selected, sum, nil
+}
+
+func (consolidationSelector) newPool(target massutil.Amount) utxoPool {
+	return &consolidationPool{
+		largest: newTopKSelector(target),
+		smallest: newRankedPool(consolidationMaxInputs, func(a, b *txmgr.Credit) bool {
+			return a.Amount.Cmp(b.Amount) < 0
+		}),
+	}
+}
+
+// accumulateCredits returns the leading candidates paying target.
+func accumulateCredits(target massutil.Amount, candidates []*txmgr.Credit) ([]*txmgr.Credit, massutil.Amount, error) {
+	sum := massutil.ZeroAmount()
+	selected := make([]*txmgr.Credit, 0)
+	for _, item := range candidates {
+		if sum.Cmp(target) >= 0 {
+			break
+		}
+		var err error
+		if sum, err = sum.Add(item.Amount); err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+		selected = append(selected, item)
+	}
+	return selected, sum, nil
+}
+
+// rankedPool keeps the k candidates ranking first by less.
+type rankedPool struct {
+	k     int
+	items []*txmgr.Credit
+	less  func(a, b *txmgr.Credit) bool
+	seen  int
+}
+
+func newRankedPool(k int, less func(a, b *txmgr.Credit) bool) *rankedPool {
+	return &rankedPool{
+		k:     k,
+		items: make([]*txmgr.Credit, 0, k),
+		less:  less,
+	}
+}
+
+// rankedPool is a heap with the last ranked candidate on top.
+func (p *rankedPool) Len() int           { return len(p.items) }
+func (p *rankedPool) Less(i, j int) bool { return p.less(p.items[j], p.items[i]) }
+func (p *rankedPool) Swap(i, j int)      { p.items[i], p.items[j] = p.items[j], p.items[i] }
+func (p *rankedPool) Push(x interface{}) { p.items = append(p.items, x.(*txmgr.Credit)) }
+func (p *rankedPool) Pop() interface{} {
+	item := p.items[len(p.items)-1]
+	p.items = p.items[:len(p.items)-1]
+	return item
+}
+
+func (p *rankedPool) submit(item *txmgr.Credit) {
+	p.seen++
+	if len(p.items) < p.k {
+		heap.Push(p, item)
+		return
+	}
+	if p.k > 0 && p.less(item, p.items[0]) {
+		p.items[0] = item
+		heap.Fix(p, 0)
+	}
+}
+
+func (p *rankedPool) Items() []*txmgr.Credit {
+	result := make([]*txmgr.Credit, len(p.items))
+	copy(result, p.items)
+	return result
+}
+
+func (p *rankedPool) full() bool {
+	return p.seen > p.k
+}
+
+// consolidationPool keeps the candidates of topKSelector for paying the
+// target, along with the smallest ones for consolidation.
+type consolidationPool struct {
+	largest  *topKSelector
+	smallest *rankedPool
+}
+
+func (p *consolidationPool) submit(item *txmgr.Credit) {
+	p.largest.submit(item)
+	p.smallest.submit(item)
+}
+
+func (p *consolidationPool) Items() []*txmgr.Credit {
+	result := p.largest.Items()
+	kept := make(map[*txmgr.Credit]struct{}, len(result))
+	for _, item := range result {
+		kept[item] = struct{}{}
+	}
+	for _, item := range p.smallest.Items() {
+		if _, ok := kept[item]; !ok {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func (p *consolidationPool) full() bool {
+	return p.largest.full()
+}
+
 type topKSelector struct {
 	k          int
 	base       []*txmgr.Credit
@@ -77,3 +404,7 @@ func (s *topKSelector) Items() []*txmgr.Credit {
 func (s *topKSelector) K() int {
 	return s.k
 }
+
+func (s *topKSelector) full() bool {
+	return len(s.Items()) == s.k
+}
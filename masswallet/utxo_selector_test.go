@@ -2,7 +2,9 @@ package masswallet
 
 import (
 	"fmt"
+	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"massnet.org/mass-wallet/masswallet/txmgr"
 
 	"massnet.org/mass-wallet/massutil"
@@ -47,3 +49,196 @@ func ExampleSubmit() {
 	// [1 20 9 40 30 100 101] 101
 	// [1 20 100 40 30 9 101] 101
 }
+
+func selectorTestCredits(amounts []int64) []*txmgr.Credit {
+	credits := make([]*txmgr.Credit, 0, len(amounts))
+	for i, n := range amounts {
+		amt, _ := massutil.NewAmountFromInt(n)
+		credit := &txmgr.Credit{Amount: amt}
+		credit.Index = uint32(i)
+		// the later, the older
+		credit.Height = uint64(len(amounts) - i)
+		credits = append(credits, credit)
+	}
+	return credits
+}
+
+func TestNewCoinSelector(t *testing.T) {
+	tests := []struct {
+		strategy string
+		expect   CoinSelector
+		err      error
+	}{
+		{"", defaultSelector{}, nil},
+		{CoinSelectionDefault, defaultSelector{}, nil},
+		{CoinSelectionBnB, bnbSelector{}, nil},
+		{CoinSelectionOldestFirst, oldestFirstSelector{}, nil},
+		{CoinSelectionConsolidate, consolidationSelector{}, nil},
+		{"random", nil, ErrInvalidParameter},
+	}
+	for _, test := range tests {
+		selector, err := NewCoinSelector(test.strategy)
+		assert.Equal(t, test.err, err, test.strategy)
+		assert.Equal(t, test.expect, selector, test.strategy)
+	}
+}
+
+func TestSearchExactMatch(t *testing.T) {
+	window, _ := massutil.NewAmountFromInt(10)
+	tests := []struct {
+		name     string
+		amounts  []int64
+		target   int64
+		expect   []int64
+		maxTries int
+	}{
+		{"single", []int64{50, 30, 20}, 30, []int64{30}, bnbMaxTries},
+		{"pair", []int64{50, 30, 20, 7}, 37, []int64{30, 7}, bnbMaxTries},
+		{"within window", []int64{60, 30, 24}, 45, []int64{24, 30}, bnbMaxTries},
+		{"least excess", []int64{50, 46, 31, 14}, 45, []int64{31, 14}, bnbMaxTries},
+		{"out of window", []int64{100, 60}, 45, nil, bnbMaxTries},
+		{"insufficient", []int64{10, 20}, 45, nil, bnbMaxTries},
+		{"tries exhausted", []int64{50, 30, 20, 7}, 37, nil, 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			target, _ := massutil.NewAmountFromInt(test.target)
+			selected, sum, ok := searchExactMatch(target, selectorTestCredits(test.amounts), window, test.maxTries)
+			assert.Equal(t, test.expect != nil, ok)
+			var got, expectSum int64
+			gotAmounts := make(map[int64]int)
+			for _, item := range selected {
+				got += item.Amount.IntValue()
+				gotAmounts[item.Amount.IntValue()]++
+			}
+			for _, n := range test.expect {
+				expectSum += n
+				gotAmounts[n]--
+			}
+			assert.Equal(t, expectSum, got)
+			assert.Equal(t, expectSum, sum.IntValue())
+			for n, count := range gotAmounts {
+				assert.Zero(t, count, n)
+			}
+		})
+	}
+}
+
+// TestCoinSelectors compares the inputs, change and fee of the strategies.
+// The target includes a fee of the dust threshold, and the fee paid adds the
+// dust change handed to miners.
+func TestCoinSelectors(t *testing.T) {
+	dust := massutil.MinRelayTxFee().IntValue()
+	fee := dust
+	tests := []struct {
+		name    string
+		amounts []int64
+		target  int64
+		// number of inputs, excess over target and fee paid per strategy, the
+		// fee is 0 if insufficient
+		expect map[string][3]int64
+	}{
+		{
+			name:    "exact match",
+			amounts: []int64{10e8, 7e8, 5e8, 3e8},
+			target:  8e8,
+			expect: map[string][3]int64{
+				CoinSelectionDefault:     {2, 2e8, fee},
+				CoinSelectionBnB:         {2, 0, fee},
+				CoinSelectionOldestFirst: {2, 0, fee},
+				CoinSelectionConsolidate: {4, 17e8, fee},
+			},
+		},
+		{
+			name:    "match within dust",
+			amounts: []int64{3e8 + dust/2, 5e8, 4e8, 3e8},
+			target:  6e8,
+			expect: map[string][3]int64{
+				CoinSelectionDefault:     {2, 2e8, fee},
+				CoinSelectionBnB:         {2, dust / 2, fee + dust/2},
+				CoinSelectionOldestFirst: {2, 1e8, fee},
+				CoinSelectionConsolidate: {4, 9e8 + dust/2, fee},
+			},
+		},
+		{
+			name:    "no match",
+			amounts: []int64{8e8, 5e8},
+			target:  6e8,
+			expect: map[string][3]int64{
+				CoinSelectionDefault:     {1, 2e8, fee},
+				CoinSelectionBnB:         {1, 2e8, fee},
+				CoinSelectionOldestFirst: {2, 7e8, fee},
+				CoinSelectionConsolidate: {2, 7e8, fee},
+			},
+		},
+		{
+			name:    "insufficient",
+			amounts: []int64{2e8, 1e8},
+			target:  6e8,
+			expect: map[string][3]int64{
+				CoinSelectionDefault:     {2, -3e8, 0},
+				CoinSelectionBnB:         {2, -3e8, 0},
+				CoinSelectionOldestFirst: {2, -3e8, 0},
+				CoinSelectionConsolidate: {2, -3e8, 0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			target, _ := massutil.NewAmountFromInt(test.target)
+			for strategy, expect := range test.expect {
+				selector, err := NewCoinSelector(strategy)
+				assert.Nil(t, err)
+				pool := selector.newPool(target)
+				for _, item := range selectorTestCredits(test.amounts) {
+					pool.submit(item)
+				}
+				assert.False(t, pool.full(), strategy)
+				candidates := pool.Items()
+				ordered := append([]*txmgr.Credit(nil), candidates...)
+				selected, sum, err := selector.Select(target, candidates)
+				assert.Nil(t, err)
+				assert.Equal(t, ordered, candidates, strategy)
+				var total int64
+				for _, item := range selected {
+					total += item.Amount.IntValue()
+				}
+				assert.Equal(t, total, sum.IntValue(), strategy)
+				assert.Equal(t, expect[0], int64(len(selected)), strategy)
+				assert.Equal(t, expect[1], total-test.target, strategy)
+
+				if total < test.target {
+					assert.Zero(t, expect[2], strategy)
+					continue
+				}
+				excess, _ := massutil.NewAmountFromInt(total - test.target)
+				change, paidDust, ok := (&CoinControl{Selector: selector}).settleChange(excess)
+				assert.True(t, ok, strategy)
+				assert.Equal(t, excess.IntValue(), change.IntValue()+paidDust.IntValue(), strategy)
+				assert.Equal(t, expect[2], fee+paidDust.IntValue(), strategy)
+			}
+		})
+	}
+
+	// dust change is no output, the default selector selects more instead
+	halfDust, _ := massutil.NewAmountFromInt(dust / 2)
+	_, _, ok := (&CoinControl{}).settleChange(halfDust)
+	assert.False(t, ok)
+}
+
+func TestRankedPool(t *testing.T) {
+	credits := selectorTestCredits([]int64{5, 3, 8, 1, 9, 2})
+	pool := newRankedPool(3, func(a, b *txmgr.Credit) bool {
+		return a.Amount.Cmp(b.Amount) < 0
+	})
+	for i, item := range credits {
+		pool.submit(item)
+		assert.Equal(t, i >= 3, pool.full())
+	}
+	got := make([]int64, 0)
+	for _, item := range pool.Items() {
+		got = append(got, item.Amount.IntValue())
+	}
+	assert.ElementsMatch(t, []int64{1, 2, 3}, got)
+}
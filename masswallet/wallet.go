@@ -14,6 +14,8 @@ import (
 	mwdb "massnet.org/mass-wallet/masswallet/db"
 	"massnet.org/mass-wallet/masswallet/ifc"
 	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/signer"
 	"massnet.org/mass-wallet/masswallet/txmgr"
 	"massnet.org/mass-wallet/txscript"
 	"massnet.org/mass-wallet/wire"
@@ -26,6 +28,7 @@ const (
 	utxoBucket     = "u"
 	txBucket       = "t"
 	syncBucket     = "s"
+	webhookBucket  = "w"
 )
 
 type WalletManager struct {
@@ -42,12 +45,16 @@ type WalletManager struct {
 
 	ntfnsHandler *NtfnsHandler
 
+	// signs instead of the keystores if configured
+	extSigner *signer.ExternalSigner
+
 	server Server
 
 	usedCache *cache.Cache
 
-	mu sync.RWMutex
-	wg sync.WaitGroup
+	// shared with the views returned by ForWallet
+	mu *sync.RWMutex
+	wg *sync.WaitGroup
 }
 
 func NewWalletManager(server Server, db mwdb.DB, config *config.Config,
@@ -67,6 +74,8 @@ func NewWalletManager(server Server, db mwdb.DB, config *config.Config,
 		bucketMeta:   &txmgr.StoreBucketMeta{},
 		server:       server,
 		usedCache:    cache.New(5*time.Minute, 10*time.Minute),
+		mu:           new(sync.RWMutex),
+		wg:           new(sync.WaitGroup),
 	}
 
 	err := mwdb.Update(db, func(tx mwdb.DBTransaction) error {
@@ -134,6 +143,20 @@ func NewWalletManager(server Server, db mwdb.DB, config *config.Config,
 			})
 			return err
 		}
+		err = w.txStore.BuildTxHistoryIndex(tx)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to build tx history index", logging.LogFormat{
+				"err": err,
+			})
+			return err
+		}
+		err = w.txStore.BuildStakingRewardIndex(tx)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to build staking reward index", logging.LogFormat{
+				"err": err,
+			})
+			return err
+		}
 		return nil
 	})
 	if err != nil {
@@ -151,6 +174,23 @@ func NewWalletManager(server Server, db mwdb.DB, config *config.Config,
 
 	w.ntfnsHandler = h
 
+	if sc := config.Signer; sc != nil {
+		timeout := time.Duration(sc.Timeout) * time.Second
+		switch {
+		case sc.Address != "":
+			w.extSigner, err = signer.NewSocketSigner(sc.Address, timeout)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "failed to create external signer", logging.LogFormat{
+					"address": sc.Address,
+					"err":     err,
+				})
+				return nil, err
+			}
+		case sc.Command != "":
+			w.extSigner = signer.NewProcessSigner(sc.Command, sc.Args, timeout)
+		}
+	}
+
 	err = checkInit(w)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to checkInit", logging.LogFormat{
@@ -183,6 +223,32 @@ func (w *WalletManager) CheckReady(name string) (bool, error) {
 	return ws.Ready() && !ws.IsRemoved(), nil
 }
 
+// ForWallet returns a view of w serving the wallet name as current wallet,
+// without changing the wallet in use, so that concurrent requests may be
+// served by different wallets.
+func (w *WalletManager) ForWallet(name string) (*WalletManager, error) {
+	ready, err := w.CheckReady(name)
+	if err != nil {
+		return nil, err
+	}
+	if !ready {
+		return nil, ErrWalletUnready
+	}
+	ksmgr, err := w.ksmgr.ForKeystore(name)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to get keystore", logging.LogFormat{
+			"wallet": name,
+			"err":    err,
+		})
+		return nil, err
+	}
+	view := *w
+	view.ksmgr = ksmgr
+	return &view, nil
+}
+
+// UseWallet sets the wallet in use, which serves requests not specifying a
+// wallet.
 func (w *WalletManager) UseWallet(name string) (*WalletInfo, error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
@@ -255,11 +321,13 @@ func (w *WalletManager) Wallets() ([]*WalletSummary, error) {
 				return fmt.Errorf("%s: %v", status.WalletID, err)
 			}
 			summary := &WalletSummary{
-				WalletID: mgr.Name(),
-				Type:     uint32(mgr.AddrUse()),
-				Version:  mgr.Version().Value(),
-				Remarks:  mgr.Remarks(),
-				Status:   status,
+				WalletID:  mgr.Name(),
+				Type:      uint32(mgr.AddrUse()),
+				Version:   mgr.Version().Value(),
+				Remarks:   mgr.Remarks(),
+				Status:    status,
+				Multisig:  mgr.Multisig(),
+				WatchOnly: mgr.IsWatchOnly(),
 			}
 			ret = append(ret, summary)
 		}
@@ -305,71 +373,36 @@ func (w *WalletManager) ImportWallet(keystoreJSON, pass string) (*WalletSummary,
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	if w.ntfnsHandler.IsWorkerBusy() {
-		return nil, ErrTooManyTask
-	}
-	var am *keystore.AddrManager
-	var ws *txmgr.WalletStatus
-	err := mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
-		var err error
-		am, err = w.ksmgr.ImportKeystore(tx, w.chainFetcher.CheckScriptHashUsed, []byte(keystoreJSON), []byte(pass), w.config.Advanced.AddressGapLimit)
-		if err != nil {
-			logging.CPrint(logging.ERROR, "failed to import keystore", logging.LogFormat{
-				"err": err,
-			})
-			return err
-		}
-		if err = w.utxoStore.InitNewWallet(tx, am); err != nil {
-			return err
-		}
-		ws = &txmgr.WalletStatus{
-			WalletID: am.Name(),
-		}
-		addrs := am.ManagedAddresses()
-		if len(addrs) == 0 {
-			ws.SyncedHeight = txmgr.WalletSyncedDone
-		}
-		err = w.syncStore.PutWalletStatus(tx, ws)
-		if err != nil {
-			logging.CPrint(logging.ERROR, "failed to put wallet status", logging.LogFormat{
-				"err": err,
-			})
-			return err
-		}
-
-		for _, managedAddr := range addrs {
-			err = w.utxoStore.PutNewAddress(tx, am.Name(), managedAddr.String(), massutil.AddressClassWitnessV0)
-			if err != nil {
-				logging.CPrint(logging.ERROR, "failed to put new address", logging.LogFormat{
-					"err": err,
-				})
-				return err
-			}
-		}
-		return nil
+	return w.importKeystore(func(tx mwdb.DBTransaction) (*keystore.AddrManager, error) {
+		return w.ksmgr.ImportKeystore(tx, w.chainFetcher.CheckScriptHashUsed, []byte(keystoreJSON), []byte(pass), w.config.Advanced.AddressGapLimit)
 	})
-	if err != nil {
-		if am != nil {
-			w.ksmgr.RemoveCachedKeystore(am.Name())
-		}
-		return nil, err
-	}
-
-	if !ws.Ready() {
-		w.ntfnsHandler.OnImportWallet(am.Name())
-	}
-	return &WalletSummary{
-		WalletID: am.Name(),
-		Type:     uint32(am.AddrUse()),
-		Version:  am.Version().Value(),
-		Remarks:  am.Remarks(),
-	}, nil
 }
 
 func (w *WalletManager) ImportWalletWithMnemonic(walletParams *keystore.WalletParams) (*WalletSummary, error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
+	return w.importKeystore(func(tx mwdb.DBTransaction) (*keystore.AddrManager, error) {
+		return w.ksmgr.ImportKeystoreWithMnemonic(tx, w.chainFetcher.CheckScriptHashUsed, walletParams)
+	})
+}
+
+// ImportWatchOnlyWallet imports a wallet from the account extended public key
+// in walletParams. The wallet is synchronized like any other wallet, but it
+// can never sign.
+func (w *WalletManager) ImportWatchOnlyWallet(walletParams *keystore.WalletParams) (*WalletSummary, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.importKeystore(func(tx mwdb.DBTransaction) (*keystore.AddrManager, error) {
+		return w.ksmgr.ImportWatchOnlyKeystore(tx, w.chainFetcher.CheckScriptHashUsed, walletParams)
+	})
+}
+
+// importKeystore stores the keystore created by importFn along with its
+// addresses, and starts synchronizing the new wallet unless none of its
+// addresses is used.
+func (w *WalletManager) importKeystore(importFn func(tx mwdb.DBTransaction) (*keystore.AddrManager, error)) (*WalletSummary, error) {
 	if w.ntfnsHandler.IsWorkerBusy() {
 		return nil, ErrTooManyTask
 	}
@@ -377,7 +410,7 @@ func (w *WalletManager) ImportWalletWithMnemonic(walletParams *keystore.WalletPa
 	var ws *txmgr.WalletStatus
 	err := mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
 		var err error
-		am, err = w.ksmgr.ImportKeystoreWithMnemonic(tx, w.chainFetcher.CheckScriptHashUsed, walletParams)
+		am, err = importFn(tx)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "failed to import keystore", logging.LogFormat{
 				"err": err,
@@ -424,10 +457,12 @@ func (w *WalletManager) ImportWalletWithMnemonic(walletParams *keystore.WalletPa
 		w.ntfnsHandler.OnImportWallet(am.Name())
 	}
 	return &WalletSummary{
-		WalletID: am.Name(),
-		Type:     uint32(am.AddrUse()),
-		Version:  am.Version().Value(),
-		Remarks:  am.Remarks(),
+		WalletID:  am.Name(),
+		Type:      uint32(am.AddrUse()),
+		Version:   am.Version().Value(),
+		Remarks:   am.Remarks(),
+		Multisig:  am.Multisig(),
+		WatchOnly: am.IsWatchOnly(),
 	}, nil
 }
 
@@ -462,10 +497,17 @@ func (w *WalletManager) RemoveWallet(walletId, pass string) error {
 		return ErrTooManyTask
 	}
 
-	err := w.ksmgr.CheckPrivPassphrase(walletId, []byte(pass))
+	// a watch-only wallet has no private passphrase to check
+	am, err := w.ksmgr.GetAddrManagerByAccountID(walletId)
 	if err != nil {
 		return err
 	}
+	if !am.IsWatchOnly() {
+		err = w.ksmgr.CheckPrivPassphrase(walletId, []byte(pass))
+		if err != nil {
+			return err
+		}
+	}
 	return w.ntfnsHandler.OnRemoveWallet(walletId)
 }
 
@@ -492,6 +534,46 @@ func (w *WalletManager) ChangePrivPassphrase(oldPass, newPass string) error {
 	})
 }
 
+// UnlockWallet keeps private keys of current wallet in memory for timeout,
+// signing requests with an empty passphrase are accepted meanwhile.
+func (w *WalletManager) UnlockWallet(pass string, timeout time.Duration) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	am := w.ksmgr.CurrentKeystore()
+	if am == nil {
+		logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{
+			"err": ErrNoWalletInUse,
+		})
+		return ErrNoWalletInUse
+	}
+	return w.ksmgr.Unlock([]byte(pass), timeout)
+}
+
+// LockWallet zeroes private keys of current wallet kept in memory by
+// UnlockWallet, or of all wallets if no wallet is in use.
+func (w *WalletManager) LockWallet() {
+	if err := w.ksmgr.Lock(); err != nil {
+		w.ksmgr.ClearPrivKey()
+	}
+}
+
+// AccountXpub returns the account extended public key of current wallet, and
+// the multisig policy if it is a multisig wallet.
+func (w *WalletManager) AccountXpub() (string, *keystore.MultisigParams, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	am := w.ksmgr.CurrentKeystore()
+	if am == nil {
+		logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{
+			"err": ErrNoWalletInUse,
+		})
+		return "", nil, ErrNoWalletInUse
+	}
+	return am.AccountXpub(), am.Multisig(), nil
+}
+
 /* func (w *WalletManager) RemoveWallet(name, pass string) error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
@@ -680,7 +762,7 @@ func (w *WalletManager) AddressBalance(confs uint32, addrs []string) ([]*Address
 			if err != nil {
 				return err
 			}
-			m, err := w.utxoStore.ScriptAddressBalance(tx, scriptSet, confs, syncedTo.Height, w.server.TxMemPool())
+			m, err := w.utxoStore.ScriptAddressBalance(tx, am.Name(), scriptSet, confs, syncedTo.Height, w.server.TxMemPool())
 			if err != nil {
 				logging.CPrint(logging.ERROR, "failed to get scriptAddress balance", logging.LogFormat{
 					"err": err,
@@ -727,10 +809,18 @@ func (w *WalletManager) GetUtxo(addrs []string) (map[string][]*UnspentDetail, er
 		})
 		return nil, err
 	}
+	var controls map[wire.OutPoint]*txmgr.UtxoControl
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) (err error) {
+		controls, err = w.utxoStore.FetchUtxoControls(tx, am.Name())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 	for addr, cres := range creditsMap {
 		unspents := make([]*UnspentDetail, 0)
 		for _, credit := range cres {
-			unspents = append(unspents, &UnspentDetail{
+			detail := &UnspentDetail{
 				TxId:           credit.OutPoint.Hash.String(),
 				Vout:           credit.OutPoint.Index,
 				Amount:         credit.Amount,
@@ -738,7 +828,12 @@ func (w *WalletManager) GetUtxo(addrs []string) (map[string][]*UnspentDetail, er
 				Maturity:       credit.Maturity,
 				Confirmations:  credit.Confirmations,
 				SpentByUnmined: credit.Flags.SpentByUnmined,
-			})
+			}
+			if ctl, ok := controls[credit.OutPoint]; ok {
+				detail.Frozen = ctl.Frozen
+				detail.Label = ctl.Label
+			}
+			unspents = append(unspents, detail)
 		}
 		ret[addr] = unspents
 	}
@@ -917,6 +1012,38 @@ func (w *WalletManager) GetAddresses(addressClass uint16) ([]*txmgr.AddressDetai
 	return result, nil
 }
 
+// receivingAddresses returns the non-change addresses of ks created as
+// massutil.AddressClassWitnessV0, by index.
+func (w *WalletManager) receivingAddresses(ks *keystore.AddrManager) ([]*keystore.ManagedAddress, error) {
+	var all []*txmgr.AddressDetail
+	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) (err error) {
+		all, err = w.utxoStore.GetAddresses(tx, ks.Name())
+		return err
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to get address from utxoStore",
+			logging.LogFormat{"err": err})
+		return nil, err
+	}
+	witness := make(map[string]struct{}, len(all))
+	for _, addr := range all {
+		if addr.AddressClass == massutil.AddressClassWitnessV0 {
+			witness[addr.Address] = struct{}{}
+		}
+	}
+
+	ret := make([]*keystore.ManagedAddress, 0, len(witness))
+	for _, ma := range ks.ManagedAddresses() {
+		if _, ok := witness[ma.String()]; ok && !ma.IsChangeAddr() {
+			ret = append(ret, ma)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].DerivationPath().Index < ret[j].DerivationPath().Index
+	})
+	return ret, nil
+}
+
 func (w *WalletManager) CreateRawTransaction(
 	inputs []*TxIn,
 	amounts map[string]massutil.Amount,
@@ -1021,12 +1148,14 @@ func (w *WalletManager) AutoCreateRawTransaction(
 	userTxFee massutil.Amount,
 	fromAddr,
 	changeAddr string,
+	replaceable bool,
+	cc *CoinControl,
 ) (string, massutil.Amount, error) {
 
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
-	mtx, txFee, err := w.EstimateTxFee(amounts, lockTime, userTxFee, fromAddr, changeAddr)
+	mtx, txFee, err := w.EstimateTxFee(amounts, lockTime, userTxFee, fromAddr, changeAddr, cc)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "estimate txFee failed", logging.LogFormat{
 			"err": err,
@@ -1034,6 +1163,9 @@ func (w *WalletManager) AutoCreateRawTransaction(
 		return "", massutil.ZeroAmount(), err
 	}
 
+	if replaceable {
+		signalReplacement(mtx)
+	}
 	mtx.LockTime = lockTime
 	mtx.Version = wire.TxVersion
 	mtxHex, err := messageToHex(mtx)
@@ -1052,12 +1184,13 @@ func (w *WalletManager) CreateStakingTransaction(
 	Outputs []*StakingTxOut,
 	lockTime uint64,
 	userTxFee massutil.Amount,
+	cc *CoinControl,
 ) (string, massutil.Amount, error) {
 
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
-	msgTx, fee, err := w.EstimateStakingTxFee(Outputs, lockTime, userTxFee, fromAddr, "")
+	msgTx, fee, err := w.EstimateStakingTxFee(Outputs, lockTime, userTxFee, fromAddr, "", cc)
 	if err != nil {
 		return "", massutil.ZeroAmount(), err
 	}
@@ -1130,30 +1263,16 @@ func (w *WalletManager) SignRawTx(password []byte, flag string, tx *wire.MsgTx)
 		return nil, ErrNoWalletInUse
 	}
 
-	var hashType txscript.SigHashType
-	switch flag {
-	case "ALL":
-		hashType = txscript.SigHashAll
-	case "NONE":
-		hashType = txscript.SigHashNone
-	case "SINGLE":
-		hashType = txscript.SigHashSingle
-	case "ALL|ANYONECANPAY":
-		hashType = txscript.SigHashAll | txscript.SigHashAnyOneCanPay
-	case "NONE|ANYONECANPAY":
-		hashType = txscript.SigHashNone | txscript.SigHashAnyOneCanPay
-	case "SINGLE|ANYONECANPAY":
-		hashType = txscript.SigHashSingle | txscript.SigHashAnyOneCanPay
-	default:
-		logging.CPrint(logging.ERROR, "Invalid sighash parameter", logging.LogFormat{"flag": flag})
-		return nil, ErrInvalidFlag
+	hashType, err := parseSigHashFlag(flag)
+	if err != nil {
+		return nil, err
 	}
 
-	// All args collected. Now we can sign all the inputs that we can.
-	// `complete' denotes that we successfully signed all outputs and that
-	// all scripts will run to completion. This is returned as part of the
-	// reply.
-	err := w.signWitnessTx(password, tx, hashType, w.chainParams)
+	// Inputs are always signed again, drop whatever witness they carry.
+	for _, txIn := range tx.TxIn {
+		txIn.Witness = nil
+	}
+	p, err := w.CreatePsbt(tx)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "Failed to sign the transaction", logging.LogFormat{
 			"err": err,
@@ -1161,6 +1280,25 @@ func (w *WalletManager) SignRawTx(password []byte, flag string, tx *wire.MsgTx)
 		return nil, err
 	}
 
+	// All args collected. Now we can sign all the inputs that we can, and
+	// make sure all scripts will run to completion.
+	_, err = w.signWitnessTx(password, p, hashType)
+	if err == nil {
+		err = psbt.Finalize(p, w.chainParams)
+	}
+	if err != nil {
+		logging.CPrint(logging.ERROR, "Failed to sign the transaction", logging.LogFormat{
+			"err": err,
+		})
+		if err == psbt.ErrNotEnoughSignatures {
+			return nil, ErrIncompleteSignature
+		}
+		return nil, err
+	}
+	for i, pIn := range p.Inputs {
+		tx.TxIn[i].Witness = pIn.FinalWitness
+	}
+
 	// All returned errors (not OOM, which panics) encounted during
 	// bytes.Buffer writes are unexpected.
 	bs, err := tx.Bytes(wire.Packet)
@@ -1174,6 +1312,26 @@ func (w *WalletManager) SignRawTx(password []byte, flag string, tx *wire.MsgTx)
 	return bs, nil
 }
 
+func parseSigHashFlag(flag string) (txscript.SigHashType, error) {
+	switch flag {
+	case "ALL":
+		return txscript.SigHashAll, nil
+	case "NONE":
+		return txscript.SigHashNone, nil
+	case "SINGLE":
+		return txscript.SigHashSingle, nil
+	case "ALL|ANYONECANPAY":
+		return txscript.SigHashAll | txscript.SigHashAnyOneCanPay, nil
+	case "NONE|ANYONECANPAY":
+		return txscript.SigHashNone | txscript.SigHashAnyOneCanPay, nil
+	case "SINGLE|ANYONECANPAY":
+		return txscript.SigHashSingle | txscript.SigHashAnyOneCanPay, nil
+	default:
+		logging.CPrint(logging.ERROR, "Invalid sighash parameter", logging.LogFormat{"flag": flag})
+		return 0, ErrInvalidFlag
+	}
+}
+
 func (w *WalletManager) GetStakingHistory(excludeWithdrawn bool) ([]*txmgr.StakingHistoryDetail, error) {
 	am := w.ksmgr.CurrentKeystore()
 	if am == nil {
@@ -1244,6 +1402,9 @@ func (w *WalletManager) Stop() {
 	w.wg.Add(1)
 	w.ntfnsHandler.Stop()
 	w.wg.Wait()
+	if w.extSigner != nil {
+		w.extSigner.Close()
+	}
 	logging.CPrint(logging.INFO, "WalletManager stopped", logging.LogFormat{})
 	return
 }
@@ -382,6 +382,66 @@ func TestWalletManager_NewAddress_GetAddresses(t *testing.T) {
 
 }
 
+func TestWalletManager_ForWallet(t *testing.T) {
+	databaseDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("new databaseDb error")
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testForWallet")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{databaseDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+
+	walletId1, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	walletId2, _, _, err := w.CreateWallet(privPassphrase2, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+
+	_, err = w.ForWallet("unknown")
+	assert.NotNil(t, err)
+
+	// served without a wallet in use
+	w2, err := w.ForWallet(walletId2)
+	if err != nil {
+		t.Fatal("for wallet error", err.Error())
+	}
+	assert.Equal(t, walletId2, w2.CurrentWallet())
+	assert.Equal(t, "", w.CurrentWallet())
+
+	_, err = w.UseWallet(walletId1)
+	if err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	addr2, err := w2.NewAddress(0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	addrs1, err := w.GetAddresses(math.MaxUint16)
+	if err != nil {
+		t.Fatal("get addresses error", err.Error())
+	}
+	assert.Equal(t, 0, len(addrs1))
+	addrs2, err := w2.GetAddresses(math.MaxUint16)
+	if err != nil {
+		t.Fatal("get addresses error", err.Error())
+	}
+	if assert.Equal(t, 1, len(addrs2)) {
+		assert.Equal(t, addr2, addrs2[0].Address)
+	}
+	assert.Equal(t, walletId1, w.CurrentWallet())
+	assert.Equal(t, walletId2, w2.CurrentWallet())
+}
+
 //TODO: importWallet
 func TestWalletManager_ExportWallet_ImportWallet(t *testing.T) {
 	databaseDb, close, err := newTestChainDB(0)
@@ -669,7 +729,7 @@ func TestWalletManager_EstimateTxFee(t *testing.T) {
 		addr1: amt1,
 	}
 	//minTxFee
-	incompleteTx, txFee, err := w.EstimateTxFee(txOuts, 0, massutil.ZeroAmount(), "", "")
+	incompleteTx, txFee, err := w.EstimateTxFee(txOuts, 0, massutil.ZeroAmount(), "", "", nil)
 	if err != nil {
 		t.Fatal("estimate txFee error", err.Error())
 	}
@@ -682,7 +742,7 @@ func TestWalletManager_EstimateTxFee(t *testing.T) {
 	//SetTxFee
 	amt, err := massutil.NewAmountFromUint(10e8)
 	assert.Nil(t, err)
-	incompleteTx0, txFee0, err := w.EstimateTxFee(txOuts, 0, amt, "", "")
+	incompleteTx0, txFee0, err := w.EstimateTxFee(txOuts, 0, amt, "", "", nil)
 	if err != nil {
 		t.Fatal("estimate txFee error", err.Error())
 	}
@@ -846,6 +906,42 @@ func TestWalletManager_AutoConstructTx(t *testing.T) {
 		t.Logf("%v_spendable: %v", index, addrBal.Spendable)
 	}
 
+	// dry run of consolidation reserves nothing
+	_, err = w.ConsolidateUtxos([]string{addr2}, 0, massutil.ZeroAmount(), "", true)
+	assert.Equal(t, ErrNothingToConsolidate, err)
+	ctxs, err := w.ConsolidateUtxos([]string{addr1}, 0, massutil.ZeroAmount(), addr2, true)
+	if err != nil {
+		t.Fatal("ConsolidateUtxos error", err.Error())
+	}
+	assert.Equal(t, 1, len(ctxs))
+	assert.Equal(t, 2, len(ctxs[0].MsgTx.TxIn))
+	assert.Equal(t, 1, len(ctxs[0].MsgTx.TxOut))
+	paid, err := ctxs[0].Amount.Add(ctxs[0].Fee)
+	assert.Nil(t, err)
+	assert.Equal(t, block15T2.TxOut[0].Value+block15T3.TxOut[0].Value, paid.IntValue())
+	for _, txIn := range ctxs[0].MsgTx.TxIn {
+		assert.False(t, w.UTXOUsed(&txIn.PreviousOutPoint))
+	}
+
+	// the target defaults to the address with the lowest index
+	var lowest *keystore.ManagedAddress
+	for _, ma := range w.ksmgr.CurrentKeystore().ManagedAddresses() {
+		if lowest == nil || ma.DerivationPath().Index < lowest.DerivationPath().Index {
+			lowest = ma
+		}
+	}
+	lowestPkScript, err := PayToWitnessV0Address(lowest.String(), &config.ChainParams)
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		ctxs, err = w.ConsolidateUtxos(nil, 0, massutil.ZeroAmount(), "", true)
+		if err != nil {
+			t.Fatal("ConsolidateUtxos error", err.Error())
+		}
+		for _, ctx := range ctxs {
+			assert.Equal(t, lowestPkScript, ctx.MsgTx.TxOut[0].PkScript)
+		}
+	}
+
 	amt2, err := massutil.NewAmountFromUint(20e8)
 	assert.Nil(t, err)
 	amt1, err := massutil.NewAmountFromUint(4e8)
@@ -857,7 +953,7 @@ func TestWalletManager_AutoConstructTx(t *testing.T) {
 	}
 	amt, err := massutil.NewAmountFromUint(10e8)
 	assert.Nil(t, err)
-	txHex, txFee, err := w.AutoCreateRawTransaction(txOuts, 0, amt, "", "")
+	txHex, txFee, err := w.AutoCreateRawTransaction(txOuts, 0, amt, "", "", false, nil)
 	if err != nil {
 		t.Fatal("AutoCreateRawTransaction error", err.Error())
 	}
@@ -880,4 +976,181 @@ func TestWalletManager_AutoConstructTx(t *testing.T) {
 	}
 	t.Log("signedTx:", signedTx)
 
+	// coin control, frozen outputs are spent only if included
+	op2 := wire.OutPoint{Hash: newBlock15T2Hash, Index: 0}
+	op3 := wire.OutPoint{Hash: newBlock15T3Hash, Index: 0}
+	err = w.SetUtxoFrozen([]wire.OutPoint{op2}, true)
+	assert.Nil(t, err)
+	_, _, err = w.AutoCreateRawTransaction(txOuts, 0, amt, "", "", false, &CoinControl{Exclude: []wire.OutPoint{op3}})
+	assert.Equal(t, ErrInsufficientFunds, err)
+	_, _, err = w.AutoCreateRawTransaction(txOuts, 0, amt, "", "", false, &CoinControl{
+		Include: []wire.OutPoint{op2},
+		Exclude: []wire.OutPoint{op2},
+	})
+	assert.Equal(t, ErrInvalidParameter, err)
+	txHex, _, err = w.AutoCreateRawTransaction(txOuts, 0, amt, "", "", false, &CoinControl{Include: []wire.OutPoint{op2}})
+	if err != nil {
+		t.Fatal("AutoCreateRawTransaction error", err.Error())
+	}
+	serializedTx, err = decodeHexStr(txHex)
+	if err != nil {
+		t.Fatal("decode hexStr error", err.Error())
+	}
+	mtx = wire.MsgTx{}
+	err = mtx.SetBytes(serializedTx, wire.Packet)
+	if err != nil {
+		t.Fatal("deserialize tx error", err.Error())
+	}
+	assert.Equal(t, op2, mtx.TxIn[0].PreviousOutPoint)
+
+	// coin control without fee rate pays the min relay fee rate
+	w.ClearUsedUTXOMark(&mtx)
+	_, fee, err := w.AutoCreateRawTransaction(txOuts, 0, massutil.ZeroAmount(), "", "", false, &CoinControl{Include: []wire.OutPoint{op2}})
+	if err != nil {
+		t.Fatal("AutoCreateRawTransaction error", err.Error())
+	}
+	assert.False(t, fee.IsZero())
+
+	utxos, err := w.GetUtxo(nil)
+	assert.Nil(t, err)
+	for _, detail := range utxos[addr1] {
+		assert.Equal(t, detail.TxId == op2.Hash.String() && detail.Vout == op2.Index, detail.Frozen)
+	}
+}
+
+func TestWalletManager_ConsolidateUtxos_StakingAddressFirst(t *testing.T) {
+	chainDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("newTestChainDB error:", err)
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testConsolidateStakingFirst")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{chainDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	walletId, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = w.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+
+	// the staking address has the lowest index
+	_, err = w.NewAddress(massutil.AddressClassWitnessStaking)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	addr1, err := w.NewAddress(massutil.AddressClassWitnessV0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	addr2, err := w.NewAddress(massutil.AddressClassWitnessV0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+
+	// fund addr2 twice
+	addr2PkScript, err := PayToWitnessV0Address(addr2, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block15T2.TxOut[0].PkScript = addr2PkScript
+	block15T3.TxOut[0].PkScript = addr2PkScript
+	newBlock15T2Hash := block15T2.TxHash()
+	newBlock15T3Hash := block15T3.TxHash()
+	replaceInputs := func(txs []*wire.MsgTx, replace map[wire.Hash]wire.Hash) {
+		for _, tx := range txs {
+			for _, txIn := range tx.TxIn {
+				if hash, ok := replace[txIn.PreviousOutPoint.Hash]; ok {
+					txIn.PreviousOutPoint.Hash = hash
+				}
+			}
+		}
+	}
+	var blk15 *massutil.Block
+	for i := 1; i <= int(block15Meta.Height); i++ {
+		// serialize anew, the replaced scripts move the tx locations
+		blk := massutil.NewBlock(blks200[i].MsgBlock())
+		blk.SetHeight(blks200[i].Height())
+		blk15 = blk
+		replaceInputs(blk.MsgBlock().Transactions, map[wire.Hash]wire.Hash{
+			b15T2Hash: newBlock15T2Hash,
+			b15T3Hash: newBlock15T3Hash,
+		})
+		if err = chainDb.SubmitBlock(blk); err != nil {
+			t.Fatal("init db error:", err)
+		}
+		chainDb.(*ldb.ChainDb).Batch(1).Set(blk.MsgBlock().BlockHash())
+		chainDb.(*ldb.ChainDb).Batch(1).Done()
+		if err = chainDb.Commit(blk.MsgBlock().BlockHash()); err != nil {
+			t.Fatal("init db error:", err)
+		}
+	}
+	defer func() {
+		block15T2.TxOut[0].PkScript = b15T2O0PkScript
+		block15T3.TxOut[0].PkScript = b15T3O0PkScript
+		for i := 1; i <= int(block15Meta.Height); i++ {
+			replaceInputs(blks200[i].MsgBlock().Transactions, map[wire.Hash]wire.Hash{
+				newBlock15T2Hash: b15T2Hash,
+				newBlock15T3Hash: b15T3Hash,
+			})
+		}
+	}()
+
+	if block15Meta.Loc, err = w.chainFetcher.FetchBlockLocByHeight(block15Meta.Height); err != nil {
+		t.Fatal("FetchBlockLocByHeight error:", err)
+	}
+	txLocs, err := blk15.TxLoc()
+	if err != nil {
+		t.Fatal("TxLoc error", err)
+	}
+	allBalances := map[string]massutil.Amount{walletId: massutil.ZeroAmount()}
+	for i, msgTx := range []*wire.MsgTx{block15T2, block15T3} {
+		rec, err := txmgr.NewTxRecordFromMsgTx(msgTx, block15.Header.Timestamp)
+		if err != nil {
+			t.Fatal("get txRecord error", err.Error())
+		}
+		if rec, err = simpleFilterTx(rec, msgTx, walletId); err != nil {
+			t.Fatal("filter tx error", err.Error())
+		}
+		rec.TxLoc = &txLocs[2+i]
+		err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+			return w.txStore.AddRelevantTx(tx, allBalances, rec, block15Meta)
+		})
+		if err != nil {
+			t.Fatal("add relevantTx error", err.Error())
+		}
+	}
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		for h := uint64(1); h <= block15Meta.Height; h++ {
+			meta := &txmgr.BlockMeta{Height: h, Hash: *blks200[h].Hash()}
+			if err := w.syncStore.SetSyncedTo(tx, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// consolidated into the witness address with the lowest index
+	ctxs, err := w.ConsolidateUtxos(nil, 0, massutil.ZeroAmount(), "", true)
+	if err != nil {
+		t.Fatal("ConsolidateUtxos error", err.Error())
+	}
+	addr1PkScript, err := PayToWitnessV0Address(addr1, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Equal(t, 1, len(ctxs)) {
+		assert.Equal(t, 2, len(ctxs[0].MsgTx.TxIn))
+		assert.Equal(t, addr1PkScript, ctxs[0].MsgTx.TxOut[0].PkScript)
+	}
 }
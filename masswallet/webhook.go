@@ -0,0 +1,548 @@
+package masswallet
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	configpb "massnet.org/mass-wallet/config/pb"
+	"massnet.org/mass-wallet/logging"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// webhook event types
+const (
+	WebhookReceived            = "received"
+	WebhookTxConfirmed         = "tx_confirmed"
+	WebhookStakingWithdrawable = "staking_withdrawable"
+)
+
+// headers of webhook requests
+const (
+	WebhookHeaderEvent     = "X-Mass-Event"
+	WebhookHeaderDelivery  = "X-Mass-Delivery"
+	WebhookHeaderSignature = "X-Mass-Signature"
+)
+
+const (
+	webhookPendingBucket = "p"
+	webhookQueueBucket   = "q"
+	webhookMetaBucket    = "m"
+
+	webhookBaseBackoff  = 5 * time.Second
+	webhookMaxBackoff   = time.Hour
+	webhookPollInterval = 10 * time.Second
+	webhookBatchSize    = 64
+)
+
+var webhookSeqKey = []byte("seq")
+
+var (
+	errWebhookStatus = errors.New("unexpected status code")
+)
+
+// WebhookPayload is the JSON body POSTed to webhook urls.
+type WebhookPayload struct {
+	Event         string `json:"event"`
+	WalletID      string `json:"wallet_id"`
+	TxID          string `json:"tx_id"`
+	Address       string `json:"address,omitempty"`
+	OutputIndex   int    `json:"output_index"`
+	Amount        string `json:"amount,omitempty"`
+	BlockHash     string `json:"block_hash,omitempty"`
+	Height        uint64 `json:"height"`
+	Confirmations uint64 `json:"confirmations"`
+}
+
+// webhookDelivery is a payload waiting to be POSTed to one url.
+type webhookDelivery struct {
+	ID          uint64          `json:"-"`
+	URL         string          `json:"url"`
+	Event       string          `json:"event"`
+	Payload     json.RawMessage `json:"payload"`
+	Attempts    uint32          `json:"attempts"`
+	NextAttempt int64           `json:"next_attempt"`
+}
+
+// webhookManager persists payment events of wallets in the webhook bucket
+// and delivers them in background, retrying with exponential backoff.
+//
+// Events waiting for confirmations are kept in the pending bucket with key
+// [due height][mined height][seq], and moved to the queue bucket with key
+// [seq] once the block of due height is connected. Both buckets are updated
+// in the same transaction as the wallet, so no event is lost or doubled on
+// restart.
+type webhookManager struct {
+	db     mwdb.DB
+	cfg    *configpb.WebhookConfig
+	client *http.Client
+
+	nsPending mwdb.BucketMeta
+	nsQueue   mwdb.BucketMeta
+	nsMeta    mwdb.BucketMeta
+
+	now  func() time.Time
+	wake chan struct{}
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newWebhookManager(db mwdb.DB, cfg *configpb.WebhookConfig) (*webhookManager, error) {
+	m := &webhookManager{
+		db:     db,
+		cfg:    cfg,
+		client: &http.Client{Timeout: time.Duration(cfg.Timeout) * time.Second},
+		now:    time.Now,
+		wake:   make(chan struct{}, 1),
+		quit:   make(chan struct{}),
+	}
+	err := mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		store, err := mwdb.GetOrCreateTopLevelBucket(tx, webhookBucket)
+		if err != nil {
+			return err
+		}
+		bucket, err := mwdb.GetOrCreateBucket(store, webhookPendingBucket)
+		if err != nil {
+			return err
+		}
+		m.nsPending = bucket.GetBucketMeta()
+		bucket, err = mwdb.GetOrCreateBucket(store, webhookQueueBucket)
+		if err != nil {
+			return err
+		}
+		m.nsQueue = bucket.GetBucketMeta()
+		bucket, err = mwdb.GetOrCreateBucket(store, webhookMetaBucket)
+		if err != nil {
+			return err
+		}
+		m.nsMeta = bucket.GetBucketMeta()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *webhookManager) nextSeq(tx mwdb.DBTransaction) (uint64, error) {
+	nsMeta := tx.FetchBucket(m.nsMeta)
+	v, err := nsMeta.Get(webhookSeqKey)
+	if err != nil {
+		return 0, err
+	}
+	var seq uint64
+	if len(v) == 8 {
+		seq = binary.BigEndian.Uint64(v)
+	}
+	seq++
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, seq)
+	return seq, nsMeta.Put(webhookSeqKey, buf)
+}
+
+// enqueue adds a delivery of p for every url.
+func (m *webhookManager) enqueue(tx mwdb.DBTransaction, p *WebhookPayload) error {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	nsQueue := tx.FetchBucket(m.nsQueue)
+	for _, url := range m.cfg.Urls {
+		seq, err := m.nextSeq(tx)
+		if err != nil {
+			return err
+		}
+		d := &webhookDelivery{
+			URL:         url,
+			Event:       p.Event,
+			Payload:     payload,
+			NextAttempt: m.now().Unix(),
+		}
+		if err = putWebhookDelivery(nsQueue, seq, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// schedule keeps p until the block of dueHeight is connected.
+func (m *webhookManager) schedule(tx mwdb.DBTransaction, dueHeight, minedHeight uint64, p *WebhookPayload) error {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	seq, err := m.nextSeq(tx)
+	if err != nil {
+		return err
+	}
+	key := make([]byte, 24)
+	binary.BigEndian.PutUint64(key[0:8], dueHeight)
+	binary.BigEndian.PutUint64(key[8:16], minedHeight)
+	binary.BigEndian.PutUint64(key[16:24], seq)
+	return tx.FetchBucket(m.nsPending).Put(key, payload)
+}
+
+// onBlockTx records the events of rec mined in blockMeta. The received events
+// are only sent if the transaction was not seen in mempool.
+func (m *webhookManager) onBlockTx(tx mwdb.DBTransaction, rec *txmgr.TxRecord,
+	blockMeta *txmgr.BlockMeta, received bool) error {
+	if received {
+		if err := m.onReceived(tx, rec, blockMeta); err != nil {
+			return err
+		}
+	}
+
+	wallets := make(map[string]struct{})
+	for _, meta := range rec.RelevantTxIn {
+		wallets[meta.WalletId] = struct{}{}
+	}
+	for _, meta := range rec.RelevantTxOut {
+		wallets[meta.WalletId] = struct{}{}
+	}
+	confirmations := uint64(m.cfg.Confirmations)
+	if confirmations == 0 {
+		confirmations = 1
+	}
+	due := blockMeta.Height + confirmations - 1
+	for walletID := range wallets {
+		p := &WebhookPayload{
+			Event:       WebhookTxConfirmed,
+			WalletID:    walletID,
+			TxID:        rec.Hash.String(),
+			OutputIndex: -1,
+			BlockHash:   blockMeta.Hash.String(),
+			Height:      blockMeta.Height,
+		}
+		if err := m.schedule(tx, due, blockMeta.Height, p); err != nil {
+			return err
+		}
+	}
+
+	for _, meta := range rec.RelevantTxOut {
+		if !meta.PkScript.IsStaking() {
+			continue
+		}
+		p, err := webhookOutputPayload(WebhookStakingWithdrawable, rec, meta, blockMeta)
+		if err != nil {
+			return err
+		}
+		if err = m.schedule(tx, blockMeta.Height+meta.PkScript.Maturity()-1, blockMeta.Height, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// onReceived enqueues an event for every output of rec paid to a non-change
+// address of wallets. Outputs of a wallet spending only its own inputs are
+// not received.
+func (m *webhookManager) onReceived(tx mwdb.DBTransaction, rec *txmgr.TxRecord, blockMeta *txmgr.BlockMeta) error {
+	for _, meta := range rec.RelevantTxOut {
+		if meta.IsChangeAddr || isSelfSend(rec, meta.WalletId) {
+			continue
+		}
+		p, err := webhookOutputPayload(WebhookReceived, rec, meta, blockMeta)
+		if err != nil {
+			return err
+		}
+		if blockMeta != nil {
+			p.Confirmations = 1
+		}
+		if err = m.enqueue(tx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSelfSend returns true if every input of rec is spent by walletID.
+func isSelfSend(rec *txmgr.TxRecord, walletID string) bool {
+	n := 0
+	for _, meta := range rec.RelevantTxIn {
+		if meta.WalletId == walletID {
+			n++
+		}
+	}
+	return n > 0 && n == len(rec.MsgTx.TxIn)
+}
+
+// releasePending moves the pending events due at or below height to the queue.
+func (m *webhookManager) releasePending(tx mwdb.DBTransaction, height uint64) error {
+	limit := make([]byte, 8)
+	binary.BigEndian.PutUint64(limit, height+1)
+	nsPending := tx.FetchBucket(m.nsPending)
+	iter := nsPending.NewIterator(&mwdb.Range{Limit: limit})
+	defer iter.Release()
+
+	keys := make([][]byte, 0)
+	payloads := make([]*WebhookPayload, 0)
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) != 24 {
+			continue
+		}
+		p := &WebhookPayload{}
+		if err := json.Unmarshal(iter.Value(), p); err != nil {
+			return err
+		}
+		p.Confirmations = height - binary.BigEndian.Uint64(key[8:16]) + 1
+		keys = append(keys, append([]byte(nil), key...))
+		payloads = append(payloads, p)
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	for i, key := range keys {
+		if err := m.enqueue(tx, payloads[i]); err != nil {
+			return err
+		}
+		if err := nsPending.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollback deletes the pending events of transactions mined at or above
+// height.
+func (m *webhookManager) rollback(tx mwdb.DBTransaction, height uint64) error {
+	nsPending := tx.FetchBucket(m.nsPending)
+	entries, err := nsPending.GetByPrefix(nil)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if len(entry.Key) != 24 || binary.BigEndian.Uint64(entry.Key[8:16]) < height {
+			continue
+		}
+		if err = nsPending.Delete(entry.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notify wakes up the dispatcher after new deliveries are committed.
+func (m *webhookManager) notify() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (m *webhookManager) start() {
+	m.wg.Add(1)
+	go m.dispatch()
+}
+
+func (m *webhookManager) stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+func (m *webhookManager) dispatch() {
+	defer Recover()
+	defer m.wg.Done()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.quit:
+			return
+		case <-m.wake:
+		case <-timer.C:
+		}
+		wait := m.deliverDue()
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(wait)
+	}
+}
+
+// deliverDue POSTs the deliveries whose next attempt is due, and returns the
+// time to wait before the next round.
+func (m *webhookManager) deliverDue() time.Duration {
+	now := m.now().Unix()
+	due := make([]*webhookDelivery, 0)
+	wait := webhookPollInterval
+	err := mwdb.View(m.db, func(tx mwdb.ReadTransaction) error {
+		iter := tx.FetchBucket(m.nsQueue).NewIterator(nil)
+		defer iter.Release()
+		for iter.Next() && len(due) < webhookBatchSize {
+			d, err := readWebhookDelivery(iter.Key(), iter.Value())
+			if err != nil {
+				return err
+			}
+			if d.NextAttempt > now {
+				if after := time.Duration(d.NextAttempt-now) * time.Second; after < wait {
+					wait = after
+				}
+				continue
+			}
+			due = append(due, d)
+		}
+		return iter.Error()
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to read webhook queue", logging.LogFormat{"err": err})
+		return wait
+	}
+	if len(due) == webhookBatchSize {
+		wait = 0
+	}
+
+	for _, d := range due {
+		select {
+		case <-m.quit:
+			return wait
+		default:
+		}
+		postErr := m.post(d)
+		err = mwdb.Update(m.db, func(tx mwdb.DBTransaction) error {
+			nsQueue := tx.FetchBucket(m.nsQueue)
+			if postErr == nil {
+				return nsQueue.Delete(webhookDeliveryKey(d.ID))
+			}
+			d.Attempts++
+			if d.Attempts > m.cfg.MaxRetries {
+				logging.CPrint(logging.ERROR, "webhook delivery dropped after max retries", logging.LogFormat{
+					"url":      d.URL,
+					"event":    d.Event,
+					"delivery": d.ID,
+					"attempts": d.Attempts,
+					"err":      postErr,
+				})
+				return nsQueue.Delete(webhookDeliveryKey(d.ID))
+			}
+			backoff := webhookBackoff(d.Attempts)
+			logging.CPrint(logging.WARN, "webhook delivery failed", logging.LogFormat{
+				"url":      d.URL,
+				"event":    d.Event,
+				"delivery": d.ID,
+				"attempts": d.Attempts,
+				"retryIn":  backoff,
+				"err":      postErr,
+			})
+			d.NextAttempt = m.now().Add(backoff).Unix()
+			if backoff < wait {
+				wait = backoff
+			}
+			return putWebhookDelivery(nsQueue, d.ID, d)
+		})
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to update webhook queue", logging.LogFormat{
+				"delivery": d.ID,
+				"err":      err,
+			})
+		}
+	}
+	return wait
+}
+
+func (m *webhookManager) post(d *webhookDelivery) error {
+	req, err := http.NewRequest(http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookHeaderEvent, d.Event)
+	req.Header.Set(WebhookHeaderDelivery, strconv.FormatUint(d.ID, 10))
+	if len(m.cfg.Secret) > 0 {
+		req.Header.Set(WebhookHeaderSignature, SignWebhookPayload([]byte(m.cfg.Secret), d.Payload))
+	}
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%v: %d", errWebhookStatus, resp.StatusCode)
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the value of header X-Mass-Signature, which is
+// "sha256=" followed by the hex-encoded HMAC-SHA256 of body keyed by secret.
+func SignWebhookPayload(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff returns the delay before the next attempt of a delivery
+// failed attempts times.
+func webhookBackoff(attempts uint32) time.Duration {
+	if attempts == 0 {
+		return 0
+	}
+	backoff := webhookBaseBackoff
+	for i := uint32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func webhookOutputPayload(event string, rec *txmgr.TxRecord, meta *txmgr.RelevantMeta,
+	blockMeta *txmgr.BlockMeta) (*WebhookPayload, error) {
+	amt, err := AmountToString(rec.MsgTx.TxOut[meta.Index].Value)
+	if err != nil {
+		return nil, err
+	}
+	p := &WebhookPayload{
+		Event:       event,
+		WalletID:    meta.WalletId,
+		TxID:        rec.Hash.String(),
+		Address:     meta.PkScript.StdEncodeAddress(),
+		OutputIndex: meta.Index,
+		Amount:      amt,
+	}
+	if blockMeta != nil {
+		p.BlockHash = blockMeta.Hash.String()
+		p.Height = blockMeta.Height
+	}
+	return p, nil
+}
+
+func webhookDeliveryKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func putWebhookDelivery(ns mwdb.Bucket, id uint64, d *webhookDelivery) error {
+	v, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	return ns.Put(webhookDeliveryKey(id), v)
+}
+
+func readWebhookDelivery(k, v []byte) (*webhookDelivery, error) {
+	if len(k) != 8 {
+		return nil, fmt.Errorf("invalid webhook delivery key %x", k)
+	}
+	d := &webhookDelivery{}
+	if err := json.Unmarshal(v, d); err != nil {
+		return nil, err
+	}
+	d.ID = binary.BigEndian.Uint64(k)
+	return d, nil
+}
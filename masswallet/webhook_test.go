@@ -0,0 +1,335 @@
+package masswallet
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"massnet.org/mass-wallet/config"
+	configpb "massnet.org/mass-wallet/config/pb"
+	"massnet.org/mass-wallet/consensus"
+	"massnet.org/mass-wallet/massutil"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/masswallet/utils"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+func TestWebhookBackoff(t *testing.T) {
+	tests := []struct {
+		attempts uint32
+		expect   time.Duration
+	}{
+		{0, 0},
+		{1, 5 * time.Second},
+		{2, 10 * time.Second},
+		{5, 80 * time.Second},
+		{10, 2560 * time.Second},
+		{11, time.Hour},
+		{100, time.Hour},
+	}
+	for i, test := range tests {
+		if got := webhookBackoff(test.attempts); got != test.expect {
+			t.Errorf("%d: expected %v, got %v", i, test.expect, got)
+		}
+	}
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	// echo -n '{"event":"received"}' | openssl dgst -sha256 -hmac secret
+	sig := SignWebhookPayload([]byte("secret"), []byte(`{"event":"received"}`))
+	if sig != "sha256=39725f77e52be768d99903e721e6e659d8fbfcf4bb554bc26f081e856c16c665" {
+		t.Fatalf("unexpected signature %s", sig)
+	}
+	if sig == SignWebhookPayload([]byte("other"), []byte(`{"event":"received"}`)) {
+		t.Fatal("signature does not depend on secret")
+	}
+}
+
+func webhookTestRecord(t *testing.T) *txmgr.TxRecord {
+	addr, err := massutil.NewAddressWitnessScriptHash(make([]byte, 32), &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stakingAddr, err := massutil.NewAddressStakingScriptHash(make([]byte, 32), &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stakingScript, err := txscript.PayToStakingAddrScript(stakingAddr, consensus.MinFrozenPeriod)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msgTx := wire.NewMsgTx()
+	msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: wire.DoubleHashH([]byte("webhook"))}, nil))
+	msgTx.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	msgTx.AddTxOut(wire.NewTxOut(2e8, pkScript))
+	msgTx.AddTxOut(wire.NewTxOut(3e8, stakingScript))
+	rec, err := txmgr.NewTxRecordFromMsgTx(msgTx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, isChange := range []bool{false, true, false} {
+		ps, err := utils.ParsePkScript(msgTx.TxOut[i].PkScript, &config.ChainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec.RelevantTxOut = append(rec.RelevantTxOut, &txmgr.RelevantMeta{
+			Index:        i,
+			PkScript:     ps,
+			WalletId:     "wallet",
+			IsChangeAddr: isChange,
+		})
+	}
+	return rec
+}
+
+func webhookQueued(t *testing.T, m *webhookManager) []*webhookDelivery {
+	var ret []*webhookDelivery
+	err := mwdb.View(m.db, func(tx mwdb.ReadTransaction) error {
+		entries, err := tx.FetchBucket(m.nsQueue).GetByPrefix(nil)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			d, err := readWebhookDelivery(entry.Key, entry.Value)
+			if err != nil {
+				return err
+			}
+			ret = append(ret, d)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ret
+}
+
+func TestWebhookManager_Queue(t *testing.T) {
+	db, teardown, err := testDB("testWebhookQueue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+	defer db.Close()
+
+	m, err := newWebhookManager(db, &configpb.WebhookConfig{
+		Urls:          []string{"http://a", "http://b"},
+		Confirmations: 3,
+		MaxRetries:    1,
+		Timeout:       1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := webhookTestRecord(t)
+	maturity := rec.RelevantTxOut[2].PkScript.Maturity()
+	blockMeta := &txmgr.BlockMeta{Height: 100}
+	update := func(f func(tx mwdb.DBTransaction) error) {
+		if err := mwdb.Update(db, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// one received event for the non-change output, for every url
+	update(func(tx mwdb.DBTransaction) error {
+		return m.onBlockTx(tx, rec, blockMeta, true)
+	})
+	queued := webhookQueued(t, m)
+	if len(queued) != 4 {
+		t.Fatalf("expected 4 deliveries, got %d", len(queued))
+	}
+	for _, d := range queued {
+		if d.Event != WebhookReceived {
+			t.Fatalf("unexpected event %s", d.Event)
+		}
+	}
+
+	// not confirmed yet
+	update(func(tx mwdb.DBTransaction) error {
+		return m.releasePending(tx, 101)
+	})
+	if n := len(webhookQueued(t, m)); n != 4 {
+		t.Fatalf("expected 4 deliveries, got %d", n)
+	}
+	update(func(tx mwdb.DBTransaction) error {
+		return m.releasePending(tx, 102)
+	})
+	queued = webhookQueued(t, m)
+	if len(queued) != 6 {
+		t.Fatalf("expected 6 deliveries, got %d", len(queued))
+	}
+	p := &WebhookPayload{}
+	if err = json.Unmarshal(queued[5].Payload, p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Event != WebhookTxConfirmed || p.Confirmations != 3 || p.TxID != rec.Hash.String() {
+		t.Fatalf("unexpected payload %v", p)
+	}
+
+	// staking output is withdrawable after maturity, unless rolled back
+	update(func(tx mwdb.DBTransaction) error {
+		return m.rollback(tx, 101)
+	})
+	update(func(tx mwdb.DBTransaction) error {
+		return m.releasePending(tx, 100+maturity)
+	})
+	if n := len(webhookQueued(t, m)); n != 8 {
+		t.Fatalf("expected 8 deliveries, got %d", n)
+	}
+	update(func(tx mwdb.DBTransaction) error {
+		return m.onBlockTx(tx, rec, blockMeta, false)
+	})
+	update(func(tx mwdb.DBTransaction) error {
+		return m.rollback(tx, 100)
+	})
+	update(func(tx mwdb.DBTransaction) error {
+		return m.releasePending(tx, 100+maturity)
+	})
+	if n := len(webhookQueued(t, m)); n != 8 {
+		t.Fatalf("expected 8 deliveries after rollback, got %d", n)
+	}
+}
+
+func TestWebhookManager_SelfSend(t *testing.T) {
+	db, teardown, err := testDB("testWebhookSelfSend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+	defer db.Close()
+
+	m, err := newWebhookManager(db, &configpb.WebhookConfig{
+		Urls:          []string{"http://a"},
+		Confirmations: 1,
+		MaxRetries:    1,
+		Timeout:       1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := webhookTestRecord(t)
+	rec.RelevantTxIn = []*txmgr.RelevantMeta{{
+		Index:    0,
+		PkScript: rec.RelevantTxOut[0].PkScript,
+		WalletId: "wallet",
+	}}
+	onReceived := func() {
+		err := mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+			return m.onReceived(tx, rec, nil)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the wallet pays itself
+	onReceived()
+	if n := len(webhookQueued(t, m)); n != 0 {
+		t.Fatalf("expected no delivery for self-send, got %d", n)
+	}
+
+	// another wallet pays the wallet
+	rec.RelevantTxIn[0].WalletId = "other"
+	onReceived()
+	if n := len(webhookQueued(t, m)); n != 2 {
+		t.Fatalf("expected 2 deliveries, got %d", n)
+	}
+}
+
+func TestWebhookManager_Deliver(t *testing.T) {
+	db, teardown, err := testDB("testWebhookDeliver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+	defer db.Close()
+
+	const secret = "secret"
+	fail := true
+	received := make([]*http.Request, 0)
+	bodies := make([][]byte, 0)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received = append(received, r)
+		bodies = append(bodies, body)
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	m, err := newWebhookManager(db, &configpb.WebhookConfig{
+		Urls:          []string{srv.URL},
+		Secret:        secret,
+		Confirmations: 1,
+		MaxRetries:    2,
+		Timeout:       1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1e9, 0)
+	m.now = func() time.Time { return now }
+
+	err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		return m.enqueue(tx, &WebhookPayload{Event: WebhookReceived, WalletID: "wallet", TxID: "tx"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// failed delivery is retried after backoff
+	if wait := m.deliverDue(); wait != webhookBackoff(1) {
+		t.Fatalf("unexpected wait %v", wait)
+	}
+	queued := webhookQueued(t, m)
+	if len(queued) != 1 || queued[0].Attempts != 1 {
+		t.Fatalf("unexpected queue %v", queued)
+	}
+	m.deliverDue()
+	if len(received) != 1 {
+		t.Fatalf("delivery retried before backoff")
+	}
+
+	r := received[0]
+	if r.Header.Get(WebhookHeaderEvent) != WebhookReceived {
+		t.Fatalf("unexpected event header %s", r.Header.Get(WebhookHeaderEvent))
+	}
+	if r.Header.Get(WebhookHeaderSignature) != SignWebhookPayload([]byte(secret), bodies[0]) {
+		t.Fatal("invalid signature")
+	}
+
+	// succeeded delivery is removed
+	now = now.Add(webhookBackoff(1))
+	fail = false
+	m.deliverDue()
+	if len(received) != 2 || len(webhookQueued(t, m)) != 0 {
+		t.Fatalf("expected delivered, received %d", len(received))
+	}
+
+	// dropped after max retries
+	fail = true
+	err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		return m.enqueue(tx, &WebhookPayload{Event: WebhookTxConfirmed, WalletID: "wallet", TxID: "tx"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := uint32(1); i <= 3; i++ {
+		m.deliverDue()
+		now = now.Add(webhookBackoff(i))
+	}
+	if len(received) != 5 || len(webhookQueued(t, m)) != 0 {
+		t.Fatalf("expected dropped after 3 attempts, received %d", len(received))
+	}
+}
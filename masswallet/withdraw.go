@@ -0,0 +1,312 @@
+package masswallet
+
+import (
+	"bytes"
+	"sort"
+
+	"massnet.org/mass-wallet/blockchain"
+	"massnet.org/mass-wallet/logging"
+	"massnet.org/mass-wallet/massutil"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/masswallet/utils"
+	"massnet.org/mass-wallet/wire"
+)
+
+// CreateStakingWithdrawTransaction creates the unsigned transaction spending
+// the expired staking outputs of txIds, or every expired staking output of
+// current wallet if txIds is empty, to the address to. The sequence of each
+// input is the frozen period of its output plus one, as the relative lock of
+// staking requires.
+//
+// The withdraw address of the first staking output is paid if to is empty,
+// and the fee is the minimum relay fee if userTxFee is zero.
+func (w *WalletManager) CreateStakingWithdrawTransaction(txIds []*wire.Hash, to string,
+	userTxFee massutil.Amount) (string, massutil.Amount, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	ks := w.ksmgr.CurrentKeystore()
+	if ks == nil {
+		return "", massutil.ZeroAmount(), ErrNoWalletInUse
+	}
+
+	selected := make(map[wire.Hash]bool)
+	for _, txId := range txIds {
+		selected[*txId] = false
+	}
+	credits, err := w.withdrawableCredits(txmgr.ClassStakingUtxo, func(item *txmgr.Credit) bool {
+		if len(selected) == 0 {
+			return true
+		}
+		if _, ok := selected[item.OutPoint.Hash]; !ok {
+			return false
+		}
+		selected[item.OutPoint.Hash] = true
+		return true
+	})
+	if err != nil {
+		return "", massutil.ZeroAmount(), err
+	}
+	for txId, ok := range selected {
+		if !ok {
+			logging.CPrint(logging.ERROR, "no withdrawable staking output in tx", logging.LogFormat{
+				"txid": txId.String(),
+			})
+			return "", massutil.ZeroAmount(), ErrStakingNotExpired
+		}
+	}
+	if len(credits) == 0 {
+		return "", massutil.ZeroAmount(), ErrNothingToWithdraw
+	}
+
+	if len(to) == 0 {
+		addr, err := massutil.NewAddressWitnessScriptHash(credits[0].ScriptHash, w.chainParams)
+		if err != nil {
+			return "", massutil.ZeroAmount(), err
+		}
+		to = addr.EncodeAddress()
+	}
+
+	msgTx, fee, err := w.newWithdrawTx(credits, to, userTxFee, nil)
+	if err != nil {
+		return "", massutil.ZeroAmount(), err
+	}
+	mtxHex, err := messageToHex(msgTx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "Error in messageToHex(mtx)", logging.LogFormat{
+			"err": err,
+		})
+		return "", massutil.ZeroAmount(), err
+	}
+	w.MarkUsedUTXO(msgTx)
+	return mtxHex, fee, nil
+}
+
+// CreateBindingWithdrawTransaction creates the unsigned transaction spending
+// the binding outputs of current wallet held by holder and bound to pocAddress,
+// either of which matches any if empty, to the address to.
+//
+// All matched outputs are unbound if amount is zero. Otherwise the smallest
+// outputs are spent until amount is covered, and the excess is bound again to
+// the holder and PoC address of the last spent output, unless it is dust. The
+// address to receives the unbound amount less the fee, and is the holder of
+// the first spent output if empty. The fee is the minimum relay fee if
+// userTxFee is zero.
+func (w *WalletManager) CreateBindingWithdrawTransaction(holder, pocAddress string, amount massutil.Amount,
+	to string, userTxFee massutil.Amount) (string, massutil.Amount, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	ks := w.ksmgr.CurrentKeystore()
+	if ks == nil {
+		return "", massutil.ZeroAmount(), ErrNoWalletInUse
+	}
+
+	var holderScript []byte
+	if len(holder) > 0 {
+		ma, err := ks.Address(holder)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "holder address not found in wallet", logging.LogFormat{
+				"holder": holder,
+				"err":    err,
+			})
+			return "", massutil.ZeroAmount(), err
+		}
+		holderScript = ma.ScriptAddress()
+	}
+	credits, err := w.withdrawableCredits(txmgr.ClassBindingUtxo, func(item *txmgr.Credit) bool {
+		return holderScript == nil || bytes.Equal(item.ScriptHash, holderScript)
+	})
+	if err != nil {
+		return "", massutil.ZeroAmount(), err
+	}
+
+	bindings := make(map[*txmgr.Credit]*BindingOutput)
+	matched := make([]*txmgr.Credit, 0, len(credits))
+	for _, item := range credits {
+		prevTx, err := w.existsMsgTx(&item.OutPoint)
+		if err != nil {
+			return "", massutil.ZeroAmount(), err
+		}
+		pks, err := utils.ParsePkScript(prevTx.TxOut[item.OutPoint.Index].PkScript, w.chainParams)
+		if err != nil {
+			return "", massutil.ZeroAmount(), err
+		}
+		if len(pocAddress) > 0 && pks.SecondEncodeAddress() != pocAddress {
+			continue
+		}
+		bindings[item] = &BindingOutput{
+			HolderAddress:  pks.StdEncodeAddress(),
+			BindingAddress: pks.SecondEncodeAddress(),
+		}
+		matched = append(matched, item)
+	}
+	if len(matched) == 0 {
+		return "", massutil.ZeroAmount(), ErrNothingToWithdraw
+	}
+
+	var rebind []*BindingOutput
+	if !amount.IsZero() {
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].Amount.Cmp(matched[j].Amount) < 0
+		})
+		sum := massutil.ZeroAmount()
+		n := 0
+		for n < len(matched) && sum.Cmp(amount) < 0 {
+			if sum, err = sum.Add(matched[n].Amount); err != nil {
+				return "", massutil.ZeroAmount(), err
+			}
+			n++
+		}
+		if sum.Cmp(amount) < 0 {
+			return "", massutil.ZeroAmount(), ErrInsufficientFunds
+		}
+		matched = matched[:n]
+		excess, err := sum.Sub(amount)
+		if err != nil {
+			return "", massutil.ZeroAmount(), err
+		}
+		if excess.Cmp(massutil.MinRelayTxFee()) >= 0 {
+			last := bindings[matched[n-1]]
+			rebind = []*BindingOutput{{
+				HolderAddress:  last.HolderAddress,
+				BindingAddress: last.BindingAddress,
+				Amount:         excess,
+			}}
+		}
+	}
+
+	if len(to) == 0 {
+		to = bindings[matched[0]].HolderAddress
+	}
+	msgTx, fee, err := w.newWithdrawTx(matched, to, userTxFee, rebind)
+	if err != nil {
+		return "", massutil.ZeroAmount(), err
+	}
+	mtxHex, err := messageToHex(msgTx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "Error in messageToHex(mtx)", logging.LogFormat{
+			"err": err,
+		})
+		return "", massutil.ZeroAmount(), err
+	}
+	w.MarkUsedUTXO(msgTx)
+	return mtxHex, fee, nil
+}
+
+// withdrawableCredits returns the mature outputs of class of current wallet
+// that are not spent or reserved yet, and accepted by filter, in the order of
+// their outpoints.
+func (w *WalletManager) withdrawableCredits(class txmgr.UtxoClass,
+	filter func(item *txmgr.Credit) bool) ([]*txmgr.Credit, error) {
+	ks := w.ksmgr.CurrentKeystore()
+	scriptSet := make(map[string]struct{})
+	for _, addr := range ks.ListAddresses() {
+		ma, err := ks.Address(addr)
+		if err != nil {
+			return nil, err
+		}
+		scriptSet[string(ma.ScriptAddress())] = struct{}{}
+	}
+
+	credits := make([]*txmgr.Credit, 0)
+	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		syncedTo, err := w.syncStore.SyncedTo(tx)
+		if err != nil {
+			return err
+		}
+		_, err = w.utxoStore.ScriptAddressUnspents(tx, ks.Name(), scriptSet, syncedTo.Height,
+			func(item *txmgr.Credit) (stopIter, selected bool) {
+				if item.Flags.Class != class || item.Confirmations < item.Maturity ||
+					item.Flags.Spent || item.Flags.SpentByUnmined || w.UTXOUsed(&item.OutPoint) ||
+					w.server.TxMemPool().CheckPoolOutPointSpend(&item.OutPoint) {
+					return
+				}
+				if filter != nil && !filter(item) {
+					return
+				}
+				credits = append(credits, item)
+				return
+			})
+		return err
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to load utxos", logging.LogFormat{
+			"err":      err,
+			"walletId": ks.Name(),
+		})
+		return nil, err
+	}
+
+	sort.Slice(credits, func(i, j int) bool {
+		if credits[i].OutPoint.Hash != credits[j].OutPoint.Hash {
+			return bytes.Compare(credits[i].OutPoint.Hash[:], credits[j].OutPoint.Hash[:]) < 0
+		}
+		return credits[i].OutPoint.Index < credits[j].OutPoint.Index
+	})
+	return credits, nil
+}
+
+// newWithdrawTx returns the transaction spending all credits to the binding
+// outputs rebind, and the rest to the standard address to, paying userTxFee,
+// or the minimum relay fee if zero.
+func (w *WalletManager) newWithdrawTx(credits []*txmgr.Credit, to string,
+	userTxFee massutil.Amount, rebind []*BindingOutput) (*wire.MsgTx, massutil.Amount, error) {
+	addr, err := massutil.DecodeAddress(to, w.chainParams)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), ErrFailedDecodeAddress
+	}
+	if massutil.IsWitnessStakingAddress(addr) {
+		return nil, massutil.ZeroAmount(), ErrInvalidAddress
+	}
+
+	size, err := w.estimateSignedSize(credits, 1+len(rebind))
+	if err != nil {
+		logging.CPrint(logging.ERROR, "estimate signedSize failed", logging.LogFormat{"err": err})
+		return nil, massutil.ZeroAmount(), err
+	}
+	if size > int64(blockchain.GetMaxStandardTxSize()) {
+		return nil, massutil.ZeroAmount(), ErrOverfullUtxo
+	}
+	fee := userTxFee
+	if fee.IsZero() {
+		if fee, err = blockchain.CalcMinRequiredTxRelayFee(size, massutil.MinRelayTxFee()); err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+	}
+
+	sum := massutil.ZeroAmount()
+	for _, item := range credits {
+		if sum, err = sum.Add(item.Amount); err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+	}
+	for _, output := range rebind {
+		if sum, err = sum.Sub(output.Amount); err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+	}
+	amount, err := sum.Sub(fee)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), ErrInsufficientFunds
+	}
+	if amount.Cmp(massutil.MinRelayTxFee()) < 0 {
+		return nil, massutil.ZeroAmount(), ErrDustAmount
+	}
+
+	msgTx := wire.NewMsgTx()
+	if err = w.addTxIn(msgTx, 0, credits); err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	if err = constructBindingTxOut(rebind, msgTx); err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	txOut, err := amountToTxOut(to, amount)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	msgTx.AddTxOut(txOut)
+	msgTx.Version = wire.TxVersion
+	return msgTx, fee, nil
+}
@@ -0,0 +1,402 @@
+package masswallet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/consensus"
+	"massnet.org/mass-wallet/database/ldb"
+	"massnet.org/mass-wallet/massutil"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/txscript"
+	"massnet.org/mass-wallet/wire"
+)
+
+func TestWalletManager_CreateWithdrawTransaction(t *testing.T) {
+	databaseDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("new databaseDb error")
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testWithdraw")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{databaseDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+
+	if _, _, err = w.CreateStakingWithdrawTransaction(nil, "", massutil.ZeroAmount()); err != ErrNoWalletInUse {
+		t.Fatalf("expected ErrNoWalletInUse, got %v", err)
+	}
+	walletId, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = w.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	addr, err := w.NewAddress(0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+
+	if _, _, err = w.CreateStakingWithdrawTransaction(nil, "", massutil.ZeroAmount()); err != ErrNothingToWithdraw {
+		t.Fatalf("expected ErrNothingToWithdraw, got %v", err)
+	}
+	txId := wire.DoubleHashH([]byte("staking"))
+	if _, _, err = w.CreateStakingWithdrawTransaction([]*wire.Hash{&txId}, "", massutil.ZeroAmount()); err != ErrStakingNotExpired {
+		t.Fatalf("expected ErrStakingNotExpired, got %v", err)
+	}
+
+	if _, _, err = w.CreateBindingWithdrawTransaction("", "", massutil.ZeroAmount(), "", massutil.ZeroAmount()); err != ErrNothingToWithdraw {
+		t.Fatalf("expected ErrNothingToWithdraw, got %v", err)
+	}
+	if _, _, err = w.CreateBindingWithdrawTransaction(addr, "", massutil.ZeroAmount(), "", massutil.ZeroAmount()); err != ErrNothingToWithdraw {
+		t.Fatalf("expected ErrNothingToWithdraw, got %v", err)
+	}
+	other := "ms1qqgzwwzt77zuw4sf8uqugqj5w6cpk6lalas4svx6jac20kjrhywx3qnshys8"
+	if _, _, err = w.CreateBindingWithdrawTransaction(other, "", massutil.ZeroAmount(), "", massutil.ZeroAmount()); err == nil {
+		t.Fatal("expected error for holder not in wallet")
+	}
+
+	// stake to addr for the min frozen period
+	witAddr, err := massutil.DecodeAddress(addr, w.chainParams)
+	if err != nil {
+		t.Fatal("decode addr error", err.Error())
+	}
+	stakingAddr, err := massutil.NewAddressStakingScriptHash(witAddr.ScriptAddress(), w.chainParams)
+	if err != nil {
+		t.Fatal("new staking addr error", err.Error())
+	}
+	frozenPeriod := consensus.MinFrozenPeriod
+	pkScript, err := txscript.PayToStakingAddrScript(stakingAddr, frozenPeriod)
+	if err != nil {
+		t.Fatal("get pkscript error", err.Error())
+	}
+	block15T2.TxOut[0].PkScript = pkScript
+	newBlock15T2Hash := block15T2.TxHash()
+	var blk15 *massutil.Block
+	for i := 1; i <= int(block15Meta.Height); i++ {
+		// serialize anew, the longer staking script moves the tx locations
+		blk := massutil.NewBlock(blks200[i].MsgBlock())
+		blk.SetHeight(blks200[i].Height())
+		blk15 = blk
+		for _, tx := range blk.MsgBlock().Transactions {
+			for _, txin := range tx.TxIn {
+				if bytes.Equal(txin.PreviousOutPoint.Hash[:], b15T2Hash[:]) {
+					txin.PreviousOutPoint.Hash = newBlock15T2Hash
+				}
+			}
+		}
+		err = databaseDb.SubmitBlock(blk)
+		if err != nil {
+			t.Fatal("init db error:", err)
+		}
+		databaseDb.(*ldb.ChainDb).Batch(1).Set(blk.MsgBlock().BlockHash())
+		databaseDb.(*ldb.ChainDb).Batch(1).Done()
+		err = databaseDb.Commit(blk.MsgBlock().BlockHash())
+		if err != nil {
+			t.Fatal("init db error:", err)
+		}
+	}
+	defer func() {
+		block15T2.TxOut[0].PkScript = b15T2O0PkScript
+		for i := 1; i <= int(block15Meta.Height); i++ {
+			for _, tx := range blks200[i].MsgBlock().Transactions {
+				for _, txin := range tx.TxIn {
+					if bytes.Equal(txin.PreviousOutPoint.Hash[:], newBlock15T2Hash[:]) {
+						txin.PreviousOutPoint.Hash = b15T2Hash
+					}
+				}
+			}
+		}
+	}()
+
+	rec, err := txmgr.NewTxRecordFromMsgTx(block15T2, block15.Header.Timestamp)
+	if err != nil {
+		t.Fatal("get txRecord error", err.Error())
+	}
+	if rec, err = simpleFilterTx(rec, block15T2, walletId); err != nil {
+		t.Fatal("filter tx error", err.Error())
+	}
+	if block15Meta.Loc, err = w.chainFetcher.FetchBlockLocByHeight(block15Meta.Height); err != nil {
+		t.Fatal("FetchBlockLocByHeight error:", err)
+	}
+	txLocs, err := blk15.TxLoc()
+	if err != nil {
+		t.Fatal("TxLoc error", err)
+	}
+	rec.TxLoc = &txLocs[2]
+	allBalances := map[string]massutil.Amount{walletId: massutil.ZeroAmount()}
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		return w.txStore.AddRelevantTx(tx, allBalances, rec, block15Meta)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncTo := func(height uint64) {
+		err := mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+			syncedTo, err := w.syncStore.SyncedTo(tx)
+			if err != nil {
+				return err
+			}
+			for h := syncedTo.Height + 1; h <= height; h++ {
+				meta := &txmgr.BlockMeta{Height: h, Hash: wire.DoubleHashH([]byte{byte(h), byte(h >> 8), byte(h >> 16)})}
+				if h <= block15Meta.Height {
+					meta.Hash = *blks200[h].Hash()
+				}
+				if err = w.syncStore.SetSyncedTo(tx, meta); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// immature at the height of the staking output
+	syncTo(block15Meta.Height)
+	if _, _, err = w.CreateStakingWithdrawTransaction(nil, "", massutil.ZeroAmount()); err != ErrNothingToWithdraw {
+		t.Fatalf("expected ErrNothingToWithdraw, got %v", err)
+	}
+
+	// matured after the frozen period
+	syncTo(block15Meta.Height + frozenPeriod)
+	to, err := w.NewAddress(0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	mtxHex, fee, err := w.CreateStakingWithdrawTransaction([]*wire.Hash{&newBlock15T2Hash}, to, massutil.ZeroAmount())
+	if err != nil {
+		t.Fatal("CreateStakingWithdrawTransaction error", err.Error())
+	}
+	serializedTx, err := decodeHexStr(mtxHex)
+	if err != nil {
+		t.Fatal("decode hexStr error", err.Error())
+	}
+	var mtx wire.MsgTx
+	if err = mtx.SetBytes(serializedTx, wire.Packet); err != nil {
+		t.Fatal("deserialize tx error", err.Error())
+	}
+	if assert.Equal(t, 1, len(mtx.TxIn)) {
+		assert.Equal(t, wire.OutPoint{Hash: newBlock15T2Hash, Index: 0}, mtx.TxIn[0].PreviousOutPoint)
+		assert.Equal(t, frozenPeriod+1, mtx.TxIn[0].Sequence)
+	}
+	toPkScript, err := PayToWitnessV0Address(to, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Equal(t, 1, len(mtx.TxOut)) {
+		assert.Equal(t, toPkScript, mtx.TxOut[0].PkScript)
+		assert.False(t, fee.IsZero())
+		assert.Equal(t, block15T2.TxOut[0].Value-fee.IntValue(), mtx.TxOut[0].Value)
+	}
+
+	// reserved for the transaction
+	if _, _, err = w.CreateStakingWithdrawTransaction(nil, "", massutil.ZeroAmount()); err != ErrNothingToWithdraw {
+		t.Fatalf("expected ErrNothingToWithdraw, got %v", err)
+	}
+}
+
+func TestWalletManager_CreateBindingWithdrawTransaction(t *testing.T) {
+	databaseDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("new databaseDb error")
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testBindingWithdraw")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{databaseDb}, walletDb, cfg, &config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	walletId, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = w.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	holder, err := w.NewAddress(0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+
+	// bind the output of holder to a poc address
+	holderAddr, err := massutil.DecodeAddress(holder, w.chainParams)
+	if err != nil {
+		t.Fatal("decode addr error", err.Error())
+	}
+	pocAddr, err := massutil.NewAddressPubKeyHash(wire.DoubleHashB([]byte("poc"))[:20], w.chainParams)
+	if err != nil {
+		t.Fatal("new poc addr error", err.Error())
+	}
+	bindingScript, err := txscript.PayToBindingScriptHashScript(holderAddr.ScriptAddress(), pocAddr.ScriptAddress())
+	if err != nil {
+		t.Fatal("get pkscript error", err.Error())
+	}
+	block15T2.TxOut[0].PkScript = bindingScript
+	newBlock15T2Hash := block15T2.TxHash()
+	var blk15 *massutil.Block
+	for i := 1; i <= int(block15Meta.Height); i++ {
+		// serialize anew, the longer binding script moves the tx locations
+		blk := massutil.NewBlock(blks200[i].MsgBlock())
+		blk.SetHeight(blks200[i].Height())
+		blk15 = blk
+		for _, tx := range blk.MsgBlock().Transactions {
+			for _, txin := range tx.TxIn {
+				if bytes.Equal(txin.PreviousOutPoint.Hash[:], b15T2Hash[:]) {
+					txin.PreviousOutPoint.Hash = newBlock15T2Hash
+				}
+			}
+		}
+		err = databaseDb.SubmitBlock(blk)
+		if err != nil {
+			t.Fatal("init db error:", err)
+		}
+		databaseDb.(*ldb.ChainDb).Batch(1).Set(blk.MsgBlock().BlockHash())
+		databaseDb.(*ldb.ChainDb).Batch(1).Done()
+		err = databaseDb.Commit(blk.MsgBlock().BlockHash())
+		if err != nil {
+			t.Fatal("init db error:", err)
+		}
+	}
+	defer func() {
+		block15T2.TxOut[0].PkScript = b15T2O0PkScript
+		for i := 1; i <= int(block15Meta.Height); i++ {
+			for _, tx := range blks200[i].MsgBlock().Transactions {
+				for _, txin := range tx.TxIn {
+					if bytes.Equal(txin.PreviousOutPoint.Hash[:], newBlock15T2Hash[:]) {
+						txin.PreviousOutPoint.Hash = b15T2Hash
+					}
+				}
+			}
+		}
+	}()
+
+	rec, err := txmgr.NewTxRecordFromMsgTx(block15T2, block15.Header.Timestamp)
+	if err != nil {
+		t.Fatal("get txRecord error", err.Error())
+	}
+	if rec, err = simpleFilterTx(rec, block15T2, walletId); err != nil {
+		t.Fatal("filter tx error", err.Error())
+	}
+	if block15Meta.Loc, err = w.chainFetcher.FetchBlockLocByHeight(block15Meta.Height); err != nil {
+		t.Fatal("FetchBlockLocByHeight error:", err)
+	}
+	txLocs, err := blk15.TxLoc()
+	if err != nil {
+		t.Fatal("TxLoc error", err)
+	}
+	rec.TxLoc = &txLocs[2]
+	allBalances := map[string]massutil.Amount{walletId: massutil.ZeroAmount()}
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		if err := w.txStore.AddRelevantTx(tx, allBalances, rec, block15Meta); err != nil {
+			return err
+		}
+		syncedTo, err := w.syncStore.SyncedTo(tx)
+		if err != nil {
+			return err
+		}
+		for h := syncedTo.Height + 1; h <= block15Meta.Height; h++ {
+			if err = w.syncStore.SetSyncedTo(tx, &txmgr.BlockMeta{Height: h, Hash: *blks200[h].Hash()}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decodeTx := func(mtxHex string) *wire.MsgTx {
+		serializedTx, err := decodeHexStr(mtxHex)
+		if err != nil {
+			t.Fatal("decode hexStr error", err.Error())
+		}
+		var mtx wire.MsgTx
+		if err = mtx.SetBytes(serializedTx, wire.Packet); err != nil {
+			t.Fatal("deserialize tx error", err.Error())
+		}
+		return &mtx
+	}
+	bindingValue := block15T2.TxOut[0].Value
+	holderScript, err := PayToWitnessV0Address(holder, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// no binding to the other poc address
+	other, err := massutil.NewAddressPubKeyHash(wire.DoubleHashB([]byte("other"))[:20], w.chainParams)
+	if err != nil {
+		t.Fatal("new poc addr error", err.Error())
+	}
+	if _, _, err = w.CreateBindingWithdrawTransaction(holder, other.EncodeAddress(), massutil.ZeroAmount(), "", massutil.ZeroAmount()); err != ErrNothingToWithdraw {
+		t.Fatalf("expected ErrNothingToWithdraw, got %v", err)
+	}
+
+	// partial unbind, the rest is bound again to the poc address
+	amount, err := massutil.NewAmountFromInt(bindingValue / 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mtxHex, fee, err := w.CreateBindingWithdrawTransaction(holder, pocAddr.EncodeAddress(), amount, "", massutil.ZeroAmount())
+	if err != nil {
+		t.Fatal("CreateBindingWithdrawTransaction error", err.Error())
+	}
+	mtx := decodeTx(mtxHex)
+	if assert.Equal(t, 1, len(mtx.TxIn)) {
+		assert.Equal(t, wire.OutPoint{Hash: newBlock15T2Hash, Index: 0}, mtx.TxIn[0].PreviousOutPoint)
+	}
+	if assert.Equal(t, 2, len(mtx.TxOut)) {
+		assert.Equal(t, bindingScript, mtx.TxOut[0].PkScript)
+		assert.Equal(t, bindingValue-amount.IntValue(), mtx.TxOut[0].Value)
+		assert.Equal(t, holderScript, mtx.TxOut[1].PkScript)
+		assert.False(t, fee.IsZero())
+		assert.Equal(t, amount.IntValue()-fee.IntValue(), mtx.TxOut[1].Value)
+	}
+
+	// reserved for the transaction
+	if _, _, err = w.CreateBindingWithdrawTransaction(holder, "", massutil.ZeroAmount(), "", massutil.ZeroAmount()); err != ErrNothingToWithdraw {
+		t.Fatalf("expected ErrNothingToWithdraw, got %v", err)
+	}
+	w.ClearUsedUTXOMark(mtx)
+
+	// full unbind to another address, paying the fee given
+	to, err := w.NewAddress(0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	toScript, err := PayToWitnessV0Address(to, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userTxFee, err := massutil.NewAmountFromInt(1e6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mtxHex, fee, err = w.CreateBindingWithdrawTransaction("", "", massutil.ZeroAmount(), to, userTxFee)
+	if err != nil {
+		t.Fatal("CreateBindingWithdrawTransaction error", err.Error())
+	}
+	mtx = decodeTx(mtxHex)
+	assert.Equal(t, userTxFee, fee)
+	if assert.Equal(t, 1, len(mtx.TxIn)) {
+		assert.Equal(t, wire.OutPoint{Hash: newBlock15T2Hash, Index: 0}, mtx.TxIn[0].PreviousOutPoint)
+	}
+	if assert.Equal(t, 1, len(mtx.TxOut)) {
+		assert.Equal(t, toScript, mtx.TxOut[0].PkScript)
+		assert.Equal(t, bindingValue-userTxFee.IntValue(), mtx.TxOut[0].Value)
+	}
+}
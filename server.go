@@ -62,6 +62,10 @@ func (s *server) Stop() error {
 
 	s.syncManager.Stop()
 
+	if err := s.chain.FeeEstimator().Save(); err != nil {
+		logging.CPrint(logging.WARN, "failed to save fee estimates", logging.LogFormat{"err": err})
+	}
+
 	// Signal the remaining goroutines to quit.
 	close(s.quit)
 